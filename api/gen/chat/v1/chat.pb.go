@@ -289,6 +289,135 @@ func (x *Room) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+// ====================================================================
+// Chat Settings Messages
+// ====================================================================
+type ChatSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only friends may start a room with the user. Defaults to false.
+	DmsFromFriendsOnly bool `protobuf:"varint,1,opt,name=dms_from_friends_only,json=dmsFromFriendsOnly,proto3" json:"dms_from_friends_only,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ChatSettings) Reset() {
+	*x = ChatSettings{}
+	mi := &file_chat_v1_chat_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatSettings) ProtoMessage() {}
+
+func (x *ChatSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatSettings.ProtoReflect.Descriptor instead.
+func (*ChatSettings) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ChatSettings) GetDmsFromFriendsOnly() bool {
+	if x != nil {
+		return x.DmsFromFriendsOnly
+	}
+	return false
+}
+
+type GetChatSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChatSettingsRequest) Reset() {
+	*x = GetChatSettingsRequest{}
+	mi := &file_chat_v1_chat_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChatSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChatSettingsRequest) ProtoMessage() {}
+
+func (x *GetChatSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChatSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetChatSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{6}
+}
+
+type UpdateChatSettingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only friends may start a room with the caller.
+	DmsFromFriendsOnly *bool `protobuf:"varint,1,opt,name=dms_from_friends_only,json=dmsFromFriendsOnly,proto3,oneof" json:"dms_from_friends_only,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateChatSettingsRequest) Reset() {
+	*x = UpdateChatSettingsRequest{}
+	mi := &file_chat_v1_chat_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateChatSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChatSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateChatSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChatSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateChatSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateChatSettingsRequest) GetDmsFromFriendsOnly() bool {
+	if x != nil && x.DmsFromFriendsOnly != nil {
+		return *x.DmsFromFriendsOnly
+	}
+	return false
+}
+
 // ====================================================================
 // Messaging Messages
 // ====================================================================
@@ -306,7 +435,7 @@ type SendMessageRequest struct {
 
 func (x *SendMessageRequest) Reset() {
 	*x = SendMessageRequest{}
-	mi := &file_chat_v1_chat_proto_msgTypes[5]
+	mi := &file_chat_v1_chat_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -318,7 +447,7 @@ func (x *SendMessageRequest) String() string {
 func (*SendMessageRequest) ProtoMessage() {}
 
 func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[5]
+	mi := &file_chat_v1_chat_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -331,7 +460,7 @@ func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
 func (*SendMessageRequest) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{5}
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SendMessageRequest) GetRoomId() string {
@@ -365,7 +494,7 @@ type SendMessageResponse struct {
 
 func (x *SendMessageResponse) Reset() {
 	*x = SendMessageResponse{}
-	mi := &file_chat_v1_chat_proto_msgTypes[6]
+	mi := &file_chat_v1_chat_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -377,7 +506,7 @@ func (x *SendMessageResponse) String() string {
 func (*SendMessageResponse) ProtoMessage() {}
 
 func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[6]
+	mi := &file_chat_v1_chat_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -390,7 +519,7 @@ func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendMessageResponse.ProtoReflect.Descriptor instead.
 func (*SendMessageResponse) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{6}
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SendMessageResponse) GetMessage() *ChatMessage {
@@ -414,7 +543,7 @@ type GetMessagesRequest struct {
 
 func (x *GetMessagesRequest) Reset() {
 	*x = GetMessagesRequest{}
-	mi := &file_chat_v1_chat_proto_msgTypes[7]
+	mi := &file_chat_v1_chat_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -426,7 +555,7 @@ func (x *GetMessagesRequest) String() string {
 func (*GetMessagesRequest) ProtoMessage() {}
 
 func (x *GetMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[7]
+	mi := &file_chat_v1_chat_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -439,7 +568,7 @@ func (x *GetMessagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMessagesRequest.ProtoReflect.Descriptor instead.
 func (*GetMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{7}
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetMessagesRequest) GetRoomId() string {
@@ -473,7 +602,7 @@ type GetMessagesResponse struct {
 
 func (x *GetMessagesResponse) Reset() {
 	*x = GetMessagesResponse{}
-	mi := &file_chat_v1_chat_proto_msgTypes[8]
+	mi := &file_chat_v1_chat_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -485,7 +614,7 @@ func (x *GetMessagesResponse) String() string {
 func (*GetMessagesResponse) ProtoMessage() {}
 
 func (x *GetMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[8]
+	mi := &file_chat_v1_chat_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -498,7 +627,7 @@ func (x *GetMessagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMessagesResponse.ProtoReflect.Descriptor instead.
 func (*GetMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{8}
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetMessagesResponse) GetMessages() []*ChatMessage {
@@ -518,7 +647,7 @@ type StreamMessagesRequest struct {
 
 func (x *StreamMessagesRequest) Reset() {
 	*x = StreamMessagesRequest{}
-	mi := &file_chat_v1_chat_proto_msgTypes[9]
+	mi := &file_chat_v1_chat_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -530,7 +659,7 @@ func (x *StreamMessagesRequest) String() string {
 func (*StreamMessagesRequest) ProtoMessage() {}
 
 func (x *StreamMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[9]
+	mi := &file_chat_v1_chat_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -543,7 +672,7 @@ func (x *StreamMessagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamMessagesRequest.ProtoReflect.Descriptor instead.
 func (*StreamMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{9}
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *StreamMessagesRequest) GetRoomId() string {
@@ -571,7 +700,7 @@ type ChatMessage struct {
 
 func (x *ChatMessage) Reset() {
 	*x = ChatMessage{}
-	mi := &file_chat_v1_chat_proto_msgTypes[10]
+	mi := &file_chat_v1_chat_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -583,7 +712,7 @@ func (x *ChatMessage) String() string {
 func (*ChatMessage) ProtoMessage() {}
 
 func (x *ChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[10]
+	mi := &file_chat_v1_chat_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -596,7 +725,7 @@ func (x *ChatMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
 func (*ChatMessage) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{10}
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ChatMessage) GetId() string {
@@ -634,6 +763,108 @@ func (x *ChatMessage) GetTimestamp() *timestamppb.Timestamp {
 	return nil
 }
 
+// ====================================================================
+// Internal Messages
+// ====================================================================
+type ExportUserChatDataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The user whose chat data is exported
+	UserId        int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserChatDataRequest) Reset() {
+	*x = ExportUserChatDataRequest{}
+	mi := &file_chat_v1_chat_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserChatDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserChatDataRequest) ProtoMessage() {}
+
+func (x *ExportUserChatDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserChatDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserChatDataRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExportUserChatDataRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ExportUserChatDataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Rooms the user belongs to
+	Rooms []*Room `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	// Messages the user sent, oldest first
+	Messages      []*ChatMessage `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserChatDataResponse) Reset() {
+	*x = ExportUserChatDataResponse{}
+	mi := &file_chat_v1_chat_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserChatDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserChatDataResponse) ProtoMessage() {}
+
+func (x *ExportUserChatDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserChatDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportUserChatDataResponse) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExportUserChatDataResponse) GetRooms() []*Room {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+func (x *ExportUserChatDataResponse) GetMessages() []*ChatMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
 var File_chat_v1_chat_proto protoreflect.FileDescriptor
 
 const file_chat_v1_chat_proto_rawDesc = "" +
@@ -653,7 +884,13 @@ const file_chat_v1_chat_proto_rawDesc = "" +
 	"\finitiator_id\x18\x02 \x01(\x03B\x03\xe0A\x03R\vinitiatorId\x12*\n" +
 	"\x0eparticipant_id\x18\x03 \x01(\x03B\x03\xe0A\x03R\rparticipantId\x12>\n" +
 	"\n" +
-	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tcreatedAt\"\x85\x01\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tcreatedAt\"A\n" +
+	"\fChatSettings\x121\n" +
+	"\x15dms_from_friends_only\x18\x01 \x01(\bR\x12dmsFromFriendsOnly\"\x18\n" +
+	"\x16GetChatSettingsRequest\"m\n" +
+	"\x19UpdateChatSettingsRequest\x126\n" +
+	"\x15dms_from_friends_only\x18\x01 \x01(\bH\x00R\x12dmsFromFriendsOnly\x88\x01\x01B\x18\n" +
+	"\x16_dms_from_friends_only\"\x85\x01\n" +
 	"\x12SendMessageRequest\x12$\n" +
 	"\aroom_id\x18\x01 \x01(\tB\v\xe0A\x02\xfaB\x05r\x03\xb0\x01\x01R\x06roomId\x12 \n" +
 	"\tsender_id\x18\x02 \x01(\x03B\x03\xe0A\x02R\bsenderId\x12'\n" +
@@ -673,7 +910,13 @@ const file_chat_v1_chat_proto_rawDesc = "" +
 	"\aroom_id\x18\x02 \x01(\tR\x06roomId\x12\x1b\n" +
 	"\tsender_id\x18\x03 \x01(\x03R\bsenderId\x12\x18\n" +
 	"\acontent\x18\x04 \x01(\tR\acontent\x128\n" +
-	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\x86\b\n" +
+	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"@\n" +
+	"\x19ExportUserChatDataRequest\x12#\n" +
+	"\auser_id\x18\x01 \x01(\x03B\n" +
+	"\xe0A\x02\xfaB\x04\"\x02 \x00R\x06userId\"s\n" +
+	"\x1aExportUserChatDataResponse\x12#\n" +
+	"\x05rooms\x18\x01 \x03(\v2\r.chat.v1.RoomR\x05rooms\x120\n" +
+	"\bmessages\x18\x02 \x03(\v2\x14.chat.v1.ChatMessageR\bmessages2\x86\b\n" +
 	"\vChatService\x12\xc5\x01\n" +
 	"\n" +
 	"CreateRoom\x12\x1a.chat.v1.CreateRoomRequest\x1a\x1b.chat.v1.CreateRoomResponse\"~\x92Ag\n" +
@@ -685,7 +928,14 @@ const file_chat_v1_chat_proto_rawDesc = "" +
 	"\vGetMessages\x12\x1b.chat.v1.GetMessagesRequest\x1a\x1c.chat.v1.GetMessagesResponse\"\x86\x01\x92A_\n" +
 	"\tMessaging\x12\rList Messages\x1aCRetrieves past messages in the specified chat room with pagination.\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/rooms/{room_id}/messages\x12\xa8\x01\n" +
 	"\x0eStreamMessages\x12\x1e.chat.v1.StreamMessagesRequest\x1a\x14.chat.v1.ChatMessage\"^\x92A[\n" +
-	"\tMessaging\x12\x0fStream Messages\x1a=Streams live messages from the specified chat room over gRPC.0\x01\x1a%\x92A\"\x12 Manages chat rooms and messagingBBZ@github.com/mamataliev-dev/social-platform/api/gen/chat/v1/chatpbb\x06proto3"
+	"\tMessaging\x12\x0fStream Messages\x1a=Streams live messages from the specified chat room over gRPC.0\x01\x1a%\x92A\"\x12 Manages chat rooms and messaging2\xc1\x03\n" +
+	"\x13ChatSettingsService\x12\xb7\x01\n" +
+	"\x0fGetChatSettings\x12\x1f.chat.v1.GetChatSettingsRequest\x1a\x15.chat.v1.ChatSettings\"l\x92AG\n" +
+	"\rChat Settings\x12\x11Get Chat Settings\x1a#Returns the caller's chat settings.\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/users/me/chat-settings\x12\xc3\x01\n" +
+	"\x12UpdateChatSettings\x12\".chat.v1.UpdateChatSettingsRequest\x1a\x15.chat.v1.ChatSettings\"r\x92AJ\n" +
+	"\rChat Settings\x12\x14Update Chat Settings\x1a#Changes the caller's chat settings.\x82\xd3\xe4\x93\x02\x1f:\x01*2\x1a/v1/users/me/chat-settings\x1a*\x92A'\x12%Manages the caller's chat preferences2t\n" +
+	"\x13InternalChatService\x12]\n" +
+	"\x12ExportUserChatData\x12\".chat.v1.ExportUserChatDataRequest\x1a#.chat.v1.ExportUserChatDataResponseBBZ@github.com/mamataliev-dev/social-platform/api/gen/chat/v1/chatpbb\x06proto3"
 
 var (
 	file_chat_v1_chat_proto_rawDescOnce sync.Once
@@ -699,43 +949,56 @@ func file_chat_v1_chat_proto_rawDescGZIP() []byte {
 	return file_chat_v1_chat_proto_rawDescData
 }
 
-var file_chat_v1_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_chat_v1_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_chat_v1_chat_proto_goTypes = []any{
-	(*CreateRoomRequest)(nil),     // 0: chat.v1.CreateRoomRequest
-	(*CreateRoomResponse)(nil),    // 1: chat.v1.CreateRoomResponse
-	(*GetUserRoomsRequest)(nil),   // 2: chat.v1.GetUserRoomsRequest
-	(*GetUserRoomsResponse)(nil),  // 3: chat.v1.GetUserRoomsResponse
-	(*Room)(nil),                  // 4: chat.v1.Room
-	(*SendMessageRequest)(nil),    // 5: chat.v1.SendMessageRequest
-	(*SendMessageResponse)(nil),   // 6: chat.v1.SendMessageResponse
-	(*GetMessagesRequest)(nil),    // 7: chat.v1.GetMessagesRequest
-	(*GetMessagesResponse)(nil),   // 8: chat.v1.GetMessagesResponse
-	(*StreamMessagesRequest)(nil), // 9: chat.v1.StreamMessagesRequest
-	(*ChatMessage)(nil),           // 10: chat.v1.ChatMessage
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(*CreateRoomRequest)(nil),          // 0: chat.v1.CreateRoomRequest
+	(*CreateRoomResponse)(nil),         // 1: chat.v1.CreateRoomResponse
+	(*GetUserRoomsRequest)(nil),        // 2: chat.v1.GetUserRoomsRequest
+	(*GetUserRoomsResponse)(nil),       // 3: chat.v1.GetUserRoomsResponse
+	(*Room)(nil),                       // 4: chat.v1.Room
+	(*ChatSettings)(nil),               // 5: chat.v1.ChatSettings
+	(*GetChatSettingsRequest)(nil),     // 6: chat.v1.GetChatSettingsRequest
+	(*UpdateChatSettingsRequest)(nil),  // 7: chat.v1.UpdateChatSettingsRequest
+	(*SendMessageRequest)(nil),         // 8: chat.v1.SendMessageRequest
+	(*SendMessageResponse)(nil),        // 9: chat.v1.SendMessageResponse
+	(*GetMessagesRequest)(nil),         // 10: chat.v1.GetMessagesRequest
+	(*GetMessagesResponse)(nil),        // 11: chat.v1.GetMessagesResponse
+	(*StreamMessagesRequest)(nil),      // 12: chat.v1.StreamMessagesRequest
+	(*ChatMessage)(nil),                // 13: chat.v1.ChatMessage
+	(*ExportUserChatDataRequest)(nil),  // 14: chat.v1.ExportUserChatDataRequest
+	(*ExportUserChatDataResponse)(nil), // 15: chat.v1.ExportUserChatDataResponse
+	(*timestamppb.Timestamp)(nil),      // 16: google.protobuf.Timestamp
 }
 var file_chat_v1_chat_proto_depIdxs = []int32{
 	4,  // 0: chat.v1.CreateRoomResponse.room:type_name -> chat.v1.Room
 	4,  // 1: chat.v1.GetUserRoomsResponse.rooms:type_name -> chat.v1.Room
-	11, // 2: chat.v1.Room.created_at:type_name -> google.protobuf.Timestamp
-	10, // 3: chat.v1.SendMessageResponse.message:type_name -> chat.v1.ChatMessage
-	10, // 4: chat.v1.GetMessagesResponse.messages:type_name -> chat.v1.ChatMessage
-	11, // 5: chat.v1.ChatMessage.timestamp:type_name -> google.protobuf.Timestamp
-	0,  // 6: chat.v1.ChatService.CreateRoom:input_type -> chat.v1.CreateRoomRequest
-	2,  // 7: chat.v1.ChatService.GetUserRooms:input_type -> chat.v1.GetUserRoomsRequest
-	5,  // 8: chat.v1.ChatService.SendMessage:input_type -> chat.v1.SendMessageRequest
-	7,  // 9: chat.v1.ChatService.GetMessages:input_type -> chat.v1.GetMessagesRequest
-	9,  // 10: chat.v1.ChatService.StreamMessages:input_type -> chat.v1.StreamMessagesRequest
-	1,  // 11: chat.v1.ChatService.CreateRoom:output_type -> chat.v1.CreateRoomResponse
-	3,  // 12: chat.v1.ChatService.GetUserRooms:output_type -> chat.v1.GetUserRoomsResponse
-	6,  // 13: chat.v1.ChatService.SendMessage:output_type -> chat.v1.SendMessageResponse
-	8,  // 14: chat.v1.ChatService.GetMessages:output_type -> chat.v1.GetMessagesResponse
-	10, // 15: chat.v1.ChatService.StreamMessages:output_type -> chat.v1.ChatMessage
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	16, // 2: chat.v1.Room.created_at:type_name -> google.protobuf.Timestamp
+	13, // 3: chat.v1.SendMessageResponse.message:type_name -> chat.v1.ChatMessage
+	13, // 4: chat.v1.GetMessagesResponse.messages:type_name -> chat.v1.ChatMessage
+	16, // 5: chat.v1.ChatMessage.timestamp:type_name -> google.protobuf.Timestamp
+	4,  // 6: chat.v1.ExportUserChatDataResponse.rooms:type_name -> chat.v1.Room
+	13, // 7: chat.v1.ExportUserChatDataResponse.messages:type_name -> chat.v1.ChatMessage
+	0,  // 8: chat.v1.ChatService.CreateRoom:input_type -> chat.v1.CreateRoomRequest
+	2,  // 9: chat.v1.ChatService.GetUserRooms:input_type -> chat.v1.GetUserRoomsRequest
+	8,  // 10: chat.v1.ChatService.SendMessage:input_type -> chat.v1.SendMessageRequest
+	10, // 11: chat.v1.ChatService.GetMessages:input_type -> chat.v1.GetMessagesRequest
+	12, // 12: chat.v1.ChatService.StreamMessages:input_type -> chat.v1.StreamMessagesRequest
+	6,  // 13: chat.v1.ChatSettingsService.GetChatSettings:input_type -> chat.v1.GetChatSettingsRequest
+	7,  // 14: chat.v1.ChatSettingsService.UpdateChatSettings:input_type -> chat.v1.UpdateChatSettingsRequest
+	14, // 15: chat.v1.InternalChatService.ExportUserChatData:input_type -> chat.v1.ExportUserChatDataRequest
+	1,  // 16: chat.v1.ChatService.CreateRoom:output_type -> chat.v1.CreateRoomResponse
+	3,  // 17: chat.v1.ChatService.GetUserRooms:output_type -> chat.v1.GetUserRoomsResponse
+	9,  // 18: chat.v1.ChatService.SendMessage:output_type -> chat.v1.SendMessageResponse
+	11, // 19: chat.v1.ChatService.GetMessages:output_type -> chat.v1.GetMessagesResponse
+	13, // 20: chat.v1.ChatService.StreamMessages:output_type -> chat.v1.ChatMessage
+	5,  // 21: chat.v1.ChatSettingsService.GetChatSettings:output_type -> chat.v1.ChatSettings
+	5,  // 22: chat.v1.ChatSettingsService.UpdateChatSettings:output_type -> chat.v1.ChatSettings
+	15, // 23: chat.v1.InternalChatService.ExportUserChatData:output_type -> chat.v1.ExportUserChatDataResponse
+	16, // [16:24] is the sub-list for method output_type
+	8,  // [8:16] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_chat_v1_chat_proto_init() }
@@ -743,15 +1006,16 @@ func file_chat_v1_chat_proto_init() {
 	if File_chat_v1_chat_proto != nil {
 		return
 	}
+	file_chat_v1_chat_proto_msgTypes[7].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_chat_v1_chat_proto_rawDesc), len(file_chat_v1_chat_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   16,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   3,
 		},
 		GoTypes:           file_chat_v1_chat_proto_goTypes,
 		DependencyIndexes: file_chat_v1_chat_proto_depIdxs,
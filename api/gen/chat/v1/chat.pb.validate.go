@@ -684,6 +684,317 @@ var _ interface {
 	ErrorName() string
 } = RoomValidationError{}
 
+// Validate checks the field values on ChatSettings with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ChatSettings) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChatSettings with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ChatSettingsMultiError, or
+// nil if none found.
+func (m *ChatSettings) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChatSettings) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DmsFromFriendsOnly
+
+	if len(errors) > 0 {
+		return ChatSettingsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChatSettingsMultiError is an error wrapping multiple validation errors
+// returned by ChatSettings.ValidateAll() if the designated constraints aren't met.
+type ChatSettingsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChatSettingsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChatSettingsMultiError) AllErrors() []error { return m }
+
+// ChatSettingsValidationError is the validation error returned by
+// ChatSettings.Validate if the designated constraints aren't met.
+type ChatSettingsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChatSettingsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChatSettingsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChatSettingsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChatSettingsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChatSettingsValidationError) ErrorName() string { return "ChatSettingsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChatSettingsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChatSettings.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChatSettingsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChatSettingsValidationError{}
+
+// Validate checks the field values on GetChatSettingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetChatSettingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetChatSettingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetChatSettingsRequestMultiError, or nil if none found.
+func (m *GetChatSettingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetChatSettingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetChatSettingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetChatSettingsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetChatSettingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetChatSettingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetChatSettingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetChatSettingsRequestMultiError) AllErrors() []error { return m }
+
+// GetChatSettingsRequestValidationError is the validation error returned by
+// GetChatSettingsRequest.Validate if the designated constraints aren't met.
+type GetChatSettingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetChatSettingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetChatSettingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetChatSettingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetChatSettingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetChatSettingsRequestValidationError) ErrorName() string {
+	return "GetChatSettingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetChatSettingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetChatSettingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetChatSettingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetChatSettingsRequestValidationError{}
+
+// Validate checks the field values on UpdateChatSettingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateChatSettingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateChatSettingsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateChatSettingsRequestMultiError, or nil if none found.
+func (m *UpdateChatSettingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateChatSettingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.DmsFromFriendsOnly != nil {
+
+		// no validation rules for DmsFromFriendsOnly
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateChatSettingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateChatSettingsRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateChatSettingsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateChatSettingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateChatSettingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateChatSettingsRequestMultiError) AllErrors() []error { return m }
+
+// UpdateChatSettingsRequestValidationError is the validation error returned by
+// UpdateChatSettingsRequest.Validate if the designated constraints aren't met.
+type UpdateChatSettingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateChatSettingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateChatSettingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateChatSettingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateChatSettingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateChatSettingsRequestValidationError) ErrorName() string {
+	return "UpdateChatSettingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateChatSettingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateChatSettingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateChatSettingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateChatSettingsRequestValidationError{}
+
 // Validate checks the field values on SendMessageRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1487,3 +1798,286 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ChatMessageValidationError{}
+
+// Validate checks the field values on ExportUserChatDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportUserChatDataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportUserChatDataRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportUserChatDataRequestMultiError, or nil if none found.
+func (m *ExportUserChatDataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportUserChatDataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := ExportUserChatDataRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExportUserChatDataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportUserChatDataRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportUserChatDataRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ExportUserChatDataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportUserChatDataRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportUserChatDataRequestMultiError) AllErrors() []error { return m }
+
+// ExportUserChatDataRequestValidationError is the validation error returned by
+// ExportUserChatDataRequest.Validate if the designated constraints aren't met.
+type ExportUserChatDataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportUserChatDataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportUserChatDataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportUserChatDataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportUserChatDataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportUserChatDataRequestValidationError) ErrorName() string {
+	return "ExportUserChatDataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportUserChatDataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportUserChatDataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportUserChatDataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportUserChatDataRequestValidationError{}
+
+// Validate checks the field values on ExportUserChatDataResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportUserChatDataResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportUserChatDataResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportUserChatDataResponseMultiError, or nil if none found.
+func (m *ExportUserChatDataResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportUserChatDataResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRooms() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExportUserChatDataResponseValidationError{
+						field:  fmt.Sprintf("Rooms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExportUserChatDataResponseValidationError{
+						field:  fmt.Sprintf("Rooms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportUserChatDataResponseValidationError{
+					field:  fmt.Sprintf("Rooms[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExportUserChatDataResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExportUserChatDataResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportUserChatDataResponseValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ExportUserChatDataResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportUserChatDataResponseMultiError is an error wrapping multiple
+// validation errors returned by ExportUserChatDataResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ExportUserChatDataResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportUserChatDataResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportUserChatDataResponseMultiError) AllErrors() []error { return m }
+
+// ExportUserChatDataResponseValidationError is the validation error returned
+// by ExportUserChatDataResponse.Validate if the designated constraints aren't met.
+type ExportUserChatDataResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportUserChatDataResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportUserChatDataResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportUserChatDataResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportUserChatDataResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportUserChatDataResponseValidationError) ErrorName() string {
+	return "ExportUserChatDataResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportUserChatDataResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportUserChatDataResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportUserChatDataResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportUserChatDataResponseValidationError{}
@@ -34,7 +34,9 @@ const (
 // ChatService: handles room creation and messaging
 // ====================================================================
 type ChatServiceClient interface {
-	// Creates or fetches a 1-on-1 room between two users.
+	// Creates or fetches a 1-on-1 room between two users. Fails with
+	// PermissionDenied if the participant only accepts DMs from friends and the
+	// initiator is not one of them.
 	CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*CreateRoomResponse, error)
 	// Gets all rooms for a user.
 	GetUserRooms(ctx context.Context, in *GetUserRoomsRequest, opts ...grpc.CallOption) (*GetUserRoomsResponse, error)
@@ -121,7 +123,9 @@ type ChatService_StreamMessagesClient = grpc.ServerStreamingClient[ChatMessage]
 // ChatService: handles room creation and messaging
 // ====================================================================
 type ChatServiceServer interface {
-	// Creates or fetches a 1-on-1 room between two users.
+	// Creates or fetches a 1-on-1 room between two users. Fails with
+	// PermissionDenied if the participant only accepts DMs from friends and the
+	// initiator is not one of them.
 	CreateRoom(context.Context, *CreateRoomRequest) (*CreateRoomResponse, error)
 	// Gets all rooms for a user.
 	GetUserRooms(context.Context, *GetUserRoomsRequest) (*GetUserRoomsResponse, error)
@@ -293,3 +297,269 @@ var ChatService_ServiceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "chat/v1/chat.proto",
 }
+
+const (
+	ChatSettingsService_GetChatSettings_FullMethodName    = "/chat.v1.ChatSettingsService/GetChatSettings"
+	ChatSettingsService_UpdateChatSettings_FullMethodName = "/chat.v1.ChatSettingsService/UpdateChatSettings"
+)
+
+// ChatSettingsServiceClient is the client API for ChatSettingsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ====================================================================
+// ChatSettingsService: per-user chat preferences
+// ====================================================================
+type ChatSettingsServiceClient interface {
+	// Returns the caller's chat settings.
+	GetChatSettings(ctx context.Context, in *GetChatSettingsRequest, opts ...grpc.CallOption) (*ChatSettings, error)
+	// Changes the caller's chat settings; unset fields keep their value.
+	UpdateChatSettings(ctx context.Context, in *UpdateChatSettingsRequest, opts ...grpc.CallOption) (*ChatSettings, error)
+}
+
+type chatSettingsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChatSettingsServiceClient(cc grpc.ClientConnInterface) ChatSettingsServiceClient {
+	return &chatSettingsServiceClient{cc}
+}
+
+func (c *chatSettingsServiceClient) GetChatSettings(ctx context.Context, in *GetChatSettingsRequest, opts ...grpc.CallOption) (*ChatSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatSettings)
+	err := c.cc.Invoke(ctx, ChatSettingsService_GetChatSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatSettingsServiceClient) UpdateChatSettings(ctx context.Context, in *UpdateChatSettingsRequest, opts ...grpc.CallOption) (*ChatSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatSettings)
+	err := c.cc.Invoke(ctx, ChatSettingsService_UpdateChatSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChatSettingsServiceServer is the server API for ChatSettingsService service.
+// All implementations must embed UnimplementedChatSettingsServiceServer
+// for forward compatibility.
+//
+// ====================================================================
+// ChatSettingsService: per-user chat preferences
+// ====================================================================
+type ChatSettingsServiceServer interface {
+	// Returns the caller's chat settings.
+	GetChatSettings(context.Context, *GetChatSettingsRequest) (*ChatSettings, error)
+	// Changes the caller's chat settings; unset fields keep their value.
+	UpdateChatSettings(context.Context, *UpdateChatSettingsRequest) (*ChatSettings, error)
+	mustEmbedUnimplementedChatSettingsServiceServer()
+}
+
+// UnimplementedChatSettingsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedChatSettingsServiceServer struct{}
+
+func (UnimplementedChatSettingsServiceServer) GetChatSettings(context.Context, *GetChatSettingsRequest) (*ChatSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChatSettings not implemented")
+}
+func (UnimplementedChatSettingsServiceServer) UpdateChatSettings(context.Context, *UpdateChatSettingsRequest) (*ChatSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChatSettings not implemented")
+}
+func (UnimplementedChatSettingsServiceServer) mustEmbedUnimplementedChatSettingsServiceServer() {}
+func (UnimplementedChatSettingsServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeChatSettingsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChatSettingsServiceServer will
+// result in compilation errors.
+type UnsafeChatSettingsServiceServer interface {
+	mustEmbedUnimplementedChatSettingsServiceServer()
+}
+
+func RegisterChatSettingsServiceServer(s grpc.ServiceRegistrar, srv ChatSettingsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedChatSettingsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ChatSettingsService_ServiceDesc, srv)
+}
+
+func _ChatSettingsService_GetChatSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChatSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatSettingsServiceServer).GetChatSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatSettingsService_GetChatSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatSettingsServiceServer).GetChatSettings(ctx, req.(*GetChatSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatSettingsService_UpdateChatSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChatSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatSettingsServiceServer).UpdateChatSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatSettingsService_UpdateChatSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatSettingsServiceServer).UpdateChatSettings(ctx, req.(*UpdateChatSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ChatSettingsService_ServiceDesc is the grpc.ServiceDesc for ChatSettingsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChatSettingsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "chat.v1.ChatSettingsService",
+	HandlerType: (*ChatSettingsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetChatSettings",
+			Handler:    _ChatSettingsService_GetChatSettings_Handler,
+		},
+		{
+			MethodName: "UpdateChatSettings",
+			Handler:    _ChatSettingsService_UpdateChatSettings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "chat/v1/chat.proto",
+}
+
+const (
+	InternalChatService_ExportUserChatData_FullMethodName = "/chat.v1.InternalChatService/ExportUserChatData"
+)
+
+// InternalChatServiceClient is the client API for InternalChatService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ====================================================================
+// InternalChatService: service-to-service access, not exposed publicly
+// ====================================================================
+type InternalChatServiceClient interface {
+	// Returns every room a user belongs to and every message they sent,
+	// for the user-service data export.
+	ExportUserChatData(ctx context.Context, in *ExportUserChatDataRequest, opts ...grpc.CallOption) (*ExportUserChatDataResponse, error)
+}
+
+type internalChatServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInternalChatServiceClient(cc grpc.ClientConnInterface) InternalChatServiceClient {
+	return &internalChatServiceClient{cc}
+}
+
+func (c *internalChatServiceClient) ExportUserChatData(ctx context.Context, in *ExportUserChatDataRequest, opts ...grpc.CallOption) (*ExportUserChatDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportUserChatDataResponse)
+	err := c.cc.Invoke(ctx, InternalChatService_ExportUserChatData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InternalChatServiceServer is the server API for InternalChatService service.
+// All implementations must embed UnimplementedInternalChatServiceServer
+// for forward compatibility.
+//
+// ====================================================================
+// InternalChatService: service-to-service access, not exposed publicly
+// ====================================================================
+type InternalChatServiceServer interface {
+	// Returns every room a user belongs to and every message they sent,
+	// for the user-service data export.
+	ExportUserChatData(context.Context, *ExportUserChatDataRequest) (*ExportUserChatDataResponse, error)
+	mustEmbedUnimplementedInternalChatServiceServer()
+}
+
+// UnimplementedInternalChatServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInternalChatServiceServer struct{}
+
+func (UnimplementedInternalChatServiceServer) ExportUserChatData(context.Context, *ExportUserChatDataRequest) (*ExportUserChatDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserChatData not implemented")
+}
+func (UnimplementedInternalChatServiceServer) mustEmbedUnimplementedInternalChatServiceServer() {}
+func (UnimplementedInternalChatServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeInternalChatServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InternalChatServiceServer will
+// result in compilation errors.
+type UnsafeInternalChatServiceServer interface {
+	mustEmbedUnimplementedInternalChatServiceServer()
+}
+
+func RegisterInternalChatServiceServer(s grpc.ServiceRegistrar, srv InternalChatServiceServer) {
+	// If the following call pancis, it indicates UnimplementedInternalChatServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&InternalChatService_ServiceDesc, srv)
+}
+
+func _InternalChatService_ExportUserChatData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserChatDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalChatServiceServer).ExportUserChatData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalChatService_ExportUserChatData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalChatServiceServer).ExportUserChatData(ctx, req.(*ExportUserChatDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InternalChatService_ServiceDesc is the grpc.ServiceDesc for InternalChatService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InternalChatService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "chat.v1.InternalChatService",
+	HandlerType: (*InternalChatServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportUserChatData",
+			Handler:    _InternalChatService_ExportUserChatData_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "chat/v1/chat.proto",
+}
@@ -25,6 +25,60 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ProfileFieldVisibility controls who, besides the owner, sees a profile
+// field in public responses. Trusted services always receive every field.
+type ProfileFieldVisibility int32
+
+const (
+	// Not set; in updates, keeps the current value.
+	ProfileFieldVisibility_PROFILE_FIELD_VISIBILITY_UNSPECIFIED ProfileFieldVisibility = 0
+	// Every signed-in user sees the field.
+	ProfileFieldVisibility_PROFILE_FIELD_VISIBILITY_EVERYONE ProfileFieldVisibility = 1
+	// Only the owner sees the field.
+	ProfileFieldVisibility_PROFILE_FIELD_VISIBILITY_ONLY_ME ProfileFieldVisibility = 2
+)
+
+// Enum value maps for ProfileFieldVisibility.
+var (
+	ProfileFieldVisibility_name = map[int32]string{
+		0: "PROFILE_FIELD_VISIBILITY_UNSPECIFIED",
+		1: "PROFILE_FIELD_VISIBILITY_EVERYONE",
+		2: "PROFILE_FIELD_VISIBILITY_ONLY_ME",
+	}
+	ProfileFieldVisibility_value = map[string]int32{
+		"PROFILE_FIELD_VISIBILITY_UNSPECIFIED": 0,
+		"PROFILE_FIELD_VISIBILITY_EVERYONE":    1,
+		"PROFILE_FIELD_VISIBILITY_ONLY_ME":     2,
+	}
+)
+
+func (x ProfileFieldVisibility) Enum() *ProfileFieldVisibility {
+	p := new(ProfileFieldVisibility)
+	*p = x
+	return p
+}
+
+func (x ProfileFieldVisibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProfileFieldVisibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_v1_user_proto_enumTypes[0].Descriptor()
+}
+
+func (ProfileFieldVisibility) Type() protoreflect.EnumType {
+	return &file_user_v1_user_proto_enumTypes[0]
+}
+
+func (x ProfileFieldVisibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProfileFieldVisibility.Descriptor instead.
+func (ProfileFieldVisibility) EnumDescriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{0}
+}
+
 // ---------------------------------------------------------------------
 // Resource definition for UserProfile
 // ---------------------------------------------------------------------
@@ -34,20 +88,27 @@ type UserProfile struct {
 	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	// The user’s chosen display name.
 	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	// The user’s email (publicly visible only if you choose).
+	// The user’s email. Hidden from other users unless the owner's privacy
+	// settings make it visible to everyone.
 	Email string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
 	// The unique nickname by which others look you up.
 	Nickname string `protobuf:"bytes,4,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	// Public biography.
+	// Biography; subject to the owner's privacy settings.
 	Bio string `protobuf:"bytes,5,opt,name=bio,proto3" json:"bio,omitempty"`
 	// URL to the avatar image.
 	AvatarUrl string `protobuf:"bytes,6,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
-	// Timestamps are always OUTPUT_ONLY on a read‐only API.
-	LastLogin     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_login,json=lastLogin,proto3" json:"last_login,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Timestamps are always OUTPUT_ONLY on a read‐only API. last_login is
+	// subject to the owner's privacy settings.
+	LastLogin *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_login,json=lastLogin,proto3" json:"last_login,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Number of followers and of followed users.
+	FollowerCount  int64 `protobuf:"varint,10,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
+	FollowingCount int64 `protobuf:"varint,11,opt,name=following_count,json=followingCount,proto3" json:"following_count,omitempty"`
+	// Whether following this user requires their approval.
+	PrivateAccount bool `protobuf:"varint,12,opt,name=private_account,json=privateAccount,proto3" json:"private_account,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UserProfile) Reset() {
@@ -143,6 +204,27 @@ func (x *UserProfile) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *UserProfile) GetFollowerCount() int64 {
+	if x != nil {
+		return x.FollowerCount
+	}
+	return 0
+}
+
+func (x *UserProfile) GetFollowingCount() int64 {
+	if x != nil {
+		return x.FollowingCount
+	}
+	return 0
+}
+
+func (x *UserProfile) GetPrivateAccount() bool {
+	if x != nil {
+		return x.PrivateAccount
+	}
+	return false
+}
+
 // ---------------------------------------------------------------------
 // Request messages
 // ---------------------------------------------------------------------
@@ -191,6 +273,304 @@ func (x *FetchUserProfileByNicknameRequest) GetNickname() string {
 	return ""
 }
 
+type SearchUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Text to match against nicknames and usernames, case-insensitively.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Maximum number of users to return; defaults to 20.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page, for the same query.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersRequest) Reset() {
+	*x = SearchUsersRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersRequest) ProtoMessage() {}
+
+func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchUsersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchUsersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Matching users, best match first.
+	Users []*UserProfile `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// Token for the next page; empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersResponse) Reset() {
+	*x = SearchUsersResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersResponse) ProtoMessage() {}
+
+func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
+func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchUsersResponse) GetUsers() []*UserProfile {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *SearchUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetPrivacySettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrivacySettingsRequest) Reset() {
+	*x = GetPrivacySettingsRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrivacySettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivacySettingsRequest) ProtoMessage() {}
+
+func (x *GetPrivacySettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrivacySettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetPrivacySettingsRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{4}
+}
+
+type UpdatePrivacySettingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Who may see the caller's email.
+	Email ProfileFieldVisibility `protobuf:"varint,1,opt,name=email,proto3,enum=user.v1.ProfileFieldVisibility" json:"email,omitempty"`
+	// Who may see when the caller last logged in.
+	LastLogin ProfileFieldVisibility `protobuf:"varint,2,opt,name=last_login,json=lastLogin,proto3,enum=user.v1.ProfileFieldVisibility" json:"last_login,omitempty"`
+	// Who may see the caller's bio.
+	Bio ProfileFieldVisibility `protobuf:"varint,3,opt,name=bio,proto3,enum=user.v1.ProfileFieldVisibility" json:"bio,omitempty"`
+	// Whether new followers need the caller's approval. Unset keeps the
+	// current value.
+	PrivateAccount *bool `protobuf:"varint,4,opt,name=private_account,json=privateAccount,proto3,oneof" json:"private_account,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdatePrivacySettingsRequest) Reset() {
+	*x = UpdatePrivacySettingsRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePrivacySettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePrivacySettingsRequest) ProtoMessage() {}
+
+func (x *UpdatePrivacySettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePrivacySettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePrivacySettingsRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdatePrivacySettingsRequest) GetEmail() ProfileFieldVisibility {
+	if x != nil {
+		return x.Email
+	}
+	return ProfileFieldVisibility_PROFILE_FIELD_VISIBILITY_UNSPECIFIED
+}
+
+func (x *UpdatePrivacySettingsRequest) GetLastLogin() ProfileFieldVisibility {
+	if x != nil {
+		return x.LastLogin
+	}
+	return ProfileFieldVisibility_PROFILE_FIELD_VISIBILITY_UNSPECIFIED
+}
+
+func (x *UpdatePrivacySettingsRequest) GetBio() ProfileFieldVisibility {
+	if x != nil {
+		return x.Bio
+	}
+	return ProfileFieldVisibility_PROFILE_FIELD_VISIBILITY_UNSPECIFIED
+}
+
+func (x *UpdatePrivacySettingsRequest) GetPrivateAccount() bool {
+	if x != nil && x.PrivateAccount != nil {
+		return *x.PrivateAccount
+	}
+	return false
+}
+
+// PrivacySettings are a user's per-field profile visibility settings and
+// whether the account is private. New accounts are public, hide their email
+// and show their last login and bio.
+type PrivacySettings struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Email     ProfileFieldVisibility `protobuf:"varint,1,opt,name=email,proto3,enum=user.v1.ProfileFieldVisibility" json:"email,omitempty"`
+	LastLogin ProfileFieldVisibility `protobuf:"varint,2,opt,name=last_login,json=lastLogin,proto3,enum=user.v1.ProfileFieldVisibility" json:"last_login,omitempty"`
+	Bio       ProfileFieldVisibility `protobuf:"varint,3,opt,name=bio,proto3,enum=user.v1.ProfileFieldVisibility" json:"bio,omitempty"`
+	// Whether new followers need approval.
+	PrivateAccount bool `protobuf:"varint,4,opt,name=private_account,json=privateAccount,proto3" json:"private_account,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PrivacySettings) Reset() {
+	*x = PrivacySettings{}
+	mi := &file_user_v1_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivacySettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacySettings) ProtoMessage() {}
+
+func (x *PrivacySettings) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivacySettings.ProtoReflect.Descriptor instead.
+func (*PrivacySettings) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PrivacySettings) GetEmail() ProfileFieldVisibility {
+	if x != nil {
+		return x.Email
+	}
+	return ProfileFieldVisibility_PROFILE_FIELD_VISIBILITY_UNSPECIFIED
+}
+
+func (x *PrivacySettings) GetLastLogin() ProfileFieldVisibility {
+	if x != nil {
+		return x.LastLogin
+	}
+	return ProfileFieldVisibility_PROFILE_FIELD_VISIBILITY_UNSPECIFIED
+}
+
+func (x *PrivacySettings) GetBio() ProfileFieldVisibility {
+	if x != nil {
+		return x.Bio
+	}
+	return ProfileFieldVisibility_PROFILE_FIELD_VISIBILITY_UNSPECIFIED
+}
+
+func (x *PrivacySettings) GetPrivateAccount() bool {
+	if x != nil {
+		return x.PrivateAccount
+	}
+	return false
+}
+
 type FetchUserProfileByIDRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The numeric ID of the user.
@@ -201,7 +581,7 @@ type FetchUserProfileByIDRequest struct {
 
 func (x *FetchUserProfileByIDRequest) Reset() {
 	*x = FetchUserProfileByIDRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[2]
+	mi := &file_user_v1_user_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -213,7 +593,7 @@ func (x *FetchUserProfileByIDRequest) String() string {
 func (*FetchUserProfileByIDRequest) ProtoMessage() {}
 
 func (x *FetchUserProfileByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[2]
+	mi := &file_user_v1_user_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -226,7 +606,7 @@ func (x *FetchUserProfileByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchUserProfileByIDRequest.ProtoReflect.Descriptor instead.
 func (*FetchUserProfileByIDRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{2}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *FetchUserProfileByIDRequest) GetUserId() int64 {
@@ -236,37 +616,704 @@ func (x *FetchUserProfileByIDRequest) GetUserId() int64 {
 	return 0
 }
 
-var File_user_v1_user_proto protoreflect.FileDescriptor
+type BatchFetchUserProfilesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IDs of the users to fetch; duplicates are looked up once.
+	UserIds       []int64 `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_user_v1_user_proto_rawDesc = "" +
-	"\n" +
-	"\x12user/v1/user.proto\x12\auser.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/resource.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\x1a:third_party/protoc-gen-openapiv2/options/annotations.proto\"\xa7\x03\n" +
-	"\vUserProfile\x12\x1c\n" +
-	"\auser_id\x18\x01 \x01(\x03B\x03\xe0A\x03R\x06userId\x12\x1f\n" +
-	"\busername\x18\x02 \x01(\tB\x03\xe0A\x03R\busername\x12\x19\n" +
-	"\x05email\x18\x03 \x01(\tB\x03\xe0A\x03R\x05email\x12\x1f\n" +
-	"\bnickname\x18\x04 \x01(\tB\x03\xe0A\x02R\bnickname\x12\x10\n" +
-	"\x03bio\x18\x05 \x01(\tR\x03bio\x12\x1d\n" +
-	"\n" +
-	"avatar_url\x18\x06 \x01(\tR\tavatarUrl\x12>\n" +
-	"\n" +
-	"last_login\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tlastLogin\x12>\n" +
-	"\n" +
-	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tcreatedAt\x12>\n" +
-	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tupdatedAt:,\xeaA)\x12\x12v1/users/{user_id}\x12\x13v1/users/{nickname}\"^\n" +
-	"!FetchUserProfileByNicknameRequest\x129\n" +
-	"\bnickname\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaB\x17r\x15\x10\x03\x18\x1e2\x0f^[A-Za-z0-9_]+$R\bnickname\"B\n" +
-	"\x1bFetchUserProfileByIDRequest\x12#\n" +
+func (x *BatchFetchUserProfilesRequest) Reset() {
+	*x = BatchFetchUserProfilesRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchFetchUserProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchFetchUserProfilesRequest) ProtoMessage() {}
+
+func (x *BatchFetchUserProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchFetchUserProfilesRequest.ProtoReflect.Descriptor instead.
+func (*BatchFetchUserProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BatchFetchUserProfilesRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type BatchFetchUserProfilesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Profiles of the users that were found, in ascending ID order.
+	Profiles []*UserProfile `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	// Requested IDs without a profile, in request order.
+	MissingUserIds []int64 `protobuf:"varint,2,rep,packed,name=missing_user_ids,json=missingUserIds,proto3" json:"missing_user_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BatchFetchUserProfilesResponse) Reset() {
+	*x = BatchFetchUserProfilesResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchFetchUserProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchFetchUserProfilesResponse) ProtoMessage() {}
+
+func (x *BatchFetchUserProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchFetchUserProfilesResponse.ProtoReflect.Descriptor instead.
+func (*BatchFetchUserProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchFetchUserProfilesResponse) GetProfiles() []*UserProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+func (x *BatchFetchUserProfilesResponse) GetMissingUserIds() []int64 {
+	if x != nil {
+		return x.MissingUserIds
+	}
+	return nil
+}
+
+type ListUserEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only events with a greater ID are returned; 0 starts from the oldest
+	// retained event.
+	AfterId int64 `protobuf:"varint,1,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	// Maximum number of events to return.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserEventsRequest) Reset() {
+	*x = ListUserEventsRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserEventsRequest) ProtoMessage() {}
+
+func (x *ListUserEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserEventsRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListUserEventsRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *ListUserEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListUserEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Events in ascending ID order.
+	Events        []*UserEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserEventsResponse) Reset() {
+	*x = ListUserEventsResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserEventsResponse) ProtoMessage() {}
+
+func (x *ListUserEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserEventsResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListUserEventsResponse) GetEvents() []*UserEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type CheckFriendshipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OtherUserId   int64                  `protobuf:"varint,2,opt,name=other_user_id,json=otherUserId,proto3" json:"other_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckFriendshipRequest) Reset() {
+	*x = CheckFriendshipRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckFriendshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckFriendshipRequest) ProtoMessage() {}
+
+func (x *CheckFriendshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckFriendshipRequest.ProtoReflect.Descriptor instead.
+func (*CheckFriendshipRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CheckFriendshipRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CheckFriendshipRequest) GetOtherUserId() int64 {
+	if x != nil {
+		return x.OtherUserId
+	}
+	return 0
+}
+
+type CheckFriendshipResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether user_id and other_user_id are friends.
+	Friends       bool `protobuf:"varint,1,opt,name=friends,proto3" json:"friends,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckFriendshipResponse) Reset() {
+	*x = CheckFriendshipResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckFriendshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckFriendshipResponse) ProtoMessage() {}
+
+func (x *CheckFriendshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckFriendshipResponse.ProtoReflect.Descriptor instead.
+func (*CheckFriendshipResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CheckFriendshipResponse) GetFriends() bool {
+	if x != nil {
+		return x.Friends
+	}
+	return false
+}
+
+type CheckFollowingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FollowerId    int64                  `protobuf:"varint,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	FolloweeId    int64                  `protobuf:"varint,2,opt,name=followee_id,json=followeeId,proto3" json:"followee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckFollowingRequest) Reset() {
+	*x = CheckFollowingRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckFollowingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckFollowingRequest) ProtoMessage() {}
+
+func (x *CheckFollowingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckFollowingRequest.ProtoReflect.Descriptor instead.
+func (*CheckFollowingRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CheckFollowingRequest) GetFollowerId() int64 {
+	if x != nil {
+		return x.FollowerId
+	}
+	return 0
+}
+
+func (x *CheckFollowingRequest) GetFolloweeId() int64 {
+	if x != nil {
+		return x.FolloweeId
+	}
+	return 0
+}
+
+type CheckFollowingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether follower_id follows followee_id.
+	Following     bool `protobuf:"varint,1,opt,name=following,proto3" json:"following,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckFollowingResponse) Reset() {
+	*x = CheckFollowingResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckFollowingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckFollowingResponse) ProtoMessage() {}
+
+func (x *CheckFollowingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckFollowingResponse.ProtoReflect.Descriptor instead.
+func (*CheckFollowingResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CheckFollowingResponse) GetFollowing() bool {
+	if x != nil {
+		return x.Following
+	}
+	return false
+}
+
+type ListFollowIDsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Only IDs greater than after_id are returned; 0 starts from the lowest.
+	AfterId int64 `protobuf:"varint,2,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	// Maximum number of IDs to return.
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowIDsRequest) Reset() {
+	*x = ListFollowIDsRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowIDsRequest) ProtoMessage() {}
+
+func (x *ListFollowIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowIDsRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowIDsRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListFollowIDsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListFollowIDsRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *ListFollowIDsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListFollowIDsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User IDs in ascending order. Fewer than limit means the list is
+	// exhausted.
+	UserIds       []int64 `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowIDsResponse) Reset() {
+	*x = ListFollowIDsResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowIDsResponse) ProtoMessage() {}
+
+func (x *ListFollowIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowIDsResponse.ProtoReflect.Descriptor instead.
+func (*ListFollowIDsResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListFollowIDsResponse) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+// UserEvent records a change to an account that other services must react to.
+type UserEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Monotonically increasing event ID, used as the consumer cursor.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Event type: "user.deleted", "user.followed" or "user.unfollowed".
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// The account the event is about.
+	UserId int64 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// When the event happened.
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// The other account involved, e.g. the followed user of "user.followed";
+	// 0 for events about a single account.
+	SubjectUserId int64 `protobuf:"varint,5,opt,name=subject_user_id,json=subjectUserId,proto3" json:"subject_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserEvent) Reset() {
+	*x = UserEvent{}
+	mi := &file_user_v1_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserEvent) ProtoMessage() {}
+
+func (x *UserEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserEvent.ProtoReflect.Descriptor instead.
+func (*UserEvent) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UserEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UserEvent) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *UserEvent) GetSubjectUserId() int64 {
+	if x != nil {
+		return x.SubjectUserId
+	}
+	return 0
+}
+
+var File_user_v1_user_proto protoreflect.FileDescriptor
+
+const file_user_v1_user_proto_rawDesc = "" +
+	"\n" +
+	"\x12user/v1/user.proto\x12\auser.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/resource.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\x1a:third_party/protoc-gen-openapiv2/options/annotations.proto\"\xaf\x04\n" +
+	"\vUserProfile\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\x03B\x03\xe0A\x03R\x06userId\x12\x1f\n" +
+	"\busername\x18\x02 \x01(\tB\x03\xe0A\x03R\busername\x12\x19\n" +
+	"\x05email\x18\x03 \x01(\tB\x03\xe0A\x03R\x05email\x12\x1f\n" +
+	"\bnickname\x18\x04 \x01(\tB\x03\xe0A\x02R\bnickname\x12\x10\n" +
+	"\x03bio\x18\x05 \x01(\tR\x03bio\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x06 \x01(\tR\tavatarUrl\x12>\n" +
+	"\n" +
+	"last_login\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tlastLogin\x12>\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tcreatedAt\x12>\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tupdatedAt\x12*\n" +
+	"\x0efollower_count\x18\n" +
+	" \x01(\x03B\x03\xe0A\x03R\rfollowerCount\x12,\n" +
+	"\x0ffollowing_count\x18\v \x01(\x03B\x03\xe0A\x03R\x0efollowingCount\x12,\n" +
+	"\x0fprivate_account\x18\f \x01(\bB\x03\xe0A\x03R\x0eprivateAccount:,\xeaA)\x12\x12v1/users/{user_id}\x12\x13v1/users/{nickname}\"^\n" +
+	"!FetchUserProfileByNicknameRequest\x129\n" +
+	"\bnickname\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaB\x17r\x15\x10\x03\x18\x1e2\x0f^[A-Za-z0-9_]+$R\bnickname\"\x89\x01\n" +
+	"\x12SearchUsersRequest\x12\"\n" +
+	"\x05query\x18\x01 \x01(\tB\f\xe0A\x02\xfaB\x06r\x04\x10\x02\x18@R\x05query\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x182(\x00R\bpageSize\x12'\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x04R\tpageToken\"i\n" +
+	"\x13SearchUsersResponse\x12*\n" +
+	"\x05users\x18\x01 \x03(\v2\x14.user.v1.UserProfileR\x05users\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x1b\n" +
+	"\x19GetPrivacySettingsRequest\"\xa8\x02\n" +
+	"\x1cUpdatePrivacySettingsRequest\x12?\n" +
+	"\x05email\x18\x01 \x01(\x0e2\x1f.user.v1.ProfileFieldVisibilityB\b\xfaB\x05\x82\x01\x02\x10\x01R\x05email\x12H\n" +
+	"\n" +
+	"last_login\x18\x02 \x01(\x0e2\x1f.user.v1.ProfileFieldVisibilityB\b\xfaB\x05\x82\x01\x02\x10\x01R\tlastLogin\x12;\n" +
+	"\x03bio\x18\x03 \x01(\x0e2\x1f.user.v1.ProfileFieldVisibilityB\b\xfaB\x05\x82\x01\x02\x10\x01R\x03bio\x12,\n" +
+	"\x0fprivate_account\x18\x04 \x01(\bH\x00R\x0eprivateAccount\x88\x01\x01B\x12\n" +
+	"\x10_private_account\"\xf8\x01\n" +
+	"\x0fPrivacySettings\x12:\n" +
+	"\x05email\x18\x01 \x01(\x0e2\x1f.user.v1.ProfileFieldVisibilityB\x03\xe0A\x03R\x05email\x12C\n" +
+	"\n" +
+	"last_login\x18\x02 \x01(\x0e2\x1f.user.v1.ProfileFieldVisibilityB\x03\xe0A\x03R\tlastLogin\x126\n" +
+	"\x03bio\x18\x03 \x01(\x0e2\x1f.user.v1.ProfileFieldVisibilityB\x03\xe0A\x03R\x03bio\x12,\n" +
+	"\x0fprivate_account\x18\x04 \x01(\bB\x03\xe0A\x03R\x0eprivateAccount\"B\n" +
+	"\x1bFetchUserProfileByIDRequest\x12#\n" +
+	"\auser_id\x18\x01 \x01(\x03B\n" +
+	"\xe0A\x02\xfaB\x04\"\x02 \x00R\x06userId\"O\n" +
+	"\x1dBatchFetchUserProfilesRequest\x12.\n" +
+	"\buser_ids\x18\x01 \x03(\x03B\x13\xe0A\x02\xfaB\r\x92\x01\n" +
+	"\b\x01\x10d\"\x04\"\x02 \x00R\auserIds\"|\n" +
+	"\x1eBatchFetchUserProfilesResponse\x120\n" +
+	"\bprofiles\x18\x01 \x03(\v2\x14.user.v1.UserProfileR\bprofiles\x12(\n" +
+	"\x10missing_user_ids\x18\x02 \x03(\x03R\x0emissingUserIds\"]\n" +
+	"\x15ListUserEventsRequest\x12\"\n" +
+	"\bafter_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\aafterId\x12 \n" +
+	"\x05limit\x18\x02 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xf4\x03 \x00R\x05limit\"D\n" +
+	"\x16ListUserEventsResponse\x12*\n" +
+	"\x06events\x18\x01 \x03(\v2\x12.user.v1.UserEventR\x06events\"m\n" +
+	"\x16CheckFriendshipRequest\x12#\n" +
+	"\auser_id\x18\x01 \x01(\x03B\n" +
+	"\xe0A\x02\xfaB\x04\"\x02 \x00R\x06userId\x12.\n" +
+	"\rother_user_id\x18\x02 \x01(\x03B\n" +
+	"\xe0A\x02\xfaB\x04\"\x02 \x00R\votherUserId\"3\n" +
+	"\x17CheckFriendshipResponse\x12\x18\n" +
+	"\afriends\x18\x01 \x01(\bR\afriends\"q\n" +
+	"\x15CheckFollowingRequest\x12+\n" +
+	"\vfollower_id\x18\x01 \x01(\x03B\n" +
+	"\xe0A\x02\xfaB\x04\"\x02 \x00R\n" +
+	"followerId\x12+\n" +
+	"\vfollowee_id\x18\x02 \x01(\x03B\n" +
+	"\xe0A\x02\xfaB\x04\"\x02 \x00R\n" +
+	"followeeId\"6\n" +
+	"\x16CheckFollowingResponse\x12\x1c\n" +
+	"\tfollowing\x18\x01 \x01(\bR\tfollowing\"\x81\x01\n" +
+	"\x14ListFollowIDsRequest\x12#\n" +
 	"\auser_id\x18\x01 \x01(\x03B\n" +
-	"\xe0A\x02\xfaB\x04\"\x02 \x00R\x06userId2\x96\x02\n" +
+	"\xe0A\x02\xfaB\x04\"\x02 \x00R\x06userId\x12\"\n" +
+	"\bafter_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\aafterId\x12 \n" +
+	"\x05limit\x18\x03 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a \x00R\x05limit\"2\n" +
+	"\x15ListFollowIDsResponse\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x03R\auserIds\"\xc6\x01\n" +
+	"\tUserEvent\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\x03B\x03\xe0A\x03R\x02id\x12\x17\n" +
+	"\x04type\x18\x02 \x01(\tB\x03\xe0A\x03R\x04type\x12\x1c\n" +
+	"\auser_id\x18\x03 \x01(\x03B\x03\xe0A\x03R\x06userId\x12@\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"occurredAt\x12+\n" +
+	"\x0fsubject_user_id\x18\x05 \x01(\x03B\x03\xe0A\x03R\rsubjectUserId*\x8f\x01\n" +
+	"\x16ProfileFieldVisibility\x12(\n" +
+	"$PROFILE_FIELD_VISIBILITY_UNSPECIFIED\x10\x00\x12%\n" +
+	"!PROFILE_FIELD_VISIBILITY_EVERYONE\x10\x01\x12$\n" +
+	" PROFILE_FIELD_VISIBILITY_ONLY_ME\x10\x022\xf1\a\n" +
 	"\vUserService\x12\xd6\x01\n" +
 	"\x1aFetchUserProfileByNickname\x12*.user.v1.FetchUserProfileByNicknameRequest\x1a\x14.user.v1.UserProfile\"v\x92AW\n" +
-	"\x04User\x12\x1cGet User Profile by Nickname\x1a1Look up a public profile via its unique nickname.\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/users/{nickname}\x1a.\x92A+\x12)Public read-only access to user profiles.2\xb6\x02\n" +
-	"\x13InternalUserService\x12\xee\x01\n" +
-	"\x14FetchUserProfileByID\x12$.user.v1.FetchUserProfileByIDRequest\x1a\x14.user.v1.UserProfile\"\x99\x01\x92At\n" +
-	"\x04User\n" +
-	"\bInternal\x12#Fetch User Profile by ID (Internal)\x1a=Service-to-service lookup by user ID. DO NOT expose publicly.\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/domain/users/{user_id}\x1a.\x92A+\x12)Internal-only user profile lookups by ID.B=Z;github.com/mamataliev-dev/social-platform/api/gen/v1/userpbb\x06proto3"
+	"\x04User\x12\x1cGet User Profile by Nickname\x1a1Look up a public profile via its unique nickname.\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/users/{nickname}\x12\x8f\x02\n" +
+	"\vSearchUsers\x12\x1b.user.v1.SearchUsersRequest\x1a\x1c.user.v1.SearchUsersResponse\"\xc4\x01\x92A\xa8\x01\n" +
+	"\x04User\x12\fSearch Users\x1a\x91\x01Finds users whose nickname or username starts with or resembles the query, best matches first. Deleted and suspended accounts are never returned.\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/users:search\x12\xcc\x01\n" +
+	"\x12GetPrivacySettings\x12\".user.v1.GetPrivacySettingsRequest\x1a\x18.user.v1.PrivacySettings\"x\x92AY\n" +
+	"\x04User\x12\x14Get Privacy Settings\x1a;Returns who may see the caller's email, last login and bio.\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/users/me/privacy\x12\x81\x02\n" +
+	"\x15UpdatePrivacySettings\x12%.user.v1.UpdatePrivacySettingsRequest\x1a\x18.user.v1.PrivacySettings\"\xa6\x01\x92A\x83\x01\n" +
+	"\x04User\x12\x17Update Privacy Settings\x1abChanges who may see the caller's email, last login and bio. Unspecified fields are left unchanged.\x82\xd3\xe4\x93\x02\x19:\x01*2\x14/v1/users/me/privacy\x1a$\x92A!\x12\x1fPublic access to user profiles.2\xf5\x04\n" +
+	"\x13InternalUserService\x12R\n" +
+	"\x14FetchUserProfileByID\x12$.user.v1.FetchUserProfileByIDRequest\x1a\x14.user.v1.UserProfile\x12i\n" +
+	"\x16BatchFetchUserProfiles\x12&.user.v1.BatchFetchUserProfilesRequest\x1a'.user.v1.BatchFetchUserProfilesResponse\x12Q\n" +
+	"\x0eListUserEvents\x12\x1e.user.v1.ListUserEventsRequest\x1a\x1f.user.v1.ListUserEventsResponse\x12T\n" +
+	"\x0fCheckFriendship\x12\x1f.user.v1.CheckFriendshipRequest\x1a .user.v1.CheckFriendshipResponse\x12Q\n" +
+	"\x0eCheckFollowing\x12\x1e.user.v1.CheckFollowingRequest\x1a\x1f.user.v1.CheckFollowingResponse\x12P\n" +
+	"\x0fListFollowerIDs\x12\x1d.user.v1.ListFollowIDsRequest\x1a\x1e.user.v1.ListFollowIDsResponse\x12Q\n" +
+	"\x10ListFollowingIDs\x12\x1d.user.v1.ListFollowIDsRequest\x1a\x1e.user.v1.ListFollowIDsResponseB=Z;github.com/mamataliev-dev/social-platform/api/gen/v1/userpbb\x06proto3"
 
 var (
 	file_user_v1_user_proto_rawDescOnce sync.Once
@@ -280,26 +1327,72 @@ func file_user_v1_user_proto_rawDescGZIP() []byte {
 	return file_user_v1_user_proto_rawDescData
 }
 
-var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_user_v1_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_user_v1_user_proto_goTypes = []any{
-	(*UserProfile)(nil),                       // 0: user.v1.UserProfile
-	(*FetchUserProfileByNicknameRequest)(nil), // 1: user.v1.FetchUserProfileByNicknameRequest
-	(*FetchUserProfileByIDRequest)(nil),       // 2: user.v1.FetchUserProfileByIDRequest
-	(*timestamppb.Timestamp)(nil),             // 3: google.protobuf.Timestamp
+	(ProfileFieldVisibility)(0),               // 0: user.v1.ProfileFieldVisibility
+	(*UserProfile)(nil),                       // 1: user.v1.UserProfile
+	(*FetchUserProfileByNicknameRequest)(nil), // 2: user.v1.FetchUserProfileByNicknameRequest
+	(*SearchUsersRequest)(nil),                // 3: user.v1.SearchUsersRequest
+	(*SearchUsersResponse)(nil),               // 4: user.v1.SearchUsersResponse
+	(*GetPrivacySettingsRequest)(nil),         // 5: user.v1.GetPrivacySettingsRequest
+	(*UpdatePrivacySettingsRequest)(nil),      // 6: user.v1.UpdatePrivacySettingsRequest
+	(*PrivacySettings)(nil),                   // 7: user.v1.PrivacySettings
+	(*FetchUserProfileByIDRequest)(nil),       // 8: user.v1.FetchUserProfileByIDRequest
+	(*BatchFetchUserProfilesRequest)(nil),     // 9: user.v1.BatchFetchUserProfilesRequest
+	(*BatchFetchUserProfilesResponse)(nil),    // 10: user.v1.BatchFetchUserProfilesResponse
+	(*ListUserEventsRequest)(nil),             // 11: user.v1.ListUserEventsRequest
+	(*ListUserEventsResponse)(nil),            // 12: user.v1.ListUserEventsResponse
+	(*CheckFriendshipRequest)(nil),            // 13: user.v1.CheckFriendshipRequest
+	(*CheckFriendshipResponse)(nil),           // 14: user.v1.CheckFriendshipResponse
+	(*CheckFollowingRequest)(nil),             // 15: user.v1.CheckFollowingRequest
+	(*CheckFollowingResponse)(nil),            // 16: user.v1.CheckFollowingResponse
+	(*ListFollowIDsRequest)(nil),              // 17: user.v1.ListFollowIDsRequest
+	(*ListFollowIDsResponse)(nil),             // 18: user.v1.ListFollowIDsResponse
+	(*UserEvent)(nil),                         // 19: user.v1.UserEvent
+	(*timestamppb.Timestamp)(nil),             // 20: google.protobuf.Timestamp
 }
 var file_user_v1_user_proto_depIdxs = []int32{
-	3, // 0: user.v1.UserProfile.last_login:type_name -> google.protobuf.Timestamp
-	3, // 1: user.v1.UserProfile.created_at:type_name -> google.protobuf.Timestamp
-	3, // 2: user.v1.UserProfile.updated_at:type_name -> google.protobuf.Timestamp
-	1, // 3: user.v1.UserService.FetchUserProfileByNickname:input_type -> user.v1.FetchUserProfileByNicknameRequest
-	2, // 4: user.v1.InternalUserService.FetchUserProfileByID:input_type -> user.v1.FetchUserProfileByIDRequest
-	0, // 5: user.v1.UserService.FetchUserProfileByNickname:output_type -> user.v1.UserProfile
-	0, // 6: user.v1.InternalUserService.FetchUserProfileByID:output_type -> user.v1.UserProfile
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	20, // 0: user.v1.UserProfile.last_login:type_name -> google.protobuf.Timestamp
+	20, // 1: user.v1.UserProfile.created_at:type_name -> google.protobuf.Timestamp
+	20, // 2: user.v1.UserProfile.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 3: user.v1.SearchUsersResponse.users:type_name -> user.v1.UserProfile
+	0,  // 4: user.v1.UpdatePrivacySettingsRequest.email:type_name -> user.v1.ProfileFieldVisibility
+	0,  // 5: user.v1.UpdatePrivacySettingsRequest.last_login:type_name -> user.v1.ProfileFieldVisibility
+	0,  // 6: user.v1.UpdatePrivacySettingsRequest.bio:type_name -> user.v1.ProfileFieldVisibility
+	0,  // 7: user.v1.PrivacySettings.email:type_name -> user.v1.ProfileFieldVisibility
+	0,  // 8: user.v1.PrivacySettings.last_login:type_name -> user.v1.ProfileFieldVisibility
+	0,  // 9: user.v1.PrivacySettings.bio:type_name -> user.v1.ProfileFieldVisibility
+	1,  // 10: user.v1.BatchFetchUserProfilesResponse.profiles:type_name -> user.v1.UserProfile
+	19, // 11: user.v1.ListUserEventsResponse.events:type_name -> user.v1.UserEvent
+	20, // 12: user.v1.UserEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	2,  // 13: user.v1.UserService.FetchUserProfileByNickname:input_type -> user.v1.FetchUserProfileByNicknameRequest
+	3,  // 14: user.v1.UserService.SearchUsers:input_type -> user.v1.SearchUsersRequest
+	5,  // 15: user.v1.UserService.GetPrivacySettings:input_type -> user.v1.GetPrivacySettingsRequest
+	6,  // 16: user.v1.UserService.UpdatePrivacySettings:input_type -> user.v1.UpdatePrivacySettingsRequest
+	8,  // 17: user.v1.InternalUserService.FetchUserProfileByID:input_type -> user.v1.FetchUserProfileByIDRequest
+	9,  // 18: user.v1.InternalUserService.BatchFetchUserProfiles:input_type -> user.v1.BatchFetchUserProfilesRequest
+	11, // 19: user.v1.InternalUserService.ListUserEvents:input_type -> user.v1.ListUserEventsRequest
+	13, // 20: user.v1.InternalUserService.CheckFriendship:input_type -> user.v1.CheckFriendshipRequest
+	15, // 21: user.v1.InternalUserService.CheckFollowing:input_type -> user.v1.CheckFollowingRequest
+	17, // 22: user.v1.InternalUserService.ListFollowerIDs:input_type -> user.v1.ListFollowIDsRequest
+	17, // 23: user.v1.InternalUserService.ListFollowingIDs:input_type -> user.v1.ListFollowIDsRequest
+	1,  // 24: user.v1.UserService.FetchUserProfileByNickname:output_type -> user.v1.UserProfile
+	4,  // 25: user.v1.UserService.SearchUsers:output_type -> user.v1.SearchUsersResponse
+	7,  // 26: user.v1.UserService.GetPrivacySettings:output_type -> user.v1.PrivacySettings
+	7,  // 27: user.v1.UserService.UpdatePrivacySettings:output_type -> user.v1.PrivacySettings
+	1,  // 28: user.v1.InternalUserService.FetchUserProfileByID:output_type -> user.v1.UserProfile
+	10, // 29: user.v1.InternalUserService.BatchFetchUserProfiles:output_type -> user.v1.BatchFetchUserProfilesResponse
+	12, // 30: user.v1.InternalUserService.ListUserEvents:output_type -> user.v1.ListUserEventsResponse
+	14, // 31: user.v1.InternalUserService.CheckFriendship:output_type -> user.v1.CheckFriendshipResponse
+	16, // 32: user.v1.InternalUserService.CheckFollowing:output_type -> user.v1.CheckFollowingResponse
+	18, // 33: user.v1.InternalUserService.ListFollowerIDs:output_type -> user.v1.ListFollowIDsResponse
+	18, // 34: user.v1.InternalUserService.ListFollowingIDs:output_type -> user.v1.ListFollowIDsResponse
+	24, // [24:35] is the sub-list for method output_type
+	13, // [13:24] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_user_v1_user_proto_init() }
@@ -307,18 +1400,20 @@ func file_user_v1_user_proto_init() {
 	if File_user_v1_user_proto != nil {
 		return
 	}
+	file_user_v1_user_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_v1_user_proto_rawDesc), len(file_user_v1_user_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      1,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
 		GoTypes:           file_user_v1_user_proto_goTypes,
 		DependencyIndexes: file_user_v1_user_proto_depIdxs,
+		EnumInfos:         file_user_v1_user_proto_enumTypes,
 		MessageInfos:      file_user_v1_user_proto_msgTypes,
 	}.Build()
 	File_user_v1_user_proto = out.File
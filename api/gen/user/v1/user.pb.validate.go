@@ -156,6 +156,12 @@ func (m *UserProfile) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for FollowerCount
+
+	// no validation rules for FollowingCount
+
+	// no validation rules for PrivateAccount
+
 	if len(errors) > 0 {
 		return UserProfileMultiError(errors)
 	}
@@ -362,32 +368,54 @@ var _ interface {
 
 var _FetchUserProfileByNicknameRequest_Nickname_Pattern = regexp.MustCompile("^[A-Za-z0-9_]+$")
 
-// Validate checks the field values on FetchUserProfileByIDRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SearchUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *FetchUserProfileByIDRequest) Validate() error {
+func (m *SearchUsersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FetchUserProfileByIDRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SearchUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// FetchUserProfileByIDRequestMultiError, or nil if none found.
-func (m *FetchUserProfileByIDRequest) ValidateAll() error {
+// SearchUsersRequestMultiError, or nil if none found.
+func (m *SearchUsersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FetchUserProfileByIDRequest) validate(all bool) error {
+func (m *SearchUsersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetUserId() <= 0 {
-		err := FetchUserProfileByIDRequestValidationError{
-			field:  "UserId",
-			reason: "value must be greater than 0",
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 2 || l > 64 {
+		err := SearchUsersRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 2 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 50 {
+		err := SearchUsersRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPageToken()) > 512 {
+		err := SearchUsersRequestValidationError{
+			field:  "PageToken",
+			reason: "value length must be at most 512 runes",
 		}
 		if !all {
 			return err
@@ -396,19 +424,19 @@ func (m *FetchUserProfileByIDRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return FetchUserProfileByIDRequestMultiError(errors)
+		return SearchUsersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// FetchUserProfileByIDRequestMultiError is an error wrapping multiple
-// validation errors returned by FetchUserProfileByIDRequest.ValidateAll() if
-// the designated constraints aren't met.
-type FetchUserProfileByIDRequestMultiError []error
+// SearchUsersRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchUsersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SearchUsersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FetchUserProfileByIDRequestMultiError) Error() string {
+func (m SearchUsersRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -417,12 +445,11 @@ func (m FetchUserProfileByIDRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FetchUserProfileByIDRequestMultiError) AllErrors() []error { return m }
+func (m SearchUsersRequestMultiError) AllErrors() []error { return m }
 
-// FetchUserProfileByIDRequestValidationError is the validation error returned
-// by FetchUserProfileByIDRequest.Validate if the designated constraints
-// aren't met.
-type FetchUserProfileByIDRequestValidationError struct {
+// SearchUsersRequestValidationError is the validation error returned by
+// SearchUsersRequest.Validate if the designated constraints aren't met.
+type SearchUsersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -430,24 +457,24 @@ type FetchUserProfileByIDRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e FetchUserProfileByIDRequestValidationError) Field() string { return e.field }
+func (e SearchUsersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FetchUserProfileByIDRequestValidationError) Reason() string { return e.reason }
+func (e SearchUsersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FetchUserProfileByIDRequestValidationError) Cause() error { return e.cause }
+func (e SearchUsersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FetchUserProfileByIDRequestValidationError) Key() bool { return e.key }
+func (e SearchUsersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FetchUserProfileByIDRequestValidationError) ErrorName() string {
-	return "FetchUserProfileByIDRequestValidationError"
+func (e SearchUsersRequestValidationError) ErrorName() string {
+	return "SearchUsersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e FetchUserProfileByIDRequestValidationError) Error() string {
+func (e SearchUsersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -459,14 +486,14 @@ func (e FetchUserProfileByIDRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFetchUserProfileByIDRequest.%s: %s%s",
+		"invalid %sSearchUsersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FetchUserProfileByIDRequestValidationError{}
+var _ error = SearchUsersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -474,4 +501,1964 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FetchUserProfileByIDRequestValidationError{}
+} = SearchUsersRequestValidationError{}
+
+// Validate checks the field values on SearchUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchUsersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchUsersResponseMultiError, or nil if none found.
+func (m *SearchUsersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchUsersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchUsersResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return SearchUsersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchUsersResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchUsersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchUsersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchUsersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchUsersResponseMultiError) AllErrors() []error { return m }
+
+// SearchUsersResponseValidationError is the validation error returned by
+// SearchUsersResponse.Validate if the designated constraints aren't met.
+type SearchUsersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchUsersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchUsersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchUsersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchUsersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchUsersResponseValidationError) ErrorName() string {
+	return "SearchUsersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchUsersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchUsersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchUsersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchUsersResponseValidationError{}
+
+// Validate checks the field values on GetPrivacySettingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPrivacySettingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPrivacySettingsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPrivacySettingsRequestMultiError, or nil if none found.
+func (m *GetPrivacySettingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPrivacySettingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetPrivacySettingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPrivacySettingsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPrivacySettingsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetPrivacySettingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPrivacySettingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPrivacySettingsRequestMultiError) AllErrors() []error { return m }
+
+// GetPrivacySettingsRequestValidationError is the validation error returned by
+// GetPrivacySettingsRequest.Validate if the designated constraints aren't met.
+type GetPrivacySettingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPrivacySettingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPrivacySettingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPrivacySettingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPrivacySettingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPrivacySettingsRequestValidationError) ErrorName() string {
+	return "GetPrivacySettingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPrivacySettingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPrivacySettingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPrivacySettingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPrivacySettingsRequestValidationError{}
+
+// Validate checks the field values on UpdatePrivacySettingsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePrivacySettingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePrivacySettingsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePrivacySettingsRequestMultiError, or nil if none found.
+func (m *UpdatePrivacySettingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePrivacySettingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := ProfileFieldVisibility_name[int32(m.GetEmail())]; !ok {
+		err := UpdatePrivacySettingsRequestValidationError{
+			field:  "Email",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ProfileFieldVisibility_name[int32(m.GetLastLogin())]; !ok {
+		err := UpdatePrivacySettingsRequestValidationError{
+			field:  "LastLogin",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ProfileFieldVisibility_name[int32(m.GetBio())]; !ok {
+		err := UpdatePrivacySettingsRequestValidationError{
+			field:  "Bio",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.PrivateAccount != nil {
+
+		// no validation rules for PrivateAccount
+
+	}
+
+	if len(errors) > 0 {
+		return UpdatePrivacySettingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePrivacySettingsRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdatePrivacySettingsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdatePrivacySettingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePrivacySettingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePrivacySettingsRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePrivacySettingsRequestValidationError is the validation error returned
+// by UpdatePrivacySettingsRequest.Validate if the designated constraints
+// aren't met.
+type UpdatePrivacySettingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePrivacySettingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePrivacySettingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePrivacySettingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePrivacySettingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePrivacySettingsRequestValidationError) ErrorName() string {
+	return "UpdatePrivacySettingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePrivacySettingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePrivacySettingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePrivacySettingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePrivacySettingsRequestValidationError{}
+
+// Validate checks the field values on PrivacySettings with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PrivacySettings) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PrivacySettings with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PrivacySettingsMultiError, or nil if none found.
+func (m *PrivacySettings) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PrivacySettings) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Email
+
+	// no validation rules for LastLogin
+
+	// no validation rules for Bio
+
+	// no validation rules for PrivateAccount
+
+	if len(errors) > 0 {
+		return PrivacySettingsMultiError(errors)
+	}
+
+	return nil
+}
+
+// PrivacySettingsMultiError is an error wrapping multiple validation errors
+// returned by PrivacySettings.ValidateAll() if the designated constraints
+// aren't met.
+type PrivacySettingsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PrivacySettingsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PrivacySettingsMultiError) AllErrors() []error { return m }
+
+// PrivacySettingsValidationError is the validation error returned by
+// PrivacySettings.Validate if the designated constraints aren't met.
+type PrivacySettingsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PrivacySettingsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PrivacySettingsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PrivacySettingsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PrivacySettingsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PrivacySettingsValidationError) ErrorName() string { return "PrivacySettingsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PrivacySettingsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPrivacySettings.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PrivacySettingsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PrivacySettingsValidationError{}
+
+// Validate checks the field values on FetchUserProfileByIDRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FetchUserProfileByIDRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FetchUserProfileByIDRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FetchUserProfileByIDRequestMultiError, or nil if none found.
+func (m *FetchUserProfileByIDRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FetchUserProfileByIDRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := FetchUserProfileByIDRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FetchUserProfileByIDRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FetchUserProfileByIDRequestMultiError is an error wrapping multiple
+// validation errors returned by FetchUserProfileByIDRequest.ValidateAll() if
+// the designated constraints aren't met.
+type FetchUserProfileByIDRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FetchUserProfileByIDRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FetchUserProfileByIDRequestMultiError) AllErrors() []error { return m }
+
+// FetchUserProfileByIDRequestValidationError is the validation error returned
+// by FetchUserProfileByIDRequest.Validate if the designated constraints
+// aren't met.
+type FetchUserProfileByIDRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FetchUserProfileByIDRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FetchUserProfileByIDRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FetchUserProfileByIDRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FetchUserProfileByIDRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FetchUserProfileByIDRequestValidationError) ErrorName() string {
+	return "FetchUserProfileByIDRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FetchUserProfileByIDRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFetchUserProfileByIDRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FetchUserProfileByIDRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FetchUserProfileByIDRequestValidationError{}
+
+// Validate checks the field values on BatchFetchUserProfilesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchFetchUserProfilesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchFetchUserProfilesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BatchFetchUserProfilesRequestMultiError, or nil if none found.
+func (m *BatchFetchUserProfilesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchFetchUserProfilesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetUserIds()); l < 1 || l > 100 {
+		err := BatchFetchUserProfilesRequestValidationError{
+			field:  "UserIds",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetUserIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := BatchFetchUserProfilesRequestValidationError{
+				field:  fmt.Sprintf("UserIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchFetchUserProfilesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchFetchUserProfilesRequestMultiError is an error wrapping multiple
+// validation errors returned by BatchFetchUserProfilesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type BatchFetchUserProfilesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchFetchUserProfilesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchFetchUserProfilesRequestMultiError) AllErrors() []error { return m }
+
+// BatchFetchUserProfilesRequestValidationError is the validation error
+// returned by BatchFetchUserProfilesRequest.Validate if the designated
+// constraints aren't met.
+type BatchFetchUserProfilesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchFetchUserProfilesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchFetchUserProfilesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchFetchUserProfilesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchFetchUserProfilesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchFetchUserProfilesRequestValidationError) ErrorName() string {
+	return "BatchFetchUserProfilesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchFetchUserProfilesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchFetchUserProfilesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchFetchUserProfilesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchFetchUserProfilesRequestValidationError{}
+
+// Validate checks the field values on BatchFetchUserProfilesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchFetchUserProfilesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchFetchUserProfilesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BatchFetchUserProfilesResponseMultiError, or nil if none found.
+func (m *BatchFetchUserProfilesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchFetchUserProfilesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProfiles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchFetchUserProfilesResponseValidationError{
+						field:  fmt.Sprintf("Profiles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchFetchUserProfilesResponseValidationError{
+						field:  fmt.Sprintf("Profiles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchFetchUserProfilesResponseValidationError{
+					field:  fmt.Sprintf("Profiles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchFetchUserProfilesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchFetchUserProfilesResponseMultiError is an error wrapping multiple
+// validation errors returned by BatchFetchUserProfilesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type BatchFetchUserProfilesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchFetchUserProfilesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchFetchUserProfilesResponseMultiError) AllErrors() []error { return m }
+
+// BatchFetchUserProfilesResponseValidationError is the validation error
+// returned by BatchFetchUserProfilesResponse.Validate if the designated
+// constraints aren't met.
+type BatchFetchUserProfilesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchFetchUserProfilesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchFetchUserProfilesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchFetchUserProfilesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchFetchUserProfilesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchFetchUserProfilesResponseValidationError) ErrorName() string {
+	return "BatchFetchUserProfilesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchFetchUserProfilesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchFetchUserProfilesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchFetchUserProfilesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchFetchUserProfilesResponseValidationError{}
+
+// Validate checks the field values on ListUserEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUserEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserEventsRequestMultiError, or nil if none found.
+func (m *ListUserEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAfterId() < 0 {
+		err := ListUserEventsRequestValidationError{
+			field:  "AfterId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val <= 0 || val > 500 {
+		err := ListUserEventsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range (0, 500]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListUserEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUserEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListUserEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListUserEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserEventsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserEventsRequestMultiError) AllErrors() []error { return m }
+
+// ListUserEventsRequestValidationError is the validation error returned by
+// ListUserEventsRequest.Validate if the designated constraints aren't met.
+type ListUserEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserEventsRequestValidationError) ErrorName() string {
+	return "ListUserEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserEventsRequestValidationError{}
+
+// Validate checks the field values on ListUserEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUserEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserEventsResponseMultiError, or nil if none found.
+func (m *ListUserEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUserEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUserEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUserEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListUserEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUserEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListUserEventsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListUserEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserEventsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserEventsResponseMultiError) AllErrors() []error { return m }
+
+// ListUserEventsResponseValidationError is the validation error returned by
+// ListUserEventsResponse.Validate if the designated constraints aren't met.
+type ListUserEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserEventsResponseValidationError) ErrorName() string {
+	return "ListUserEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserEventsResponseValidationError{}
+
+// Validate checks the field values on CheckFriendshipRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckFriendshipRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckFriendshipRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckFriendshipRequestMultiError, or nil if none found.
+func (m *CheckFriendshipRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckFriendshipRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := CheckFriendshipRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOtherUserId() <= 0 {
+		err := CheckFriendshipRequestValidationError{
+			field:  "OtherUserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CheckFriendshipRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckFriendshipRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckFriendshipRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckFriendshipRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckFriendshipRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckFriendshipRequestMultiError) AllErrors() []error { return m }
+
+// CheckFriendshipRequestValidationError is the validation error returned by
+// CheckFriendshipRequest.Validate if the designated constraints aren't met.
+type CheckFriendshipRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckFriendshipRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckFriendshipRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckFriendshipRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckFriendshipRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckFriendshipRequestValidationError) ErrorName() string {
+	return "CheckFriendshipRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckFriendshipRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckFriendshipRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckFriendshipRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckFriendshipRequestValidationError{}
+
+// Validate checks the field values on CheckFriendshipResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckFriendshipResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckFriendshipResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckFriendshipResponseMultiError, or nil if none found.
+func (m *CheckFriendshipResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckFriendshipResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Friends
+
+	if len(errors) > 0 {
+		return CheckFriendshipResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckFriendshipResponseMultiError is an error wrapping multiple validation
+// errors returned by CheckFriendshipResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CheckFriendshipResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckFriendshipResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckFriendshipResponseMultiError) AllErrors() []error { return m }
+
+// CheckFriendshipResponseValidationError is the validation error returned by
+// CheckFriendshipResponse.Validate if the designated constraints aren't met.
+type CheckFriendshipResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckFriendshipResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckFriendshipResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckFriendshipResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckFriendshipResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckFriendshipResponseValidationError) ErrorName() string {
+	return "CheckFriendshipResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckFriendshipResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckFriendshipResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckFriendshipResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckFriendshipResponseValidationError{}
+
+// Validate checks the field values on CheckFollowingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckFollowingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckFollowingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckFollowingRequestMultiError, or nil if none found.
+func (m *CheckFollowingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckFollowingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetFollowerId() <= 0 {
+		err := CheckFollowingRequestValidationError{
+			field:  "FollowerId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFolloweeId() <= 0 {
+		err := CheckFollowingRequestValidationError{
+			field:  "FolloweeId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CheckFollowingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckFollowingRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckFollowingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckFollowingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckFollowingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckFollowingRequestMultiError) AllErrors() []error { return m }
+
+// CheckFollowingRequestValidationError is the validation error returned by
+// CheckFollowingRequest.Validate if the designated constraints aren't met.
+type CheckFollowingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckFollowingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckFollowingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckFollowingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckFollowingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckFollowingRequestValidationError) ErrorName() string {
+	return "CheckFollowingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckFollowingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckFollowingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckFollowingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckFollowingRequestValidationError{}
+
+// Validate checks the field values on CheckFollowingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckFollowingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckFollowingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckFollowingResponseMultiError, or nil if none found.
+func (m *CheckFollowingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckFollowingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Following
+
+	if len(errors) > 0 {
+		return CheckFollowingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckFollowingResponseMultiError is an error wrapping multiple validation
+// errors returned by CheckFollowingResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CheckFollowingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckFollowingResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckFollowingResponseMultiError) AllErrors() []error { return m }
+
+// CheckFollowingResponseValidationError is the validation error returned by
+// CheckFollowingResponse.Validate if the designated constraints aren't met.
+type CheckFollowingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckFollowingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckFollowingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckFollowingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckFollowingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckFollowingResponseValidationError) ErrorName() string {
+	return "CheckFollowingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckFollowingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckFollowingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckFollowingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckFollowingResponseValidationError{}
+
+// Validate checks the field values on ListFollowIDsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFollowIDsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFollowIDsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFollowIDsRequestMultiError, or nil if none found.
+func (m *ListFollowIDsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFollowIDsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := ListFollowIDsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAfterId() < 0 {
+		err := ListFollowIDsRequestValidationError{
+			field:  "AfterId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val <= 0 || val > 1000 {
+		err := ListFollowIDsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range (0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListFollowIDsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFollowIDsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListFollowIDsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListFollowIDsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFollowIDsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFollowIDsRequestMultiError) AllErrors() []error { return m }
+
+// ListFollowIDsRequestValidationError is the validation error returned by
+// ListFollowIDsRequest.Validate if the designated constraints aren't met.
+type ListFollowIDsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFollowIDsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFollowIDsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFollowIDsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFollowIDsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFollowIDsRequestValidationError) ErrorName() string {
+	return "ListFollowIDsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFollowIDsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFollowIDsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFollowIDsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFollowIDsRequestValidationError{}
+
+// Validate checks the field values on ListFollowIDsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFollowIDsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFollowIDsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFollowIDsResponseMultiError, or nil if none found.
+func (m *ListFollowIDsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFollowIDsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListFollowIDsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFollowIDsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListFollowIDsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListFollowIDsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFollowIDsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFollowIDsResponseMultiError) AllErrors() []error { return m }
+
+// ListFollowIDsResponseValidationError is the validation error returned by
+// ListFollowIDsResponse.Validate if the designated constraints aren't met.
+type ListFollowIDsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFollowIDsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFollowIDsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFollowIDsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFollowIDsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFollowIDsResponseValidationError) ErrorName() string {
+	return "ListFollowIDsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFollowIDsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFollowIDsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFollowIDsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFollowIDsResponseValidationError{}
+
+// Validate checks the field values on UserEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserEventMultiError, or nil
+// if none found.
+func (m *UserEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserEventValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SubjectUserId
+
+	if len(errors) > 0 {
+		return UserEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserEventMultiError is an error wrapping multiple validation errors returned
+// by UserEvent.ValidateAll() if the designated constraints aren't met.
+type UserEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserEventMultiError) AllErrors() []error { return m }
+
+// UserEventValidationError is the validation error returned by
+// UserEvent.Validate if the designated constraints aren't met.
+type UserEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserEventValidationError) ErrorName() string { return "UserEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserEventValidationError{}
@@ -20,6 +20,9 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	UserService_FetchUserProfileByNickname_FullMethodName = "/user.v1.UserService/FetchUserProfileByNickname"
+	UserService_SearchUsers_FullMethodName                = "/user.v1.UserService/SearchUsers"
+	UserService_GetPrivacySettings_FullMethodName         = "/user.v1.UserService/GetPrivacySettings"
+	UserService_UpdatePrivacySettings_FullMethodName      = "/user.v1.UserService/UpdatePrivacySettings"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -27,11 +30,19 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
 // ---------------------------------------------------------------------
-// Public, read‐only API for end‐users (fetch by nickname)
+// Public API for end‐users (fetch by nickname, search, privacy settings)
 // ---------------------------------------------------------------------
 type UserServiceClient interface {
-	// Retrieves a public user profile by nickname.
+	// Retrieves a public user profile by nickname. Fields the owner has hidden
+	// in their privacy settings are left empty unless the caller is the owner.
 	FetchUserProfileByNickname(ctx context.Context, in *FetchUserProfileByNicknameRequest, opts ...grpc.CallOption) (*UserProfile, error)
+	// Searches users by nickname and username prefix or similarity.
+	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
+	// Returns the caller's profile privacy settings.
+	GetPrivacySettings(ctx context.Context, in *GetPrivacySettingsRequest, opts ...grpc.CallOption) (*PrivacySettings, error)
+	// Changes the caller's profile privacy settings. Fields left unspecified
+	// keep their current value.
+	UpdatePrivacySettings(ctx context.Context, in *UpdatePrivacySettingsRequest, opts ...grpc.CallOption) (*PrivacySettings, error)
 }
 
 type userServiceClient struct {
@@ -52,16 +63,54 @@ func (c *userServiceClient) FetchUserProfileByNickname(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *userServiceClient) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchUsersResponse)
+	err := c.cc.Invoke(ctx, UserService_SearchUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetPrivacySettings(ctx context.Context, in *GetPrivacySettingsRequest, opts ...grpc.CallOption) (*PrivacySettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrivacySettings)
+	err := c.cc.Invoke(ctx, UserService_GetPrivacySettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdatePrivacySettings(ctx context.Context, in *UpdatePrivacySettingsRequest, opts ...grpc.CallOption) (*PrivacySettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrivacySettings)
+	err := c.cc.Invoke(ctx, UserService_UpdatePrivacySettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
 //
 // ---------------------------------------------------------------------
-// Public, read‐only API for end‐users (fetch by nickname)
+// Public API for end‐users (fetch by nickname, search, privacy settings)
 // ---------------------------------------------------------------------
 type UserServiceServer interface {
-	// Retrieves a public user profile by nickname.
+	// Retrieves a public user profile by nickname. Fields the owner has hidden
+	// in their privacy settings are left empty unless the caller is the owner.
 	FetchUserProfileByNickname(context.Context, *FetchUserProfileByNicknameRequest) (*UserProfile, error)
+	// Searches users by nickname and username prefix or similarity.
+	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
+	// Returns the caller's profile privacy settings.
+	GetPrivacySettings(context.Context, *GetPrivacySettingsRequest) (*PrivacySettings, error)
+	// Changes the caller's profile privacy settings. Fields left unspecified
+	// keep their current value.
+	UpdatePrivacySettings(context.Context, *UpdatePrivacySettingsRequest) (*PrivacySettings, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -75,6 +124,15 @@ type UnimplementedUserServiceServer struct{}
 func (UnimplementedUserServiceServer) FetchUserProfileByNickname(context.Context, *FetchUserProfileByNicknameRequest) (*UserProfile, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FetchUserProfileByNickname not implemented")
 }
+func (UnimplementedUserServiceServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+}
+func (UnimplementedUserServiceServer) GetPrivacySettings(context.Context, *GetPrivacySettingsRequest) (*PrivacySettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrivacySettings not implemented")
+}
+func (UnimplementedUserServiceServer) UpdatePrivacySettings(context.Context, *UpdatePrivacySettingsRequest) (*PrivacySettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePrivacySettings not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -114,6 +172,60 @@ func _UserService_FetchUserProfileByNickname_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SearchUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SearchUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SearchUsers(ctx, req.(*SearchUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetPrivacySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrivacySettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPrivacySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPrivacySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPrivacySettings(ctx, req.(*GetPrivacySettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdatePrivacySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePrivacySettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdatePrivacySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdatePrivacySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdatePrivacySettings(ctx, req.(*UpdatePrivacySettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -125,13 +237,31 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FetchUserProfileByNickname",
 			Handler:    _UserService_FetchUserProfileByNickname_Handler,
 		},
+		{
+			MethodName: "SearchUsers",
+			Handler:    _UserService_SearchUsers_Handler,
+		},
+		{
+			MethodName: "GetPrivacySettings",
+			Handler:    _UserService_GetPrivacySettings_Handler,
+		},
+		{
+			MethodName: "UpdatePrivacySettings",
+			Handler:    _UserService_UpdatePrivacySettings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user/v1/user.proto",
 }
 
 const (
-	InternalUserService_FetchUserProfileByID_FullMethodName = "/user.v1.InternalUserService/FetchUserProfileByID"
+	InternalUserService_FetchUserProfileByID_FullMethodName   = "/user.v1.InternalUserService/FetchUserProfileByID"
+	InternalUserService_BatchFetchUserProfiles_FullMethodName = "/user.v1.InternalUserService/BatchFetchUserProfiles"
+	InternalUserService_ListUserEvents_FullMethodName         = "/user.v1.InternalUserService/ListUserEvents"
+	InternalUserService_CheckFriendship_FullMethodName        = "/user.v1.InternalUserService/CheckFriendship"
+	InternalUserService_CheckFollowing_FullMethodName         = "/user.v1.InternalUserService/CheckFollowing"
+	InternalUserService_ListFollowerIDs_FullMethodName        = "/user.v1.InternalUserService/ListFollowerIDs"
+	InternalUserService_ListFollowingIDs_FullMethodName       = "/user.v1.InternalUserService/ListFollowingIDs"
 )
 
 // InternalUserServiceClient is the client API for InternalUserService service.
@@ -139,11 +269,34 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
 // ---------------------------------------------------------------------
-// Internal API for service-to-service calls (fetch by ID)
+// Internal API for service-to-service calls. Served only on user-service's
+// internal listener, never through the REST gateway, and every call must
+// carry a signed service token in the "x-service-token" metadata header.
 // ---------------------------------------------------------------------
 type InternalUserServiceClient interface {
-	// Fetches a user profile by its numeric ID. Not exposed to external clients.
+	// Fetches a user profile by its numeric ID. Internal callers are trusted and
+	// receive every field regardless of the user's privacy settings. gRPC-only.
 	FetchUserProfileByID(ctx context.Context, in *FetchUserProfileByIDRequest, opts ...grpc.CallOption) (*UserProfile, error)
+	// Fetches up to 100 user profiles by ID in one call. Unknown and deleted
+	// users are reported in missing_user_ids instead of failing the call.
+	// gRPC-only.
+	BatchFetchUserProfiles(ctx context.Context, in *BatchFetchUserProfilesRequest, opts ...grpc.CallOption) (*BatchFetchUserProfilesResponse, error)
+	// Lists account and follow events (e.g. "user.deleted", "user.followed")
+	// after a cursor, oldest first. Consumers persist the ID of the last handled event and poll again
+	// from there, so every event is delivered at least once. gRPC-only.
+	ListUserEvents(ctx context.Context, in *ListUserEventsRequest, opts ...grpc.CallOption) (*ListUserEventsResponse, error)
+	// Reports whether two users are friends. Returns NotFound unless both
+	// accounts exist. gRPC-only.
+	CheckFriendship(ctx context.Context, in *CheckFriendshipRequest, opts ...grpc.CallOption) (*CheckFriendshipResponse, error)
+	// Reports whether follower_id follows followee_id; a pending follow request
+	// does not count. Returns NotFound unless both accounts exist. gRPC-only.
+	CheckFollowing(ctx context.Context, in *CheckFollowingRequest, opts ...grpc.CallOption) (*CheckFollowingResponse, error)
+	// Lists the IDs of a user's followers in ascending order, starting after
+	// after_id. Unknown users have no followers. gRPC-only.
+	ListFollowerIDs(ctx context.Context, in *ListFollowIDsRequest, opts ...grpc.CallOption) (*ListFollowIDsResponse, error)
+	// Lists the IDs of the users a user follows in ascending order, starting
+	// after after_id. Unknown users follow nobody. gRPC-only.
+	ListFollowingIDs(ctx context.Context, in *ListFollowIDsRequest, opts ...grpc.CallOption) (*ListFollowIDsResponse, error)
 }
 
 type internalUserServiceClient struct {
@@ -164,16 +317,99 @@ func (c *internalUserServiceClient) FetchUserProfileByID(ctx context.Context, in
 	return out, nil
 }
 
+func (c *internalUserServiceClient) BatchFetchUserProfiles(ctx context.Context, in *BatchFetchUserProfilesRequest, opts ...grpc.CallOption) (*BatchFetchUserProfilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchFetchUserProfilesResponse)
+	err := c.cc.Invoke(ctx, InternalUserService_BatchFetchUserProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalUserServiceClient) ListUserEvents(ctx context.Context, in *ListUserEventsRequest, opts ...grpc.CallOption) (*ListUserEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserEventsResponse)
+	err := c.cc.Invoke(ctx, InternalUserService_ListUserEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalUserServiceClient) CheckFriendship(ctx context.Context, in *CheckFriendshipRequest, opts ...grpc.CallOption) (*CheckFriendshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckFriendshipResponse)
+	err := c.cc.Invoke(ctx, InternalUserService_CheckFriendship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalUserServiceClient) CheckFollowing(ctx context.Context, in *CheckFollowingRequest, opts ...grpc.CallOption) (*CheckFollowingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckFollowingResponse)
+	err := c.cc.Invoke(ctx, InternalUserService_CheckFollowing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalUserServiceClient) ListFollowerIDs(ctx context.Context, in *ListFollowIDsRequest, opts ...grpc.CallOption) (*ListFollowIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFollowIDsResponse)
+	err := c.cc.Invoke(ctx, InternalUserService_ListFollowerIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalUserServiceClient) ListFollowingIDs(ctx context.Context, in *ListFollowIDsRequest, opts ...grpc.CallOption) (*ListFollowIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFollowIDsResponse)
+	err := c.cc.Invoke(ctx, InternalUserService_ListFollowingIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InternalUserServiceServer is the server API for InternalUserService service.
 // All implementations must embed UnimplementedInternalUserServiceServer
 // for forward compatibility.
 //
 // ---------------------------------------------------------------------
-// Internal API for service-to-service calls (fetch by ID)
+// Internal API for service-to-service calls. Served only on user-service's
+// internal listener, never through the REST gateway, and every call must
+// carry a signed service token in the "x-service-token" metadata header.
 // ---------------------------------------------------------------------
 type InternalUserServiceServer interface {
-	// Fetches a user profile by its numeric ID. Not exposed to external clients.
+	// Fetches a user profile by its numeric ID. Internal callers are trusted and
+	// receive every field regardless of the user's privacy settings. gRPC-only.
 	FetchUserProfileByID(context.Context, *FetchUserProfileByIDRequest) (*UserProfile, error)
+	// Fetches up to 100 user profiles by ID in one call. Unknown and deleted
+	// users are reported in missing_user_ids instead of failing the call.
+	// gRPC-only.
+	BatchFetchUserProfiles(context.Context, *BatchFetchUserProfilesRequest) (*BatchFetchUserProfilesResponse, error)
+	// Lists account and follow events (e.g. "user.deleted", "user.followed")
+	// after a cursor, oldest first. Consumers persist the ID of the last handled event and poll again
+	// from there, so every event is delivered at least once. gRPC-only.
+	ListUserEvents(context.Context, *ListUserEventsRequest) (*ListUserEventsResponse, error)
+	// Reports whether two users are friends. Returns NotFound unless both
+	// accounts exist. gRPC-only.
+	CheckFriendship(context.Context, *CheckFriendshipRequest) (*CheckFriendshipResponse, error)
+	// Reports whether follower_id follows followee_id; a pending follow request
+	// does not count. Returns NotFound unless both accounts exist. gRPC-only.
+	CheckFollowing(context.Context, *CheckFollowingRequest) (*CheckFollowingResponse, error)
+	// Lists the IDs of a user's followers in ascending order, starting after
+	// after_id. Unknown users have no followers. gRPC-only.
+	ListFollowerIDs(context.Context, *ListFollowIDsRequest) (*ListFollowIDsResponse, error)
+	// Lists the IDs of the users a user follows in ascending order, starting
+	// after after_id. Unknown users follow nobody. gRPC-only.
+	ListFollowingIDs(context.Context, *ListFollowIDsRequest) (*ListFollowIDsResponse, error)
 	mustEmbedUnimplementedInternalUserServiceServer()
 }
 
@@ -187,6 +423,24 @@ type UnimplementedInternalUserServiceServer struct{}
 func (UnimplementedInternalUserServiceServer) FetchUserProfileByID(context.Context, *FetchUserProfileByIDRequest) (*UserProfile, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FetchUserProfileByID not implemented")
 }
+func (UnimplementedInternalUserServiceServer) BatchFetchUserProfiles(context.Context, *BatchFetchUserProfilesRequest) (*BatchFetchUserProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchFetchUserProfiles not implemented")
+}
+func (UnimplementedInternalUserServiceServer) ListUserEvents(context.Context, *ListUserEventsRequest) (*ListUserEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserEvents not implemented")
+}
+func (UnimplementedInternalUserServiceServer) CheckFriendship(context.Context, *CheckFriendshipRequest) (*CheckFriendshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckFriendship not implemented")
+}
+func (UnimplementedInternalUserServiceServer) CheckFollowing(context.Context, *CheckFollowingRequest) (*CheckFollowingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckFollowing not implemented")
+}
+func (UnimplementedInternalUserServiceServer) ListFollowerIDs(context.Context, *ListFollowIDsRequest) (*ListFollowIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowerIDs not implemented")
+}
+func (UnimplementedInternalUserServiceServer) ListFollowingIDs(context.Context, *ListFollowIDsRequest) (*ListFollowIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowingIDs not implemented")
+}
 func (UnimplementedInternalUserServiceServer) mustEmbedUnimplementedInternalUserServiceServer() {}
 func (UnimplementedInternalUserServiceServer) testEmbeddedByValue()                             {}
 
@@ -226,6 +480,114 @@ func _InternalUserService_FetchUserProfileByID_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InternalUserService_BatchFetchUserProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchFetchUserProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalUserServiceServer).BatchFetchUserProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalUserService_BatchFetchUserProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalUserServiceServer).BatchFetchUserProfiles(ctx, req.(*BatchFetchUserProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalUserService_ListUserEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalUserServiceServer).ListUserEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalUserService_ListUserEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalUserServiceServer).ListUserEvents(ctx, req.(*ListUserEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalUserService_CheckFriendship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckFriendshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalUserServiceServer).CheckFriendship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalUserService_CheckFriendship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalUserServiceServer).CheckFriendship(ctx, req.(*CheckFriendshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalUserService_CheckFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckFollowingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalUserServiceServer).CheckFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalUserService_CheckFollowing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalUserServiceServer).CheckFollowing(ctx, req.(*CheckFollowingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalUserService_ListFollowerIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalUserServiceServer).ListFollowerIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalUserService_ListFollowerIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalUserServiceServer).ListFollowerIDs(ctx, req.(*ListFollowIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalUserService_ListFollowingIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalUserServiceServer).ListFollowingIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalUserService_ListFollowingIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalUserServiceServer).ListFollowingIDs(ctx, req.(*ListFollowIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InternalUserService_ServiceDesc is the grpc.ServiceDesc for InternalUserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -237,6 +599,30 @@ var InternalUserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FetchUserProfileByID",
 			Handler:    _InternalUserService_FetchUserProfileByID_Handler,
 		},
+		{
+			MethodName: "BatchFetchUserProfiles",
+			Handler:    _InternalUserService_BatchFetchUserProfiles_Handler,
+		},
+		{
+			MethodName: "ListUserEvents",
+			Handler:    _InternalUserService_ListUserEvents_Handler,
+		},
+		{
+			MethodName: "CheckFriendship",
+			Handler:    _InternalUserService_CheckFriendship_Handler,
+		},
+		{
+			MethodName: "CheckFollowing",
+			Handler:    _InternalUserService_CheckFollowing_Handler,
+		},
+		{
+			MethodName: "ListFollowerIDs",
+			Handler:    _InternalUserService_ListFollowerIDs_Handler,
+		},
+		{
+			MethodName: "ListFollowingIDs",
+			Handler:    _InternalUserService_ListFollowingIDs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user/v1/user.proto",
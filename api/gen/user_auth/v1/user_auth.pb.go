@@ -13,6 +13,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -31,7 +32,7 @@ type RegisterRequest struct {
 	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	// The user's email address.
 	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	// The user's password (plain text), which must be at least 6 characters.
+	// The user's password (plain text); must satisfy the configured password policy.
 	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
 	// The unique nickname for public profile lookup (3–30 alphanumeric or underscore).
 	Nickname string `protobuf:"bytes,4,opt,name=nickname,proto3" json:"nickname,omitempty"`
@@ -219,7 +220,11 @@ type AuthTokenResponse struct {
 	// A newly issued access token (JWT), min length 10.
 	AccessToken string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
 	// A newly issued refresh token (UUID format).
-	RefreshToken  string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	RefreshToken string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// Set by Login when the account requires a second factor; no tokens are issued.
+	MfaRequired bool `protobuf:"varint,3,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	// Short-lived challenge token to pass to VerifyMFA when mfa_required is set.
+	MfaToken      string `protobuf:"bytes,4,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -268,29 +273,1761 @@ func (x *AuthTokenResponse) GetRefreshToken() string {
 	return ""
 }
 
+func (x *AuthTokenResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *AuthTokenResponse) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
 type LogoutResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Confirmation message on successful logout.
-	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// Confirmation message on successful logout.
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResponse) ProtoMessage() {}
+
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LogoutResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type VerifyEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The verification token delivered to the user's inbox.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailRequest) Reset() {
+	*x = VerifyEmailRequest{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailRequest) ProtoMessage() {}
+
+func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VerifyEmailRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type VerifyEmailResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Confirmation message on successful verification.
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailResponse) Reset() {
+	*x = VerifyEmailResponse{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailResponse) ProtoMessage() {}
+
+func (x *VerifyEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailResponse.ProtoReflect.Descriptor instead.
+func (*VerifyEmailResponse) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *VerifyEmailResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ResendVerificationEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The email address of the account awaiting verification.
+	Email         string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendVerificationEmailRequest) Reset() {
+	*x = ResendVerificationEmailRequest{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendVerificationEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendVerificationEmailRequest) ProtoMessage() {}
+
+func (x *ResendVerificationEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendVerificationEmailRequest.ProtoReflect.Descriptor instead.
+func (*ResendVerificationEmailRequest) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ResendVerificationEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ResendVerificationEmailResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Confirmation message; identical whether or not the address is registered.
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendVerificationEmailResponse) Reset() {
+	*x = ResendVerificationEmailResponse{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendVerificationEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendVerificationEmailResponse) ProtoMessage() {}
+
+func (x *ResendVerificationEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendVerificationEmailResponse.ProtoReflect.Descriptor instead.
+func (*ResendVerificationEmailResponse) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ResendVerificationEmailResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type VerifyMFARequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The challenge token returned by Login.
+	MfaToken string `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	// A 6-digit TOTP code or an unused recovery code.
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyMFARequest) Reset() {
+	*x = VerifyMFARequest{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMFARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMFARequest) ProtoMessage() {}
+
+func (x *VerifyMFARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMFARequest.ProtoReflect.Descriptor instead.
+func (*VerifyMFARequest) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *VerifyMFARequest) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *VerifyMFARequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type EnrollTOTPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTOTPRequest) Reset() {
+	*x = EnrollTOTPRequest{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTOTPRequest) ProtoMessage() {}
+
+func (x *EnrollTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTOTPRequest.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{10}
+}
+
+type EnrollTOTPResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Base32-encoded shared secret, for manual entry.
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// otpauth:// URI to render as a QR code for authenticator apps.
+	OtpauthUri    string `protobuf:"bytes,2,opt,name=otpauth_uri,json=otpauthUri,proto3" json:"otpauth_uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTOTPResponse) Reset() {
+	*x = EnrollTOTPResponse{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTOTPResponse) ProtoMessage() {}
+
+func (x *EnrollTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTOTPResponse.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EnrollTOTPResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollTOTPResponse) GetOtpauthUri() string {
+	if x != nil {
+		return x.OtpauthUri
+	}
+	return ""
+}
+
+type ConfirmTOTPRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The current 6-digit code shown by the authenticator app.
+	Code          string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTOTPRequest) Reset() {
+	*x = ConfirmTOTPRequest{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPRequest) ProtoMessage() {}
+
+func (x *ConfirmTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ConfirmTOTPRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmTOTPResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One-time recovery codes; each can replace a TOTP code once.
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTOTPResponse) Reset() {
+	*x = ConfirmTOTPResponse{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPResponse) ProtoMessage() {}
+
+func (x *ConfirmTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ConfirmTOTPResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type DisableTOTPRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A current TOTP code or an unused recovery code.
+	Code          string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTOTPRequest) Reset() {
+	*x = DisableTOTPRequest{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTOTPRequest) ProtoMessage() {}
+
+func (x *DisableTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTOTPRequest.ProtoReflect.Descriptor instead.
+func (*DisableTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DisableTOTPRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DisableTOTPResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Confirmation message on successful deactivation.
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTOTPResponse) Reset() {
+	*x = DisableTOTPResponse{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTOTPResponse) ProtoMessage() {}
+
+func (x *DisableTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTOTPResponse.ProtoReflect.Descriptor instead.
+func (*DisableTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DisableTOTPResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BeginOAuthLoginRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of a configured identity provider, e.g. "google".
+	Provider      string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginOAuthLoginRequest) Reset() {
+	*x = BeginOAuthLoginRequest{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginOAuthLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginOAuthLoginRequest) ProtoMessage() {}
+
+func (x *BeginOAuthLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginOAuthLoginRequest.ProtoReflect.Descriptor instead.
+func (*BeginOAuthLoginRequest) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BeginOAuthLoginRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type BeginOAuthLoginResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// URL to redirect the user's browser to.
+	AuthorizationUrl string `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
+	// Opaque value the provider echoes back; pass it to CompleteOAuthLogin.
+	State         string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginOAuthLoginResponse) Reset() {
+	*x = BeginOAuthLoginResponse{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginOAuthLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginOAuthLoginResponse) ProtoMessage() {}
+
+func (x *BeginOAuthLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginOAuthLoginResponse.ProtoReflect.Descriptor instead.
+func (*BeginOAuthLoginResponse) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BeginOAuthLoginResponse) GetAuthorizationUrl() string {
+	if x != nil {
+		return x.AuthorizationUrl
+	}
+	return ""
+}
+
+func (x *BeginOAuthLoginResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type CompleteOAuthLoginRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the identity provider the login was started with.
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// The state returned by BeginOAuthLogin and echoed by the provider.
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// The authorization code from the provider's redirect.
+	Code          string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteOAuthLoginRequest) Reset() {
+	*x = CompleteOAuthLoginRequest{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteOAuthLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteOAuthLoginRequest) ProtoMessage() {}
+
+func (x *CompleteOAuthLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteOAuthLoginRequest.ProtoReflect.Descriptor instead.
+func (*CompleteOAuthLoginRequest) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CompleteOAuthLoginRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *CompleteOAuthLoginRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CompleteOAuthLoginRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type Session struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Stable identifier of the session; survives refresh token rotation.
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// User agent reported by the client when the session was last used.
+	UserAgent string `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// Client IP address observed when the session was last used.
+	IpAddress string `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	// Whether this is the session making the request.
+	Current       bool                   `protobuf:"varint,4,opt,name=current,proto3" json:"current,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Session) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *Session) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+func (x *Session) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Session) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *Session) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{20}
+}
+
+type ListSessionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Active sessions, most recently used first.
+	Sessions      []*Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The session to revoke.
+	SessionId     string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RevokeSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type RevokeSessionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Confirmation message on successful revocation.
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionResponse) ProtoMessage() {}
+
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RevokeSessionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RevokeAllOtherSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAllOtherSessionsRequest) Reset() {
+	*x = RevokeAllOtherSessionsRequest{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllOtherSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllOtherSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeAllOtherSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllOtherSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAllOtherSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{24}
+}
+
+type RevokeAllOtherSessionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of sessions that were revoked.
+	RevokedCount  int64 `protobuf:"varint,1,opt,name=revoked_count,json=revokedCount,proto3" json:"revoked_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAllOtherSessionsResponse) Reset() {
+	*x = RevokeAllOtherSessionsResponse{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllOtherSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllOtherSessionsResponse) ProtoMessage() {}
+
+func (x *RevokeAllOtherSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllOtherSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAllOtherSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RevokeAllOtherSessionsResponse) GetRevokedCount() int64 {
+	if x != nil {
+		return x.RevokedCount
+	}
+	return 0
+}
+
+type ChangePasswordRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The caller's current password. Accounts without a password, which only
+	// sign in through a social login provider, leave it empty and must have
+	// signed in recently instead.
+	CurrentPassword string `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	// The new password; must satisfy the configured password policy.
+	NewPassword   string `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ChangePasswordRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangePasswordResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Confirmation message on success.
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResponse) ProtoMessage() {}
+
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ChangePasswordResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RequestPasswordResetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The email address of the account to reset.
+	Email         string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type RequestPasswordResetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Confirmation message; identical whether or not the address is registered.
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetResponse) Reset() {
+	*x = RequestPasswordResetResponse{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetResponse) ProtoMessage() {}
+
+func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RequestPasswordResetResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ResetPasswordRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The reset token delivered to the user's inbox.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The new password; must satisfy the configured password policy.
+	NewPassword   string `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ResetPasswordRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ResetPasswordResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Confirmation message on success.
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordResponse) ProtoMessage() {}
+
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ResetPasswordResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeleteMyAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The caller's current password, confirming the deletion. Accounts without
+	// a password leave it empty and must have signed in recently instead.
+	Password      string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMyAccountRequest) Reset() {
+	*x = DeleteMyAccountRequest{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMyAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMyAccountRequest) ProtoMessage() {}
+
+func (x *DeleteMyAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMyAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMyAccountRequest) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteMyAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type DeleteMyAccountResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Confirmation message on success.
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// When the account and its data will be permanently erased.
+	PurgeAfter    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=purge_after,json=purgeAfter,proto3" json:"purge_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMyAccountResponse) Reset() {
+	*x = DeleteMyAccountResponse{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMyAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMyAccountResponse) ProtoMessage() {}
+
+func (x *DeleteMyAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMyAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMyAccountResponse) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteMyAccountResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeleteMyAccountResponse) GetPurgeAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAfter
+	}
+	return nil
+}
+
+type ExportMyDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMyDataRequest) Reset() {
+	*x = ExportMyDataRequest{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMyDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataRequest) ProtoMessage() {}
+
+func (x *ExportMyDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportMyDataRequest) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{34}
+}
+
+type GetDataExportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID returned by ExportMyData.
+	JobId         string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataExportRequest) Reset() {
+	*x = GetDataExportRequest{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataExportRequest) ProtoMessage() {}
+
+func (x *GetDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataExportRequest.ProtoReflect.Descriptor instead.
+func (*GetDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetDataExportRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type DataExportJob struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique export job ID.
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// One of "pending", "processing", "ready" or "failed".
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// When the export was requested.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// When the archive was built; unset until the job has finished.
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// When the archive stops being downloadable; unset until ready.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Relative URL of the zip archive; empty until ready. Requires the same
+	// bearer token as the other endpoints.
+	DownloadUrl   string `protobuf:"bytes,6,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataExportJob) Reset() {
+	*x = DataExportJob{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataExportJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataExportJob) ProtoMessage() {}
+
+func (x *DataExportJob) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataExportJob.ProtoReflect.Descriptor instead.
+func (*DataExportJob) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DataExportJob) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *DataExportJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DataExportJob) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DataExportJob) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *DataExportJob) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *DataExportJob) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+type AdminUser struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Nickname string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Email    string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	// Elevated roles such as "moderator" or "admin"; every account is a "user".
+	Roles     []string               `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// When the account was suspended; unset while active.
+	SuspendedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=suspended_at,json=suspendedAt,proto3" json:"suspended_at,omitempty"`
+	// Reason given by the moderator; empty while active.
+	SuspendedReason string `protobuf:"bytes,7,opt,name=suspended_reason,json=suspendedReason,proto3" json:"suspended_reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AdminUser) Reset() {
+	*x = AdminUser{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUser) ProtoMessage() {}
+
+func (x *AdminUser) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUser.ProtoReflect.Descriptor instead.
+func (*AdminUser) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AdminUser) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminUser) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *AdminUser) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AdminUser) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *AdminUser) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AdminUser) GetSuspendedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SuspendedAt
+	}
+	return nil
+}
+
+func (x *AdminUser) GetSuspendedReason() string {
+	if x != nil {
+		return x.SuspendedReason
+	}
+	return ""
+}
+
+type ListUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only accounts with a greater ID are returned; 0 starts from the first.
+	AfterId int64 `protobuf:"varint,1,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	// Maximum number of accounts to return.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogoutResponse) Reset() {
-	*x = LogoutResponse{}
-	mi := &file_user_auth_v1_user_auth_proto_msgTypes[4]
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogoutResponse) String() string {
+func (x *ListUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutResponse) ProtoMessage() {}
+func (*ListUsersRequest) ProtoMessage() {}
 
-func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_auth_v1_user_auth_proto_msgTypes[4]
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,23 +2038,264 @@ func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
-func (*LogoutResponse) Descriptor() ([]byte, []int) {
-	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *LogoutResponse) GetMessage() string {
+func (x *ListUsersRequest) GetAfterId() int64 {
 	if x != nil {
-		return x.Message
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListUsersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Accounts in ascending ID order.
+	Users         []*AdminUser `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListUsersResponse) GetUsers() []*AdminUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type SuspendUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The account to suspend.
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Why the account is suspended; recorded for other moderators.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendUserRequest) Reset() {
+	*x = SuspendUserRequest{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserRequest) ProtoMessage() {}
+
+func (x *SuspendUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserRequest.ProtoReflect.Descriptor instead.
+func (*SuspendUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SuspendUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SuspendUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
+type UnsuspendUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The account to reinstate.
+	UserId        int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsuspendUserRequest) Reset() {
+	*x = UnsuspendUserRequest{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsuspendUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsuspendUserRequest) ProtoMessage() {}
+
+func (x *UnsuspendUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsuspendUserRequest.ProtoReflect.Descriptor instead.
+func (*UnsuspendUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UnsuspendUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ForceLogoutRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The account to sign out.
+	UserId        int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceLogoutRequest) Reset() {
+	*x = ForceLogoutRequest{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceLogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceLogoutRequest) ProtoMessage() {}
+
+func (x *ForceLogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceLogoutRequest.ProtoReflect.Descriptor instead.
+func (*ForceLogoutRequest) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ForceLogoutRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ForceLogoutResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of sessions that were revoked.
+	RevokedSessions int64 `protobuf:"varint,1,opt,name=revoked_sessions,json=revokedSessions,proto3" json:"revoked_sessions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ForceLogoutResponse) Reset() {
+	*x = ForceLogoutResponse{}
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceLogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceLogoutResponse) ProtoMessage() {}
+
+func (x *ForceLogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_auth_v1_user_auth_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceLogoutResponse.ProtoReflect.Descriptor instead.
+func (*ForceLogoutResponse) Descriptor() ([]byte, []int) {
+	return file_user_auth_v1_user_auth_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ForceLogoutResponse) GetRevokedSessions() int64 {
+	if x != nil {
+		return x.RevokedSessions
+	}
+	return 0
+}
+
 var File_user_auth_v1_user_auth_proto protoreflect.FileDescriptor
 
 const file_user_auth_v1_user_auth_proto_rawDesc = "" +
 	"\n" +
-	"\x1cuser_auth/v1/user_auth.proto\x12\fuser.auth.v1\x1a\x12user/v1/user.proto\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a:third_party/protoc-gen-openapiv2/options/annotations.proto\"\x96\x02\n" +
+	"\x1cuser_auth/v1/user_auth.proto\x12\fuser.auth.v1\x1a\x12user/v1/user.proto\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a:third_party/protoc-gen-openapiv2/options/annotations.proto\"\x96\x02\n" +
 	"\x0fRegisterRequest\x129\n" +
 	"\busername\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaB\x17r\x15\x10\x03\x18\x1e2\x0f^[A-Za-z0-9_]+$R\busername\x12 \n" +
 	"\x05email\x18\x02 \x01(\tB\n" +
@@ -335,25 +2313,204 @@ const file_user_auth_v1_user_auth_proto_rawDesc = "" +
 	"\xe0A\x02\xfaB\x04r\x02\x10\x06R\bpassword\"F\n" +
 	"\x13RefreshTokenPayload\x12/\n" +
 	"\rrefresh_token\x18\x01 \x01(\tB\n" +
-	"\xe0A\x02\xfaB\x04r\x02\x10 R\frefreshToken\"t\n" +
+	"\xe0A\x02\xfaB\x04r\x02\x10 R\frefreshToken\"\xbe\x01\n" +
 	"\x11AuthTokenResponse\x12-\n" +
 	"\faccess_token\x18\x01 \x01(\tB\n" +
 	"\xe0A\x03\xfaB\x04r\x02\x10\n" +
 	"R\vaccessToken\x120\n" +
-	"\rrefresh_token\x18\x02 \x01(\tB\v\xe0A\x03\xfaB\x05r\x03\xb0\x01\x01R\frefreshToken\"6\n" +
+	"\rrefresh_token\x18\x02 \x01(\tB\v\xe0A\x03\xfaB\x05r\x03\xb0\x01\x01R\frefreshToken\x12&\n" +
+	"\fmfa_required\x18\x03 \x01(\bB\x03\xe0A\x03R\vmfaRequired\x12 \n" +
+	"\tmfa_token\x18\x04 \x01(\tB\x03\xe0A\x03R\bmfaToken\"6\n" +
 	"\x0eLogoutResponse\x12$\n" +
 	"\amessage\x18\x01 \x01(\tB\n" +
-	"\xe0A\x03\xfaB\x04r\x02\x10\x01R\amessage2\xcb\a\n" +
+	"\xe0A\x03\xfaB\x04r\x02\x10\x01R\amessage\"6\n" +
+	"\x12VerifyEmailRequest\x12 \n" +
+	"\x05token\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xfaB\x04r\x02\x10 R\x05token\";\n" +
+	"\x13VerifyEmailResponse\x12$\n" +
+	"\amessage\x18\x01 \x01(\tB\n" +
+	"\xe0A\x03\xfaB\x04r\x02\x10\x01R\amessage\"B\n" +
+	"\x1eResendVerificationEmailRequest\x12 \n" +
+	"\x05email\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xfaB\x04r\x02`\x01R\x05email\"G\n" +
+	"\x1fResendVerificationEmailResponse\x12$\n" +
+	"\amessage\x18\x01 \x01(\tB\n" +
+	"\xe0A\x03\xfaB\x04r\x02\x10\x01R\amessage\"]\n" +
+	"\x10VerifyMFARequest\x12'\n" +
+	"\tmfa_token\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xfaB\x04r\x02\x10 R\bmfaToken\x12 \n" +
+	"\x04code\x18\x02 \x01(\tB\f\xe0A\x02\xfaB\x06r\x04\x10\x06\x18 R\x04code\"\x13\n" +
+	"\x11EnrollTOTPRequest\"W\n" +
+	"\x12EnrollTOTPResponse\x12\x1b\n" +
+	"\x06secret\x18\x01 \x01(\tB\x03\xe0A\x03R\x06secret\x12$\n" +
+	"\votpauth_uri\x18\x02 \x01(\tB\x03\xe0A\x03R\n" +
+	"otpauthUri\"@\n" +
+	"\x12ConfirmTOTPRequest\x12*\n" +
+	"\x04code\x18\x01 \x01(\tB\x16\xe0A\x02\xfaB\x10r\x0e\x10\x06\x18\x062\b^[0-9]+$R\x04code\"A\n" +
+	"\x13ConfirmTOTPResponse\x12*\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tB\x03\xe0A\x03R\rrecoveryCodes\"6\n" +
+	"\x12DisableTOTPRequest\x12 \n" +
+	"\x04code\x18\x01 \x01(\tB\f\xe0A\x02\xfaB\x06r\x04\x10\x06\x18 R\x04code\";\n" +
+	"\x13DisableTOTPResponse\x12$\n" +
+	"\amessage\x18\x01 \x01(\tB\n" +
+	"\xe0A\x03\xfaB\x04r\x02\x10\x01R\amessage\"Q\n" +
+	"\x16BeginOAuthLoginRequest\x127\n" +
+	"\bprovider\x18\x01 \x01(\tB\x1b\xe0A\x02\xfaB\x15r\x13\x10\x01\x18 2\r^[a-z0-9_-]+$R\bprovider\"f\n" +
+	"\x17BeginOAuthLoginResponse\x120\n" +
+	"\x11authorization_url\x18\x01 \x01(\tB\x03\xe0A\x03R\x10authorizationUrl\x12\x19\n" +
+	"\x05state\x18\x02 \x01(\tB\x03\xe0A\x03R\x05state\"\x9c\x01\n" +
+	"\x19CompleteOAuthLoginRequest\x127\n" +
+	"\bprovider\x18\x01 \x01(\tB\x1b\xe0A\x02\xfaB\x15r\x13\x10\x01\x18 2\r^[a-z0-9_-]+$R\bprovider\x12#\n" +
+	"\x05state\x18\x02 \x01(\tB\r\xe0A\x02\xfaB\ar\x05\x10 \x18\x80\x01R\x05state\x12!\n" +
+	"\x04code\x18\x03 \x01(\tB\r\xe0A\x02\xfaB\ar\x05\x10\x01\x18\x80\x10R\x04code\"\xd7\x02\n" +
+	"\aSession\x12\"\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tB\x03\xe0A\x03R\tsessionId\x12\"\n" +
+	"\n" +
+	"user_agent\x18\x02 \x01(\tB\x03\xe0A\x03R\tuserAgent\x12\"\n" +
+	"\n" +
+	"ip_address\x18\x03 \x01(\tB\x03\xe0A\x03R\tipAddress\x12\x1d\n" +
+	"\acurrent\x18\x04 \x01(\bB\x03\xe0A\x03R\acurrent\x12>\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tcreatedAt\x12A\n" +
+	"\flast_used_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"lastUsedAt\x12>\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\texpiresAt\"\x15\n" +
+	"\x13ListSessionsRequest\"N\n" +
+	"\x14ListSessionsResponse\x126\n" +
+	"\bsessions\x18\x01 \x03(\v2\x15.user.auth.v1.SessionB\x03\xe0A\x03R\bsessions\"B\n" +
+	"\x14RevokeSessionRequest\x12*\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tB\v\xe0A\x02\xfaB\x05r\x03\xb0\x01\x01R\tsessionId\"=\n" +
+	"\x15RevokeSessionResponse\x12$\n" +
+	"\amessage\x18\x01 \x01(\tB\n" +
+	"\xe0A\x03\xfaB\x04r\x02\x10\x01R\amessage\"\x1f\n" +
+	"\x1dRevokeAllOtherSessionsRequest\"J\n" +
+	"\x1eRevokeAllOtherSessionsResponse\x12(\n" +
+	"\rrevoked_count\x18\x01 \x01(\x03B\x03\xe0A\x03R\frevokedCount\"q\n" +
+	"\x15ChangePasswordRequest\x12)\n" +
+	"\x10current_password\x18\x01 \x01(\tR\x0fcurrentPassword\x12-\n" +
+	"\fnew_password\x18\x02 \x01(\tB\n" +
+	"\xe0A\x02\xfaB\x04r\x02\x10\x06R\vnewPassword\">\n" +
+	"\x16ChangePasswordResponse\x12$\n" +
+	"\amessage\x18\x01 \x01(\tB\n" +
+	"\xe0A\x03\xfaB\x04r\x02\x10\x01R\amessage\"?\n" +
+	"\x1bRequestPasswordResetRequest\x12 \n" +
+	"\x05email\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xfaB\x04r\x02`\x01R\x05email\"D\n" +
+	"\x1cRequestPasswordResetResponse\x12$\n" +
+	"\amessage\x18\x01 \x01(\tB\n" +
+	"\xe0A\x03\xfaB\x04r\x02\x10\x01R\amessage\"g\n" +
+	"\x14ResetPasswordRequest\x12 \n" +
+	"\x05token\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xfaB\x04r\x02\x10 R\x05token\x12-\n" +
+	"\fnew_password\x18\x02 \x01(\tB\n" +
+	"\xe0A\x02\xfaB\x04r\x02\x10\x06R\vnewPassword\"=\n" +
+	"\x15ResetPasswordResponse\x12$\n" +
+	"\amessage\x18\x01 \x01(\tB\n" +
+	"\xe0A\x03\xfaB\x04r\x02\x10\x01R\amessage\"4\n" +
+	"\x16DeleteMyAccountRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\"\x81\x01\n" +
+	"\x17DeleteMyAccountResponse\x12$\n" +
+	"\amessage\x18\x01 \x01(\tB\n" +
+	"\xe0A\x03\xfaB\x04r\x02\x10\x01R\amessage\x12@\n" +
+	"\vpurge_after\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"purgeAfter\"\x15\n" +
+	"\x13ExportMyDataRequest\":\n" +
+	"\x14GetDataExportRequest\x12\"\n" +
+	"\x06job_id\x18\x01 \x01(\tB\v\xe0A\x02\xfaB\x05r\x03\xb0\x01\x01R\x05jobId\"\xb4\x02\n" +
+	"\rDataExportJob\x12\x1a\n" +
+	"\x06job_id\x18\x01 \x01(\tB\x03\xe0A\x03R\x05jobId\x12\x1b\n" +
+	"\x06status\x18\x02 \x01(\tB\x03\xe0A\x03R\x06status\x12>\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tcreatedAt\x12B\n" +
+	"\fcompleted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vcompletedAt\x12>\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\texpiresAt\x12&\n" +
+	"\fdownload_url\x18\x06 \x01(\tB\x03\xe0A\x03R\vdownloadUrl\"\xb4\x02\n" +
+	"\tAdminUser\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\x03B\x03\xe0A\x03R\x06userId\x12\x1f\n" +
+	"\bnickname\x18\x02 \x01(\tB\x03\xe0A\x03R\bnickname\x12\x19\n" +
+	"\x05email\x18\x03 \x01(\tB\x03\xe0A\x03R\x05email\x12\x19\n" +
+	"\x05roles\x18\x04 \x03(\tB\x03\xe0A\x03R\x05roles\x12>\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tcreatedAt\x12B\n" +
+	"\fsuspended_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vsuspendedAt\x12.\n" +
+	"\x10suspended_reason\x18\a \x01(\tB\x03\xe0A\x03R\x0fsuspendedReason\"W\n" +
+	"\x10ListUsersRequest\x12\"\n" +
+	"\bafter_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\aafterId\x12\x1f\n" +
+	"\x05limit\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d \x00R\x05limit\"G\n" +
+	"\x11ListUsersResponse\x122\n" +
+	"\x05users\x18\x01 \x03(\v2\x17.user.auth.v1.AdminUserB\x03\xe0A\x03R\x05users\"`\n" +
+	"\x12SuspendUserRequest\x12#\n" +
+	"\auser_id\x18\x01 \x01(\x03B\n" +
+	"\xe0A\x02\xfaB\x04\"\x02 \x00R\x06userId\x12%\n" +
+	"\x06reason\x18\x02 \x01(\tB\r\xe0A\x02\xfaB\ar\x05\x10\x01\x18\xf4\x03R\x06reason\";\n" +
+	"\x14UnsuspendUserRequest\x12#\n" +
+	"\auser_id\x18\x01 \x01(\x03B\n" +
+	"\xe0A\x02\xfaB\x04\"\x02 \x00R\x06userId\"9\n" +
+	"\x12ForceLogoutRequest\x12#\n" +
+	"\auser_id\x18\x01 \x01(\x03B\n" +
+	"\xe0A\x02\xfaB\x04\"\x02 \x00R\x06userId\"E\n" +
+	"\x13ForceLogoutResponse\x12.\n" +
+	"\x10revoked_sessions\x18\x01 \x01(\x03B\x03\xe0A\x03R\x0frevokedSessions2\x921\n" +
 	"\vAuthService\x12\xd5\x01\n" +
 	"\bRegister\x12\x1d.user.auth.v1.RegisterRequest\x1a\x1f.user.auth.v1.AuthTokenResponse\"\x88\x01\x92Ai\n" +
-	"\x04Auth\x12\x11User Registration\x1aNRegisters a new user with username, email, and password, returning new tokens.\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/auth/register\x12\x96\x02\n" +
-	"\x05Login\x12\x1a.user.auth.v1.LoginRequest\x1a\x1f.user.auth.v1.AuthTokenResponse\"\xcf\x01\x92A\xb2\x01\n" +
+	"\x04Auth\x12\x11User Registration\x1aNRegisters a new user with username, email, and password, returning new tokens.\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/auth/register\x12\xe9\x03\n" +
+	"\x05Login\x12\x1a.user.auth.v1.LoginRequest\x1a\x1f.user.auth.v1.AuthTokenResponse\"\xa2\x03\x92A\x85\x03\n" +
 	"\x04Auth\x12\n" +
-	"User Login\x1a\x9d\x01Validates credentials (via domain fetch-by-email lookup) and returns new access + refresh tokens. This RPC is the only public entry point for authentication.\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/login\x12\xba\x01\n" +
+	"User Login\x1a\xf0\x02Validates credentials (via domain fetch-by-email lookup) and returns new access + refresh tokens. Accounts with two-factor authentication instead receive an MFA challenge token to redeem via VerifyMFA. Unknown emails and wrong passwords return the same error; repeated failures temporarily lock the account and client IP (RESOURCE_EXHAUSTED with a Retry-After header).\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/login\x12\xba\x01\n" +
 	"\x06Logout\x12!.user.auth.v1.RefreshTokenPayload\x1a\x1c.user.auth.v1.LogoutResponse\"o\x92AR\n" +
 	"\x04Auth\x12\vUser Logout\x1a=Invalidates the provided refresh token, logging the user out.\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/auth/logout\x12\xbc\x01\n" +
 	"\fRefreshToken\x12!.user.auth.v1.RefreshTokenPayload\x1a\x1f.user.auth.v1.AuthTokenResponse\"h\x92AJ\n" +
-	"\x04Auth\x12\rRefresh Token\x1a3Refreshes access token using a valid refresh token.\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/auth/refresh\x1aO\x92AL\x12JHandles user registration, login, logout, and token refresh functionality.BAZ?github.com/mamataliev-dev/social-platform/api/gen/v1/userauthpbb\x06proto3"
+	"\x04Auth\x12\rRefresh Token\x1a3Refreshes access token using a valid refresh token.\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/auth/refresh\x12\x9d\x02\n" +
+	"\vVerifyEmail\x12 .user.auth.v1.VerifyEmailRequest\x1a!.user.auth.v1.VerifyEmailResponse\"\xc8\x01\x92A\xa4\x01\n" +
+	"\x04Auth\x12\fVerify Email\x1a\x8d\x01Marks the account's email as verified using the token delivered by email. Refresh the access token afterwards to pick up the verified status.\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/auth/verify-email\x12\xb8\x02\n" +
+	"\x17ResendVerificationEmail\x12,.user.auth.v1.ResendVerificationEmailRequest\x1a-.user.auth.v1.ResendVerificationEmailResponse\"\xbf\x01\x92A\x94\x01\n" +
+	"\x04Auth\x12\x19Resend Verification Email\x1aqIssues a new verification token for an unverified account. Requests made within the cooldown window are rejected.\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/auth/verify-email/resend\x12\x83\x02\n" +
+	"\tVerifyMFA\x12\x1e.user.auth.v1.VerifyMFARequest\x1a\x1f.user.auth.v1.AuthTokenResponse\"\xb4\x01\x92A\x92\x01\n" +
+	"\x03MFA\x12\n" +
+	"Verify MFA\x1a\x7fRedeems the MFA challenge token returned by Login together with a TOTP or recovery code, returning new access + refresh tokens.\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/auth/mfa/verify\x12\x9f\x02\n" +
+	"\n" +
+	"EnrollTOTP\x12\x1f.user.auth.v1.EnrollTOTPRequest\x1a .user.auth.v1.EnrollTOTPResponse\"\xcd\x01\x92A\xa6\x01\n" +
+	"\x03MFA\x12\vEnroll TOTP\x1a\x91\x01Generates a new TOTP secret and returns it as an otpauth URI for authenticator apps. Two-factor authentication is enabled only after ConfirmTOTP.\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/auth/mfa/totp/enroll\x12\xa5\x02\n" +
+	"\vConfirmTOTP\x12 .user.auth.v1.ConfirmTOTPRequest\x1a!.user.auth.v1.ConfirmTOTPResponse\"\xd0\x01\x92A\xa8\x01\n" +
+	"\x03MFA\x12\fConfirm TOTP\x1a\x92\x01Verifies a code from the pending enrollment, enables two-factor authentication and returns one-time recovery codes. The codes are shown only once.\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/auth/mfa/totp/confirm\x12\x86\x02\n" +
+	"\vDisableTOTP\x12 .user.auth.v1.DisableTOTPRequest\x1a!.user.auth.v1.DisableTOTPResponse\"\xb1\x01\x92A\x89\x01\n" +
+	"\x03MFA\x12\fDisable TOTP\x1atTurns off two-factor authentication after checking a current TOTP or recovery code, and discards all recovery codes.\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/auth/mfa/totp/disable\x12\xe7\x02\n" +
+	"\x0fBeginOAuthLogin\x12$.user.auth.v1.BeginOAuthLoginRequest\x1a%.user.auth.v1.BeginOAuthLoginResponse\"\x86\x02\x92A\xd8\x01\n" +
+	"\x05OAuth\x12\x11Begin OAuth Login\x1a\xbb\x01Returns the provider's authorization URL for the authorization code flow with PKCE. The client redirects the user there and later passes the returned code and state to CompleteOAuthLogin.\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/auth/oauth/{provider}/begin\x12\xb6\x03\n" +
+	"\x12CompleteOAuthLogin\x12'.user.auth.v1.CompleteOAuthLoginRequest\x1a\x1f.user.auth.v1.AuthTokenResponse\"\xd5\x02\x92A\xa4\x02\n" +
+	"\x05OAuth\x12\x14Complete OAuth Login\x1a\x84\x02Exchanges the authorization code for a verified ID token. Signs in the linked account, links an existing account with the same verified email, or creates a new account with a generated nickname. Accounts with two-factor authentication receive an MFA challenge.\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/auth/oauth/{provider}/complete\x12\xde\x01\n" +
+	"\fListSessions\x12!.user.auth.v1.ListSessionsRequest\x1a\".user.auth.v1.ListSessionsResponse\"\x86\x01\x92Aj\n" +
+	"\bSessions\x12\rList Sessions\x1aOReturns every unexpired session of the authenticated user with device metadata.\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/auth/sessions\x12\xec\x01\n" +
+	"\rRevokeSession\x12\".user.auth.v1.RevokeSessionRequest\x1a#.user.auth.v1.RevokeSessionResponse\"\x91\x01\x92Ah\n" +
+	"\bSessions\x12\x0eRevoke Session\x1aLInvalidates the refresh token of the given session, signing that device out.\x82\xd3\xe4\x93\x02 *\x1e/v1/auth/sessions/{session_id}\x12\x83\x02\n" +
+	"\x16RevokeAllOtherSessions\x12+.user.auth.v1.RevokeAllOtherSessionsRequest\x1a,.user.auth.v1.RevokeAllOtherSessionsResponse\"\x8d\x01\x92A`\n" +
+	"\bSessions\x12\x19Revoke All Other Sessions\x1a9Signs out every device except the one making the request.\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/auth/sessions/revoke-others\x12\x97\x02\n" +
+	"\x0eChangePassword\x12#.user.auth.v1.ChangePasswordRequest\x1a$.user.auth.v1.ChangePasswordResponse\"\xb9\x01\x92A\x92\x01\n" +
+	"\bPassword\x12\x0fChange Password\x1auReplaces the caller's password. The new password must satisfy the password policy. Every other session is signed out.\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/auth/password/change\x12\xbf\x02\n" +
+	"\x14RequestPasswordReset\x12).user.auth.v1.RequestPasswordResetRequest\x1a*.user.auth.v1.RequestPasswordResetResponse\"\xcf\x01\x92A\xa1\x01\n" +
+	"\bPassword\x12\x16Request Password Reset\x1a}Emails a single-use password reset link if the address belongs to an account. The response is the same for unknown addresses.\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/auth/password/reset/request\x12\xa4\x02\n" +
+	"\rResetPassword\x12\".user.auth.v1.ResetPasswordRequest\x1a#.user.auth.v1.ResetPasswordResponse\"\xc9\x01\x92A\xa3\x01\n" +
+	"\bPassword\x12\x0eReset Password\x1a\x86\x01Redeems a password reset token and sets a new password that satisfies the password policy. Every session of the account is signed out.\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/auth/password/reset\x12\xe4\x02\n" +
+	"\x0fDeleteMyAccount\x12$.user.auth.v1.DeleteMyAccountRequest\x1a%.user.auth.v1.DeleteMyAccountResponse\"\x83\x02\x92A\xdd\x01\n" +
+	"\aAccount\x12\x11Delete My Account\x1a\xbe\x01Deactivates the caller's account immediately, signs out every session and permanently erases the account once the grace period has passed. Other services remove or anonymize the user's data.\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/auth/account/delete\x12\xb5\x02\n" +
+	"\fExportMyData\x12!.user.auth.v1.ExportMyDataRequest\x1a\x1b.user.auth.v1.DataExportJob\"\xe4\x01\x92A\xbd\x01\n" +
+	"\aAccount\x12\x0eExport My Data\x1a\xa1\x01Queues a zip archive of JSON files with the caller's profile, sessions, chat rooms and authored messages. Returns the existing job if one is already in progress.\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/auth/account/exports\x12\xa2\x02\n" +
+	"\rGetDataExport\x12\".user.auth.v1.GetDataExportRequest\x1a\x1b.user.auth.v1.DataExportJob\"\xcf\x01\x92A\xa2\x01\n" +
+	"\aAccount\x12\x0fGet Data Export\x1a\x85\x01Returns the status of a data export. Once ready, download_url serves the zip archive to the same authenticated user until expires_at.\x82\xd3\xe4\x93\x02#\x12!/v1/auth/account/exports/{job_id}\x1aO\x92AL\x12JHandles user registration, login, logout, and token refresh functionality.2\xe2\t\n" +
+	"\fAdminService\x12\xaa\x02\n" +
+	"\tListUsers\x12\x1e.user.auth.v1.ListUsersRequest\x1a\x1f.user.auth.v1.ListUsersResponse\"\xdb\x01\x92A\xc0\x01\n" +
+	"\x05Admin\x12\n" +
+	"List Users\x1a\xaa\x01Returns accounts with their roles and suspension state, ordered by ID. Pass the last returned ID as after_id to fetch the next page. Requires the moderator or admin role.\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/admin/users\x12\x80\x03\n" +
+	"\vSuspendUser\x12 .user.auth.v1.SuspendUserRequest\x1a\x17.user.auth.v1.AdminUser\"\xb5\x02\x92A\x85\x02\n" +
+	"\x05Admin\x12\fSuspend User\x1a\xed\x01Blocks sign-in and token refresh for the account and revokes every session. Access tokens already issued stay valid until they expire. Administrators and the caller's own account cannot be suspended. Requires the moderator or admin role.\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/admin/users/{user_id}/suspend\x12\xe3\x01\n" +
+	"\rUnsuspendUser\x12\".user.auth.v1.UnsuspendUserRequest\x1a\x17.user.auth.v1.AdminUser\"\x94\x01\x92Ac\n" +
+	"\x05Admin\x12\x0eUnsuspend User\x1aJAllows the account to sign in again. Requires the moderator or admin role.\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/admin/users/{user_id}/unsuspend\x12\xff\x01\n" +
+	"\vForceLogout\x12 .user.auth.v1.ForceLogoutRequest\x1a!.user.auth.v1.ForceLogoutResponse\"\xaa\x01\x92A|\n" +
+	"\x05Admin\x12\fForce Logout\x1aeSigns the account out of every device by revoking all of its refresh tokens. Requires the admin role.\x82\xd3\xe4\x93\x02%:\x01*\" /v1/admin/users/{user_id}/logout\x1a:\x92A7\x125Account moderation for moderators and administrators.BAZ?github.com/mamataliev-dev/social-platform/api/gen/v1/userauthpbb\x06proto3"
 
 var (
 	file_user_auth_v1_user_auth_proto_rawDescOnce sync.Once
@@ -367,28 +2524,121 @@ func file_user_auth_v1_user_auth_proto_rawDescGZIP() []byte {
 	return file_user_auth_v1_user_auth_proto_rawDescData
 }
 
-var file_user_auth_v1_user_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_user_auth_v1_user_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
 var file_user_auth_v1_user_auth_proto_goTypes = []any{
-	(*RegisterRequest)(nil),     // 0: user.auth.v1.RegisterRequest
-	(*LoginRequest)(nil),        // 1: user.auth.v1.LoginRequest
-	(*RefreshTokenPayload)(nil), // 2: user.auth.v1.RefreshTokenPayload
-	(*AuthTokenResponse)(nil),   // 3: user.auth.v1.AuthTokenResponse
-	(*LogoutResponse)(nil),      // 4: user.auth.v1.LogoutResponse
+	(*RegisterRequest)(nil),                 // 0: user.auth.v1.RegisterRequest
+	(*LoginRequest)(nil),                    // 1: user.auth.v1.LoginRequest
+	(*RefreshTokenPayload)(nil),             // 2: user.auth.v1.RefreshTokenPayload
+	(*AuthTokenResponse)(nil),               // 3: user.auth.v1.AuthTokenResponse
+	(*LogoutResponse)(nil),                  // 4: user.auth.v1.LogoutResponse
+	(*VerifyEmailRequest)(nil),              // 5: user.auth.v1.VerifyEmailRequest
+	(*VerifyEmailResponse)(nil),             // 6: user.auth.v1.VerifyEmailResponse
+	(*ResendVerificationEmailRequest)(nil),  // 7: user.auth.v1.ResendVerificationEmailRequest
+	(*ResendVerificationEmailResponse)(nil), // 8: user.auth.v1.ResendVerificationEmailResponse
+	(*VerifyMFARequest)(nil),                // 9: user.auth.v1.VerifyMFARequest
+	(*EnrollTOTPRequest)(nil),               // 10: user.auth.v1.EnrollTOTPRequest
+	(*EnrollTOTPResponse)(nil),              // 11: user.auth.v1.EnrollTOTPResponse
+	(*ConfirmTOTPRequest)(nil),              // 12: user.auth.v1.ConfirmTOTPRequest
+	(*ConfirmTOTPResponse)(nil),             // 13: user.auth.v1.ConfirmTOTPResponse
+	(*DisableTOTPRequest)(nil),              // 14: user.auth.v1.DisableTOTPRequest
+	(*DisableTOTPResponse)(nil),             // 15: user.auth.v1.DisableTOTPResponse
+	(*BeginOAuthLoginRequest)(nil),          // 16: user.auth.v1.BeginOAuthLoginRequest
+	(*BeginOAuthLoginResponse)(nil),         // 17: user.auth.v1.BeginOAuthLoginResponse
+	(*CompleteOAuthLoginRequest)(nil),       // 18: user.auth.v1.CompleteOAuthLoginRequest
+	(*Session)(nil),                         // 19: user.auth.v1.Session
+	(*ListSessionsRequest)(nil),             // 20: user.auth.v1.ListSessionsRequest
+	(*ListSessionsResponse)(nil),            // 21: user.auth.v1.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),            // 22: user.auth.v1.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),           // 23: user.auth.v1.RevokeSessionResponse
+	(*RevokeAllOtherSessionsRequest)(nil),   // 24: user.auth.v1.RevokeAllOtherSessionsRequest
+	(*RevokeAllOtherSessionsResponse)(nil),  // 25: user.auth.v1.RevokeAllOtherSessionsResponse
+	(*ChangePasswordRequest)(nil),           // 26: user.auth.v1.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),          // 27: user.auth.v1.ChangePasswordResponse
+	(*RequestPasswordResetRequest)(nil),     // 28: user.auth.v1.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil),    // 29: user.auth.v1.RequestPasswordResetResponse
+	(*ResetPasswordRequest)(nil),            // 30: user.auth.v1.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),           // 31: user.auth.v1.ResetPasswordResponse
+	(*DeleteMyAccountRequest)(nil),          // 32: user.auth.v1.DeleteMyAccountRequest
+	(*DeleteMyAccountResponse)(nil),         // 33: user.auth.v1.DeleteMyAccountResponse
+	(*ExportMyDataRequest)(nil),             // 34: user.auth.v1.ExportMyDataRequest
+	(*GetDataExportRequest)(nil),            // 35: user.auth.v1.GetDataExportRequest
+	(*DataExportJob)(nil),                   // 36: user.auth.v1.DataExportJob
+	(*AdminUser)(nil),                       // 37: user.auth.v1.AdminUser
+	(*ListUsersRequest)(nil),                // 38: user.auth.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),               // 39: user.auth.v1.ListUsersResponse
+	(*SuspendUserRequest)(nil),              // 40: user.auth.v1.SuspendUserRequest
+	(*UnsuspendUserRequest)(nil),            // 41: user.auth.v1.UnsuspendUserRequest
+	(*ForceLogoutRequest)(nil),              // 42: user.auth.v1.ForceLogoutRequest
+	(*ForceLogoutResponse)(nil),             // 43: user.auth.v1.ForceLogoutResponse
+	(*timestamppb.Timestamp)(nil),           // 44: google.protobuf.Timestamp
 }
 var file_user_auth_v1_user_auth_proto_depIdxs = []int32{
-	0, // 0: user.auth.v1.AuthService.Register:input_type -> user.auth.v1.RegisterRequest
-	1, // 1: user.auth.v1.AuthService.Login:input_type -> user.auth.v1.LoginRequest
-	2, // 2: user.auth.v1.AuthService.Logout:input_type -> user.auth.v1.RefreshTokenPayload
-	2, // 3: user.auth.v1.AuthService.RefreshToken:input_type -> user.auth.v1.RefreshTokenPayload
-	3, // 4: user.auth.v1.AuthService.Register:output_type -> user.auth.v1.AuthTokenResponse
-	3, // 5: user.auth.v1.AuthService.Login:output_type -> user.auth.v1.AuthTokenResponse
-	4, // 6: user.auth.v1.AuthService.Logout:output_type -> user.auth.v1.LogoutResponse
-	3, // 7: user.auth.v1.AuthService.RefreshToken:output_type -> user.auth.v1.AuthTokenResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	44, // 0: user.auth.v1.Session.created_at:type_name -> google.protobuf.Timestamp
+	44, // 1: user.auth.v1.Session.last_used_at:type_name -> google.protobuf.Timestamp
+	44, // 2: user.auth.v1.Session.expires_at:type_name -> google.protobuf.Timestamp
+	19, // 3: user.auth.v1.ListSessionsResponse.sessions:type_name -> user.auth.v1.Session
+	44, // 4: user.auth.v1.DeleteMyAccountResponse.purge_after:type_name -> google.protobuf.Timestamp
+	44, // 5: user.auth.v1.DataExportJob.created_at:type_name -> google.protobuf.Timestamp
+	44, // 6: user.auth.v1.DataExportJob.completed_at:type_name -> google.protobuf.Timestamp
+	44, // 7: user.auth.v1.DataExportJob.expires_at:type_name -> google.protobuf.Timestamp
+	44, // 8: user.auth.v1.AdminUser.created_at:type_name -> google.protobuf.Timestamp
+	44, // 9: user.auth.v1.AdminUser.suspended_at:type_name -> google.protobuf.Timestamp
+	37, // 10: user.auth.v1.ListUsersResponse.users:type_name -> user.auth.v1.AdminUser
+	0,  // 11: user.auth.v1.AuthService.Register:input_type -> user.auth.v1.RegisterRequest
+	1,  // 12: user.auth.v1.AuthService.Login:input_type -> user.auth.v1.LoginRequest
+	2,  // 13: user.auth.v1.AuthService.Logout:input_type -> user.auth.v1.RefreshTokenPayload
+	2,  // 14: user.auth.v1.AuthService.RefreshToken:input_type -> user.auth.v1.RefreshTokenPayload
+	5,  // 15: user.auth.v1.AuthService.VerifyEmail:input_type -> user.auth.v1.VerifyEmailRequest
+	7,  // 16: user.auth.v1.AuthService.ResendVerificationEmail:input_type -> user.auth.v1.ResendVerificationEmailRequest
+	9,  // 17: user.auth.v1.AuthService.VerifyMFA:input_type -> user.auth.v1.VerifyMFARequest
+	10, // 18: user.auth.v1.AuthService.EnrollTOTP:input_type -> user.auth.v1.EnrollTOTPRequest
+	12, // 19: user.auth.v1.AuthService.ConfirmTOTP:input_type -> user.auth.v1.ConfirmTOTPRequest
+	14, // 20: user.auth.v1.AuthService.DisableTOTP:input_type -> user.auth.v1.DisableTOTPRequest
+	16, // 21: user.auth.v1.AuthService.BeginOAuthLogin:input_type -> user.auth.v1.BeginOAuthLoginRequest
+	18, // 22: user.auth.v1.AuthService.CompleteOAuthLogin:input_type -> user.auth.v1.CompleteOAuthLoginRequest
+	20, // 23: user.auth.v1.AuthService.ListSessions:input_type -> user.auth.v1.ListSessionsRequest
+	22, // 24: user.auth.v1.AuthService.RevokeSession:input_type -> user.auth.v1.RevokeSessionRequest
+	24, // 25: user.auth.v1.AuthService.RevokeAllOtherSessions:input_type -> user.auth.v1.RevokeAllOtherSessionsRequest
+	26, // 26: user.auth.v1.AuthService.ChangePassword:input_type -> user.auth.v1.ChangePasswordRequest
+	28, // 27: user.auth.v1.AuthService.RequestPasswordReset:input_type -> user.auth.v1.RequestPasswordResetRequest
+	30, // 28: user.auth.v1.AuthService.ResetPassword:input_type -> user.auth.v1.ResetPasswordRequest
+	32, // 29: user.auth.v1.AuthService.DeleteMyAccount:input_type -> user.auth.v1.DeleteMyAccountRequest
+	34, // 30: user.auth.v1.AuthService.ExportMyData:input_type -> user.auth.v1.ExportMyDataRequest
+	35, // 31: user.auth.v1.AuthService.GetDataExport:input_type -> user.auth.v1.GetDataExportRequest
+	38, // 32: user.auth.v1.AdminService.ListUsers:input_type -> user.auth.v1.ListUsersRequest
+	40, // 33: user.auth.v1.AdminService.SuspendUser:input_type -> user.auth.v1.SuspendUserRequest
+	41, // 34: user.auth.v1.AdminService.UnsuspendUser:input_type -> user.auth.v1.UnsuspendUserRequest
+	42, // 35: user.auth.v1.AdminService.ForceLogout:input_type -> user.auth.v1.ForceLogoutRequest
+	3,  // 36: user.auth.v1.AuthService.Register:output_type -> user.auth.v1.AuthTokenResponse
+	3,  // 37: user.auth.v1.AuthService.Login:output_type -> user.auth.v1.AuthTokenResponse
+	4,  // 38: user.auth.v1.AuthService.Logout:output_type -> user.auth.v1.LogoutResponse
+	3,  // 39: user.auth.v1.AuthService.RefreshToken:output_type -> user.auth.v1.AuthTokenResponse
+	6,  // 40: user.auth.v1.AuthService.VerifyEmail:output_type -> user.auth.v1.VerifyEmailResponse
+	8,  // 41: user.auth.v1.AuthService.ResendVerificationEmail:output_type -> user.auth.v1.ResendVerificationEmailResponse
+	3,  // 42: user.auth.v1.AuthService.VerifyMFA:output_type -> user.auth.v1.AuthTokenResponse
+	11, // 43: user.auth.v1.AuthService.EnrollTOTP:output_type -> user.auth.v1.EnrollTOTPResponse
+	13, // 44: user.auth.v1.AuthService.ConfirmTOTP:output_type -> user.auth.v1.ConfirmTOTPResponse
+	15, // 45: user.auth.v1.AuthService.DisableTOTP:output_type -> user.auth.v1.DisableTOTPResponse
+	17, // 46: user.auth.v1.AuthService.BeginOAuthLogin:output_type -> user.auth.v1.BeginOAuthLoginResponse
+	3,  // 47: user.auth.v1.AuthService.CompleteOAuthLogin:output_type -> user.auth.v1.AuthTokenResponse
+	21, // 48: user.auth.v1.AuthService.ListSessions:output_type -> user.auth.v1.ListSessionsResponse
+	23, // 49: user.auth.v1.AuthService.RevokeSession:output_type -> user.auth.v1.RevokeSessionResponse
+	25, // 50: user.auth.v1.AuthService.RevokeAllOtherSessions:output_type -> user.auth.v1.RevokeAllOtherSessionsResponse
+	27, // 51: user.auth.v1.AuthService.ChangePassword:output_type -> user.auth.v1.ChangePasswordResponse
+	29, // 52: user.auth.v1.AuthService.RequestPasswordReset:output_type -> user.auth.v1.RequestPasswordResetResponse
+	31, // 53: user.auth.v1.AuthService.ResetPassword:output_type -> user.auth.v1.ResetPasswordResponse
+	33, // 54: user.auth.v1.AuthService.DeleteMyAccount:output_type -> user.auth.v1.DeleteMyAccountResponse
+	36, // 55: user.auth.v1.AuthService.ExportMyData:output_type -> user.auth.v1.DataExportJob
+	36, // 56: user.auth.v1.AuthService.GetDataExport:output_type -> user.auth.v1.DataExportJob
+	39, // 57: user.auth.v1.AdminService.ListUsers:output_type -> user.auth.v1.ListUsersResponse
+	37, // 58: user.auth.v1.AdminService.SuspendUser:output_type -> user.auth.v1.AdminUser
+	37, // 59: user.auth.v1.AdminService.UnsuspendUser:output_type -> user.auth.v1.AdminUser
+	43, // 60: user.auth.v1.AdminService.ForceLogout:output_type -> user.auth.v1.ForceLogoutResponse
+	36, // [36:61] is the sub-list for method output_type
+	11, // [11:36] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_user_auth_v1_user_auth_proto_init() }
@@ -402,9 +2652,9 @@ func file_user_auth_v1_user_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_auth_v1_user_auth_proto_rawDesc), len(file_user_auth_v1_user_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   44,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_user_auth_v1_user_auth_proto_goTypes,
 		DependencyIndexes: file_user_auth_v1_user_auth_proto_depIdxs,
@@ -0,0 +1,40 @@
+package auth
+
+import "errors"
+
+var (
+	// ErrMalformedToken indicates a string that is not a well-formed JWT.
+	ErrMalformedToken = errors.New("malformed token")
+	// ErrInvalidSignature indicates a token whose signature does not verify.
+	ErrInvalidSignature = errors.New("invalid token signature")
+	// ErrUnexpectedSigningMethod indicates a token signed with a disallowed algorithm.
+	ErrUnexpectedSigningMethod = errors.New("unexpected JWT signing method")
+	// ErrUnknownSigningKey indicates a token whose "kid" is not in the trusted key set.
+	ErrUnknownSigningKey = errors.New("unknown signing key")
+	// ErrJWKSUnavailable indicates the JWKS document could not be fetched or decoded.
+	ErrJWKSUnavailable = errors.New("jwks unavailable")
+
+	// ErrTokenExpired indicates a token past its "exp", beyond the allowed leeway.
+	ErrTokenExpired = errors.New("token is expired")
+	// ErrTokenNotYetValid indicates a token used before its "nbf" or "iat".
+	ErrTokenNotYetValid = errors.New("token is not valid yet")
+	// ErrInvalidIssuer indicates a token issued by an untrusted "iss".
+	ErrInvalidIssuer = errors.New("token has invalid issuer")
+	// ErrInvalidAudience indicates a token not intended for this audience.
+	ErrInvalidAudience = errors.New("token has invalid audience")
+	// ErrMissingClaim indicates a token without a required claim such as "exp",
+	// or without "iss"/"aud" when the verifier is configured to check them.
+	ErrMissingClaim = errors.New("token is missing a required claim")
+	// ErrInvalidSubject indicates a "sub" claim that is not a user ID.
+	ErrInvalidSubject = errors.New("token has invalid subject")
+
+	// ErrWeakServiceSecret indicates a service token secret shorter than MinServiceSecretLength.
+	ErrWeakServiceSecret = errors.New("service token secret is too short")
+	// ErrTokenLifetimeTooLong indicates a service token valid for longer than ServiceTokenTTL.
+	ErrTokenLifetimeTooLong = errors.New("service token lifetime is too long")
+
+	// ErrMethodNotInPolicy indicates a method that has no entry in the MethodPolicy.
+	ErrMethodNotInPolicy = errors.New("method is not covered by the access policy")
+	// ErrInsufficientRole indicates a caller holding none of the roles a method requires.
+	ErrInsufficientRole = errors.New("caller lacks the required role")
+)
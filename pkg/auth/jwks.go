@@ -0,0 +1,228 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/sync/singleflight"
+)
+
+// minJWKSRefresh bounds how often an unknown "kid" may trigger a refetch, so
+// forged tokens cannot turn the verifier into a request amplifier. It is also
+// how long a failed fetch is remembered before the issuer is tried again.
+const minJWKSRefresh = 30 * time.Second
+
+// jwk is the subset of RFC 7517 fields needed to rebuild RSA and Ed25519 keys.
+type jwk struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	N         string `json:"n"`
+	E         string `json:"e"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+}
+
+// verificationKey is a public key and the JWT algorithm it is used with.
+type verificationKey struct {
+	alg string
+	key crypto.PublicKey
+}
+
+// JWKSClient fetches the issuer's JSON Web Key Set and caches it for ttl.
+// A token with an unknown "kid" forces an early refresh, which picks up
+// rotated keys without waiting for the cache to expire. Concurrent refreshes
+// share a single request, made without holding the cache lock, and a failed
+// one is not retried for minJWKSRefresh.
+type JWKSClient struct {
+	url        string
+	ttl        time.Duration
+	httpClient *http.Client
+	refresh    singleflight.Group
+
+	mu        sync.Mutex
+	keys      map[string]verificationKey
+	fetchedAt time.Time
+	fetchErr  error
+	retryAt   time.Time
+}
+
+// NewJWKSClient creates a client for the JWKS document at url.
+func NewJWKSClient(url string, ttl time.Duration) *JWKSClient {
+	return &JWKSClient{
+		url:        url,
+		ttl:        ttl,
+		httpClient: &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// Keyfunc resolves the verification key for token by its "kid". It satisfies
+// jwt.Keyfunc.
+func (c *JWKSClient) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	key, err := c.lookup(kid)
+	if err != nil {
+		return nil, err
+	}
+	if key.alg != token.Method.Alg() {
+		return nil, ErrUnexpectedSigningMethod
+	}
+	return key.key, nil
+}
+
+// lookup returns the cached key for kid, refreshing the cache when it is stale,
+// or when kid is unknown and the last fetch is older than minJWKSRefresh.
+// While the issuer is unreachable, known keys are served from the stale cache.
+func (c *JWKSClient) lookup(kid string) (verificationKey, error) {
+	c.mu.Lock()
+	key, ok := c.keys[kid]
+	age := time.Since(c.fetchedAt)
+	backoff := time.Now().Before(c.retryAt)
+	fetchErr := c.fetchErr
+	c.mu.Unlock()
+
+	switch {
+	case ok && (age < c.ttl || backoff):
+		return key, nil
+	case !ok && backoff:
+		return verificationKey{}, fetchErr
+	case !ok && age < c.ttl && age < minJWKSRefresh:
+		return verificationKey{}, ErrUnknownSigningKey
+	}
+
+	keys, err := c.refreshKeys()
+	if err != nil {
+		if ok {
+			// Serve the stale key rather than failing every request while
+			// the issuer is unreachable.
+			return key, nil
+		}
+		return verificationKey{}, err
+	}
+
+	key, ok = keys[kid]
+	if !ok {
+		return verificationKey{}, ErrUnknownSigningKey
+	}
+	return key, nil
+}
+
+// refreshKeys fetches the key set and updates the cache. Concurrent callers
+// wait for the same fetch instead of issuing their own.
+func (c *JWKSClient) refreshKeys() (map[string]verificationKey, error) {
+	v, err, _ := c.refresh.Do("jwks", func() (interface{}, error) {
+		keys, err := c.fetch()
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if err != nil {
+			c.fetchErr = err
+			c.retryAt = time.Now().Add(minJWKSRefresh)
+			return nil, err
+		}
+		c.keys = keys
+		c.fetchedAt = time.Now()
+		c.fetchErr = nil
+		c.retryAt = time.Time{}
+		return keys, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(map[string]verificationKey), nil
+}
+
+// fetch downloads and decodes the key set.
+func (c *JWKSClient) fetch() (map[string]verificationKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrJWKSUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: status %d", ErrJWKSUnavailable, resp.StatusCode)
+	}
+
+	var doc struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrJWKSUnavailable, err)
+	}
+
+	keys := make(map[string]verificationKey, len(doc.Keys))
+	for _, k := range doc.Keys {
+		pub, err := k.publicKey()
+		if err != nil {
+			// Skip key types we do not understand instead of rejecting the set.
+			continue
+		}
+		keys[k.KeyID] = verificationKey{alg: k.algorithm(), key: pub}
+	}
+	return keys, nil
+}
+
+// algorithm returns the key's "alg", which RFC 7517 makes optional. When it is
+// absent the algorithm is inferred from the key type, as many OpenID Connect
+// providers publish keys without it.
+func (k jwk) algorithm() string {
+	if k.Algorithm != "" {
+		return k.Algorithm
+	}
+	switch k.KeyType {
+	case "RSA":
+		return jwt.SigningMethodRS256.Alg()
+	case "OKP":
+		return jwt.SigningMethodEdDSA.Alg()
+	}
+	return ""
+}
+
+// publicKey rebuilds the RSA or Ed25519 public key described by k.
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.KeyType {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "OKP":
+		if k.Curve != "Ed25519" {
+			return nil, ErrUnknownSigningKey
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, ErrUnknownSigningKey
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, ErrUnknownSigningKey
+	}
+}
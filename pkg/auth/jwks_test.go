@@ -0,0 +1,259 @@
+package auth_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+)
+
+// jwksServer serves a mutable JWKS document and counts fetches.
+type jwksServer struct {
+	mu      sync.Mutex
+	keys    []map[string]string
+	status  int
+	fetches int
+}
+
+func (s *jwksServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fetches++
+	if s.status != 0 {
+		w.WriteHeader(s.status)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{"keys": s.keys})
+}
+
+func (s *jwksServer) set(keys ...map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+}
+
+func (s *jwksServer) fail(status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = status
+}
+
+func (s *jwksServer) fetchCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.fetches
+}
+
+// edKey generates an Ed25519 key and its JWK representation.
+func edKey(t *testing.T, kid string) (ed25519.PrivateKey, map[string]string) {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return priv, map[string]string{
+		"kty": "OKP", "kid": kid, "use": "sig", "alg": "EdDSA", "crv": "Ed25519",
+		"x": base64.RawURLEncoding.EncodeToString(pub),
+	}
+}
+
+// rsaKey generates an RSA key and its JWK representation.
+func rsaKey(t *testing.T, kid string) (*rsa.PrivateKey, map[string]string) {
+	t.Helper()
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return priv, map[string]string{
+		"kty": "RSA", "kid": kid, "use": "sig", "alg": "RS256",
+		"n": base64.RawURLEncoding.EncodeToString(priv.N.Bytes()),
+		"e": base64.RawURLEncoding.EncodeToString(big.NewInt(int64(priv.E)).Bytes()),
+	}
+}
+
+// sign issues a short-lived token with the given kid.
+func sign(t *testing.T, method jwt.SigningMethod, kid string, key any) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, jwt.MapClaims{"sub": 1, "exp": time.Now().Add(time.Minute).Unix()})
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+// TestJWKSClient_VerifiesAndCaches ensures that RSA and Ed25519 keys are both
+// usable and that the key set is fetched only once within the TTL.
+func TestJWKSClient_VerifiesAndCaches(t *testing.T) {
+	edPriv, edJWK := edKey(t, "ed-1")
+	rsaPriv, rsaJWK := rsaKey(t, "rsa-1")
+	srv := &jwksServer{}
+	srv.set(edJWK, rsaJWK)
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	c := auth.NewJWKSClient(ts.URL, time.Hour)
+
+	_, err := jwt.Parse(sign(t, jwt.SigningMethodEdDSA, "ed-1", edPriv), c.Keyfunc)
+	assert.NoError(t, err)
+	_, err = jwt.Parse(sign(t, jwt.SigningMethodRS256, "rsa-1", rsaPriv), c.Keyfunc)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, srv.fetchCount())
+}
+
+// TestJWKSClient_PicksUpRotatedKey ensures that once the cache expires, a key
+// added by rotation on user-service is trusted.
+func TestJWKSClient_PicksUpRotatedKey(t *testing.T) {
+	_, oldJWK := edKey(t, "old")
+	newPriv, newJWK := edKey(t, "new")
+	srv := &jwksServer{}
+	srv.set(oldJWK)
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	c := auth.NewJWKSClient(ts.URL, 0)
+
+	_, err := jwt.Parse(sign(t, jwt.SigningMethodEdDSA, "new", newPriv), c.Keyfunc)
+	assert.ErrorIs(t, err, auth.ErrUnknownSigningKey)
+
+	srv.set(oldJWK, newJWK)
+	_, err = jwt.Parse(sign(t, jwt.SigningMethodEdDSA, "new", newPriv), c.Keyfunc)
+	assert.NoError(t, err)
+}
+
+// TestJWKSClient_UnknownKeyIDIsRateLimited ensures that tokens with unknown
+// kids cannot force a refetch on every request.
+func TestJWKSClient_UnknownKeyIDIsRateLimited(t *testing.T) {
+	priv, jwk := edKey(t, "ed-1")
+	srv := &jwksServer{}
+	srv.set(jwk)
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	c := auth.NewJWKSClient(ts.URL, time.Hour)
+
+	for i := 0; i < 5; i++ {
+		_, err := jwt.Parse(sign(t, jwt.SigningMethodEdDSA, "forged", priv), c.Keyfunc)
+		assert.ErrorIs(t, err, auth.ErrUnknownSigningKey)
+	}
+	assert.Equal(t, 1, srv.fetchCount())
+}
+
+// TestJWKSClient_ServesStaleKeyWhenUnavailable ensures that an outage of the
+// JWKS endpoint neither rejects tokens signed by an already known key nor
+// stalls every request on a refetch.
+func TestJWKSClient_ServesStaleKeyWhenUnavailable(t *testing.T) {
+	priv, jwk := edKey(t, "ed-1")
+	srv := &jwksServer{}
+	srv.set(jwk)
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	c := auth.NewJWKSClient(ts.URL, 0)
+	_, err := jwt.Parse(sign(t, jwt.SigningMethodEdDSA, "ed-1", priv), c.Keyfunc)
+	require.NoError(t, err)
+
+	srv.fail(http.StatusServiceUnavailable)
+	for i := 0; i < 3; i++ {
+		_, err = jwt.Parse(sign(t, jwt.SigningMethodEdDSA, "ed-1", priv), c.Keyfunc)
+		assert.NoError(t, err)
+	}
+	// Only the first request after the outage waits for the issuer; later
+	// ones use the stale key until the retry delay has passed.
+	assert.Equal(t, 2, srv.fetchCount())
+}
+
+// TestJWKSClient_ConcurrentRefreshSharesFetch ensures that requests arriving
+// while the key set is being fetched wait for that fetch instead of starting
+// their own.
+func TestJWKSClient_ConcurrentRefreshSharesFetch(t *testing.T) {
+	priv, jwk := edKey(t, "ed-1")
+	srv := &jwksServer{}
+	srv.set(jwk)
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		srv.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+
+	c := auth.NewJWKSClient(ts.URL, time.Hour)
+	token := sign(t, jwt.SigningMethodEdDSA, "ed-1", priv)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := jwt.Parse(token, c.Keyfunc)
+			errs <- err
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, srv.fetchCount())
+}
+
+// TestJWKSClient_Unavailable ensures that a failed first fetch is reported
+// and not retried on every request.
+func TestJWKSClient_Unavailable(t *testing.T) {
+	priv, _ := edKey(t, "ed-1")
+	srv := &jwksServer{}
+	srv.fail(http.StatusInternalServerError)
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	c := auth.NewJWKSClient(ts.URL, time.Hour)
+	_, err := jwt.Parse(sign(t, jwt.SigningMethodEdDSA, "ed-1", priv), c.Keyfunc)
+	assert.ErrorIs(t, err, auth.ErrJWKSUnavailable)
+	_, err = jwt.Parse(sign(t, jwt.SigningMethodEdDSA, "ed-1", priv), c.Keyfunc)
+	assert.ErrorIs(t, err, auth.ErrJWKSUnavailable)
+	assert.Equal(t, 1, srv.fetchCount())
+}
+
+// TestJWKSClient_AlgorithmMismatch ensures that a token cannot claim a
+// different algorithm than its key was published with.
+func TestJWKSClient_AlgorithmMismatch(t *testing.T) {
+	_, jwk := edKey(t, "shared")
+	rsaPriv, _ := rsaKey(t, "unused")
+	srv := &jwksServer{}
+	srv.set(jwk)
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	c := auth.NewJWKSClient(ts.URL, time.Hour)
+	_, err := jwt.Parse(sign(t, jwt.SigningMethodRS256, "shared", rsaPriv), c.Keyfunc)
+	assert.ErrorIs(t, err, auth.ErrUnexpectedSigningMethod)
+}
+
+// TestJWKSClient_InfersMissingAlgorithm ensures that keys published without
+// "alg" are usable with the algorithm implied by their key type only.
+func TestJWKSClient_InfersMissingAlgorithm(t *testing.T) {
+	rsaPriv, jwk := rsaKey(t, "rsa-1")
+	delete(jwk, "alg")
+	srv := &jwksServer{}
+	srv.set(jwk)
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	c := auth.NewJWKSClient(ts.URL, time.Hour)
+	_, err := jwt.Parse(sign(t, jwt.SigningMethodRS256, "rsa-1", rsaPriv), c.Keyfunc)
+	assert.NoError(t, err)
+	_, err = jwt.Parse(sign(t, jwt.SigningMethodRS512, "rsa-1", rsaPriv), c.Keyfunc)
+	assert.ErrorIs(t, err, auth.ErrUnexpectedSigningMethod)
+}
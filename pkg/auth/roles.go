@@ -0,0 +1,40 @@
+package auth
+
+import "slices"
+
+// Role names carried in the "roles" claim. Every authenticated caller holds
+// RoleUser implicitly, so tokens list only elevated roles.
+const (
+	RoleUser      = "user"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+)
+
+// HasRole reports whether the claims grant role. RoleUser is granted to every
+// verified token.
+func (c *Claims) HasRole(role string) bool {
+	return role == RoleUser || slices.Contains(c.Roles, role)
+}
+
+// MethodPolicy maps full gRPC method names to the roles allowed to call them.
+// A caller needs any one of the listed roles; listing RoleUser opens a method
+// to every authenticated caller. Methods missing from the policy are denied,
+// so a newly added RPC stays closed until it is given an entry.
+type MethodPolicy map[string][]string
+
+// Authorize reports whether claims may call method. It returns
+// ErrMethodNotInPolicy for methods without an entry and ErrInsufficientRole
+// when the caller holds none of the required roles.
+func (p MethodPolicy) Authorize(method string, claims *Claims) error {
+	roles, ok := p[method]
+	if !ok {
+		return ErrMethodNotInPolicy
+	}
+
+	for _, role := range roles {
+		if claims.HasRole(role) {
+			return nil
+		}
+	}
+	return ErrInsufficientRole
+}
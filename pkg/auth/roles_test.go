@@ -0,0 +1,55 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+)
+
+// TestClaims_HasRole ensures that RoleUser is implied and elevated roles must
+// be listed in the token.
+func TestClaims_HasRole(t *testing.T) {
+	claims := &auth.Claims{Roles: []string{auth.RoleModerator}}
+
+	assert.True(t, claims.HasRole(auth.RoleUser))
+	assert.True(t, claims.HasRole(auth.RoleModerator))
+	assert.False(t, claims.HasRole(auth.RoleAdmin))
+}
+
+// TestMethodPolicy_Authorize ensures that callers need one of the listed roles
+// and that methods missing from the policy are denied.
+func TestMethodPolicy_Authorize(t *testing.T) {
+	policy := auth.MethodPolicy{
+		"/svc/Open":     {auth.RoleUser},
+		"/svc/Moderate": {auth.RoleModerator, auth.RoleAdmin},
+		"/svc/Admin":    {auth.RoleAdmin},
+	}
+	user := &auth.Claims{}
+	moderator := &auth.Claims{Roles: []string{auth.RoleModerator}}
+
+	tests := []struct {
+		name    string
+		method  string
+		claims  *auth.Claims
+		wantErr error
+	}{
+		{name: "open to users", method: "/svc/Open", claims: user},
+		{name: "moderator allowed", method: "/svc/Moderate", claims: moderator},
+		{name: "user lacks role", method: "/svc/Moderate", claims: user, wantErr: auth.ErrInsufficientRole},
+		{name: "moderator lacks admin", method: "/svc/Admin", claims: moderator, wantErr: auth.ErrInsufficientRole},
+		{name: "unlisted method", method: "/svc/Unknown", claims: moderator, wantErr: auth.ErrMethodNotInPolicy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Authorize(tt.method, tt.claims)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
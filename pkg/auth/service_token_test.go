@@ -0,0 +1,112 @@
+package auth_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+)
+
+const testServiceSecret = "0123456789abcdef0123456789abcdef"
+
+// TestServiceToken_RoundTrip ensures that a signed token verifies for its
+// audience and names the calling service.
+func TestServiceToken_RoundTrip(t *testing.T) {
+	signer, err := auth.NewServiceTokenSigner(testServiceSecret, "chat-service")
+	require.NoError(t, err)
+	verifier, err := auth.NewServiceTokenVerifier(testServiceSecret, "user-service", time.Second)
+	require.NoError(t, err)
+
+	token, err := signer.Sign("user-service")
+	require.NoError(t, err)
+
+	caller, err := verifier.Verify(token)
+	assert.NoError(t, err)
+	assert.Equal(t, "chat-service", caller)
+}
+
+// TestServiceToken_Rejections ensures that tokens for another audience, with
+// another secret, expired, overly long-lived or asymmetric are rejected.
+func TestServiceToken_Rejections(t *testing.T) {
+	verifier, err := auth.NewServiceTokenVerifier(testServiceSecret, "user-service", time.Second)
+	require.NoError(t, err)
+
+	sign := func(secret string, claims jwt.RegisteredClaims) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+		require.NoError(t, err)
+		return token
+	}
+	now := time.Now()
+	valid := jwt.RegisteredClaims{
+		Issuer:    "chat-service",
+		Audience:  jwt.ClaimStrings{"user-service"},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(30 * time.Second)),
+	}
+
+	otherAudience := valid
+	otherAudience.Audience = jwt.ClaimStrings{"chat-service"}
+	expired := valid
+	expired.IssuedAt = jwt.NewNumericDate(now.Add(-2 * time.Minute))
+	expired.ExpiresAt = jwt.NewNumericDate(now.Add(-time.Minute))
+	longLived := valid
+	longLived.ExpiresAt = jwt.NewNumericDate(now.Add(time.Hour))
+
+	keys := newTestKeys(t)
+	accessToken := keys.sign(t, validClaims())
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{name: "valid", token: sign(testServiceSecret, valid)},
+		{name: "other audience", token: sign(testServiceSecret, otherAudience), wantErr: auth.ErrInvalidAudience},
+		{name: "other secret", token: sign(strings.Repeat("x", 32), valid), wantErr: auth.ErrInvalidSignature},
+		{name: "expired", token: sign(testServiceSecret, expired), wantErr: auth.ErrTokenExpired},
+		{name: "too long-lived", token: sign(testServiceSecret, longLived), wantErr: auth.ErrTokenLifetimeTooLong},
+		{name: "user access token", token: accessToken, wantErr: auth.ErrInvalidSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := verifier.Verify(tt.token)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestServiceToken_WeakSecret ensures that short secrets are refused.
+func TestServiceToken_WeakSecret(t *testing.T) {
+	_, err := auth.NewServiceTokenSigner("short", "chat-service")
+	assert.ErrorIs(t, err, auth.ErrWeakServiceSecret)
+
+	_, err = auth.NewServiceTokenVerifier("", "user-service", 0)
+	assert.ErrorIs(t, err, auth.ErrWeakServiceSecret)
+}
+
+// TestServiceToken_Credentials ensures that per-RPC credentials attach a
+// token for the configured audience.
+func TestServiceToken_Credentials(t *testing.T) {
+	signer, err := auth.NewServiceTokenSigner(testServiceSecret, "user-service")
+	require.NoError(t, err)
+	verifier, err := auth.NewServiceTokenVerifier(testServiceSecret, "chat-service", 0)
+	require.NoError(t, err)
+
+	md, err := signer.Credentials("chat-service").GetRequestMetadata(context.Background())
+	require.NoError(t, err)
+
+	caller, err := verifier.Verify(md[auth.ServiceTokenHeader])
+	assert.NoError(t, err)
+	assert.Equal(t, "user-service", caller)
+}
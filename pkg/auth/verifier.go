@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// SigningMethods lists the algorithms access tokens may be signed with.
+// Symmetric algorithms are never accepted, so a public key cannot be abused
+// as an HMAC secret.
+var SigningMethods = []string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}
+
+// Config describes what a verifier expects of incoming tokens.
+type Config struct {
+	Issuer   string        // Required "iss"; empty disables the check
+	Audience string        // Required entry in "aud"; empty disables the check
+	Leeway   time.Duration // Clock skew tolerated for "exp", "nbf" and "iat"
+}
+
+// Verifier validates access tokens: signature, algorithm, expiry, not-before,
+// issued-at, issuer and audience.
+type Verifier struct {
+	keyfunc jwt.Keyfunc
+	parser  *jwt.Parser
+}
+
+// NewVerifier creates a verifier that resolves keys with keyfunc, typically
+// JWKSClient.Keyfunc or an in-process key set.
+func NewVerifier(keyfunc jwt.Keyfunc, cfg Config) *Verifier {
+	opts := []jwt.ParserOption{
+		jwt.WithLeeway(cfg.Leeway),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+	return &Verifier{keyfunc: keyfunc, parser: jwt.NewParser(opts...)}
+}
+
+// Verify parses tokenStr and returns its claims if every check passes. The
+// returned error wraps one of this package's sentinel errors.
+func (v *Verifier) Verify(tokenStr string) (*Claims, error) {
+	claims := &Claims{}
+	if _, err := v.parser.ParseWithClaims(tokenStr, claims, v.resolveKey); err != nil {
+		return nil, classify(err)
+	}
+	if _, err := claims.UserID(); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// resolveKey rejects disallowed algorithms before the key lookup.
+func (v *Verifier) resolveKey(token *jwt.Token) (interface{}, error) {
+	alg := token.Method.Alg()
+	for _, allowed := range SigningMethods {
+		if alg == allowed {
+			return v.keyfunc(token)
+		}
+	}
+	return nil, ErrUnexpectedSigningMethod
+}
+
+// classify maps jwt library errors onto this package's sentinel errors.
+func classify(err error) error {
+	for _, own := range []error{ErrUnexpectedSigningMethod, ErrUnknownSigningKey, ErrJWKSUnavailable} {
+		if errors.Is(err, own) {
+			return own
+		}
+	}
+
+	switch {
+	case errors.Is(err, jwt.ErrTokenMalformed):
+		return ErrMalformedToken
+	case errors.Is(err, jwt.ErrTokenSignatureInvalid):
+		return ErrInvalidSignature
+	case errors.Is(err, jwt.ErrTokenExpired):
+		return ErrTokenExpired
+	case errors.Is(err, jwt.ErrTokenNotValidYet), errors.Is(err, jwt.ErrTokenUsedBeforeIssued):
+		return ErrTokenNotYetValid
+	case errors.Is(err, jwt.ErrTokenInvalidIssuer):
+		return ErrInvalidIssuer
+	case errors.Is(err, jwt.ErrTokenInvalidAudience):
+		return ErrInvalidAudience
+	case errors.Is(err, jwt.ErrTokenRequiredClaimMissing):
+		return ErrMissingClaim
+	default:
+		return ErrInvalidSignature
+	}
+}
@@ -0,0 +1,221 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+)
+
+const (
+	testIssuer   = "user-service"
+	testAudience = "social-platform"
+	testKeyID    = "test-key"
+	testLeeway   = 30 * time.Second
+)
+
+// testKeys is a key pair trusted by the verifier under test.
+type testKeys struct {
+	pub  ed25519.PublicKey
+	priv ed25519.PrivateKey
+}
+
+func newTestKeys(t *testing.T) testKeys {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return testKeys{pub: pub, priv: priv}
+}
+
+func (k testKeys) keyfunc(token *jwt.Token) (interface{}, error) {
+	if token.Header["kid"] != testKeyID {
+		return nil, auth.ErrUnknownSigningKey
+	}
+	return k.pub, nil
+}
+
+// validClaims returns claims that pass every check.
+func validClaims() jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss":            testIssuer,
+		"aud":            []string{testAudience},
+		"sub":            "42",
+		"nickname":       "alice",
+		"email_verified": true,
+		"sid":            "session-1",
+		"roles":          []string{"moderator"},
+		"iat":            now.Unix(),
+		"nbf":            now.Unix(),
+		"exp":            now.Add(time.Minute).Unix(),
+	}
+}
+
+func (k testKeys) sign(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	token.Header["kid"] = testKeyID
+	signed, err := token.SignedString(k.priv)
+	require.NoError(t, err)
+	return signed
+}
+
+// TestVerifier_Verify covers the accepted token and every rejection reason.
+func TestVerifier_Verify(t *testing.T) {
+	keys := newTestKeys(t)
+	otherKeys := newTestKeys(t)
+	now := time.Now()
+
+	with := func(key string, value any) string {
+		claims := validClaims()
+		claims[key] = value
+		return keys.sign(t, claims)
+	}
+	without := func(key string) string {
+		claims := validClaims()
+		delete(claims, key)
+		return keys.sign(t, claims)
+	}
+
+	tests := []struct {
+		name    string
+		token   func() string
+		wantErr error
+	}{
+		{name: "valid", token: func() string { return keys.sign(t, validClaims()) }},
+		{name: "expired within leeway", token: func() string { return with("exp", now.Add(-testLeeway/2).Unix()) }},
+		{name: "not before within leeway", token: func() string { return with("nbf", now.Add(testLeeway/2).Unix()) }},
+		{name: "audience among several", token: func() string { return with("aud", []string{"other", testAudience}) }},
+		{name: "expired", token: func() string { return with("exp", now.Add(-time.Hour).Unix()) }, wantErr: auth.ErrTokenExpired},
+		{name: "not yet valid", token: func() string { return with("nbf", now.Add(time.Hour).Unix()) }, wantErr: auth.ErrTokenNotYetValid},
+		{name: "issued in the future", token: func() string { return with("iat", now.Add(time.Hour).Unix()) }, wantErr: auth.ErrTokenNotYetValid},
+		{name: "missing expiry", token: func() string { return without("exp") }, wantErr: auth.ErrMissingClaim},
+		{name: "wrong issuer", token: func() string { return with("iss", "evil") }, wantErr: auth.ErrInvalidIssuer},
+		{name: "missing issuer", token: func() string { return without("iss") }, wantErr: auth.ErrMissingClaim},
+		{name: "wrong audience", token: func() string { return with("aud", []string{"other-platform"}) }, wantErr: auth.ErrInvalidAudience},
+		{name: "missing audience", token: func() string { return without("aud") }, wantErr: auth.ErrMissingClaim},
+		{name: "non-numeric subject", token: func() string { return with("sub", "alice") }, wantErr: auth.ErrInvalidSubject},
+		{name: "missing subject", token: func() string { return without("sub") }, wantErr: auth.ErrInvalidSubject},
+		{name: "malformed", token: func() string { return "not.a.jwt" }, wantErr: auth.ErrMalformedToken},
+		{name: "empty", token: func() string { return "" }, wantErr: auth.ErrMalformedToken},
+		{
+			name:    "signed by untrusted key",
+			token:   func() string { return otherKeys.sign(t, validClaims()) },
+			wantErr: auth.ErrInvalidSignature,
+		},
+		{
+			name: "tampered payload",
+			token: func() string {
+				parts := strings.Split(keys.sign(t, validClaims()), ".")
+				forged := strings.Split(with("sub", "1"), ".")
+				return parts[0] + "." + forged[1] + "." + parts[2]
+			},
+			wantErr: auth.ErrInvalidSignature,
+		},
+		{
+			name: "unknown key id",
+			token: func() string {
+				token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, validClaims())
+				token.Header["kid"] = "rotated-away"
+				signed, err := token.SignedString(keys.priv)
+				require.NoError(t, err)
+				return signed
+			},
+			wantErr: auth.ErrUnknownSigningKey,
+		},
+		{
+			name: "hmac algorithm",
+			token: func() string {
+				token := jwt.NewWithClaims(jwt.SigningMethodHS256, validClaims())
+				token.Header["kid"] = testKeyID
+				signed, err := token.SignedString([]byte(keys.pub))
+				require.NoError(t, err)
+				return signed
+			},
+			wantErr: auth.ErrUnexpectedSigningMethod,
+		},
+		{
+			name: "none algorithm",
+			token: func() string {
+				token := jwt.NewWithClaims(jwt.SigningMethodNone, validClaims())
+				token.Header["kid"] = testKeyID
+				signed, err := token.SignedString(jwt.UnsafeAllowNoneSignatureType)
+				require.NoError(t, err)
+				return signed
+			},
+			wantErr: auth.ErrUnexpectedSigningMethod,
+		},
+	}
+
+	verifier := auth.NewVerifier(keys.keyfunc, auth.Config{
+		Issuer:   testIssuer,
+		Audience: testAudience,
+		Leeway:   testLeeway,
+	})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := verifier.Verify(tt.token())
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, claims)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testIssuer, claims.Issuer)
+		})
+	}
+}
+
+// TestVerifier_TypedClaims ensures that custom claims are decoded.
+func TestVerifier_TypedClaims(t *testing.T) {
+	keys := newTestKeys(t)
+	verifier := auth.NewVerifier(keys.keyfunc, auth.Config{Issuer: testIssuer, Audience: testAudience})
+
+	claims, err := verifier.Verify(keys.sign(t, validClaims()))
+	require.NoError(t, err)
+
+	userID, err := claims.UserID()
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), userID)
+	assert.Equal(t, "alice", claims.Nickname)
+	assert.True(t, claims.EmailVerified)
+	assert.Equal(t, "session-1", claims.SessionID)
+	assert.Equal(t, []string{"moderator"}, claims.Roles)
+}
+
+// TestVerifier_OptionalIssuerAndAudience ensures that an empty config skips
+// the issuer and audience checks while still enforcing expiry.
+func TestVerifier_OptionalIssuerAndAudience(t *testing.T) {
+	keys := newTestKeys(t)
+	verifier := auth.NewVerifier(keys.keyfunc, auth.Config{})
+
+	claims := validClaims()
+	delete(claims, "iss")
+	delete(claims, "aud")
+	_, err := verifier.Verify(keys.sign(t, claims))
+	assert.NoError(t, err)
+
+	claims["exp"] = time.Now().Add(-time.Second).Unix()
+	_, err = verifier.Verify(keys.sign(t, claims))
+	assert.ErrorIs(t, err, auth.ErrTokenExpired)
+}
+
+// TestClaimsContext ensures that claims round-trip through a context.
+func TestClaimsContext(t *testing.T) {
+	_, ok := auth.ClaimsFromContext(context.Background())
+	assert.False(t, ok)
+
+	claims := &auth.Claims{SessionID: "s"}
+	got, ok := auth.ClaimsFromContext(auth.ContextWithClaims(context.Background(), claims))
+	assert.True(t, ok)
+	assert.Same(t, claims, got)
+}
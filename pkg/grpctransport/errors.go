@@ -0,0 +1,12 @@
+package grpctransport
+
+import "errors"
+
+var (
+	// ErrMissingCertificate indicates TLS is enabled without a certificate or key file.
+	ErrMissingCertificate = errors.New("tls is enabled but cert_file or key_file is not set")
+	// ErrInvalidCertificate indicates a certificate pair that cannot be read or parsed.
+	ErrInvalidCertificate = errors.New("invalid TLS certificate")
+	// ErrInvalidCABundle indicates a CA file without any PEM certificate.
+	ErrInvalidCABundle = errors.New("CA bundle contains no certificates")
+)
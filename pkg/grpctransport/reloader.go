@@ -0,0 +1,123 @@
+package grpctransport
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// certReloader serves a certificate loaded from disk and replaces it when the
+// certificate or key file changes, so renewed certificates are picked up
+// without a restart. Existing connections keep the certificate they were
+// established with.
+type certReloader struct {
+	certFile string
+	keyFile  string
+
+	mu      sync.RWMutex
+	cert    *tls.Certificate
+	modTime time.Time
+}
+
+// newCertReloader loads the certificate pair and fails if it is unusable.
+func newCertReloader(certFile, keyFile string) (*certReloader, error) {
+	r := &certReloader{certFile: certFile, keyFile: keyFile}
+	if _, err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// reload loads the pair again if either file changed since the last load and
+// reports whether it did. On error the previous certificate stays in use.
+func (r *certReloader) reload() (bool, error) {
+	modTime, err := latestModTime(r.certFile, r.keyFile)
+	if err != nil {
+		return false, err
+	}
+
+	r.mu.RLock()
+	unchanged := r.cert != nil && modTime.Equal(r.modTime)
+	r.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", ErrInvalidCertificate, err)
+	}
+
+	r.mu.Lock()
+	r.cert = &cert
+	r.modTime = modTime
+	r.mu.Unlock()
+	return true, nil
+}
+
+// GetCertificate implements tls.Config.GetCertificate.
+func (r *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+// GetClientCertificate implements tls.Config.GetClientCertificate.
+func (r *certReloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+// latestModTime returns the most recent modification time of the files.
+func latestModTime(paths ...string) (time.Time, error) {
+	var latest time.Time
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: %v", ErrInvalidCertificate, err)
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+// Run checks the certificate files every ReloadInterval and swaps in the new
+// certificate when they change, until ctx is cancelled. A failed reload is
+// logged and the current certificate kept. Without TLS it just waits for ctx.
+// It always returns nil so it can run inside an errgroup.
+func (t *Transport) Run(ctx context.Context) error {
+	if t.cert == nil {
+		<-ctx.Done()
+		return nil
+	}
+
+	interval := t.cfg.TLS.ReloadInterval
+	if interval <= 0 {
+		interval = DefaultReloadInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		reloaded, err := t.cert.reload()
+		switch {
+		case err != nil:
+			slog.Error("failed to reload TLS certificate", "cert_file", t.cert.certFile, "err", err)
+		case reloaded:
+			slog.Info("reloaded TLS certificate", "cert_file", t.cert.certFile)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+// Package grpctransport builds the gRPC server and dial options shared by all
+// services: TLS with optional client-certificate verification (mTLS),
+// certificates reloaded from disk when they change, keepalive pings and a
+// limit on concurrent streams.
+package grpctransport
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+)
+
+// DefaultReloadInterval is how often certificate files are checked for
+// changes when TLSConfig.ReloadInterval is not set.
+const DefaultReloadInterval = time.Minute
+
+// TLSConfig configures transport security. CertFile and KeyFile hold the
+// service's own certificate: it is presented to clients and, when dialing, to
+// servers that ask for a client certificate. CAFile verifies the servers the
+// service dials (system roots when empty). When ClientCAFile is set, clients
+// of servers that require client certificates must present one signed by it.
+type TLSConfig struct {
+	Enabled        bool
+	CertFile       string
+	KeyFile        string
+	CAFile         string
+	ClientCAFile   string
+	ReloadInterval time.Duration
+}
+
+// KeepaliveConfig configures keepalive pings. Time is the idle period after
+// which a ping is sent and the minimum interval the server accepts pings at;
+// Timeout is how long to wait for the acknowledgement. A zero Time keeps the
+// gRPC defaults.
+type KeepaliveConfig struct {
+	Time    time.Duration
+	Timeout time.Duration
+}
+
+// Config holds the transport settings of one service.
+type Config struct {
+	TLS                  TLSConfig
+	Keepalive            KeepaliveConfig
+	MaxConcurrentStreams uint32
+}
+
+// Transport turns a Config into gRPC server and dial options. With TLS
+// enabled it owns the certificate, which Run keeps in sync with the files.
+type Transport struct {
+	cfg       Config
+	cert      *certReloader
+	rootCAs   *x509.CertPool
+	clientCAs *x509.CertPool
+}
+
+// New validates cfg and loads the certificate and CA bundles it names.
+func New(cfg Config) (*Transport, error) {
+	t := &Transport{cfg: cfg}
+	if !cfg.TLS.Enabled {
+		return t, nil
+	}
+
+	if cfg.TLS.CertFile == "" || cfg.TLS.KeyFile == "" {
+		return nil, ErrMissingCertificate
+	}
+	cert, err := newCertReloader(cfg.TLS.CertFile, cfg.TLS.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	t.cert = cert
+
+	if cfg.TLS.CAFile != "" {
+		if t.rootCAs, err = loadCertPool(cfg.TLS.CAFile); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.TLS.ClientCAFile != "" {
+		if t.clientCAs, err = loadCertPool(cfg.TLS.ClientCAFile); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// ServerOptions returns the options for a gRPC server: TLS credentials,
+// keepalive parameters and the stream limit. With requireClientCert and
+// ClientCAFile set, clients must present a certificate signed by it (mTLS);
+// listeners open to external clients pass false.
+func (t *Transport) ServerOptions(requireClientCert bool) []grpc.ServerOption {
+	var opts []grpc.ServerOption
+
+	if t.cfg.TLS.Enabled {
+		tlsCfg := &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: t.cert.GetCertificate,
+		}
+		if requireClientCert && t.clientCAs != nil {
+			tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+			tlsCfg.ClientCAs = t.clientCAs
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsCfg)))
+	}
+
+	if t.cfg.Keepalive.Time > 0 {
+		opts = append(opts,
+			grpc.KeepaliveParams(keepalive.ServerParameters{
+				Time:    t.cfg.Keepalive.Time,
+				Timeout: t.cfg.Keepalive.Timeout,
+			}),
+			grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+				MinTime:             t.cfg.Keepalive.Time,
+				PermitWithoutStream: true,
+			}),
+		)
+	}
+
+	if t.cfg.MaxConcurrentStreams > 0 {
+		opts = append(opts, grpc.MaxConcurrentStreams(t.cfg.MaxConcurrentStreams))
+	}
+	return opts
+}
+
+// DialOptions returns the options for a client connection. With TLS enabled
+// the server is verified against CAFile and, when serverName is not empty,
+// must present a certificate for serverName instead of the dialed host; the
+// service's own certificate is offered if the server asks for one.
+func (t *Transport) DialOptions(serverName string) []grpc.DialOption {
+	var opts []grpc.DialOption
+
+	if t.cfg.TLS.Enabled {
+		tlsCfg := &tls.Config{
+			MinVersion:           tls.VersionTLS12,
+			RootCAs:              t.rootCAs,
+			ServerName:           serverName,
+			GetClientCertificate: t.cert.GetClientCertificate,
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg)))
+	} else {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+
+	if t.cfg.Keepalive.Time > 0 {
+		opts = append(opts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                t.cfg.Keepalive.Time,
+			Timeout:             t.cfg.Keepalive.Timeout,
+			PermitWithoutStream: true,
+		}))
+	}
+	return opts
+}
+
+// loadCertPool reads a PEM bundle of CA certificates.
+func loadCertPool(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read CA bundle %s: %w", path, err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCABundle, path)
+	}
+	return pool, nil
+}
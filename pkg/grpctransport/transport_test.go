@@ -0,0 +1,239 @@
+package grpctransport_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/mamataliev-dev/social-platform/pkg/grpctransport"
+)
+
+// testCA issues certificates for "localhost" and writes them as PEM files.
+type testCA struct {
+	t    *testing.T
+	dir  string
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	file string
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	ca := &testCA{t: t, dir: t.TempDir(), cert: cert, key: key}
+	ca.file = filepath.Join(ca.dir, "ca.pem")
+	writePEM(t, ca.file, "CERTIFICATE", der)
+	return ca
+}
+
+// issue writes a leaf certificate with the given serial number, usable by
+// both servers and clients, and returns the certificate and key file paths.
+func (ca *testCA) issue(name string, serial int64) (string, string) {
+	ca.t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(ca.t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(ca.t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(ca.t, err)
+
+	certFile := filepath.Join(ca.dir, name+".pem")
+	keyFile := filepath.Join(ca.dir, name+"-key.pem")
+	writePEM(ca.t, certFile, "CERTIFICATE", der)
+	writePEM(ca.t, keyFile, "EC PRIVATE KEY", keyDER)
+	return certFile, keyFile
+}
+
+func writePEM(t *testing.T, path, blockType string, der []byte) {
+	t.Helper()
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+}
+
+// serve starts a gRPC server with the health service and returns its address.
+func serve(t *testing.T, tr *grpctransport.Transport, requireClientCert bool) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := grpc.NewServer(tr.ServerOptions(requireClientCert)...)
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String()
+}
+
+// check calls the health service at addr with the client transport.
+func check(t *testing.T, tr *grpctransport.Transport, addr string) error {
+	t.Helper()
+	conn, err := grpc.NewClient(addr, tr.DialOptions("localhost")...)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	return err
+}
+
+func TestNew_TLSWithoutCertificate(t *testing.T) {
+	_, err := grpctransport.New(grpctransport.Config{TLS: grpctransport.TLSConfig{Enabled: true}})
+	assert.ErrorIs(t, err, grpctransport.ErrMissingCertificate)
+}
+
+func TestNew_InvalidFiles(t *testing.T) {
+	ca := newTestCA(t)
+	certFile, keyFile := ca.issue("server", 2)
+
+	_, err := grpctransport.New(grpctransport.Config{TLS: grpctransport.TLSConfig{
+		Enabled: true, CertFile: certFile, KeyFile: ca.file,
+	}})
+	assert.ErrorIs(t, err, grpctransport.ErrInvalidCertificate)
+
+	_, err = grpctransport.New(grpctransport.Config{TLS: grpctransport.TLSConfig{
+		Enabled: true, CertFile: certFile, KeyFile: keyFile, ClientCAFile: keyFile,
+	}})
+	assert.ErrorIs(t, err, grpctransport.ErrInvalidCABundle)
+}
+
+func TestTransport_Insecure(t *testing.T) {
+	tr, err := grpctransport.New(grpctransport.Config{
+		Keepalive:            grpctransport.KeepaliveConfig{Time: time.Minute, Timeout: 20 * time.Second},
+		MaxConcurrentStreams: 10,
+	})
+	require.NoError(t, err)
+
+	assert.NoError(t, check(t, tr, serve(t, tr, false)))
+}
+
+func TestTransport_TLS(t *testing.T) {
+	ca := newTestCA(t)
+	certFile, keyFile := ca.issue("server", 2)
+	tr, err := grpctransport.New(grpctransport.Config{TLS: grpctransport.TLSConfig{
+		Enabled: true, CertFile: certFile, KeyFile: keyFile, CAFile: ca.file,
+	}})
+	require.NoError(t, err)
+	addr := serve(t, tr, false)
+
+	assert.NoError(t, check(t, tr, addr))
+
+	plain, err := grpctransport.New(grpctransport.Config{})
+	require.NoError(t, err)
+	assert.Error(t, check(t, plain, addr), "plaintext clients must be rejected")
+}
+
+func TestTransport_MutualTLS(t *testing.T) {
+	ca := newTestCA(t)
+	serverCert, serverKey := ca.issue("server", 2)
+	server, err := grpctransport.New(grpctransport.Config{TLS: grpctransport.TLSConfig{
+		Enabled: true, CertFile: serverCert, KeyFile: serverKey, ClientCAFile: ca.file,
+	}})
+	require.NoError(t, err)
+	addr := serve(t, server, true)
+
+	clientCert, clientKey := ca.issue("client", 3)
+	trusted, err := grpctransport.New(grpctransport.Config{TLS: grpctransport.TLSConfig{
+		Enabled: true, CertFile: clientCert, KeyFile: clientKey, CAFile: ca.file,
+	}})
+	require.NoError(t, err)
+	assert.NoError(t, check(t, trusted, addr))
+
+	other := newTestCA(t)
+	otherCert, otherKey := other.issue("client", 4)
+	untrusted, err := grpctransport.New(grpctransport.Config{TLS: grpctransport.TLSConfig{
+		Enabled: true, CertFile: otherCert, KeyFile: otherKey, CAFile: ca.file,
+	}})
+	require.NoError(t, err)
+	assert.Error(t, check(t, untrusted, addr), "clients with a foreign certificate must be rejected")
+
+	public := serve(t, server, false)
+	assert.NoError(t, check(t, untrusted, public), "listeners without mTLS must not verify client certificates")
+}
+
+func TestTransport_ReloadsCertificate(t *testing.T) {
+	ca := newTestCA(t)
+	certFile, keyFile := ca.issue("server", 2)
+	tr, err := grpctransport.New(grpctransport.Config{TLS: grpctransport.TLSConfig{
+		Enabled: true, CertFile: certFile, KeyFile: keyFile, ReloadInterval: 10 * time.Millisecond,
+	}})
+	require.NoError(t, err)
+	addr := serve(t, tr, false)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- tr.Run(ctx) }()
+
+	assert.Equal(t, int64(2), servedSerial(t, addr, ca))
+
+	ca.issue("server", 5)
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(certFile, later, later))
+	require.NoError(t, os.Chtimes(keyFile, later, later))
+
+	assert.Eventually(t, func() bool {
+		return servedSerial(t, addr, ca) == 5
+	}, 2*time.Second, 20*time.Millisecond)
+
+	cancel()
+	assert.NoError(t, <-done)
+}
+
+// servedSerial completes a TLS handshake with addr and returns the serial
+// number of the certificate it presents.
+func servedSerial(t *testing.T, addr string, ca *testCA) int64 {
+	t.Helper()
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+
+	conn, err := tls.Dial("tcp", addr, &tls.Config{
+		RootCAs:    pool,
+		ServerName: "localhost",
+		NextProtos: []string{"h2"},
+	})
+	require.NoError(t, err)
+	defer conn.Close()
+	return conn.ConnectionState().PeerCertificates[0].SerialNumber.Int64()
+}
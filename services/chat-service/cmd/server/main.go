@@ -17,10 +17,12 @@ import (
 	"github.com/joho/godotenv"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
 	chatpb "github.com/mamataliev-dev/social-platform/api/gen/chat/v1"
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+	"github.com/mamataliev-dev/social-platform/pkg/grpctransport"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/clients"
 	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/config"
 	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/logger"
 	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/mapper"
@@ -36,6 +38,13 @@ func main() {
 	}
 }
 
+// serviceName identifies chat-service in service tokens: it is the audience of
+// tokens sent to this service and the issuer of tokens it sends.
+const serviceName = "chat-service"
+
+// userServiceName is the audience of service tokens sent to user-service.
+const userServiceName = "user-service"
+
 // run loads configuration, sets up logging, initializes the database and all
 // service dependencies, and starts both gRPC and HTTP REST servers.
 func run() error {
@@ -64,25 +73,91 @@ func run() error {
 
 	// Initialize dependencies
 	roomLogger := baseLogger.With("service", "room")
+	chatSettingsLogger := baseLogger.With("service", "chat_settings")
+	userEventLogger := baseLogger.With("service", "user_events")
+	internalLogger := baseLogger.With("service", "internal")
+	userClientLogger := baseLogger.With("client", "user-service")
 
 	mappers := mapper.NewMappers()
 
 	roomRepo := repository.NewRoomPostgres(db, mappers.Room)
+	userEventRepo := repository.NewUserEventPostgres(db)
+	userDataRepo := repository.NewUserDataPostgres(db)
+	chatSettingsRepo := repository.NewChatSettingsPostgres(db)
 
-	roomSvc := service.NewRoomService(roomRepo, mappers.Room, roomLogger)
+	// Service tokens; a missing or short secret disables internal RPCs
+	serviceSigner, err := auth.NewServiceTokenSigner(cfg.UserService.ServiceToken, serviceName)
+	if err != nil {
+		slog.Warn("calls to user-service will not be authenticated", "error", err)
+	}
+	serviceVerifier, err := auth.NewServiceTokenVerifier(cfg.Internal.ServiceToken, serviceName, cfg.JWT.Leeway)
+	if err != nil {
+		slog.Warn("internal RPCs will reject every call", "error", err)
+	}
+
+	// TLS, keepalive and stream limits for the gRPC server and all clients
+	transport, err := grpctransport.New(grpctransport.Config{
+		TLS: grpctransport.TLSConfig{
+			Enabled:        cfg.GRPC.TLS.Enabled,
+			CertFile:       cfg.GRPC.TLS.CertFile,
+			KeyFile:        cfg.GRPC.TLS.KeyFile,
+			CAFile:         cfg.GRPC.TLS.CAFile,
+			ClientCAFile:   cfg.GRPC.TLS.ClientCAFile,
+			ReloadInterval: cfg.GRPC.TLS.ReloadInterval,
+		},
+		Keepalive: grpctransport.KeepaliveConfig{
+			Time:    cfg.GRPC.Keepalive.Time,
+			Timeout: cfg.GRPC.Keepalive.Timeout,
+		},
+		MaxConcurrentStreams: cfg.GRPC.MaxConcurrentStreams,
+	})
+	if err != nil {
+		slog.Error("failed to configure gRPC transport", "error", err)
+		return err
+	}
+
+	userClient, err := clients.NewUserServiceClient(cfg.UserService, transport, serviceSigner, userServiceName, userClientLogger)
+	if err != nil {
+		slog.Error("failed to create user-service client", "error", err)
+		return err
+	}
+	defer userClient.Close()
+
+	roomSvc := service.NewRoomService(roomRepo, chatSettingsRepo, userClient, mappers.Room, roomLogger)
+	chatSettingsSvc := service.NewChatSettingsService(chatSettingsRepo, mappers.ChatSettings, chatSettingsLogger)
+	internalChatSvc := service.NewInternalChatService(userDataRepo, mappers.UserData, internalLogger)
+	userEventConsumer := service.NewUserEventConsumer(
+		userClient,
+		userEventRepo,
+		cfg.UserService.EventPollInterval,
+		cfg.UserService.EventBatchSize,
+		userEventLogger,
+	)
 
 	// Context and signal handling
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
 	// gRPC server setup
-	grpcServer := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			middleware.UnaryAuthInterceptor,
-		),
-	)
+	jwks := auth.NewJWKSClient(cfg.JWT.JWKSURL, cfg.JWT.JWKSCacheTTL)
+	verifier := auth.NewVerifier(jwks.Keyfunc, auth.Config{
+		Issuer:   cfg.JWT.Issuer,
+		Audience: cfg.JWT.Audience,
+		Leeway:   cfg.JWT.Leeway,
+	})
+	interceptors := []grpc.UnaryServerInterceptor{
+		middleware.UnaryAuthInterceptor(verifier),
+	}
+	if cfg.Security.RequireVerifiedEmail {
+		interceptors = append(interceptors, middleware.VerifiedEmailInterceptor)
+	}
+
+	grpcServer := grpc.NewServer(append(transport.ServerOptions(false),
+		grpc.ChainUnaryInterceptor(interceptors...),
+	)...)
 
 	chatpb.RegisterChatServiceServer(grpcServer, roomSvc)
+	chatpb.RegisterChatSettingsServiceServer(grpcServer, chatSettingsSvc)
 	reflection.Register(grpcServer)
 
 	grpcAddr := fmt.Sprintf("%s:%d", cfg.GRPC.Host, cfg.GRPC.Port)
@@ -92,14 +167,31 @@ func run() error {
 		return err
 	}
 
+	// Internal gRPC server for other services, never exposed via the gateway
+	internalServer := grpc.NewServer(append(transport.ServerOptions(true),
+		grpc.ChainUnaryInterceptor(middleware.ServiceTokenInterceptor(serviceVerifier)),
+	)...)
+	chatpb.RegisterInternalChatServiceServer(internalServer, internalChatSvc)
+
+	internalAddr := fmt.Sprintf("%s:%d", cfg.Internal.Host, cfg.Internal.Port)
+	internalLis, err := net.Listen("tcp", internalAddr)
+	if err != nil {
+		slog.Error("failed to listen for internal gRPC", "error", err)
+		return err
+	}
+
 	// HTTP REST Gateway setup
 	mux := runtime.NewServeMux()
-	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	opts := transport.DialOptions(cfg.GRPC.TLS.ServerName)
 
 	if err := chatpb.RegisterChatServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
 		slog.Error("failed to register room gateway", "error", err)
 		return err
 	}
+	if err := chatpb.RegisterChatSettingsServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		slog.Error("failed to register chat settings gateway", "error", err)
+		return err
+	}
 
 	httpServer := &http.Server{
 		Addr:    fmt.Sprintf(":%s", cfg.Server.Port),
@@ -112,10 +204,21 @@ func run() error {
 		slog.Info("starting gRPC server", "addr", grpcAddr)
 		return grpcServer.Serve(lis)
 	})
+	eg.Go(func() error {
+		slog.Info("starting internal gRPC server", "addr", internalAddr)
+		return internalServer.Serve(internalLis)
+	})
 	eg.Go(func() error {
 		slog.Info("starting HTTP REST server", "addr", httpServer.Addr)
 		return httpServer.ListenAndServe()
 	})
+	eg.Go(func() error {
+		return transport.Run(egCtx)
+	})
+	eg.Go(func() error {
+		slog.Info("starting user event consumer", "addr", cfg.UserService.Addr)
+		return userEventConsumer.Run(egCtx)
+	})
 
 	// Wait for shutdown signal
 	<-egCtx.Done()
@@ -125,6 +228,7 @@ func run() error {
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 	grpcServer.GracefulStop()
+	internalServer.GracefulStop()
 	if err := httpServer.Shutdown(shutdownCtx); err != nil {
 		slog.Error("HTTP server shutdown failed", "error", err)
 	}
@@ -0,0 +1,86 @@
+package clients
+
+import (
+	"sync"
+	"time"
+)
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+// circuitBreaker stops calling a failing dependency. After threshold
+// consecutive failures it opens and rejects calls for cooldown; then a single
+// trial call is let through, which closes the circuit on success and reopens
+// it on failure. If the trial call never reports back, another one is let
+// through after a further cooldown. A threshold of zero disables the breaker.
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	state    breakerState
+	failures int
+	openedAt time.Time // when the circuit opened or the last trial call started
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// allow reports whether a call may proceed.
+func (b *circuitBreaker) allow() bool {
+	if b.threshold <= 0 {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == breakerClosed {
+		return true
+	}
+	if b.now().Sub(b.openedAt) < b.cooldown {
+		return false
+	}
+	b.state = breakerHalfOpen
+	b.openedAt = b.now()
+	return true
+}
+
+// success records a call that reached a healthy dependency.
+func (b *circuitBreaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = breakerClosed
+	b.failures = 0
+}
+
+// failure records a failed call and reports whether it opened the circuit.
+func (b *circuitBreaker) failure() bool {
+	if b.threshold <= 0 {
+		return false
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	if b.state == breakerHalfOpen || b.failures >= b.threshold {
+		opened := b.state != breakerOpen
+		b.state = breakerOpen
+		b.openedAt = b.now()
+		return opened
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+package clients
+
+import (
+	"sync"
+	"time"
+
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/model"
+)
+
+type cachedProfile struct {
+	profile   model.UserProfile
+	expiresAt time.Time
+}
+
+// profileCache keeps fetched profiles for ttl, holding at most size entries.
+// When full, expired entries are dropped first and then arbitrary ones. A
+// zero ttl disables caching.
+type profileCache struct {
+	ttl  time.Duration
+	size int
+	now  func() time.Time
+
+	mu      sync.Mutex
+	entries map[int64]cachedProfile
+}
+
+func newProfileCache(ttl time.Duration, size int) *profileCache {
+	return &profileCache{
+		ttl:     ttl,
+		size:    size,
+		now:     time.Now,
+		entries: make(map[int64]cachedProfile),
+	}
+}
+
+// get returns the cached profile of userID if it has not expired.
+func (c *profileCache) get(userID int64) (model.UserProfile, bool) {
+	if c.ttl <= 0 {
+		return model.UserProfile{}, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[userID]
+	if !ok || !c.now().Before(entry.expiresAt) {
+		return model.UserProfile{}, false
+	}
+	return entry.profile, true
+}
+
+// set caches profile until the TTL elapses.
+func (c *profileCache) set(profile model.UserProfile) {
+	if c.ttl <= 0 || c.size <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if _, ok := c.entries[profile.ID]; !ok && len(c.entries) >= c.size {
+		c.evict(now)
+	}
+	c.entries[profile.ID] = cachedProfile{profile: profile, expiresAt: now.Add(c.ttl)}
+}
+
+// evict frees at least one slot. The caller must hold c.mu.
+func (c *profileCache) evict(now time.Time) {
+	for id, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, id)
+		}
+	}
+	for id := range c.entries {
+		if len(c.entries) < c.size {
+			return
+		}
+		delete(c.entries, id)
+	}
+}
@@ -3,48 +3,326 @@ package clients
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"math/rand/v2"
 	"time"
 
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	userpb "github.com/mamataliev-dev/social-platform/api/gen/user/v1"
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+	"github.com/mamataliev-dev/social-platform/pkg/grpctransport"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/config"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/model"
 )
 
+// authorizationHeader carries the end user's access token. It is forwarded to
+// user-service so calls made on behalf of a user stay attributable to them.
+const authorizationHeader = "authorization"
+
+// maxBatchFetchSize is the most user IDs BatchFetchUserProfiles accepts.
+const maxBatchFetchSize = 100
+
+// UserClient calls the InternalUserService of user-service. Every call runs
+// under the caller's context and authorization, each attempt has its own
+// deadline, transient failures are retried with exponential backoff, and a
+// circuit breaker fails fast while user-service is down. Profiles are cached
+// for a short TTL. It implements model.UserDirectory and model.UserEventSource.
 type UserClient struct {
-	conn   *grpc.ClientConn
-	client userpb.InternalUserServiceClient
+	conn    *grpc.ClientConn
+	client  userpb.InternalUserServiceClient
+	cfg     config.UserService
+	breaker *circuitBreaker
+	cache   *profileCache
+	logger  *slog.Logger
 }
 
-func NewUserServiceClient(addr string) (*UserClient, error) {
-	target := fmt.Sprintf("dns:///%s", addr)
+// NewUserServiceClient connects to user-service's internal listener at
+// cfg.Addr with the TLS and keepalive settings of transport. Every call
+// carries a token from signer addressed to audience; a nil signer sends none
+// and user-service rejects the calls.
+func NewUserServiceClient(
+	cfg config.UserService,
+	transport *grpctransport.Transport,
+	signer *auth.ServiceTokenSigner,
+	audience string,
+	logger *slog.Logger,
+) (*UserClient, error) {
+	target := fmt.Sprintf("dns:///%s", cfg.Addr)
+
+	opts := transport.DialOptions("")
+	if signer != nil {
+		opts = append(opts, grpc.WithPerRPCCredentials(signer.Credentials(audience)))
+	}
 
-	conn, err := grpc.NewClient(
-		target,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	conn, err := grpc.NewClient(target, opts...)
 	if err != nil {
 		return nil, err
 	}
 
+	u := NewUserClient(userpb.NewInternalUserServiceClient(conn), cfg, logger)
+	u.conn = conn
+	return u, nil
+}
+
+// NewUserClient wraps an InternalUserService client with the retry, circuit
+// breaker and caching behaviour configured in cfg.
+//
+//   - client: generated gRPC client for user-service.
+//   - cfg:    timeouts, retries, breaker and cache settings.
+//   - logger: structured logger for diagnostics.
+func NewUserClient(
+	client userpb.InternalUserServiceClient,
+	cfg config.UserService,
+	logger *slog.Logger,
+) *UserClient {
 	return &UserClient{
-		conn:   conn,
-		client: userpb.NewInternalUserServiceClient(conn),
-	}, nil
+		client:  client,
+		cfg:     cfg,
+		breaker: newCircuitBreaker(cfg.BreakerThreshold, cfg.BreakerCooldown),
+		cache:   newProfileCache(cfg.ProfileCacheTTL, cfg.ProfileCacheSize),
+		logger:  logger,
+	}
 }
 
-func (u *UserClient) FetchUserByID(userID int64) (*userpb.UserProfile, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
-	defer cancel()
-	resp, err := u.client.FetchUserProfileByID(ctx, &userpb.FetchUserProfileByIDRequest{
-		UserId: userID,
+// FetchUserByID returns the profile of userID, from the cache if possible.
+func (u *UserClient) FetchUserByID(ctx context.Context, userID int64) (model.UserProfile, error) {
+	if profile, ok := u.cache.get(userID); ok {
+		return profile, nil
+	}
+
+	var resp *userpb.UserProfile
+	err := u.call(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = u.client.FetchUserProfileByID(ctx, &userpb.FetchUserProfileByIDRequest{UserId: userID})
+		return err
 	})
+	if status.Code(err) == codes.NotFound {
+		return model.UserProfile{}, fmt.Errorf("%w: %d", errs.ErrUserNotFound, userID)
+	}
 	if err != nil {
+		return model.UserProfile{}, err
+	}
+
+	profile := toUserProfile(resp)
+	u.cache.set(profile)
+	return profile, nil
+}
+
+// BatchFetchUsers returns the profiles of userIDs keyed by ID, skipping users
+// that do not exist. Duplicate IDs are looked up once and cached profiles are
+// reused; the rest are fetched with BatchFetchUserProfiles in chunks of
+// maxBatchFetchSize, with up to cfg.BatchConcurrency chunks in flight. Any
+// failed chunk fails the whole batch.
+func (u *UserClient) BatchFetchUsers(ctx context.Context, userIDs []int64) (map[int64]model.UserProfile, error) {
+	profiles := make(map[int64]model.UserProfile, len(userIDs))
+
+	seen := make(map[int64]bool, len(userIDs))
+	var missing []int64
+	for _, id := range userIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if profile, ok := u.cache.get(id); ok {
+			profiles[id] = profile
+			continue
+		}
+		missing = append(missing, id)
+	}
+
+	var chunks [][]int64
+	for len(missing) > 0 {
+		n := min(len(missing), maxBatchFetchSize)
+		chunks = append(chunks, missing[:n])
+		missing = missing[n:]
+	}
+	fetched := make([][]*userpb.UserProfile, len(chunks))
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(max(u.cfg.BatchConcurrency, 1))
+	for i, chunk := range chunks {
+		g.Go(func() error {
+			return u.call(gctx, func(ctx context.Context) error {
+				resp, err := u.client.BatchFetchUserProfiles(ctx, &userpb.BatchFetchUserProfilesRequest{UserIds: chunk})
+				fetched[i] = resp.GetProfiles()
+				return err
+			})
+		})
+	}
+	if err := g.Wait(); err != nil {
 		return nil, err
 	}
-	return resp, nil
+
+	for _, chunk := range fetched {
+		for _, p := range chunk {
+			profile := toUserProfile(p)
+			u.cache.set(profile)
+			profiles[profile.ID] = profile
+		}
+	}
+	return profiles, nil
+}
+
+// AreFriends asks user-service whether userID and otherUserID are friends.
+// Friendships are not cached, so that removing a friend takes effect at once.
+func (u *UserClient) AreFriends(ctx context.Context, userID, otherUserID int64) (bool, error) {
+	var resp *userpb.CheckFriendshipResponse
+	err := u.call(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = u.client.CheckFriendship(ctx, &userpb.CheckFriendshipRequest{
+			UserId:      userID,
+			OtherUserId: otherUserID,
+		})
+		return err
+	})
+	if status.Code(err) == codes.NotFound {
+		return false, fmt.Errorf("%w: %d or %d", errs.ErrUserNotFound, userID, otherUserID)
+	}
+	if err != nil {
+		return false, err
+	}
+	return resp.GetFriends(), nil
 }
 
+// ListUserEvents fetches up to limit account events after afterID from
+// user-service.
+func (u *UserClient) ListUserEvents(ctx context.Context, afterID int64, limit int) ([]model.UserEvent, error) {
+	var resp *userpb.ListUserEventsResponse
+	err := u.call(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = u.client.ListUserEvents(ctx, &userpb.ListUserEventsRequest{
+			AfterId: afterID,
+			Limit:   int32(limit),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]model.UserEvent, 0, len(resp.GetEvents()))
+	for _, e := range resp.GetEvents() {
+		events = append(events, model.UserEvent{
+			ID:         e.GetId(),
+			Type:       e.GetType(),
+			UserID:     e.GetUserId(),
+			OccurredAt: e.GetOccurredAt().AsTime(),
+		})
+	}
+	return events, nil
+}
+
+// Close closes the underlying connection, if the client owns one.
 func (u *UserClient) Close() error {
+	if u.conn == nil {
+		return nil
+	}
 	return u.conn.Close()
 }
+
+// call runs an idempotent RPC through the circuit breaker, retrying transient
+// failures up to cfg.MaxRetries times. Each attempt gets its own
+// cfg.RequestTimeout deadline within ctx.
+func (u *UserClient) call(ctx context.Context, rpc func(ctx context.Context) error) error {
+	if !u.breaker.allow() {
+		return errs.ErrUserServiceUnavailable
+	}
+	ctx = forwardAuthorization(ctx)
+
+	for attempt := 0; ; attempt++ {
+		err := u.attempt(ctx, rpc)
+		switch {
+		case err != nil && ctx.Err() != nil:
+			return ctx.Err()
+		case err == nil || !unhealthy(err):
+			u.breaker.success()
+			return err
+		case !retryable(err) || attempt >= u.cfg.MaxRetries:
+			if u.breaker.failure() {
+				u.logger.Warn("user-service circuit opened", slog.Any("error", err))
+			}
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(u.backoff(attempt)):
+		}
+	}
+}
+
+// attempt runs rpc once under the per-attempt deadline.
+func (u *UserClient) attempt(ctx context.Context, rpc func(ctx context.Context) error) error {
+	if u.cfg.RequestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, u.cfg.RequestTimeout)
+		defer cancel()
+	}
+	return rpc(ctx)
+}
+
+// backoff returns the delay before retry number attempt+1: RetryBackoff
+// doubled per attempt, with up to half of it randomized so that clients do
+// not retry in lockstep.
+func (u *UserClient) backoff(attempt int) time.Duration {
+	delay := u.cfg.RetryBackoff << attempt
+	if delay <= 0 {
+		return 0
+	}
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// forwardAuthorization copies the caller's authorization header from the
+// incoming request into the outgoing call.
+func forwardAuthorization(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, authorizationHeader, values[0])
+}
+
+// retryable reports whether a failed call may succeed when repeated.
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// unhealthy reports whether err indicates a problem with user-service itself,
+// as opposed to a rejected request, and so counts against the circuit.
+func unhealthy(err error) bool {
+	if retryable(err) {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
+
+// toUserProfile keeps the profile fields chat-service displays.
+func toUserProfile(p *userpb.UserProfile) model.UserProfile {
+	return model.UserProfile{
+		ID:        p.GetUserId(),
+		Username:  p.GetUsername(),
+		Nickname:  p.GetNickname(),
+		AvatarURL: p.GetAvatarUrl(),
+	}
+}
@@ -12,13 +12,15 @@ import (
 // Config holds all configuration for the user-service, including server, gRPC,
 // database, security, logging, and JWT settings.
 type Config struct {
-	Env      string     `yaml:"env"`      // Application environment (e.g., "development", "production")
-	Server   Server     `yaml:"server"`   // HTTP server settings
-	GRPC     GRPCConfig `yaml:"grpc"`     // gRPC server settings
-	Database Database   `yaml:"database"` // Database connection settings
-	Security Security   `yaml:"security"` // Security-related settings (e.g., CORS)
-	Logging  Logging    `yaml:"logging"`  // Logging level and format
-	JWT      JWT        `yaml:"jwt"`      // JWT signing
+	Env         string      `yaml:"env"`          // Application environment (e.g., "development", "production")
+	Server      Server      `yaml:"server"`       // HTTP server settings
+	GRPC        GRPCConfig  `yaml:"grpc"`         // gRPC server settings
+	Database    Database    `yaml:"database"`     // Database connection settings
+	Security    Security    `yaml:"security"`     // Security-related settings (e.g., CORS)
+	Logging     Logging     `yaml:"logging"`      // Logging level and format
+	JWT         JWT         `yaml:"jwt"`          // JWT verification
+	UserService UserService `yaml:"user_service"` // user-service connection and event feed
+	Internal    Internal    `yaml:"internal"`     // Internal RPC listener and authentication
 }
 
 // Server contains HTTP server configuration parameters.
@@ -34,11 +36,16 @@ type KeepaliveConfig struct {
 	Timeout time.Duration `yaml:"timeout"` // Timeout for ping ack
 }
 
-// TLSConfig holds TLS settings for secure gRPC communication.
+// TLSConfig holds TLS settings for secure gRPC communication. Enabling TLS
+// also makes the service dial user-service over TLS.
 type TLSConfig struct {
-	Enabled  bool   `yaml:"enabled"`   // Enable TLS encryption
-	CertFile string `yaml:"cert_file"` // Path to TLS certificate file
-	KeyFile  string `yaml:"key_file"`  // Path to TLS key file
+	Enabled        bool          `yaml:"enabled"`         // Enable TLS encryption
+	CertFile       string        `yaml:"cert_file"`       // Path to TLS certificate file, also presented to servers that ask for one
+	KeyFile        string        `yaml:"key_file"`        // Path to TLS key file
+	CAFile         string        `yaml:"ca_file"`         // CA bundle verifying dialed servers (system roots if empty)
+	ClientCAFile   string        `yaml:"client_ca_file"`  // CA bundle internal-listener clients must present a certificate from (mTLS), optional
+	ServerName     string        `yaml:"server_name"`     // Name the gateway expects in the gRPC server's certificate
+	ReloadInterval time.Duration `yaml:"reload_interval"` // How often certificate files are checked for changes
 }
 
 // GRPCConfig contains gRPC server configuration parameters.
@@ -61,14 +68,47 @@ type Database struct {
 	SSLMode  string `yaml:"sslmode"`  // SSL mode (e.g., "disable", "require")
 }
 
-// JWT contains JWT signing secret and token expiry settings.
+// JWT contains settings for verifying access tokens issued by user-service.
 type JWT struct {
-	Secret string `yaml:"secret"` // Secret key for signing JWT tokens
+	JWKSURL      string        `yaml:"jwks_url"`       // user-service /.well-known/jwks.json endpoint
+	JWKSCacheTTL time.Duration `yaml:"jwks_cache_ttl"` // How long fetched keys are trusted before refetching
+	Issuer       string        `yaml:"issuer"`         // Expected "iss" claim
+	Audience     string        `yaml:"audience"`       // Expected entry in the "aud" claim
+	Leeway       time.Duration `yaml:"leeway"`         // Clock skew tolerated for "exp", "nbf" and "iat"
+}
+
+// UserService configures the connection to user-service, the resilience of
+// calls to it, the profile cache and the polling of its account event feed.
+type UserService struct {
+	Addr              string        `yaml:"addr"`                // user-service gRPC address
+	ServiceToken      string        `yaml:"service_token"`       // Shared secret service tokens are signed with
+	RequestTimeout    time.Duration `yaml:"request_timeout"`     // Deadline of a single attempt
+	MaxRetries        int           `yaml:"max_retries"`         // Extra attempts after a transient failure
+	RetryBackoff      time.Duration `yaml:"retry_backoff"`       // Delay before the first retry, doubled for each further one
+	BreakerThreshold  int           `yaml:"breaker_threshold"`   // Consecutive failed calls that open the circuit (0 disables)
+	BreakerCooldown   time.Duration `yaml:"breaker_cooldown"`    // How long the circuit stays open before a trial call
+	ProfileCacheTTL   time.Duration `yaml:"profile_cache_ttl"`   // How long fetched profiles are reused (0 disables)
+	ProfileCacheSize  int           `yaml:"profile_cache_size"`  // Maximum number of cached profiles
+	BatchConcurrency  int           `yaml:"batch_concurrency"`   // Parallel batch RPCs per BatchFetchUsers call
+	EventPollInterval time.Duration `yaml:"event_poll_interval"` // Delay between polls once caught up
+	EventBatchSize    int           `yaml:"event_batch_size"`    // Events fetched per poll
+}
+
+// Internal configures RPCs called by other services. They are served on a
+// separate listener at Host:Port that must not be reachable from outside the
+// cluster. ServiceToken is the shared secret (at least 32 bytes) that the
+// signed tokens they present in the "x-service-token" metadata header are
+// verified with; a missing or short secret rejects every internal call.
+type Internal struct {
+	Host         string `yaml:"host"`          // Internal gRPC server host
+	Port         int    `yaml:"port"`          // Internal gRPC server port
+	ServiceToken string `yaml:"service_token"` // Shared secret service tokens are verified with
 }
 
 // Security holds security-related configuration, such as allowed CORS origins.
 type Security struct {
-	AllowedOrigins []string `yaml:"allowed_origins"` // List of allowed CORS origins
+	AllowedOrigins       []string `yaml:"allowed_origins"`        // List of allowed CORS origins
+	RequireVerifiedEmail bool     `yaml:"require_verified_email"` // Block unverified accounts from restricted RPCs
 }
 
 // Logging defines logging level and format configuration.
@@ -17,4 +17,22 @@ var (
 	ErrMissingAuthToken = errors.New("authorization token is not supplied")
 	// ErrInvalidToken indicates an invalid JWT token.
 	ErrInvalidToken = errors.New("invalid token")
+	// ErrMissingServiceToken indicates a service call without a service token.
+	ErrMissingServiceToken = errors.New("service token is not supplied")
+	// ErrInvalidServiceToken indicates a service token that does not match.
+	ErrInvalidServiceToken = errors.New("invalid service token")
+	// ErrEmailNotVerified indicates the caller must confirm their email first.
+	ErrEmailNotVerified = errors.New("email address is not verified")
+	// ErrPermissionDenied indicates a caller whose roles do not allow the method.
+	ErrPermissionDenied = errors.New("permission denied")
+	// ErrNotInitiator indicates a room created on behalf of another user.
+	ErrNotInitiator = errors.New("rooms can only be created by their initiator")
+	// ErrFriendsOnly indicates a room with a user who only accepts DMs from friends.
+	ErrFriendsOnly = errors.New("this user only accepts messages from friends")
+
+	// ErrUserNotFound indicates a user that does not exist in user-service.
+	ErrUserNotFound = errors.New("user not found")
+	// ErrUserServiceUnavailable indicates user-service calls are failing and
+	// the circuit breaker is rejecting them.
+	ErrUserServiceUnavailable = errors.New("user-service is unavailable")
 )
@@ -0,0 +1,37 @@
+package mapper
+
+import (
+	chatpb "github.com/mamataliev-dev/social-platform/api/gen/chat/v1"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/model"
+)
+
+// ChatSettingsMapper defines mapping operations for chat settings.
+type ChatSettingsMapper interface {
+	// GRPC ↔ Domain
+	ToChatSettingsUpdate(req *chatpb.UpdateChatSettingsRequest) model.ChatSettingsUpdate
+	ToChatSettingsResponse(settings model.ChatSettings) *chatpb.ChatSettings
+}
+
+type chatSettingsMapper struct{}
+
+func NewChatSettingsMapper() *chatSettingsMapper {
+	return &chatSettingsMapper{}
+}
+
+// ToChatSettingsUpdate maps an UpdateChatSettingsRequest into the settings
+// to change; fields the request leaves unset stay nil.
+func (m *chatSettingsMapper) ToChatSettingsUpdate(req *chatpb.UpdateChatSettingsRequest) model.ChatSettingsUpdate {
+	if req == nil {
+		return model.ChatSettingsUpdate{}
+	}
+	return model.ChatSettingsUpdate{
+		DMsFromFriendsOnly: req.DmsFromFriendsOnly,
+	}
+}
+
+// ToChatSettingsResponse maps domain chat settings into the gRPC message.
+func (m *chatSettingsMapper) ToChatSettingsResponse(settings model.ChatSettings) *chatpb.ChatSettings {
+	return &chatpb.ChatSettings{
+		DmsFromFriendsOnly: settings.DMsFromFriendsOnly,
+	}
+}
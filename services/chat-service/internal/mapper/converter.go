@@ -3,12 +3,16 @@ package mapper
 // Mappers groups every service-specific mapper under one struct,
 // so you can inject a single dependency.
 type Mappers struct {
-	Room RoomMapper
+	Room         RoomMapper
+	UserData     UserDataMapper
+	ChatSettings ChatSettingsMapper
 }
 
 // NewMappers constructs a Mappers with all sub-mappers initialized.
 func NewMappers() *Mappers {
 	return &Mappers{
-		Room: NewRoomMapper(),
+		Room:         NewRoomMapper(),
+		UserData:     NewUserDataMapper(),
+		ChatSettings: NewChatSettingsMapper(),
 	}
 }
@@ -0,0 +1,46 @@
+package mapper
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	chatpb "github.com/mamataliev-dev/social-platform/api/gen/chat/v1"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/model"
+)
+
+// UserDataMapper defines mapping operations for a user's chat data export.
+type UserDataMapper interface {
+	// Domain → GRPC
+	ToExportUserChatDataResponse(data model.UserChatData) *chatpb.ExportUserChatDataResponse
+}
+
+type userDataMapper struct{}
+
+func NewUserDataMapper() *userDataMapper {
+	return &userDataMapper{}
+}
+
+// ToExportUserChatDataResponse maps a user's rooms and messages into the gRPC response.
+func (m *userDataMapper) ToExportUserChatDataResponse(data model.UserChatData) *chatpb.ExportUserChatDataResponse {
+	resp := &chatpb.ExportUserChatDataResponse{
+		Rooms:    make([]*chatpb.Room, 0, len(data.Rooms)),
+		Messages: make([]*chatpb.ChatMessage, 0, len(data.Messages)),
+	}
+	for _, r := range data.Rooms {
+		resp.Rooms = append(resp.Rooms, &chatpb.Room{
+			Id:            r.ID,
+			InitiatorId:   r.InitiatorID,
+			ParticipantId: r.ParticipantID,
+			CreatedAt:     timestamppb.New(r.CreatedAt),
+		})
+	}
+	for _, msg := range data.Messages {
+		resp.Messages = append(resp.Messages, &chatpb.ChatMessage{
+			Id:        msg.ID,
+			RoomId:    msg.RoomID,
+			SenderId:  msg.SenderID,
+			Content:   msg.Content,
+			Timestamp: timestamppb.New(msg.CreatedAt),
+		})
+	}
+	return resp
+}
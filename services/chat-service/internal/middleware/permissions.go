@@ -0,0 +1,17 @@
+package middleware
+
+import "github.com/mamataliev-dev/social-platform/pkg/auth"
+
+// methodPermissions lists the roles allowed to call every method that requires
+// a user JWT. Service endpoints are authenticated separately and are not
+// listed; any other method missing from the table is denied.
+var methodPermissions = auth.MethodPolicy{
+	"/chat.v1.ChatService/CreateRoom":     {auth.RoleUser},
+	"/chat.v1.ChatService/GetUserRooms":   {auth.RoleUser},
+	"/chat.v1.ChatService/SendMessage":    {auth.RoleUser},
+	"/chat.v1.ChatService/GetMessages":    {auth.RoleUser},
+	"/chat.v1.ChatService/StreamMessages": {auth.RoleUser},
+
+	"/chat.v1.ChatSettingsService/GetChatSettings":    {auth.RoleUser},
+	"/chat.v1.ChatSettingsService/UpdateChatSettings": {auth.RoleUser},
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/errs"
+)
+
+// serviceEndpoints are called by other services rather than users. They carry
+// no user JWT and are authenticated by ServiceTokenInterceptor instead.
+var serviceEndpoints = map[string]bool{
+	"/chat.v1.InternalChatService/ExportUserChatData": true,
+}
+
+// ServiceTokenInterceptor returns an interceptor that requires a signed
+// service token on every service endpoint. Tokens are checked by verifier
+// (signature, audience and a lifetime of at most auth.ServiceTokenTTL); a nil
+// verifier rejects every call.
+func ServiceTokenInterceptor(verifier *auth.ServiceTokenVerifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !serviceEndpoints[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, errs.ErrMissingMetadata.Error())
+		}
+
+		values := md[auth.ServiceTokenHeader]
+		if len(values) == 0 {
+			return nil, status.Error(codes.Unauthenticated, errs.ErrMissingServiceToken.Error())
+		}
+
+		if verifier == nil {
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidServiceToken.Error())
+		}
+		if _, err := verifier.Verify(values[0]); err != nil {
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidServiceToken.Error())
+		}
+
+		return handler(ctx, req)
+	}
+}
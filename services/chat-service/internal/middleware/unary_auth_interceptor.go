@@ -2,46 +2,50 @@ package middleware
 
 import (
 	"context"
-	"os"
 	"strings"
 
-	"github.com/golang-jwt/jwt/v5"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
 	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/errs"
 )
 
-// UnaryAuthInterceptor intercepts unary gRPC calls to enforce JWT authentication.
-// It extracts the 'Authorization' header from metadata, validates the Bearer token,
-// and ensures the JWT signature and claims are correct before invoking the handler.
-func UnaryAuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+// UnaryAuthInterceptor returns an interceptor that enforces JWT authentication.
+// It extracts the 'Authorization' header from metadata and checks the Bearer
+// token with the shared verifier (signature against user-service's JWKS,
+// algorithm, expiry, issuer and audience) and checks the caller's roles
+// against methodPermissions before invoking the handler. The verified claims
+// are attached to the context for downstream interceptors.
+// Service endpoints are authenticated by ServiceTokenInterceptor instead.
+func UnaryAuthInterceptor(verifier *auth.Verifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if serviceEndpoints[info.FullMethod] {
+			return handler(ctx, req)
+		}
 
-	var jwtSecret = []byte(os.Getenv("JWT_SECRET"))
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, errs.ErrMissingMetadata.Error())
+		}
 
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingMetadata.Error())
-	}
+		authHeader := md["authorization"]
+		if len(authHeader) == 0 {
+			return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+		}
 
-	authHeader := md["authorization"]
-	if len(authHeader) == 0 {
-		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
-	}
+		tokenStr := strings.TrimPrefix(authHeader[0], "Bearer ")
+		claims, err := verifier.Verify(tokenStr)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidToken.Error())
+		}
 
-	tokenStr := strings.TrimPrefix(authHeader[0], "Bearer ")
-	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, status.Error(codes.Unauthenticated, errs.ErrUnexpectedSigningMethod.Error())
+		if err := methodPermissions.Authorize(info.FullMethod, claims); err != nil {
+			return nil, status.Error(codes.PermissionDenied, errs.ErrPermissionDenied.Error())
 		}
-		return jwtSecret, nil
-	})
 
-	if err != nil || !token.Valid {
-		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidToken.Error())
+		return handler(auth.ContextWithClaims(ctx, claims), req)
 	}
-
-	return handler(ctx, req)
 }
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/errs"
+)
+
+// verifiedEmailMethods lists RPCs that unverified accounts may not call.
+var verifiedEmailMethods = map[string]bool{
+	"/chat.v1.ChatService/CreateRoom":  true,
+	"/chat.v1.ChatService/SendMessage": true,
+}
+
+// VerifiedEmailInterceptor rejects restricted RPCs with PermissionDenied unless
+// the caller's access token carries an "email_verified": true claim. It must be
+// chained after UnaryAuthInterceptor, which attaches the claims to the context.
+func VerifiedEmailInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !verifiedEmailMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidToken.Error())
+	}
+
+	if !claims.EmailVerified {
+		return nil, status.Error(codes.PermissionDenied, errs.ErrEmailNotVerified.Error())
+	}
+
+	return handler(ctx, req)
+}
@@ -0,0 +1,27 @@
+package model
+
+import "context"
+
+// ChatSettings holds a user's chat preferences.
+// - DMsFromFriendsOnly: only friends of the user may start a room with them.
+type ChatSettings struct {
+	UserID             int64 // owner's user ID
+	DMsFromFriendsOnly bool  // only friends may start a room
+}
+
+// ChatSettingsUpdate changes some of a user's chat settings; nil fields keep
+// their value.
+type ChatSettingsUpdate struct {
+	DMsFromFriendsOnly *bool
+}
+
+// ChatSettingsRepository defines persistence operations for chat settings.
+type ChatSettingsRepository interface {
+	// GetChatSettings returns the settings of userID, or the defaults if the
+	// user never changed them.
+	GetChatSettings(ctx context.Context, userID int64) (ChatSettings, error)
+
+	// UpdateChatSettings applies update to the settings of userID and returns
+	// the result.
+	UpdateChatSettings(ctx context.Context, userID int64, update ChatSettingsUpdate) (ChatSettings, error)
+}
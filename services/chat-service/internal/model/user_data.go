@@ -0,0 +1,30 @@
+package model
+
+import (
+	"context"
+	"time"
+)
+
+// Message represents a chat message as stored.
+// - SenderID: 0 once the sender has deleted their account.
+type Message struct {
+	ID        string    // unique message UUID
+	RoomID    string    // room the message was posted in
+	SenderID  int64     // author's user ID
+	Content   string    // message text
+	CreatedAt time.Time // creation timestamp
+}
+
+// UserChatData is everything chat-service stores about one user, as included
+// in their data export.
+type UserChatData struct {
+	Rooms    []Room    // rooms the user belongs to
+	Messages []Message // messages the user sent, oldest first
+}
+
+// UserDataRepository reads all chat data belonging to a user.
+type UserDataRepository interface {
+	// FetchUserChatData returns the rooms the user belongs to and the
+	// messages they sent, or an error on failure.
+	FetchUserChatData(ctx context.Context, userID int64) (UserChatData, error)
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"context"
+	"time"
+)
+
+// UserEventDeleted is published by user-service when an account is deleted.
+const UserEventDeleted = "user.deleted"
+
+// UserEvent is an account lifecycle event published by user-service.
+// - ID: monotonically increasing cursor assigned by user-service.
+// - Type: event type, e.g. UserEventDeleted.
+// - UserID: the account the event is about.
+// - OccurredAt: when the change happened.
+type UserEvent struct {
+	ID         int64     // event cursor
+	Type       string    // event type
+	UserID     int64     // affected user's ID
+	OccurredAt time.Time // event timestamp
+}
+
+// UserEventSource reads the account event feed of user-service.
+type UserEventSource interface {
+	// ListUserEvents returns up to limit events with an ID greater than
+	// afterID, oldest first.
+	ListUserEvents(ctx context.Context, afterID int64, limit int) ([]UserEvent, error)
+}
+
+// UserEventRepository applies account events to chat data and remembers how
+// far the feed has been consumed. Applying an event twice must be harmless,
+// because the feed is delivered at least once.
+type UserEventRepository interface {
+	// UserEventCursor returns the ID of the last handled event, or 0 if none
+	// has been handled yet.
+	UserEventCursor(ctx context.Context) (int64, error)
+
+	// ApplyUserDeleted anonymizes the user's rooms and messages, removes their
+	// read receipts and advances the cursor to eventID, atomically.
+	ApplyUserDeleted(ctx context.Context, eventID, userID int64) error
+
+	// AdvanceUserEventCursor records eventID as handled without changing any
+	// chat data. It is used for event types chat-service does not act on.
+	AdvanceUserEventCursor(ctx context.Context, eventID int64) error
+}
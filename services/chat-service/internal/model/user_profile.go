@@ -0,0 +1,27 @@
+package model
+
+import "context"
+
+// UserProfile is the part of a user-service profile chat-service displays
+// next to rooms and messages.
+type UserProfile struct {
+	ID        int64  // user's ID
+	Username  string // display name
+	Nickname  string // unique handle
+	AvatarURL string // avatar image URL
+}
+
+// UserDirectory looks up user profiles in user-service.
+type UserDirectory interface {
+	// FetchUserByID returns the profile of userID, or errs.ErrUserNotFound
+	// if no such user exists.
+	FetchUserByID(ctx context.Context, userID int64) (UserProfile, error)
+
+	// BatchFetchUsers returns the profiles of the given users keyed by ID.
+	// Users that do not exist are left out of the result.
+	BatchFetchUsers(ctx context.Context, userIDs []int64) (map[int64]UserProfile, error)
+
+	// AreFriends reports whether userID and otherUserID are friends, or
+	// returns errs.ErrUserNotFound if either user does not exist.
+	AreFriends(ctx context.Context, userID, otherUserID int64) (bool, error)
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/model"
+)
+
+// ChatSettingsPostgres is a PostgreSQL implementation of
+// model.ChatSettingsRepository.
+type ChatSettingsPostgres struct {
+	db *sql.DB
+}
+
+// NewChatSettingsPostgres creates a new ChatSettingsPostgres backed by the
+// given SQL DB.
+func NewChatSettingsPostgres(db *sql.DB) *ChatSettingsPostgres {
+	return &ChatSettingsPostgres{db: db}
+}
+
+// GetChatSettings reads the settings row of userID and falls back to the
+// column defaults if there is none. Returns ErrDBFailure on database errors.
+func (r *ChatSettingsPostgres) GetChatSettings(ctx context.Context, userID int64) (model.ChatSettings, error) {
+	settings := model.ChatSettings{UserID: userID}
+
+	err := r.db.QueryRowContext(ctx, `
+        SELECT dms_from_friends_only FROM chat_settings WHERE user_id = $1
+    `, userID).Scan(&settings.DMsFromFriendsOnly)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return settings, nil
+	case err != nil:
+		return model.ChatSettings{}, fmt.Errorf("%w: failed to read chat settings: %v", errs.ErrDBFailure, err)
+	}
+	return settings, nil
+}
+
+// UpdateChatSettings upserts the settings row of userID, keeping the stored
+// value of every field update leaves nil. Returns ErrDBFailure on database
+// errors.
+func (r *ChatSettingsPostgres) UpdateChatSettings(
+	ctx context.Context,
+	userID int64,
+	update model.ChatSettingsUpdate,
+) (model.ChatSettings, error) {
+	query := `
+        INSERT INTO chat_settings (user_id, dms_from_friends_only)
+        VALUES ($1, COALESCE($2, FALSE))
+        ON CONFLICT (user_id) DO UPDATE SET
+            dms_from_friends_only = COALESCE($2, chat_settings.dms_from_friends_only),
+            updated_at = NOW()
+        RETURNING dms_from_friends_only
+    `
+
+	var friendsOnly sql.NullBool
+	if update.DMsFromFriendsOnly != nil {
+		friendsOnly = sql.NullBool{Bool: *update.DMsFromFriendsOnly, Valid: true}
+	}
+
+	settings := model.ChatSettings{UserID: userID}
+	if err := r.db.QueryRowContext(ctx, query, userID, friendsOnly).Scan(&settings.DMsFromFriendsOnly); err != nil {
+		return model.ChatSettings{}, fmt.Errorf("%w: failed to update chat settings: %v", errs.ErrDBFailure, err)
+	}
+	return settings, nil
+}
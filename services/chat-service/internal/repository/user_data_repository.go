@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/model"
+)
+
+// UserDataPostgres is a PostgreSQL implementation of model.UserDataRepository.
+type UserDataPostgres struct {
+	db *sql.DB
+}
+
+// NewUserDataPostgres creates a new UserDataPostgres backed by the given SQL DB.
+func NewUserDataPostgres(db *sql.DB) *UserDataPostgres {
+	return &UserDataPostgres{db: db}
+}
+
+// FetchUserChatData returns the user's rooms and the messages they sent,
+// skipping deleted messages. Participants who deleted their account are
+// reported with ID 0. Returns ErrDBFailure on database errors.
+func (r *UserDataPostgres) FetchUserChatData(ctx context.Context, userID int64) (model.UserChatData, error) {
+	rooms, err := r.fetchRooms(ctx, userID)
+	if err != nil {
+		return model.UserChatData{}, err
+	}
+
+	messages, err := r.fetchMessages(ctx, userID)
+	if err != nil {
+		return model.UserChatData{}, err
+	}
+
+	return model.UserChatData{Rooms: rooms, Messages: messages}, nil
+}
+
+func (r *UserDataPostgres) fetchRooms(ctx context.Context, userID int64) ([]model.Room, error) {
+	query := `
+        SELECT id, COALESCE(initiator_id, 0), COALESCE(participant_id, 0), created_at
+        FROM rooms
+        WHERE initiator_id = $1 OR participant_id = $1
+        ORDER BY created_at
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to query rooms: %v", errs.ErrDBFailure, err)
+	}
+	defer rows.Close()
+
+	var rooms []model.Room
+	for rows.Next() {
+		var room model.Room
+		if err := rows.Scan(&room.ID, &room.InitiatorID, &room.ParticipantID, &room.CreatedAt); err != nil {
+			return nil, fmt.Errorf("%w: failed to scan room: %v", errs.ErrDBFailure, err)
+		}
+		rooms = append(rooms, room)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: failed to read rooms: %v", errs.ErrDBFailure, err)
+	}
+	return rooms, nil
+}
+
+func (r *UserDataPostgres) fetchMessages(ctx context.Context, userID int64) ([]model.Message, error) {
+	query := `
+        SELECT id, room_id, sender_id, COALESCE(content, ''), created_at
+        FROM messages
+        WHERE sender_id = $1 AND NOT is_deleted
+        ORDER BY created_at
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to query messages: %v", errs.ErrDBFailure, err)
+	}
+	defer rows.Close()
+
+	var messages []model.Message
+	for rows.Next() {
+		var m model.Message
+		if err := rows.Scan(&m.ID, &m.RoomID, &m.SenderID, &m.Content, &m.CreatedAt); err != nil {
+			return nil, fmt.Errorf("%w: failed to scan message: %v", errs.ErrDBFailure, err)
+		}
+		messages = append(messages, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: failed to read messages: %v", errs.ErrDBFailure, err)
+	}
+	return messages, nil
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/errs"
+)
+
+// UserEventPostgres is a PostgreSQL implementation of model.UserEventRepository.
+type UserEventPostgres struct {
+	db *sql.DB
+}
+
+// NewUserEventPostgres creates a new UserEventPostgres backed by the given SQL DB.
+func NewUserEventPostgres(db *sql.DB) *UserEventPostgres {
+	return &UserEventPostgres{db: db}
+}
+
+// UserEventCursor returns the ID of the last handled user event, or 0 if no
+// event has been handled yet. Returns ErrDBFailure on database errors.
+func (r *UserEventPostgres) UserEventCursor(ctx context.Context) (int64, error) {
+	var lastID int64
+	err := r.db.QueryRowContext(ctx, `SELECT last_event_id FROM user_event_cursor`).Scan(&lastID)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return 0, nil
+	case err != nil:
+		return 0, fmt.Errorf("%w: failed to read user event cursor: %v", errs.ErrDBFailure, err)
+	}
+	return lastID, nil
+}
+
+// ApplyUserDeleted clears the user's ID from rooms and sent messages, deletes
+// their read receipts and chat settings and advances the cursor in a single transaction, so a
+// crash never leaves the event half applied. Returns ErrDBFailure on database
+// errors.
+func (r *UserEventPostgres) ApplyUserDeleted(ctx context.Context, eventID, userID int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%w: failed to begin transaction: %v", errs.ErrDBFailure, err)
+	}
+	defer tx.Rollback()
+
+	statements := []string{
+		`UPDATE messages SET sender_id = NULL WHERE sender_id = $1`,
+		`UPDATE rooms SET initiator_id = NULL, updated_at = NOW() WHERE initiator_id = $1`,
+		`UPDATE rooms SET participant_id = NULL, updated_at = NOW() WHERE participant_id = $1`,
+		`DELETE FROM message_receipts WHERE user_id = $1`,
+		`DELETE FROM chat_settings WHERE user_id = $1`,
+	}
+	for _, stmt := range statements {
+		if _, err := tx.ExecContext(ctx, stmt, userID); err != nil {
+			return fmt.Errorf("%w: failed to anonymize deleted user: %v", errs.ErrDBFailure, err)
+		}
+	}
+
+	if err := advanceCursor(ctx, tx, eventID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%w: failed to commit transaction: %v", errs.ErrDBFailure, err)
+	}
+	return nil
+}
+
+// AdvanceUserEventCursor records eventID as the last handled user event.
+// Returns ErrDBFailure on database errors.
+func (r *UserEventPostgres) AdvanceUserEventCursor(ctx context.Context, eventID int64) error {
+	return advanceCursor(ctx, r.db, eventID)
+}
+
+// execer is satisfied by both *sql.DB and *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// advanceCursor moves the cursor forward to eventID. It never moves the cursor
+// backwards, so redelivered events cannot rewind it.
+func advanceCursor(ctx context.Context, db execer, eventID int64) error {
+	query := `
+        INSERT INTO user_event_cursor (id, last_event_id)
+        VALUES (TRUE, $1)
+        ON CONFLICT (id) DO UPDATE
+        SET last_event_id = GREATEST(user_event_cursor.last_event_id, EXCLUDED.last_event_id),
+            updated_at = NOW()
+    `
+	if _, err := db.ExecContext(ctx, query, eventID); err != nil {
+		return fmt.Errorf("%w: failed to advance user event cursor: %v", errs.ErrDBFailure, err)
+	}
+	return nil
+}
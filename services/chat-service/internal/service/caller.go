@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/errs"
+)
+
+// callerID returns the user ID of the verified access token attached to ctx
+// by the auth interceptor, or an Unauthenticated error if there is none.
+func callerID(ctx context.Context) (int64, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return 0, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+	id, err := claims.UserID()
+	if err != nil {
+		return 0, status.Error(codes.Unauthenticated, errs.ErrInvalidToken.Error())
+	}
+	return id, nil
+}
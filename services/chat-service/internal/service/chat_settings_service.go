@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	chatpb "github.com/mamataliev-dev/social-platform/api/gen/chat/v1"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/mapper"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/model"
+)
+
+// ChatSettingsService implements the ChatSettingsServiceServer for reading and
+// changing the caller's chat preferences.
+type ChatSettingsService struct {
+	chatpb.UnimplementedChatSettingsServiceServer
+
+	settingsRepo model.ChatSettingsRepository
+	mapper       mapper.ChatSettingsMapper
+	logger       *slog.Logger
+}
+
+// NewChatSettingsService constructs a ChatSettingsService with the given
+// dependencies.
+//
+//   - settingsRepo: interface for persisting chat settings.
+//   - mapper:       converts between gRPC messages and internal models.
+//   - logger:       structured logger for diagnostics.
+func NewChatSettingsService(
+	settingsRepo model.ChatSettingsRepository,
+	mapper mapper.ChatSettingsMapper,
+	logger *slog.Logger,
+) *ChatSettingsService {
+	return &ChatSettingsService{
+		settingsRepo: settingsRepo,
+		mapper:       mapper,
+		logger:       logger,
+	}
+}
+
+// GetChatSettings returns the caller's chat settings.
+func (s *ChatSettingsService) GetChatSettings(
+	ctx context.Context,
+	_ *chatpb.GetChatSettingsRequest,
+) (*chatpb.ChatSettings, error) {
+	caller, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := s.settingsRepo.GetChatSettings(ctx, caller)
+	if err != nil {
+		s.logger.Error("unable to read chat settings", slog.Int64("user_id", caller), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	return s.mapper.ToChatSettingsResponse(settings), nil
+}
+
+// UpdateChatSettings changes the settings the request sets and returns the
+// caller's resulting chat settings.
+func (s *ChatSettingsService) UpdateChatSettings(
+	ctx context.Context,
+	req *chatpb.UpdateChatSettingsRequest,
+) (*chatpb.ChatSettings, error) {
+	caller, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := s.settingsRepo.UpdateChatSettings(ctx, caller, s.mapper.ToChatSettingsUpdate(req))
+	if err != nil {
+		s.logger.Error("unable to update chat settings", slog.Int64("user_id", caller), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	s.logger.Info("updated chat settings", slog.Int64("user_id", caller))
+	return s.mapper.ToChatSettingsResponse(settings), nil
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	chatpb "github.com/mamataliev-dev/social-platform/api/gen/chat/v1"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/mapper"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/model"
+)
+
+// InternalChatService implements the InternalChatServiceServer, which other
+// services call with the shared service token.
+type InternalChatService struct {
+	chatpb.UnimplementedInternalChatServiceServer
+
+	userDataRepo model.UserDataRepository
+	mapper       mapper.UserDataMapper
+	logger       *slog.Logger
+}
+
+// NewInternalChatService constructs an InternalChatService with the given dependencies.
+//
+//   - userDataRepo: reads a user's rooms and messages.
+//   - mapper:       converts chat data into gRPC messages.
+//   - logger:       structured logger for diagnostics.
+func NewInternalChatService(
+	userDataRepo model.UserDataRepository,
+	mapper mapper.UserDataMapper,
+	logger *slog.Logger,
+) *InternalChatService {
+	return &InternalChatService{
+		userDataRepo: userDataRepo,
+		mapper:       mapper,
+		logger:       logger,
+	}
+}
+
+// ExportUserChatData returns every room the user belongs to and every message
+// they sent, for inclusion in their data export. If the lookup fails, it logs
+// the error and returns a gRPC Internal error status.
+func (s *InternalChatService) ExportUserChatData(ctx context.Context, req *chatpb.ExportUserChatDataRequest) (*chatpb.ExportUserChatDataResponse, error) {
+	data, err := s.userDataRepo.FetchUserChatData(ctx, req.GetUserId())
+	if err != nil {
+		s.logger.Error("unable to export user chat data", slog.Int64("user_id", req.GetUserId()), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	s.logger.Info("exported user chat data",
+		slog.Int64("user_id", req.GetUserId()),
+		slog.Int("rooms", len(data.Rooms)),
+		slog.Int("messages", len(data.Messages)),
+	)
+	return s.mapper.ToExportUserChatDataResponse(data), nil
+}
@@ -3,6 +3,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 
 	"google.golang.org/grpc/codes"
@@ -18,37 +19,57 @@ import (
 type RoomService struct {
 	chatpb.UnimplementedChatServiceServer
 
-	roomRepo model.RoomRepository
-	mapper   mapper.RoomMapper
-	logger   *slog.Logger
+	roomRepo     model.RoomRepository
+	settingsRepo model.ChatSettingsRepository
+	users        model.UserDirectory
+	mapper       mapper.RoomMapper
+	logger       *slog.Logger
 }
 
 // NewRoomService constructs a RoomService with the given dependencies.
 //
-//   - roomRepo: interface for persisting and retrieving rooms.
-//   - mapper:   converts between gRPC messages and internal models.
-//   - logger:   structured logger for diagnostics.
+//   - roomRepo:     interface for persisting and retrieving rooms.
+//   - settingsRepo: reads whether participants only accept DMs from friends.
+//   - users:        asks user-service whether two users are friends.
+//   - mapper:       converts between gRPC messages and internal models.
+//   - logger:       structured logger for diagnostics.
 func NewRoomService(
 	roomRepo model.RoomRepository,
+	settingsRepo model.ChatSettingsRepository,
+	users model.UserDirectory,
 	mapper mapper.RoomMapper,
 	logger *slog.Logger,
 ) *RoomService {
 	return &RoomService{
-		roomRepo: roomRepo,
-		mapper:   mapper,
-		logger:   logger,
+		roomRepo:     roomRepo,
+		settingsRepo: settingsRepo,
+		users:        users,
+		mapper:       mapper,
+		logger:       logger,
 	}
 }
 
 // CreateRoom creates a new chat room based on the client request.
 //
-// It maps the incoming gRPC request to the internal Room model, calls the
-// repository to persist the room, and returns a CreateRoomResponse message.
-// If persistence fails, it logs the error with structured metadata and
-// returns a gRPC Internal error status.
+// It maps the incoming gRPC request to the internal Room model, checks that
+// the caller is the initiator and that the participant accepts DMs from them,
+// calls the repository to persist the room, and returns a CreateRoomResponse
+// message. If persistence fails, it logs the error with structured metadata
+// and returns a gRPC Internal error status.
 func (s *RoomService) CreateRoom(ctx context.Context, req *chatpb.CreateRoomRequest) (*chatpb.CreateRoomResponse, error) {
 	roomModel := s.mapper.ToRoomModel(req)
 
+	caller, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if roomModel.InitiatorID != caller {
+		return nil, status.Error(codes.PermissionDenied, errs.ErrNotInitiator.Error())
+	}
+	if err := s.checkDMAllowed(ctx, roomModel); err != nil {
+		return nil, err
+	}
+
 	room, err := s.roomRepo.CreateRoom(ctx, roomModel)
 	if err != nil {
 		s.logger.Error("unable to create room", slog.Any("error", err))
@@ -58,3 +79,30 @@ func (s *RoomService) CreateRoom(ctx context.Context, req *chatpb.CreateRoomRequ
 	resp := s.mapper.ToCreateRoomResponse(room)
 	return resp, nil
 }
+
+// checkDMAllowed returns PermissionDenied if the participant only accepts DMs
+// from friends and the initiator is not one. Friendships are checked with
+// user-service; if it cannot answer, the room is refused with Unavailable
+// rather than let through.
+func (s *RoomService) checkDMAllowed(ctx context.Context, room model.Room) error {
+	settings, err := s.settingsRepo.GetChatSettings(ctx, room.ParticipantID)
+	if err != nil {
+		s.logger.Error("unable to read chat settings", slog.Int64("user_id", room.ParticipantID), slog.Any("error", err))
+		return status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+	if !settings.DMsFromFriendsOnly {
+		return nil
+	}
+
+	friends, err := s.users.AreFriends(ctx, room.InitiatorID, room.ParticipantID)
+	switch {
+	case errors.Is(err, errs.ErrUserNotFound):
+		return status.Error(codes.NotFound, errs.ErrUserNotFound.Error())
+	case err != nil:
+		s.logger.Error("unable to check friendship", slog.Any("error", err))
+		return status.Error(codes.Unavailable, errs.ErrUserServiceUnavailable.Error())
+	case !friends:
+		return status.Error(codes.PermissionDenied, errs.ErrFriendsOnly.Error())
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/model"
+)
+
+// UserEventConsumer polls the user-service account event feed and applies the
+// events to chat data. Progress is stored in the database, so events are
+// delivered at least once across restarts; every handler is idempotent.
+type UserEventConsumer struct {
+	source       model.UserEventSource
+	repo         model.UserEventRepository
+	pollInterval time.Duration
+	batchSize    int
+	logger       *slog.Logger
+}
+
+// NewUserEventConsumer constructs a UserEventConsumer with the given dependencies.
+//
+//   - source:       reads events from user-service.
+//   - repo:         applies events and stores the cursor.
+//   - pollInterval: delay between polls once the feed is drained.
+//   - batchSize:    maximum number of events fetched per poll.
+//   - logger:       structured logger for diagnostics.
+func NewUserEventConsumer(
+	source model.UserEventSource,
+	repo model.UserEventRepository,
+	pollInterval time.Duration,
+	batchSize int,
+	logger *slog.Logger,
+) *UserEventConsumer {
+	return &UserEventConsumer{
+		source:       source,
+		repo:         repo,
+		pollInterval: pollInterval,
+		batchSize:    batchSize,
+		logger:       logger,
+	}
+}
+
+// Run polls for events until ctx is cancelled. Full batches are followed by
+// an immediate poll; otherwise it waits pollInterval. Failures are logged and
+// retried on the next poll. It always returns nil so it can run inside an
+// errgroup.
+func (c *UserEventConsumer) Run(ctx context.Context) error {
+	for {
+		handled, err := c.PollOnce(ctx)
+		if err != nil {
+			c.logger.Error("unable to consume user events", slog.Any("error", err))
+		}
+
+		wait := c.pollInterval
+		if err == nil && handled == c.batchSize {
+			wait = 0
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait):
+		}
+	}
+}
+
+// PollOnce fetches the next batch of events after the stored cursor and
+// applies them in order. It returns how many events were handled; on error
+// the cursor stays at the last successfully handled event.
+func (c *UserEventConsumer) PollOnce(ctx context.Context) (int, error) {
+	cursor, err := c.repo.UserEventCursor(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	events, err := c.source.ListUserEvents(ctx, cursor, c.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, event := range events {
+		if err := c.apply(ctx, event); err != nil {
+			return i, err
+		}
+	}
+	return len(events), nil
+}
+
+// apply handles a single event and advances the cursor past it.
+func (c *UserEventConsumer) apply(ctx context.Context, event model.UserEvent) error {
+	switch event.Type {
+	case model.UserEventDeleted:
+		if err := c.repo.ApplyUserDeleted(ctx, event.ID, event.UserID); err != nil {
+			return err
+		}
+		c.logger.Info("anonymized deleted user", slog.Int64("user_id", event.UserID), slog.Int64("event_id", event.ID))
+		return nil
+	default:
+		return c.repo.AdvanceUserEventCursor(ctx, event.ID)
+	}
+}
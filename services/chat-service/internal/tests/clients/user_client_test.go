@@ -0,0 +1,280 @@
+package clients
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	userpb "github.com/mamataliev-dev/social-platform/api/gen/user/v1"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/clients"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/config"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/tests/mocks"
+)
+
+// testConfig retries twice without delay, opens the circuit after three
+// failed calls and caches profiles for a minute.
+func testConfig() config.UserService {
+	return config.UserService{
+		RequestTimeout:   time.Second,
+		MaxRetries:       2,
+		RetryBackoff:     time.Millisecond,
+		BreakerThreshold: 3,
+		BreakerCooldown:  time.Hour,
+		ProfileCacheTTL:  time.Minute,
+		ProfileCacheSize: 100,
+		BatchConcurrency: 4,
+	}
+}
+
+func newTestClient(cfg config.UserService) (*clients.UserClient, *mocks.InternalUserServiceClientMock) {
+	grpcClient := new(mocks.InternalUserServiceClientMock)
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
+	return clients.NewUserClient(grpcClient, cfg, logger), grpcClient
+}
+
+func byID(userID int64) *userpb.FetchUserProfileByIDRequest {
+	return &userpb.FetchUserProfileByIDRequest{UserId: userID}
+}
+
+func profile(userID int64) *userpb.UserProfile {
+	return &userpb.UserProfile{
+		UserId:    userID,
+		Username:  "User",
+		Nickname:  "user",
+		Email:     "user@example.com",
+		AvatarUrl: "https://cdn.example.com/a.png",
+	}
+}
+
+// TestFetchUserByID_MapsProfile verifies the profile fields chat-service keeps.
+func TestFetchUserByID_MapsProfile(t *testing.T) {
+	client, grpcClient := newTestClient(testConfig())
+	grpcClient.On("FetchUserProfileByID", mock.Anything, byID(7)).Return(profile(7), nil).Once()
+
+	got, err := client.FetchUserByID(context.Background(), 7)
+
+	require.NoError(t, err)
+	assert.Equal(t, model.UserProfile{
+		ID:        7,
+		Username:  "User",
+		Nickname:  "user",
+		AvatarURL: "https://cdn.example.com/a.png",
+	}, got)
+	grpcClient.AssertExpectations(t)
+}
+
+// TestFetchUserByID_ForwardsAuthorization verifies that the caller's access
+// token is sent along and the caller's context is used.
+func TestFetchUserByID_ForwardsAuthorization(t *testing.T) {
+	client, grpcClient := newTestClient(testConfig())
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer user-token"))
+
+	grpcClient.On("FetchUserProfileByID", mock.MatchedBy(func(ctx context.Context) bool {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		_, hasDeadline := ctx.Deadline()
+		return hasDeadline && assert.ObjectsAreEqual([]string{"Bearer user-token"}, md.Get("authorization"))
+	}), byID(7)).Return(profile(7), nil).Once()
+
+	_, err := client.FetchUserByID(ctx, 7)
+
+	require.NoError(t, err)
+	grpcClient.AssertExpectations(t)
+}
+
+// TestFetchUserByID_RetriesTransientFailures verifies that Unavailable is
+// retried and the eventual success is returned.
+func TestFetchUserByID_RetriesTransientFailures(t *testing.T) {
+	client, grpcClient := newTestClient(testConfig())
+	grpcClient.On("FetchUserProfileByID", mock.Anything, byID(7)).
+		Return(nil, status.Error(codes.Unavailable, "connection refused")).Twice()
+	grpcClient.On("FetchUserProfileByID", mock.Anything, byID(7)).Return(profile(7), nil).Once()
+
+	got, err := client.FetchUserByID(context.Background(), 7)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), got.ID)
+	grpcClient.AssertNumberOfCalls(t, "FetchUserProfileByID", 3)
+}
+
+// TestFetchUserByID_GivesUpAfterMaxRetries verifies the number of attempts.
+func TestFetchUserByID_GivesUpAfterMaxRetries(t *testing.T) {
+	client, grpcClient := newTestClient(testConfig())
+	grpcClient.On("FetchUserProfileByID", mock.Anything, byID(7)).
+		Return(nil, status.Error(codes.Unavailable, "connection refused"))
+
+	_, err := client.FetchUserByID(context.Background(), 7)
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	grpcClient.AssertNumberOfCalls(t, "FetchUserProfileByID", 3)
+}
+
+// TestFetchUserByID_NotFound verifies that NotFound is not retried and is
+// reported as ErrUserNotFound.
+func TestFetchUserByID_NotFound(t *testing.T) {
+	client, grpcClient := newTestClient(testConfig())
+	grpcClient.On("FetchUserProfileByID", mock.Anything, byID(7)).
+		Return(nil, status.Error(codes.NotFound, "user not found")).Once()
+
+	_, err := client.FetchUserByID(context.Background(), 7)
+
+	assert.ErrorIs(t, err, errs.ErrUserNotFound)
+	grpcClient.AssertNumberOfCalls(t, "FetchUserProfileByID", 1)
+}
+
+// TestFetchUserByID_UsesCache verifies that a cached profile is served
+// without calling user-service again.
+func TestFetchUserByID_UsesCache(t *testing.T) {
+	client, grpcClient := newTestClient(testConfig())
+	grpcClient.On("FetchUserProfileByID", mock.Anything, byID(7)).Return(profile(7), nil).Once()
+
+	first, err := client.FetchUserByID(context.Background(), 7)
+	require.NoError(t, err)
+	second, err := client.FetchUserByID(context.Background(), 7)
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+	grpcClient.AssertNumberOfCalls(t, "FetchUserProfileByID", 1)
+}
+
+// TestFetchUserByID_CircuitOpens verifies that after BreakerThreshold failed
+// calls user-service is no longer called until the cooldown has passed.
+func TestFetchUserByID_CircuitOpens(t *testing.T) {
+	cfg := testConfig()
+	cfg.MaxRetries = 0
+	client, grpcClient := newTestClient(cfg)
+	grpcClient.On("FetchUserProfileByID", mock.Anything, mock.Anything).
+		Return(nil, status.Error(codes.Unavailable, "connection refused"))
+
+	for i := 0; i < cfg.BreakerThreshold; i++ {
+		_, err := client.FetchUserByID(context.Background(), 7)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	}
+	_, err := client.FetchUserByID(context.Background(), 7)
+
+	assert.ErrorIs(t, err, errs.ErrUserServiceUnavailable)
+	grpcClient.AssertNumberOfCalls(t, "FetchUserProfileByID", cfg.BreakerThreshold)
+}
+
+// TestFetchUserByID_CircuitRecovers verifies that a successful trial call
+// after the cooldown closes the circuit again.
+func TestFetchUserByID_CircuitRecovers(t *testing.T) {
+	cfg := testConfig()
+	cfg.MaxRetries = 0
+	cfg.BreakerThreshold = 1
+	cfg.BreakerCooldown = 10 * time.Millisecond
+	client, grpcClient := newTestClient(cfg)
+	grpcClient.On("FetchUserProfileByID", mock.Anything, byID(7)).
+		Return(nil, status.Error(codes.Unavailable, "connection refused")).Once()
+	grpcClient.On("FetchUserProfileByID", mock.Anything, byID(7)).Return(profile(7), nil).Once()
+
+	_, err := client.FetchUserByID(context.Background(), 7)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	_, err = client.FetchUserByID(context.Background(), 7)
+	assert.ErrorIs(t, err, errs.ErrUserServiceUnavailable)
+
+	time.Sleep(cfg.BreakerCooldown)
+	_, err = client.FetchUserByID(context.Background(), 7)
+
+	assert.NoError(t, err)
+	grpcClient.AssertExpectations(t)
+}
+
+// TestFetchUserByID_RejectedRequestsKeepCircuitClosed verifies that errors
+// caused by the request itself do not count against user-service.
+func TestFetchUserByID_RejectedRequestsKeepCircuitClosed(t *testing.T) {
+	cfg := testConfig()
+	client, grpcClient := newTestClient(cfg)
+	grpcClient.On("FetchUserProfileByID", mock.Anything, mock.Anything).
+		Return(nil, status.Error(codes.NotFound, "user not found"))
+
+	for i := 0; i <= cfg.BreakerThreshold; i++ {
+		_, err := client.FetchUserByID(context.Background(), int64(i))
+		assert.ErrorIs(t, err, errs.ErrUserNotFound)
+	}
+}
+
+// TestBatchFetchUsers verifies that duplicates are requested once, cached
+// profiles are reused and missing users are left out.
+func TestBatchFetchUsers(t *testing.T) {
+	client, grpcClient := newTestClient(testConfig())
+	grpcClient.On("FetchUserProfileByID", mock.Anything, byID(1)).Return(profile(1), nil).Once()
+	grpcClient.On("BatchFetchUserProfiles", mock.Anything, &userpb.BatchFetchUserProfilesRequest{UserIds: []int64{2, 3}}).
+		Return(&userpb.BatchFetchUserProfilesResponse{
+			Profiles:       []*userpb.UserProfile{profile(2)},
+			MissingUserIds: []int64{3},
+		}, nil).Once()
+
+	_, err := client.FetchUserByID(context.Background(), 1)
+	require.NoError(t, err)
+
+	got, err := client.BatchFetchUsers(context.Background(), []int64{1, 2, 2, 3})
+
+	require.NoError(t, err)
+	assert.Len(t, got, 2)
+	assert.Equal(t, int64(1), got[1].ID)
+	assert.Equal(t, int64(2), got[2].ID)
+
+	_, err = client.FetchUserByID(context.Background(), 2)
+	require.NoError(t, err, "batch results must be cached")
+	grpcClient.AssertExpectations(t)
+}
+
+// TestBatchFetchUsers_Chunks verifies that large batches are split into
+// requests user-service accepts.
+func TestBatchFetchUsers_Chunks(t *testing.T) {
+	client, grpcClient := newTestClient(testConfig())
+	ids := make([]int64, 250)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+	grpcClient.On("BatchFetchUserProfiles", mock.Anything, mock.MatchedBy(func(req *userpb.BatchFetchUserProfilesRequest) bool {
+		return len(req.GetUserIds()) <= 100
+	})).Return(&userpb.BatchFetchUserProfilesResponse{}, nil)
+
+	got, err := client.BatchFetchUsers(context.Background(), ids)
+
+	require.NoError(t, err)
+	assert.Empty(t, got)
+	grpcClient.AssertNumberOfCalls(t, "BatchFetchUserProfiles", 3)
+}
+
+// TestBatchFetchUsers_Failure verifies that a failed chunk fails the batch.
+func TestBatchFetchUsers_Failure(t *testing.T) {
+	cfg := testConfig()
+	cfg.MaxRetries = 0
+	client, grpcClient := newTestClient(cfg)
+	grpcClient.On("BatchFetchUserProfiles", mock.Anything, mock.Anything).
+		Return(nil, status.Error(codes.Internal, "boom")).Once()
+
+	_, err := client.BatchFetchUsers(context.Background(), []int64{1, 2})
+
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+// TestAreFriends verifies the friendship answer and that unknown users are
+// reported as ErrUserNotFound.
+func TestAreFriends(t *testing.T) {
+	client, grpcClient := newTestClient(testConfig())
+	grpcClient.On("CheckFriendship", mock.Anything, &userpb.CheckFriendshipRequest{UserId: 1, OtherUserId: 2}).
+		Return(&userpb.CheckFriendshipResponse{Friends: true}, nil).Once()
+	grpcClient.On("CheckFriendship", mock.Anything, &userpb.CheckFriendshipRequest{UserId: 1, OtherUserId: 7}).
+		Return(nil, status.Error(codes.NotFound, "user not found")).Once()
+
+	friends, err := client.AreFriends(context.Background(), 1, 2)
+	require.NoError(t, err)
+	assert.True(t, friends)
+
+	_, err = client.AreFriends(context.Background(), 1, 7)
+	assert.ErrorIs(t, err, errs.ErrUserNotFound)
+}
@@ -0,0 +1,66 @@
+package middleware_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/middleware"
+)
+
+const (
+	exportUserChatDataMethod = "/chat.v1.InternalChatService/ExportUserChatData"
+	testServiceSecret        = "0123456789abcdef0123456789abcdef"
+)
+
+// TestServiceTokenInterceptor verifies that service endpoints require a token
+// signed with the shared secret and addressed to chat-service, and other
+// methods pass through untouched.
+func TestServiceTokenInterceptor(t *testing.T) {
+	verifier, err := auth.NewServiceTokenVerifier(testServiceSecret, "chat-service", time.Second)
+	require.NoError(t, err)
+	sign := func(secret, audience string) string {
+		signer, err := auth.NewServiceTokenSigner(secret, "user-service")
+		require.NoError(t, err)
+		token, err := signer.Sign(audience)
+		require.NoError(t, err)
+		return token
+	}
+
+	tests := []struct {
+		name     string
+		verifier *auth.ServiceTokenVerifier
+		token    string
+		method   string
+		wantCode codes.Code
+	}{
+		{"valid token", verifier, sign(testServiceSecret, "chat-service"), exportUserChatDataMethod, codes.OK},
+		{"wrong audience", verifier, sign(testServiceSecret, "user-service"), exportUserChatDataMethod, codes.Unauthenticated},
+		{"wrong secret", verifier, sign("fedcba9876543210fedcba9876543210", "chat-service"), exportUserChatDataMethod, codes.Unauthenticated},
+		{"static token", verifier, testServiceSecret, exportUserChatDataMethod, codes.Unauthenticated},
+		{"missing token", verifier, "", exportUserChatDataMethod, codes.Unauthenticated},
+		{"no secret configured", nil, sign(testServiceSecret, "chat-service"), exportUserChatDataMethod, codes.Unauthenticated},
+		{"other method", verifier, "", "/chat.v1.ChatService/CreateRoom", codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.token != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(auth.ServiceTokenHeader, tt.token))
+			}
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+			_, err := middleware.ServiceTokenInterceptor(tt.verifier)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
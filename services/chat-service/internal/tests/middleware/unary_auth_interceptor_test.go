@@ -3,13 +3,17 @@ package middleware_test
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	chatpb "github.com/mamataliev-dev/social-platform/api/gen/chat/v1"
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
 	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/middleware"
 )
 
@@ -21,7 +25,8 @@ func TestUnaryAuthInterceptor_MissingMetadata(t *testing.T) {
 		return "should not be called", nil
 	}
 
-	_, err := middleware.UnaryAuthInterceptor(context.Background(), nil, info, handler)
+	verifier, _ := testVerifier(t)
+	_, err := middleware.UnaryAuthInterceptor(verifier)(context.Background(), nil, info, handler)
 	st, _ := status.FromError(err)
 	assert.Equal(t, codes.Unauthenticated, st.Code())
 }
@@ -36,7 +41,86 @@ func TestUnaryAuthInterceptor_InvalidToken(t *testing.T) {
 		return "should not be called", nil
 	}
 
-	_, err := middleware.UnaryAuthInterceptor(ctx, nil, info, handler)
+	verifier, _ := testVerifier(t)
+	_, err := middleware.UnaryAuthInterceptor(verifier)(ctx, nil, info, handler)
 	st, _ := status.FromError(err)
 	assert.Equal(t, codes.Unauthenticated, st.Code())
 }
+
+// TestUnaryAuthInterceptor_ValidToken ensures that a token signed by a trusted
+// key passes through to the handler.
+func TestUnaryAuthInterceptor_ValidToken(t *testing.T) {
+	verifier, sign := testVerifier(t)
+	token := sign(jwt.MapClaims{"sub": "1", "exp": time.Now().Add(time.Hour).Unix()})
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	info := &grpc.UnaryServerInfo{FullMethod: "/chat.v1.ChatService/CreateRoom"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		claims, ok := auth.ClaimsFromContext(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, "1", claims.Subject)
+		return "ok", nil
+	}
+
+	_, err := middleware.UnaryAuthInterceptor(verifier)(ctx, nil, info, handler)
+	assert.NoError(t, err)
+}
+
+// TestUnaryAuthInterceptor_ExpiredToken ensures that an expired token is
+// rejected even when its signature is valid.
+func TestUnaryAuthInterceptor_ExpiredToken(t *testing.T) {
+	verifier, sign := testVerifier(t)
+	token := sign(jwt.MapClaims{"sub": "1", "exp": time.Now().Add(-time.Hour).Unix()})
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	info := &grpc.UnaryServerInfo{FullMethod: "/chat.v1.ChatService/CreateRoom"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "should not be called", nil
+	}
+
+	_, err := middleware.UnaryAuthInterceptor(verifier)(ctx, nil, info, handler)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+}
+
+// TestUnaryAuthInterceptor_RejectsHMAC ensures that symmetric tokens are
+// rejected now that chat-service holds no signing secret.
+func TestUnaryAuthInterceptor_RejectsHMAC(t *testing.T) {
+	verifier, _ := testVerifier(t)
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "1", "exp": time.Now().Add(time.Hour).Unix()})
+	token.Header["kid"] = testKeyID
+	signed, err := token.SignedString([]byte("test-secret"))
+	assert.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+signed))
+	info := &grpc.UnaryServerInfo{FullMethod: "/chat.v1.ChatService/CreateRoom"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "should not be called", nil
+	}
+
+	_, err = middleware.UnaryAuthInterceptor(verifier)(ctx, nil, info, handler)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+}
+
+// TestUnaryAuthInterceptor_EveryMethodHasPolicy ensures that every ChatService
+// RPC is listed in the permission map, and that methods outside it are denied.
+func TestUnaryAuthInterceptor_EveryMethodHasPolicy(t *testing.T) {
+	verifier, sign := testVerifier(t)
+	token := sign(jwt.MapClaims{"sub": "1", "exp": time.Now().Add(time.Hour).Unix()})
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	methods := []string{"/chat.v1.ChatService/StreamMessages"}
+	for _, m := range chatpb.ChatService_ServiceDesc.Methods {
+		methods = append(methods, "/chat.v1.ChatService/"+m.MethodName)
+	}
+	for _, method := range methods {
+		_, err := middleware.UnaryAuthInterceptor(verifier)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		assert.NoError(t, err, method)
+	}
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/chat.v1.ChatService/DeleteEverything"}
+	_, err := middleware.UnaryAuthInterceptor(verifier)(ctx, nil, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,95 @@
+package middleware_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/middleware"
+)
+
+const testKeyID = "test-key"
+
+// testVerifier generates an Ed25519 key pair and returns a verifier that
+// trusts it along with a signer for test tokens.
+func testVerifier(t *testing.T) (*auth.Verifier, func(jwt.MapClaims) string) {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	keyfunc := func(token *jwt.Token) (interface{}, error) {
+		if token.Header["kid"] != testKeyID {
+			return nil, auth.ErrUnknownSigningKey
+		}
+		return pub, nil
+	}
+	sign := func(claims jwt.MapClaims) string {
+		claims["iss"] = "user-service"
+		claims["aud"] = "social-platform"
+		token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+		token.Header["kid"] = testKeyID
+		signed, err := token.SignedString(priv)
+		require.NoError(t, err)
+		return signed
+	}
+	verifier := auth.NewVerifier(keyfunc, auth.Config{Issuer: "user-service", Audience: "social-platform"})
+	return verifier, sign
+}
+
+// authenticatedCall runs the request through UnaryAuthInterceptor and then
+// VerifiedEmailInterceptor, as they are chained in main.
+func authenticatedCall(t *testing.T, method string, emailVerified bool) error {
+	verifier, sign := testVerifier(t)
+	token := sign(jwt.MapClaims{
+		"sub":            "1",
+		"email_verified": emailVerified,
+		"exp":            time.Now().Add(time.Hour).Unix(),
+	})
+
+	md := metadata.Pairs("authorization", "Bearer "+token)
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	_, err := middleware.UnaryAuthInterceptor(verifier)(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return middleware.VerifiedEmailInterceptor(ctx, req, info, handler)
+	})
+	return err
+}
+
+// TestVerifiedEmailInterceptor_UnverifiedCreateRoom ensures that an unverified
+// account cannot create rooms.
+func TestVerifiedEmailInterceptor_UnverifiedCreateRoom(t *testing.T) {
+	err := authenticatedCall(t, "/chat.v1.ChatService/CreateRoom", false)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+	assert.Equal(t, errs.ErrEmailNotVerified.Error(), st.Message())
+}
+
+// TestVerifiedEmailInterceptor_VerifiedCreateRoom ensures that a verified
+// account passes through to the handler.
+func TestVerifiedEmailInterceptor_VerifiedCreateRoom(t *testing.T) {
+	err := authenticatedCall(t, "/chat.v1.ChatService/CreateRoom", true)
+	assert.NoError(t, err)
+}
+
+// TestVerifiedEmailInterceptor_UnrestrictedMethod ensures that unverified
+// accounts can still call methods outside the restricted set.
+func TestVerifiedEmailInterceptor_UnrestrictedMethod(t *testing.T) {
+	err := authenticatedCall(t, "/chat.v1.ChatService/GetUserRooms", false)
+	assert.NoError(t, err)
+}
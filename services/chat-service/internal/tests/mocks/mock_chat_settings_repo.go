@@ -0,0 +1,31 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/model"
+)
+
+// ChatSettingsRepoMock is a testify mock for the ChatSettingsRepository
+// interface.
+type ChatSettingsRepoMock struct {
+	mock.Mock
+}
+
+// GetChatSettings mocks reading a user's chat settings.
+func (m *ChatSettingsRepoMock) GetChatSettings(ctx context.Context, userID int64) (model.ChatSettings, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(model.ChatSettings), args.Error(1)
+}
+
+// UpdateChatSettings mocks changing a user's chat settings.
+func (m *ChatSettingsRepoMock) UpdateChatSettings(
+	ctx context.Context,
+	userID int64,
+	update model.ChatSettingsUpdate,
+) (model.ChatSettings, error) {
+	args := m.Called(ctx, userID, update)
+	return args.Get(0).(model.ChatSettings), args.Error(1)
+}
@@ -0,0 +1,32 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	chatpb "github.com/mamataliev-dev/social-platform/api/gen/chat/v1"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/model"
+)
+
+// UserDataRepoMock is a testify mock for the UserDataRepository interface.
+type UserDataRepoMock struct {
+	mock.Mock
+}
+
+// FetchUserChatData mocks reading a user's rooms and messages.
+func (m *UserDataRepoMock) FetchUserChatData(ctx context.Context, userID int64) (model.UserChatData, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(model.UserChatData), args.Error(1)
+}
+
+// UserDataMapperMock is a testify mock for the UserDataMapper interface.
+type UserDataMapperMock struct {
+	mock.Mock
+}
+
+// ToExportUserChatDataResponse mocks mapping chat data into the gRPC response.
+func (m *UserDataMapperMock) ToExportUserChatDataResponse(data model.UserChatData) *chatpb.ExportUserChatDataResponse {
+	args := m.Called(data)
+	return args.Get(0).(*chatpb.ExportUserChatDataResponse)
+}
@@ -0,0 +1,33 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/model"
+)
+
+// UserDirectoryMock is a testify mock for the UserDirectory interface.
+type UserDirectoryMock struct {
+	mock.Mock
+}
+
+// FetchUserByID mocks looking up a single user profile.
+func (m *UserDirectoryMock) FetchUserByID(ctx context.Context, userID int64) (model.UserProfile, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(model.UserProfile), args.Error(1)
+}
+
+// BatchFetchUsers mocks looking up several user profiles.
+func (m *UserDirectoryMock) BatchFetchUsers(ctx context.Context, userIDs []int64) (map[int64]model.UserProfile, error) {
+	args := m.Called(ctx, userIDs)
+	profiles, _ := args.Get(0).(map[int64]model.UserProfile)
+	return profiles, args.Error(1)
+}
+
+// AreFriends mocks checking whether two users are friends.
+func (m *UserDirectoryMock) AreFriends(ctx context.Context, userID, otherUserID int64) (bool, error) {
+	args := m.Called(ctx, userID, otherUserID)
+	return args.Bool(0), args.Error(1)
+}
@@ -0,0 +1,93 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+
+	userpb "github.com/mamataliev-dev/social-platform/api/gen/user/v1"
+)
+
+// InternalUserServiceClientMock is a testify mock for the generated
+// InternalUserServiceClient.
+type InternalUserServiceClientMock struct {
+	mock.Mock
+}
+
+// FetchUserProfileByID mocks fetching a single profile from user-service.
+func (m *InternalUserServiceClientMock) FetchUserProfileByID(
+	ctx context.Context,
+	in *userpb.FetchUserProfileByIDRequest,
+	_ ...grpc.CallOption,
+) (*userpb.UserProfile, error) {
+	args := m.Called(ctx, in)
+	profile, _ := args.Get(0).(*userpb.UserProfile)
+	return profile, args.Error(1)
+}
+
+// BatchFetchUserProfiles mocks fetching several profiles from user-service.
+func (m *InternalUserServiceClientMock) BatchFetchUserProfiles(
+	ctx context.Context,
+	in *userpb.BatchFetchUserProfilesRequest,
+	_ ...grpc.CallOption,
+) (*userpb.BatchFetchUserProfilesResponse, error) {
+	args := m.Called(ctx, in)
+	resp, _ := args.Get(0).(*userpb.BatchFetchUserProfilesResponse)
+	return resp, args.Error(1)
+}
+
+// ListUserEvents mocks reading the account event feed of user-service.
+func (m *InternalUserServiceClientMock) ListUserEvents(
+	ctx context.Context,
+	in *userpb.ListUserEventsRequest,
+	_ ...grpc.CallOption,
+) (*userpb.ListUserEventsResponse, error) {
+	args := m.Called(ctx, in)
+	resp, _ := args.Get(0).(*userpb.ListUserEventsResponse)
+	return resp, args.Error(1)
+}
+
+// CheckFriendship mocks asking user-service whether two users are friends.
+func (m *InternalUserServiceClientMock) CheckFriendship(
+	ctx context.Context,
+	in *userpb.CheckFriendshipRequest,
+	_ ...grpc.CallOption,
+) (*userpb.CheckFriendshipResponse, error) {
+	args := m.Called(ctx, in)
+	resp, _ := args.Get(0).(*userpb.CheckFriendshipResponse)
+	return resp, args.Error(1)
+}
+
+// CheckFollowing mocks asking user-service whether one user follows another.
+func (m *InternalUserServiceClientMock) CheckFollowing(
+	ctx context.Context,
+	in *userpb.CheckFollowingRequest,
+	_ ...grpc.CallOption,
+) (*userpb.CheckFollowingResponse, error) {
+	args := m.Called(ctx, in)
+	resp, _ := args.Get(0).(*userpb.CheckFollowingResponse)
+	return resp, args.Error(1)
+}
+
+// ListFollowerIDs mocks paging through the IDs of a user's followers.
+func (m *InternalUserServiceClientMock) ListFollowerIDs(
+	ctx context.Context,
+	in *userpb.ListFollowIDsRequest,
+	_ ...grpc.CallOption,
+) (*userpb.ListFollowIDsResponse, error) {
+	args := m.Called(ctx, in)
+	resp, _ := args.Get(0).(*userpb.ListFollowIDsResponse)
+	return resp, args.Error(1)
+}
+
+// ListFollowingIDs mocks paging through the IDs of the users a user follows.
+func (m *InternalUserServiceClientMock) ListFollowingIDs(
+	ctx context.Context,
+	in *userpb.ListFollowIDsRequest,
+	_ ...grpc.CallOption,
+) (*userpb.ListFollowIDsResponse, error) {
+	args := m.Called(ctx, in)
+	resp, _ := args.Get(0).(*userpb.ListFollowIDsResponse)
+	return resp, args.Error(1)
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	chatpb "github.com/mamataliev-dev/social-platform/api/gen/chat/v1"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/mapper"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/service"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/tests/mocks"
+)
+
+func newChatSettingsService() (*service.ChatSettingsService, *mocks.ChatSettingsRepoMock) {
+	repo := new(mocks.ChatSettingsRepoMock)
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
+	return service.NewChatSettingsService(repo, mapper.NewChatSettingsMapper(), logger), repo
+}
+
+// TestGetChatSettings verifies that the caller's settings are returned.
+func TestGetChatSettings(t *testing.T) {
+	svc, repo := newChatSettingsService()
+	repo.On("GetChatSettings", mock.Anything, int64(1)).
+		Return(model.ChatSettings{UserID: 1, DMsFromFriendsOnly: true}, nil)
+
+	resp, err := svc.GetChatSettings(initiatorContext(), &chatpb.GetChatSettingsRequest{})
+
+	assert.NoError(t, err)
+	assert.True(t, resp.GetDmsFromFriendsOnly())
+}
+
+// TestGetChatSettings_Unauthenticated verifies that a caller without a
+// verified token is rejected.
+func TestGetChatSettings_Unauthenticated(t *testing.T) {
+	svc, repo := newChatSettingsService()
+
+	_, err := svc.GetChatSettings(context.Background(), &chatpb.GetChatSettingsRequest{})
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	repo.AssertNotCalled(t, "GetChatSettings", mock.Anything, mock.Anything)
+}
+
+// TestUpdateChatSettings verifies that only the fields set in the request are
+// passed on to the repository.
+func TestUpdateChatSettings(t *testing.T) {
+	svc, repo := newChatSettingsService()
+	friendsOnly := true
+	repo.On("UpdateChatSettings", mock.Anything, int64(1), model.ChatSettingsUpdate{DMsFromFriendsOnly: &friendsOnly}).
+		Return(model.ChatSettings{UserID: 1, DMsFromFriendsOnly: true}, nil)
+
+	resp, err := svc.UpdateChatSettings(initiatorContext(), &chatpb.UpdateChatSettingsRequest{
+		DmsFromFriendsOnly: &friendsOnly,
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, resp.GetDmsFromFriendsOnly())
+	repo.AssertExpectations(t)
+}
+
+// TestUpdateChatSettings_InternalError verifies that repository failures are
+// reported as Internal.
+func TestUpdateChatSettings_InternalError(t *testing.T) {
+	svc, repo := newChatSettingsService()
+	repo.On("UpdateChatSettings", mock.Anything, int64(1), model.ChatSettingsUpdate{}).
+		Return(model.ChatSettings{}, errors.New("db down"))
+
+	_, err := svc.UpdateChatSettings(initiatorContext(), &chatpb.UpdateChatSettingsRequest{})
+
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
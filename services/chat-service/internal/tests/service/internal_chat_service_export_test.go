@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	chatpb "github.com/mamataliev-dev/social-platform/api/gen/chat/v1"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/service"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/tests/mocks"
+)
+
+// TestExportUserChatData_Success verifies that the user's rooms and messages
+// are read and returned through the mapper.
+func TestExportUserChatData_Success(t *testing.T) {
+	repo := new(mocks.UserDataRepoMock)
+	dataMapper := new(mocks.UserDataMapperMock)
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
+	svc := service.NewInternalChatService(repo, dataMapper, logger)
+
+	createdAt := time.Date(2025, 7, 23, 15, 0, 0, 0, time.UTC)
+	data := model.UserChatData{
+		Rooms:    []model.Room{{ID: "room-1", InitiatorID: 7, ParticipantID: 8, CreatedAt: createdAt}},
+		Messages: []model.Message{{ID: "msg-1", RoomID: "room-1", SenderID: 7, Content: "hi", CreatedAt: createdAt}},
+	}
+	expected := &chatpb.ExportUserChatDataResponse{
+		Rooms:    []*chatpb.Room{{Id: "room-1"}},
+		Messages: []*chatpb.ChatMessage{{Id: "msg-1"}},
+	}
+	repo.On("FetchUserChatData", mock.Anything, int64(7)).Return(data, nil)
+	dataMapper.On("ToExportUserChatDataResponse", data).Return(expected)
+
+	resp, err := svc.ExportUserChatData(context.Background(), &chatpb.ExportUserChatDataRequest{UserId: 7})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, resp)
+	repo.AssertExpectations(t)
+	dataMapper.AssertExpectations(t)
+}
+
+// TestExportUserChatData_RepoError verifies that a repository failure yields
+// an Internal error and nothing is mapped.
+func TestExportUserChatData_RepoError(t *testing.T) {
+	repo := new(mocks.UserDataRepoMock)
+	dataMapper := new(mocks.UserDataMapperMock)
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
+	svc := service.NewInternalChatService(repo, dataMapper, logger)
+
+	repo.On("FetchUserChatData", mock.Anything, int64(7)).Return(model.UserChatData{}, errs.ErrDBFailure)
+
+	resp, err := svc.ExportUserChatData(context.Background(), &chatpb.ExportUserChatDataRequest{UserId: 7})
+
+	assert.Nil(t, resp)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Internal, st.Code())
+	dataMapper.AssertNotCalled(t, "ToExportUserChatDataResponse", mock.Anything)
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/codes"
@@ -14,6 +15,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	chatpb "github.com/mamataliev-dev/social-platform/api/gen/chat/v1"
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
 	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/errs"
 	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/model"
 	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/service"
@@ -43,13 +45,29 @@ func validExpectedResp() *chatpb.CreateRoomResponse {
 	}
 }
 
+// initiatorContext returns a context carrying the access token claims of the
+// initiator of validLoginRequest.
+func initiatorContext() context.Context {
+	return auth.ContextWithClaims(context.Background(), &auth.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: "1"},
+	})
+}
+
+// newRoomServiceMocks returns the RoomService mocks with the participant
+// accepting DMs from anyone.
+func newRoomServiceMocks() (*mocks.RoomRepoMock, *mocks.ChatSettingsRepoMock, *mocks.UserDirectoryMock, *mocks.RoomMapperMock) {
+	settingsRepo := new(mocks.ChatSettingsRepoMock)
+	settingsRepo.On("GetChatSettings", mock.Anything, int64(2)).
+		Return(model.ChatSettings{UserID: 2}, nil).Maybe()
+	return new(mocks.RoomRepoMock), settingsRepo, new(mocks.UserDirectoryMock), new(mocks.RoomMapperMock)
+}
+
 // TestCreateRoom_Success verifies that CreateRoom returns a mapped response
 // and no error when the repository successfully creates the room.
 func TestCreateRoom_Success(t *testing.T) {
-	roomRepo := new(mocks.RoomRepoMock)
-	roomMapper := new(mocks.RoomMapperMock)
+	roomRepo, settingsRepo, users, roomMapper := newRoomServiceMocks()
 	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
-	svc := service.NewRoomService(roomRepo, roomMapper, logger)
+	svc := service.NewRoomService(roomRepo, settingsRepo, users, roomMapper, logger)
 
 	req := validLoginRequest()
 	expectedResp := validExpectedResp()
@@ -72,7 +90,7 @@ func TestCreateRoom_Success(t *testing.T) {
 
 	roomMapper.On("ToCreateRoomResponse", createdModel).Return(expectedResp)
 
-	resp, err := svc.CreateRoom(context.Background(), req)
+	resp, err := svc.CreateRoom(initiatorContext(), req)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedResp, resp)
@@ -84,10 +102,9 @@ func TestCreateRoom_Success(t *testing.T) {
 // TestCreateRoom_InternalError verifies that CreateRoom returns a gRPC Internal error
 // when the repository fails to create the room.
 func TestCreateRoom_InternalError(t *testing.T) {
-	roomRepo := new(mocks.RoomRepoMock)
-	roomMapper := new(mocks.RoomMapperMock)
+	roomRepo, settingsRepo, users, roomMapper := newRoomServiceMocks()
 	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
-	svc := service.NewRoomService(roomRepo, roomMapper, logger)
+	svc := service.NewRoomService(roomRepo, settingsRepo, users, roomMapper, logger)
 
 	req := validLoginRequest()
 	expectedResp := validExpectedResp()
@@ -108,7 +125,7 @@ func TestCreateRoom_InternalError(t *testing.T) {
 
 	roomRepo.On("CreateRoom", mock.Anything, inModel).Return(createdModel, errs.ErrInternal)
 
-	_, err := svc.CreateRoom(context.Background(), req)
+	_, err := svc.CreateRoom(initiatorContext(), req)
 
 	assert.Error(t, err)
 	st, ok := status.FromError(err)
@@ -119,3 +136,70 @@ func TestCreateRoom_InternalError(t *testing.T) {
 	roomMapper.AssertExpectations(t)
 	roomRepo.AssertExpectations(t)
 }
+
+// TestCreateRoom_NotInitiator verifies that a caller cannot create a room on
+// behalf of another initiator.
+func TestCreateRoom_NotInitiator(t *testing.T) {
+	roomRepo, settingsRepo, users, roomMapper := newRoomServiceMocks()
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
+	svc := service.NewRoomService(roomRepo, settingsRepo, users, roomMapper, logger)
+
+	req := &chatpb.CreateRoomRequest{InitiatorId: 3, ParticipantId: 2}
+	roomMapper.On("ToRoomModel", req).Return(model.Room{InitiatorID: 3, ParticipantID: 2})
+
+	_, err := svc.CreateRoom(initiatorContext(), req)
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+	assert.Equal(t, errs.ErrNotInitiator.Error(), st.Message())
+	roomRepo.AssertNotCalled(t, "CreateRoom", mock.Anything, mock.Anything)
+}
+
+// TestCreateRoom_FriendsOnly verifies that a participant who only accepts DMs
+// from friends cannot be messaged by anyone else, and that an unreachable
+// user-service refuses the room.
+func TestCreateRoom_FriendsOnly(t *testing.T) {
+	tests := []struct {
+		name       string
+		friends    bool
+		friendsErr error
+		wantCode   codes.Code
+	}{
+		{name: "friends", friends: true, wantCode: codes.OK},
+		{name: "not friends", wantCode: codes.PermissionDenied},
+		{name: "unknown user", friendsErr: errs.ErrUserNotFound, wantCode: codes.NotFound},
+		{name: "user-service down", friendsErr: errs.ErrUserServiceUnavailable, wantCode: codes.Unavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roomRepo := new(mocks.RoomRepoMock)
+			settingsRepo := new(mocks.ChatSettingsRepoMock)
+			users := new(mocks.UserDirectoryMock)
+			roomMapper := new(mocks.RoomMapperMock)
+			logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
+			svc := service.NewRoomService(roomRepo, settingsRepo, users, roomMapper, logger)
+
+			req := validLoginRequest()
+			expectedResp := validExpectedResp()
+			inModel := model.Room{InitiatorID: 1, ParticipantID: 2}
+			createdModel := model.Room{ID: expectedResp.Room.Id, InitiatorID: 1, ParticipantID: 2}
+
+			roomMapper.On("ToRoomModel", req).Return(inModel)
+			settingsRepo.On("GetChatSettings", mock.Anything, int64(2)).
+				Return(model.ChatSettings{UserID: 2, DMsFromFriendsOnly: true}, nil)
+			users.On("AreFriends", mock.Anything, int64(1), int64(2)).Return(tt.friends, tt.friendsErr)
+			roomRepo.On("CreateRoom", mock.Anything, inModel).Return(createdModel, nil).Maybe()
+			roomMapper.On("ToCreateRoomResponse", createdModel).Return(expectedResp).Maybe()
+
+			resp, err := svc.CreateRoom(initiatorContext(), req)
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, expectedResp, resp)
+			} else {
+				roomRepo.AssertNotCalled(t, "CreateRoom", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
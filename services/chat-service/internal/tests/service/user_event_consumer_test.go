@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/service"
+	"github.com/mamataliev-dev/social-platform/services/chat-service/internal/tests/mocks"
+)
+
+// newTestConsumer builds a UserEventConsumer with a batch size of 10 and a
+// discarding logger.
+func newTestConsumer(source *mocks.UserEventSourceMock, repo *mocks.UserEventRepoMock) *service.UserEventConsumer {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
+	return service.NewUserEventConsumer(source, repo, time.Second, 10, logger)
+}
+
+// TestPollOnce_AppliesEventsInOrder verifies that events after the stored
+// cursor are applied in order and unknown event types only advance the cursor.
+func TestPollOnce_AppliesEventsInOrder(t *testing.T) {
+	source := new(mocks.UserEventSourceMock)
+	repo := new(mocks.UserEventRepoMock)
+	consumer := newTestConsumer(source, repo)
+
+	events := []model.UserEvent{
+		{ID: 6, Type: model.UserEventDeleted, UserID: 42},
+		{ID: 7, Type: "user.renamed", UserID: 43},
+	}
+	repo.On("UserEventCursor", mock.Anything).Return(int64(5), nil)
+	source.On("ListUserEvents", mock.Anything, int64(5), 10).Return(events, nil)
+	repo.On("ApplyUserDeleted", mock.Anything, int64(6), int64(42)).Return(nil).Once()
+	repo.On("AdvanceUserEventCursor", mock.Anything, int64(7)).Return(nil).Once()
+
+	handled, err := consumer.PollOnce(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, handled)
+	repo.AssertExpectations(t)
+}
+
+// TestPollOnce_StopsAtFailedEvent verifies that a failing event stops the
+// batch, so later events are not applied before it.
+func TestPollOnce_StopsAtFailedEvent(t *testing.T) {
+	source := new(mocks.UserEventSourceMock)
+	repo := new(mocks.UserEventRepoMock)
+	consumer := newTestConsumer(source, repo)
+
+	events := []model.UserEvent{
+		{ID: 1, Type: model.UserEventDeleted, UserID: 42},
+		{ID: 2, Type: model.UserEventDeleted, UserID: 43},
+	}
+	repo.On("UserEventCursor", mock.Anything).Return(int64(0), nil)
+	source.On("ListUserEvents", mock.Anything, int64(0), 10).Return(events, nil)
+	repo.On("ApplyUserDeleted", mock.Anything, int64(1), int64(42)).Return(errs.ErrDBFailure)
+
+	handled, err := consumer.PollOnce(context.Background())
+
+	assert.ErrorIs(t, err, errs.ErrDBFailure)
+	assert.Equal(t, 0, handled)
+	repo.AssertNotCalled(t, "ApplyUserDeleted", mock.Anything, int64(2), int64(43))
+}
+
+// TestPollOnce_SourceUnavailable verifies that a failed fetch applies nothing.
+func TestPollOnce_SourceUnavailable(t *testing.T) {
+	source := new(mocks.UserEventSourceMock)
+	repo := new(mocks.UserEventRepoMock)
+	consumer := newTestConsumer(source, repo)
+
+	repo.On("UserEventCursor", mock.Anything).Return(int64(3), nil)
+	source.On("ListUserEvents", mock.Anything, int64(3), 10).Return(nil, errs.ErrInternal)
+
+	handled, err := consumer.PollOnce(context.Background())
+
+	assert.Error(t, err)
+	assert.Equal(t, 0, handled)
+	repo.AssertNotCalled(t, "ApplyUserDeleted", mock.Anything, mock.Anything, mock.Anything)
+}
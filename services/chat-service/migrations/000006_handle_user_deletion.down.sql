@@ -0,0 +1,13 @@
+DROP TABLE IF EXISTS user_event_cursor;
+
+DROP INDEX IF EXISTS uniq_room_users;
+DELETE FROM rooms WHERE initiator_id IS NULL OR participant_id IS NULL;
+CREATE UNIQUE INDEX uniq_room_users ON rooms (
+  LEAST(initiator_id, participant_id),
+  GREATEST(initiator_id, participant_id)
+);
+
+DELETE FROM messages WHERE sender_id IS NULL;
+ALTER TABLE messages ALTER COLUMN sender_id SET NOT NULL;
+ALTER TABLE rooms ALTER COLUMN participant_id SET NOT NULL;
+ALTER TABLE rooms ALTER COLUMN initiator_id SET NOT NULL;
@@ -0,0 +1,18 @@
+-- Deleted users are anonymized: their IDs are cleared from rooms and messages
+-- so the other participant keeps the conversation.
+ALTER TABLE rooms ALTER COLUMN initiator_id DROP NOT NULL;
+ALTER TABLE rooms ALTER COLUMN participant_id DROP NOT NULL;
+ALTER TABLE messages ALTER COLUMN sender_id DROP NOT NULL;
+
+DROP INDEX IF EXISTS uniq_room_users;
+CREATE UNIQUE INDEX uniq_room_users ON rooms (
+  LEAST(initiator_id, participant_id),
+  GREATEST(initiator_id, participant_id)
+) WHERE initiator_id IS NOT NULL AND participant_id IS NOT NULL;
+
+CREATE TABLE user_event_cursor
+(
+    id            BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    last_event_id BIGINT    NOT NULL,
+    updated_at    TIMESTAMP NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,7 @@
+-- Per-user chat preferences; users without a row use the defaults.
+CREATE TABLE chat_settings
+(
+    user_id               BIGINT PRIMARY KEY,
+    dms_from_friends_only BOOLEAN   NOT NULL DEFAULT FALSE,
+    updated_at            TIMESTAMP NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+
+	"github.com/joho/godotenv"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	postpb "github.com/mamataliev-dev/social-platform/api/gen/post/v1"
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+	"github.com/mamataliev-dev/social-platform/pkg/grpctransport"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/clients"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/config"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/logger"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/mapper"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/middleware"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/repository"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/service"
+)
+
+// main is the entrypoint for the post-service application
+func main() {
+	if err := run(); err != nil {
+		log.Fatalf("application error: %v", err)
+	}
+}
+
+// serviceName identifies post-service as the issuer of the service tokens it
+// sends.
+const serviceName = "post-service"
+
+// userServiceName is the audience of service tokens sent to user-service.
+const userServiceName = "user-service"
+
+// run loads configuration, sets up logging, initializes the database and all
+// service dependencies, and starts both gRPC and HTTP REST servers.
+func run() error {
+	// Load environment and configuration
+	if err := godotenv.Load(); err != nil {
+		slog.Warn(".env file not found, falling back to environment variables")
+	}
+
+	cfg, err := config.Load("config.yaml")
+	if err != nil {
+		slog.Error("failed to load configuration", "error", err)
+		return err
+	}
+
+	// Initialize logger
+	baseLogger := logger.SetupLogger(cfg.Env)
+	slog.Info("logger initialized", "env", cfg.Env)
+
+	// Database connection
+	db, err := repository.NewPostgresConnection(cfg)
+	if err != nil {
+		slog.Error("failed to connect to Postgres", "error", err)
+		return err
+	}
+	defer db.Close()
+
+	// Initialize dependencies
+	postLogger := baseLogger.With("service", "post")
+	feedLogger := baseLogger.With("service", "feed")
+	fanOutLogger := baseLogger.With("service", "fan_out")
+	userEventLogger := baseLogger.With("service", "user_events")
+	userClientLogger := baseLogger.With("client", "user-service")
+
+	mappers := mapper.NewMappers()
+
+	postRepo := repository.NewPostPostgres(db)
+	commentRepo := repository.NewCommentPostgres(db)
+	feedRepo := repository.NewFeedPostgres(db)
+	userEventRepo := repository.NewUserEventPostgres(db)
+
+	// Service tokens; a missing or short secret leaves calls to user-service
+	// unauthenticated
+	serviceSigner, err := auth.NewServiceTokenSigner(cfg.UserService.ServiceToken, serviceName)
+	if err != nil {
+		slog.Warn("calls to user-service will not be authenticated", "error", err)
+	}
+
+	// TLS, keepalive and stream limits for the gRPC server and all clients
+	transport, err := grpctransport.New(grpctransport.Config{
+		TLS: grpctransport.TLSConfig{
+			Enabled:        cfg.GRPC.TLS.Enabled,
+			CertFile:       cfg.GRPC.TLS.CertFile,
+			KeyFile:        cfg.GRPC.TLS.KeyFile,
+			CAFile:         cfg.GRPC.TLS.CAFile,
+			ClientCAFile:   cfg.GRPC.TLS.ClientCAFile,
+			ReloadInterval: cfg.GRPC.TLS.ReloadInterval,
+		},
+		Keepalive: grpctransport.KeepaliveConfig{
+			Time:    cfg.GRPC.Keepalive.Time,
+			Timeout: cfg.GRPC.Keepalive.Timeout,
+		},
+		MaxConcurrentStreams: cfg.GRPC.MaxConcurrentStreams,
+	})
+	if err != nil {
+		slog.Error("failed to configure gRPC transport", "error", err)
+		return err
+	}
+
+	userClient, err := clients.NewUserServiceClient(cfg.UserService, transport, serviceSigner, userServiceName, userClientLogger)
+	if err != nil {
+		slog.Error("failed to create user-service client", "error", err)
+		return err
+	}
+	defer userClient.Close()
+
+	postSvc := service.NewPostService(postRepo, commentRepo, userClient, mappers.Post, postLogger)
+	feedSvc := service.NewFeedService(feedRepo, userClient, mappers.Post, feedLogger)
+	fanOutWorker := service.NewFanOutWorker(
+		feedRepo,
+		userClient,
+		cfg.Feed.FanOutThreshold,
+		cfg.Feed.FanOutBatchSize,
+		cfg.Feed.FanOutPollInterval,
+		fanOutLogger,
+	)
+	userEventConsumer := service.NewUserEventConsumer(
+		userClient,
+		userEventRepo,
+		cfg.UserService.EventPollInterval,
+		cfg.UserService.EventBatchSize,
+		cfg.Feed.BackfillSize,
+		userEventLogger,
+	)
+
+	// Context and signal handling
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// gRPC server setup
+	jwks := auth.NewJWKSClient(cfg.JWT.JWKSURL, cfg.JWT.JWKSCacheTTL)
+	verifier := auth.NewVerifier(jwks.Keyfunc, auth.Config{
+		Issuer:   cfg.JWT.Issuer,
+		Audience: cfg.JWT.Audience,
+		Leeway:   cfg.JWT.Leeway,
+	})
+	interceptors := []grpc.UnaryServerInterceptor{
+		middleware.ValidationInterceptor,
+		middleware.UnaryAuthInterceptor(verifier),
+	}
+	if cfg.Security.RequireVerifiedEmail {
+		interceptors = append(interceptors, middleware.VerifiedEmailInterceptor)
+	}
+
+	grpcServer := grpc.NewServer(append(transport.ServerOptions(false),
+		grpc.ChainUnaryInterceptor(interceptors...),
+	)...)
+
+	postpb.RegisterPostServiceServer(grpcServer, postSvc)
+	postpb.RegisterFeedServiceServer(grpcServer, feedSvc)
+	reflection.Register(grpcServer)
+
+	grpcAddr := fmt.Sprintf("%s:%d", cfg.GRPC.Host, cfg.GRPC.Port)
+	lis, err := net.Listen("tcp", grpcAddr)
+	if err != nil {
+		slog.Error("failed to listen for gRPC", "error", err)
+		return err
+	}
+
+	// HTTP REST Gateway setup
+	mux := runtime.NewServeMux()
+	opts := transport.DialOptions(cfg.GRPC.TLS.ServerName)
+
+	if err := postpb.RegisterPostServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		slog.Error("failed to register post gateway", "error", err)
+		return err
+	}
+	if err := postpb.RegisterFeedServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		slog.Error("failed to register feed gateway", "error", err)
+		return err
+	}
+
+	httpServer := &http.Server{
+		Addr:    fmt.Sprintf(":%s", cfg.Server.Port),
+		Handler: mux,
+	}
+
+	// Run servers concurrently
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		slog.Info("starting gRPC server", "addr", grpcAddr)
+		return grpcServer.Serve(lis)
+	})
+	eg.Go(func() error {
+		slog.Info("starting HTTP REST server", "addr", httpServer.Addr)
+		return httpServer.ListenAndServe()
+	})
+	eg.Go(func() error {
+		return transport.Run(egCtx)
+	})
+	eg.Go(func() error {
+		slog.Info("starting fan-out worker", "threshold", cfg.Feed.FanOutThreshold)
+		return fanOutWorker.Run(egCtx)
+	})
+	eg.Go(func() error {
+		slog.Info("starting user event consumer", "addr", cfg.UserService.Addr)
+		return userEventConsumer.Run(egCtx)
+	})
+
+	// Wait for shutdown signal
+	<-egCtx.Done()
+	slog.Info("shutdown signal received, stopping servers...")
+
+	// Graceful shutdown
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	grpcServer.GracefulStop()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		slog.Error("HTTP server shutdown failed", "error", err)
+	}
+
+	// Wait for all goroutines to finish
+	return eg.Wait()
+}
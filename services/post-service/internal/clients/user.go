@@ -0,0 +1,261 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	userpb "github.com/mamataliev-dev/social-platform/api/gen/user/v1"
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+	"github.com/mamataliev-dev/social-platform/pkg/grpctransport"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/config"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/model"
+)
+
+// authorizationHeader carries the end user's access token. It is forwarded to
+// user-service so calls made on behalf of a user stay attributable to them.
+const authorizationHeader = "authorization"
+
+// UserClient calls the InternalUserService of user-service. Every call runs
+// under the caller's context and authorization, each attempt has its own
+// deadline, and transient failures are retried with exponential backoff.
+// It implements model.SocialGraph and model.UserEventSource.
+type UserClient struct {
+	conn   *grpc.ClientConn
+	client userpb.InternalUserServiceClient
+	cfg    config.UserService
+	logger *slog.Logger
+}
+
+// NewUserServiceClient connects to user-service's internal listener at
+// cfg.Addr with the TLS and keepalive settings of transport. Every call
+// carries a token from signer addressed to audience; a nil signer sends none
+// and user-service rejects the calls.
+func NewUserServiceClient(
+	cfg config.UserService,
+	transport *grpctransport.Transport,
+	signer *auth.ServiceTokenSigner,
+	audience string,
+	logger *slog.Logger,
+) (*UserClient, error) {
+	target := fmt.Sprintf("dns:///%s", cfg.Addr)
+
+	opts := transport.DialOptions("")
+	if signer != nil {
+		opts = append(opts, grpc.WithPerRPCCredentials(signer.Credentials(audience)))
+	}
+
+	conn, err := grpc.NewClient(target, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	u := NewUserClient(userpb.NewInternalUserServiceClient(conn), cfg, logger)
+	u.conn = conn
+	return u, nil
+}
+
+// NewUserClient wraps an InternalUserService client with the timeouts and
+// retries configured in cfg.
+//
+//   - client: generated gRPC client for user-service.
+//   - cfg:    timeout and retry settings.
+//   - logger: structured logger for diagnostics.
+func NewUserClient(
+	client userpb.InternalUserServiceClient,
+	cfg config.UserService,
+	logger *slog.Logger,
+) *UserClient {
+	return &UserClient{
+		client: client,
+		cfg:    cfg,
+		logger: logger,
+	}
+}
+
+// IsFollowing asks user-service whether followerID follows followeeID. The
+// answer is not cached, so that unfollowing takes effect at once.
+func (u *UserClient) IsFollowing(ctx context.Context, followerID, followeeID int64) (bool, error) {
+	var resp *userpb.CheckFollowingResponse
+	err := u.call(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = u.client.CheckFollowing(ctx, &userpb.CheckFollowingRequest{
+			FollowerId: followerID,
+			FolloweeId: followeeID,
+		})
+		return err
+	})
+	if status.Code(err) == codes.NotFound {
+		return false, fmt.Errorf("%w: %d or %d", errs.ErrUserNotFound, followerID, followeeID)
+	}
+	if err != nil {
+		return false, err
+	}
+	return resp.GetFollowing(), nil
+}
+
+// FollowerCount reads the follower count from the user's profile.
+func (u *UserClient) FollowerCount(ctx context.Context, userID int64) (int64, error) {
+	var resp *userpb.UserProfile
+	err := u.call(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = u.client.FetchUserProfileByID(ctx, &userpb.FetchUserProfileByIDRequest{UserId: userID})
+		return err
+	})
+	if status.Code(err) == codes.NotFound {
+		return 0, fmt.Errorf("%w: %d", errs.ErrUserNotFound, userID)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return resp.GetFollowerCount(), nil
+}
+
+// ListFollowerIDs fetches one page of the IDs of userID's followers.
+func (u *UserClient) ListFollowerIDs(ctx context.Context, userID, afterID int64, limit int) ([]int64, error) {
+	return u.listFollowIDs(ctx, u.client.ListFollowerIDs, userID, afterID, limit)
+}
+
+// ListFollowingIDs fetches one page of the IDs of the users userID follows.
+func (u *UserClient) ListFollowingIDs(ctx context.Context, userID, afterID int64, limit int) ([]int64, error) {
+	return u.listFollowIDs(ctx, u.client.ListFollowingIDs, userID, afterID, limit)
+}
+
+// ListUserEvents fetches up to limit account and follow events after afterID
+// from user-service.
+func (u *UserClient) ListUserEvents(ctx context.Context, afterID int64, limit int) ([]model.UserEvent, error) {
+	var resp *userpb.ListUserEventsResponse
+	err := u.call(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = u.client.ListUserEvents(ctx, &userpb.ListUserEventsRequest{
+			AfterId: afterID,
+			Limit:   int32(limit),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]model.UserEvent, 0, len(resp.GetEvents()))
+	for _, e := range resp.GetEvents() {
+		events = append(events, model.UserEvent{
+			ID:            e.GetId(),
+			Type:          e.GetType(),
+			UserID:        e.GetUserId(),
+			SubjectUserID: e.GetSubjectUserId(),
+			OccurredAt:    e.GetOccurredAt().AsTime(),
+		})
+	}
+	return events, nil
+}
+
+// Close closes the underlying connection, if the client owns one.
+func (u *UserClient) Close() error {
+	if u.conn == nil {
+		return nil
+	}
+	return u.conn.Close()
+}
+
+// call runs an idempotent RPC, retrying transient failures up to
+// cfg.MaxRetries times. Each attempt gets its own cfg.RequestTimeout deadline
+// within ctx.
+func (u *UserClient) call(ctx context.Context, rpc func(ctx context.Context) error) error {
+	ctx = forwardAuthorization(ctx)
+
+	for attempt := 0; ; attempt++ {
+		err := u.attempt(ctx, rpc)
+		switch {
+		case err != nil && ctx.Err() != nil:
+			return ctx.Err()
+		case err == nil || !retryable(err):
+			return err
+		case attempt >= u.cfg.MaxRetries:
+			u.logger.Warn("user-service call failed", slog.Int("attempts", attempt+1), slog.Any("error", err))
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(u.backoff(attempt)):
+		}
+	}
+}
+
+// listFollowIDs calls one of the follow ID listing RPCs.
+func (u *UserClient) listFollowIDs(
+	ctx context.Context,
+	rpc func(context.Context, *userpb.ListFollowIDsRequest, ...grpc.CallOption) (*userpb.ListFollowIDsResponse, error),
+	userID, afterID int64,
+	limit int,
+) ([]int64, error) {
+	var resp *userpb.ListFollowIDsResponse
+	err := u.call(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = rpc(ctx, &userpb.ListFollowIDsRequest{
+			UserId:  userID,
+			AfterId: afterID,
+			Limit:   int32(limit),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetUserIds(), nil
+}
+
+// attempt runs rpc once under the per-attempt deadline.
+func (u *UserClient) attempt(ctx context.Context, rpc func(ctx context.Context) error) error {
+	if u.cfg.RequestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, u.cfg.RequestTimeout)
+		defer cancel()
+	}
+	return rpc(ctx)
+}
+
+// backoff returns the delay before retry number attempt+1: RetryBackoff
+// doubled per attempt, with up to half of it randomized so that clients do
+// not retry in lockstep.
+func (u *UserClient) backoff(attempt int) time.Duration {
+	delay := u.cfg.RetryBackoff << attempt
+	if delay <= 0 {
+		return 0
+	}
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// forwardAuthorization copies the caller's authorization header from the
+// incoming request into the outgoing call.
+func forwardAuthorization(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, authorizationHeader, values[0])
+}
+
+// retryable reports whether a failed call may succeed when repeated.
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
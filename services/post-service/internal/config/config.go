@@ -0,0 +1,139 @@
+package config
+
+import (
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+// Config holds all configuration for the post-service, including server, gRPC,
+// database, security, logging, and JWT settings.
+type Config struct {
+	Env         string      `yaml:"env"`          // Application environment (e.g., "development", "production")
+	Server      Server      `yaml:"server"`       // HTTP server settings
+	GRPC        GRPCConfig  `yaml:"grpc"`         // gRPC server settings
+	Database    Database    `yaml:"database"`     // Database connection settings
+	Security    Security    `yaml:"security"`     // Security-related settings (e.g., CORS)
+	Logging     Logging     `yaml:"logging"`      // Logging level and format
+	JWT         JWT         `yaml:"jwt"`          // JWT verification
+	UserService UserService `yaml:"user_service"` // user-service connection
+	Feed        Feed        `yaml:"feed"`         // Home feed generation
+}
+
+// Server contains HTTP server configuration parameters.
+type Server struct {
+	Host  string `yaml:"host"`  // Server host address
+	Port  string `yaml:"port"`  // Server port
+	Debug bool   `yaml:"debug"` // Debug mode flag
+}
+
+// KeepaliveConfig defines gRPC keepalive settings for connection health checks.
+type KeepaliveConfig struct {
+	Time    time.Duration `yaml:"time"`    // Interval between pings
+	Timeout time.Duration `yaml:"timeout"` // Timeout for ping ack
+}
+
+// TLSConfig holds TLS settings for secure gRPC communication. Enabling TLS
+// also makes the service dial user-service over TLS.
+type TLSConfig struct {
+	Enabled        bool          `yaml:"enabled"`         // Enable TLS encryption
+	CertFile       string        `yaml:"cert_file"`       // Path to TLS certificate file, also presented to servers that ask for one
+	KeyFile        string        `yaml:"key_file"`        // Path to TLS key file
+	CAFile         string        `yaml:"ca_file"`         // CA bundle verifying dialed servers (system roots if empty)
+	ClientCAFile   string        `yaml:"client_ca_file"`  // CA bundle for mTLS on internal listeners; post-service has none
+	ServerName     string        `yaml:"server_name"`     // Name the gateway expects in the gRPC server's certificate
+	ReloadInterval time.Duration `yaml:"reload_interval"` // How often certificate files are checked for changes
+}
+
+// GRPCConfig contains gRPC server configuration parameters.
+type GRPCConfig struct {
+	Host                 string          `yaml:"host"`                   // gRPC server host
+	Port                 int             `yaml:"port"`                   // gRPC server port
+	MaxConcurrentStreams uint32          `yaml:"max_concurrent_streams"` // Max parallel RPC streams
+	Keepalive            KeepaliveConfig `yaml:"keepalive"`              // Keepalive settings
+	TLS                  TLSConfig       `yaml:"tls"`                    // TLS configuration
+}
+
+// Database holds database connection configuration.
+type Database struct {
+	Driver   string `yaml:"driver"`   // Database driver (e.g., "postgres")
+	Host     string `yaml:"host"`     // Database host address
+	Port     int    `yaml:"port"`     // Database port
+	User     string `yaml:"user"`     // Database user
+	Password string `yaml:"password"` // Database password
+	Name     string `yaml:"name"`     // Database name
+	SSLMode  string `yaml:"sslmode"`  // SSL mode (e.g., "disable", "require")
+}
+
+// JWT contains settings for verifying access tokens issued by user-service.
+type JWT struct {
+	JWKSURL      string        `yaml:"jwks_url"`       // user-service /.well-known/jwks.json endpoint
+	JWKSCacheTTL time.Duration `yaml:"jwks_cache_ttl"` // How long fetched keys are trusted before refetching
+	Issuer       string        `yaml:"issuer"`         // Expected "iss" claim
+	Audience     string        `yaml:"audience"`       // Expected entry in the "aud" claim
+	Leeway       time.Duration `yaml:"leeway"`         // Clock skew tolerated for "exp", "nbf" and "iat"
+}
+
+// UserService configures the connection to user-service, which post-service
+// asks about the follow graph, the retries of calls to it and the polling of
+// its event feed.
+type UserService struct {
+	Addr              string        `yaml:"addr"`                // user-service gRPC address
+	ServiceToken      string        `yaml:"service_token"`       // Shared secret service tokens are signed with
+	RequestTimeout    time.Duration `yaml:"request_timeout"`     // Deadline of a single attempt
+	MaxRetries        int           `yaml:"max_retries"`         // Extra attempts after a transient failure
+	RetryBackoff      time.Duration `yaml:"retry_backoff"`       // Delay before the first retry, doubled for each further one
+	EventPollInterval time.Duration `yaml:"event_poll_interval"` // Delay between polls of the event feed once it is drained
+	EventBatchSize    int           `yaml:"event_batch_size"`    // Maximum number of events fetched per poll
+}
+
+// Feed configures home feed generation. Posts are copied into the feeds of
+// their author's followers when written, except for authors with at least
+// FanOutThreshold followers, whose posts are merged into feeds when read.
+type Feed struct {
+	FanOutThreshold    int64         `yaml:"fan_out_threshold"`     // Follower count from which posts are merged on read
+	FanOutBatchSize    int           `yaml:"fan_out_batch_size"`    // Followers fetched and written per batch, and posts fanned out per poll
+	FanOutPollInterval time.Duration `yaml:"fan_out_poll_interval"` // Delay between polls for new posts once none are pending
+	BackfillSize       int           `yaml:"backfill_size"`         // Recent posts of a newly followed user added to the follower's feed
+}
+
+// Security holds security-related configuration, such as allowed CORS origins.
+type Security struct {
+	AllowedOrigins       []string `yaml:"allowed_origins"`        // List of allowed CORS origins
+	RequireVerifiedEmail bool     `yaml:"require_verified_email"` // Block unverified accounts from restricted RPCs
+}
+
+// Logging defines logging level and format configuration.
+type Logging struct {
+	Level  string `yaml:"level"`  // Log level (e.g., "info", "debug")
+	Format string `yaml:"format"` // Log output format (e.g., "json", "text")
+}
+
+// Load reads and parses the YAML configuration from the specified file path.
+// It loads environment variables from a .env file, expands them in the YAML,
+// and unmarshals into a Config struct. Returns an error on failure.
+func Load(path string) (*Config, error) {
+	if err := godotenv.Load(".env"); err == nil {
+		slog.Info("Loaded environment variables from .env file")
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		slog.Error("Failed to read config file", "error", err)
+		return nil, err
+	}
+
+	expanded := os.ExpandEnv(string(raw))
+
+	var cfg Config
+	if err := yaml.Unmarshal([]byte(expanded), &cfg); err != nil {
+		slog.Error("Failed to parse YAML config", "error", err)
+		return nil, err
+	}
+
+	slog.Info("Configuration loaded successfully", "environment", cfg.Env)
+	return &cfg, nil
+}
@@ -0,0 +1,49 @@
+package errs
+
+import "errors"
+
+var (
+	// ErrInternal indicates a generic domain error.
+	ErrInternal = errors.New("domain error")
+	// ErrDBFailure indicates a database operation failure.
+	ErrDBFailure = errors.New("database failure")
+
+	// ErrMissingMetadata indicates missing required metadata in a request.
+	ErrMissingMetadata = errors.New("missing metadata")
+	// ErrInvalidArgument indicates a request that breaks its validation rules.
+	ErrInvalidArgument = errors.New("invalid argument")
+
+	// ErrMissingAuthToken indicates that an authorization token was not supplied.
+	ErrMissingAuthToken = errors.New("authorization token is not supplied")
+	// ErrInvalidToken indicates an invalid JWT token.
+	ErrInvalidToken = errors.New("invalid token")
+	// ErrEmailNotVerified indicates the caller must confirm their email first.
+	ErrEmailNotVerified = errors.New("email address is not verified")
+	// ErrPermissionDenied indicates a caller whose roles do not allow the method.
+	ErrPermissionDenied = errors.New("permission denied")
+
+	// ErrEmptyPost indicates a post with neither text nor attachments.
+	ErrEmptyPost = errors.New("a post needs text or attachments")
+	// ErrPostNotFound indicates a post that does not exist or that the caller
+	// may not see.
+	ErrPostNotFound = errors.New("post not found")
+	// ErrNotPostAuthor indicates a change to someone else's post.
+	ErrNotPostAuthor = errors.New("only the author can change a post")
+	// ErrEmptyComment indicates a comment without text.
+	ErrEmptyComment = errors.New("a comment needs text")
+	// ErrCommentNotFound indicates a comment that does not exist on the post.
+	ErrCommentNotFound = errors.New("comment not found")
+	// ErrNestedReply indicates a reply to a reply; only top-level comments
+	// can be replied to.
+	ErrNestedReply = errors.New("only top-level comments can be replied to")
+	// ErrNotCommentAuthor indicates a deletion of someone else's comment on
+	// someone else's post.
+	ErrNotCommentAuthor = errors.New("only the comment's or the post's author can delete a comment")
+	// ErrInvalidPageToken indicates a malformed or foreign page token.
+	ErrInvalidPageToken = errors.New("invalid page token")
+
+	// ErrUserNotFound indicates a user that does not exist in user-service.
+	ErrUserNotFound = errors.New("user not found")
+	// ErrUserServiceUnavailable indicates that user-service could not answer.
+	ErrUserServiceUnavailable = errors.New("user-service is unavailable")
+)
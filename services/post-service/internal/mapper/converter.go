@@ -0,0 +1,14 @@
+package mapper
+
+// Mappers groups every service-specific mapper under one struct,
+// so you can inject a single dependency.
+type Mappers struct {
+	Post PostMapper
+}
+
+// NewMappers constructs a Mappers with all sub-mappers initialized.
+func NewMappers() *Mappers {
+	return &Mappers{
+		Post: NewPostMapper(),
+	}
+}
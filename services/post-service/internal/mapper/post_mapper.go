@@ -0,0 +1,196 @@
+package mapper
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	postpb "github.com/mamataliev-dev/social-platform/api/gen/post/v1"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/model"
+)
+
+// PostMapper defines all mapping operations for Post.
+type PostMapper interface {
+	// GRPC ↔ Domain
+	ToPostModel(req *postpb.CreatePostRequest) model.Post
+	ToPostUpdate(req *postpb.UpdatePostRequest) model.PostUpdate
+	ToPostResponse(post model.Post) *postpb.Post
+	ToListUserPostsResponse(posts []model.Post, nextPageToken string) *postpb.ListUserPostsResponse
+	ToGetHomeFeedResponse(posts []model.Post, nextPageToken string) *postpb.GetHomeFeedResponse
+	ToCommentModel(req *postpb.CreateCommentRequest) model.Comment
+	ToCommentResponse(comment model.Comment) *postpb.Comment
+	ToListCommentsResponse(comments []model.Comment, nextPageToken string) *postpb.ListCommentsResponse
+}
+
+type postMapper struct{}
+
+func NewPostMapper() *postMapper {
+	return &postMapper{}
+}
+
+// ToPostModel maps a CreatePostRequest into a domain model.Post; posts
+// without a visibility are public. The author is left for the caller to set.
+func (m *postMapper) ToPostModel(req *postpb.CreatePostRequest) model.Post {
+	if req == nil {
+		return model.Post{}
+	}
+	visibility := model.VisibilityPublic
+	if v, ok := toVisibility(req.GetVisibility()); ok {
+		visibility = v
+	}
+	return model.Post{
+		Text:        req.GetText(),
+		Attachments: toAttachments(req.GetAttachments()),
+		Visibility:  visibility,
+	}
+}
+
+// ToPostUpdate maps an UpdatePostRequest into the fields to change; fields
+// the request leaves unset stay nil.
+func (m *postMapper) ToPostUpdate(req *postpb.UpdatePostRequest) model.PostUpdate {
+	var update model.PostUpdate
+	if req == nil {
+		return update
+	}
+	update.Text = req.Text
+	if req.GetAttachments() != nil {
+		attachments := toAttachments(req.GetAttachments().GetItems())
+		update.Attachments = &attachments
+	}
+	if v, ok := toVisibility(req.GetVisibility()); ok {
+		update.Visibility = &v
+	}
+	return update
+}
+
+// ToPostResponse maps a domain Post into the gRPC message.
+func (m *postMapper) ToPostResponse(post model.Post) *postpb.Post {
+	attachments := make([]*postpb.Attachment, 0, len(post.Attachments))
+	for _, a := range post.Attachments {
+		attachments = append(attachments, &postpb.Attachment{
+			Url:       a.URL,
+			MediaType: fromMediaType(a.MediaType),
+		})
+	}
+	return &postpb.Post{
+		Id:           post.ID,
+		AuthorId:     post.AuthorID,
+		Text:         post.Text,
+		Attachments:  attachments,
+		Visibility:   fromVisibility(post.Visibility),
+		CreatedAt:    timestamppb.New(post.CreatedAt),
+		UpdatedAt:    timestamppb.New(post.UpdatedAt),
+		LikeCount:    post.LikeCount,
+		CommentCount: post.CommentCount,
+	}
+}
+
+// ToListUserPostsResponse maps one page of posts into the gRPC response.
+func (m *postMapper) ToListUserPostsResponse(posts []model.Post, nextPageToken string) *postpb.ListUserPostsResponse {
+	resp := &postpb.ListUserPostsResponse{
+		Posts:         make([]*postpb.Post, 0, len(posts)),
+		NextPageToken: nextPageToken,
+	}
+	for _, post := range posts {
+		resp.Posts = append(resp.Posts, m.ToPostResponse(post))
+	}
+	return resp
+}
+
+// ToGetHomeFeedResponse maps one page of a home feed into the gRPC response.
+func (m *postMapper) ToGetHomeFeedResponse(posts []model.Post, nextPageToken string) *postpb.GetHomeFeedResponse {
+	resp := &postpb.GetHomeFeedResponse{
+		Posts:         make([]*postpb.Post, 0, len(posts)),
+		NextPageToken: nextPageToken,
+	}
+	for _, post := range posts {
+		resp.Posts = append(resp.Posts, m.ToPostResponse(post))
+	}
+	return resp
+}
+
+// ToCommentModel maps a CreateCommentRequest into a domain model.Comment. The
+// author is left for the caller to set.
+func (m *postMapper) ToCommentModel(req *postpb.CreateCommentRequest) model.Comment {
+	if req == nil {
+		return model.Comment{}
+	}
+	return model.Comment{
+		PostID:   req.GetPostId(),
+		ParentID: req.GetParentCommentId(),
+		Text:     req.GetText(),
+	}
+}
+
+// ToCommentResponse maps a domain Comment into the gRPC message.
+func (m *postMapper) ToCommentResponse(comment model.Comment) *postpb.Comment {
+	return &postpb.Comment{
+		Id:              comment.ID,
+		PostId:          comment.PostID,
+		ParentCommentId: comment.ParentID,
+		AuthorId:        comment.AuthorID,
+		Text:            comment.Text,
+		ReplyCount:      comment.ReplyCount,
+		CreatedAt:       timestamppb.New(comment.CreatedAt),
+	}
+}
+
+// ToListCommentsResponse maps one page of comments into the gRPC response.
+func (m *postMapper) ToListCommentsResponse(comments []model.Comment, nextPageToken string) *postpb.ListCommentsResponse {
+	resp := &postpb.ListCommentsResponse{
+		Comments:      make([]*postpb.Comment, 0, len(comments)),
+		NextPageToken: nextPageToken,
+	}
+	for _, comment := range comments {
+		resp.Comments = append(resp.Comments, m.ToCommentResponse(comment))
+	}
+	return resp
+}
+
+func toAttachments(in []*postpb.Attachment) []model.Attachment {
+	attachments := make([]model.Attachment, 0, len(in))
+	for _, a := range in {
+		mediaType := model.MediaTypeImage
+		if a.GetMediaType() == postpb.MediaType_MEDIA_TYPE_VIDEO {
+			mediaType = model.MediaTypeVideo
+		}
+		attachments = append(attachments, model.Attachment{URL: a.GetUrl(), MediaType: mediaType})
+	}
+	return attachments
+}
+
+func fromMediaType(t model.MediaType) postpb.MediaType {
+	switch t {
+	case model.MediaTypeImage:
+		return postpb.MediaType_MEDIA_TYPE_IMAGE
+	case model.MediaTypeVideo:
+		return postpb.MediaType_MEDIA_TYPE_VIDEO
+	default:
+		return postpb.MediaType_MEDIA_TYPE_UNSPECIFIED
+	}
+}
+
+// toVisibility maps a gRPC visibility; ok is false for UNSPECIFIED.
+func toVisibility(v postpb.PostVisibility) (model.Visibility, bool) {
+	switch v {
+	case postpb.PostVisibility_POST_VISIBILITY_PUBLIC:
+		return model.VisibilityPublic, true
+	case postpb.PostVisibility_POST_VISIBILITY_FOLLOWERS:
+		return model.VisibilityFollowers, true
+	case postpb.PostVisibility_POST_VISIBILITY_PRIVATE:
+		return model.VisibilityPrivate, true
+	default:
+		return "", false
+	}
+}
+
+func fromVisibility(v model.Visibility) postpb.PostVisibility {
+	switch v {
+	case model.VisibilityPublic:
+		return postpb.PostVisibility_POST_VISIBILITY_PUBLIC
+	case model.VisibilityFollowers:
+		return postpb.PostVisibility_POST_VISIBILITY_FOLLOWERS
+	case model.VisibilityPrivate:
+		return postpb.PostVisibility_POST_VISIBILITY_PRIVATE
+	default:
+		return postpb.PostVisibility_POST_VISIBILITY_UNSPECIFIED
+	}
+}
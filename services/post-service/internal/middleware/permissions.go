@@ -0,0 +1,19 @@
+package middleware
+
+import "github.com/mamataliev-dev/social-platform/pkg/auth"
+
+// methodPermissions lists the roles allowed to call every method that requires
+// a user JWT; any other method is denied.
+var methodPermissions = auth.MethodPolicy{
+	"/post.v1.PostService/CreatePost":    {auth.RoleUser},
+	"/post.v1.PostService/GetPost":       {auth.RoleUser},
+	"/post.v1.PostService/UpdatePost":    {auth.RoleUser},
+	"/post.v1.PostService/DeletePost":    {auth.RoleUser},
+	"/post.v1.PostService/ListUserPosts": {auth.RoleUser},
+	"/post.v1.PostService/LikePost":      {auth.RoleUser},
+	"/post.v1.PostService/UnlikePost":    {auth.RoleUser},
+	"/post.v1.PostService/CreateComment": {auth.RoleUser},
+	"/post.v1.PostService/ListComments":  {auth.RoleUser},
+	"/post.v1.PostService/DeleteComment": {auth.RoleUser},
+	"/post.v1.FeedService/GetHomeFeed":   {auth.RoleUser},
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/errs"
+)
+
+// UnaryAuthInterceptor returns an interceptor that enforces JWT authentication.
+// It extracts the 'Authorization' header from metadata and checks the Bearer
+// token with the shared verifier (signature against user-service's JWKS,
+// algorithm, expiry, issuer and audience) and checks the caller's roles
+// against methodPermissions before invoking the handler. The verified claims
+// are attached to the context for downstream interceptors.
+func UnaryAuthInterceptor(verifier *auth.Verifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, errs.ErrMissingMetadata.Error())
+		}
+
+		authHeader := md["authorization"]
+		if len(authHeader) == 0 {
+			return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+		}
+
+		tokenStr := strings.TrimPrefix(authHeader[0], "Bearer ")
+		claims, err := verifier.Verify(tokenStr)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidToken.Error())
+		}
+
+		if err := methodPermissions.Authorize(info.FullMethod, claims); err != nil {
+			return nil, status.Error(codes.PermissionDenied, errs.ErrPermissionDenied.Error())
+		}
+
+		return handler(auth.ContextWithClaims(ctx, claims), req)
+	}
+}
@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/errs"
+)
+
+// ValidationInterceptor rejects requests that break the protoc-gen-validate
+// rules of their message with InvalidArgument.
+func ValidationInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if msg, ok := req.(interface{ Validate() error }); ok {
+		if err := msg.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s: %s", errs.ErrInvalidArgument.Error(), err.Error())
+		}
+	}
+	return handler(ctx, req)
+}
@@ -0,0 +1,59 @@
+package model
+
+import (
+	"context"
+	"time"
+)
+
+// Comment is a comment on a post. Comments are threaded one level deep:
+// top-level comments may have replies, replies may not.
+// - ID: unique identifier assigned upon creation.
+// - ParentID: the top-level comment replied to; empty for top-level comments.
+// - ReplyCount: filled in for top-level comments when they are read.
+type Comment struct {
+	ID         string    // unique comment UUID
+	PostID     string    // post commented on
+	ParentID   string    // top-level comment replied to, or empty
+	AuthorID   int64     // author's user ID
+	Text       string    // comment text
+	ReplyCount int64     // number of replies
+	CreatedAt  time.Time // creation timestamp
+}
+
+// CommentCursor is the position after which a page of comments starts. It is
+// encoded into page tokens and bound to the post and parent it was issued for.
+type CommentCursor struct {
+	PostID    string    `json:"p"`
+	ParentID  string    `json:"r"`
+	CreatedAt time.Time `json:"t"`
+	ID        string    `json:"i"`
+}
+
+// CommentListQuery selects one page of the top-level comments of a post, or
+// of the replies to one of them, oldest first.
+type CommentListQuery struct {
+	PostID   string         // post whose comments are listed
+	ParentID string         // list replies to this comment; empty for top-level comments
+	Limit    int            // maximum number of comments
+	After    *CommentCursor // start after this comment; nil for the first page
+}
+
+// CommentRepository defines persistence operations for comments. Every change
+// keeps the comment count of the post up to date.
+type CommentRepository interface {
+	// CreateComment stores a new Comment and returns it populated with ID and
+	// CreatedAt. Returns errs.ErrPostNotFound or errs.ErrCommentNotFound if
+	// the post or the parent comment no longer exists.
+	CreateComment(ctx context.Context, comment Comment) (Comment, error)
+
+	// GetComment returns the comment with the given ID, or
+	// errs.ErrCommentNotFound.
+	GetComment(ctx context.Context, commentID string) (Comment, error)
+
+	// DeleteComment deletes the comment and its replies, or returns
+	// errs.ErrCommentNotFound if there is no such comment.
+	DeleteComment(ctx context.Context, commentID string) error
+
+	// ListComments returns one page of comments selected by query.
+	ListComments(ctx context.Context, query CommentListQuery) ([]Comment, error)
+}
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"context"
+	"time"
+)
+
+// FanOut is how a post reaches the home feeds of its author's followers.
+type FanOut string
+
+const (
+	// FanOutPending posts wait for the fan-out worker.
+	FanOutPending FanOut = "pending"
+	// FanOutDone posts have been copied into their followers' feeds.
+	FanOutDone FanOut = "done"
+	// FanOutSkipped posts belong to authors with too many followers to copy
+	// to; they are merged into feeds when those are read.
+	FanOutSkipped FanOut = "skipped"
+)
+
+// FanOutTask is a post waiting to be fanned out.
+type FanOutTask struct {
+	PostID   string // post UUID
+	AuthorID int64  // author's user ID
+}
+
+// FeedCursor is the position after which a page of a home feed starts. It is
+// encoded into page tokens and bound to the user it was issued for.
+type FeedCursor struct {
+	UserID    int64     `json:"u"`
+	CreatedAt time.Time `json:"t"`
+	ID        string    `json:"i"`
+}
+
+// FeedQuery selects one page of a home feed, newest first.
+type FeedQuery struct {
+	UserID      int64       // owner of the feed
+	FolloweeIDs []int64     // users UserID follows; only their posts are listed
+	Limit       int         // maximum number of posts
+	After       *FeedCursor // start after this post; nil for the first page
+}
+
+// FeedRepository defines persistence operations for home feeds.
+type FeedRepository interface {
+	// ListPendingFanOuts returns up to limit posts waiting to be fanned out,
+	// oldest first.
+	ListPendingFanOuts(ctx context.Context, limit int) ([]FanOutTask, error)
+
+	// AddFeedItems adds the post to the feeds of userIDs. Posts already in a
+	// feed are left as they are, and a deleted post is added nowhere.
+	AddFeedItems(ctx context.Context, postID string, userIDs []int64) error
+
+	// CompleteFanOut records that the post has been fanned out, with
+	// FanOutDone, or is left to be merged on read, with FanOutSkipped.
+	CompleteFanOut(ctx context.Context, postID string, fanOut FanOut) error
+
+	// ListFeed returns one page of the public and followers-only posts in
+	// the feed selected by query.
+	ListFeed(ctx context.Context, query FeedQuery) ([]Post, error)
+}
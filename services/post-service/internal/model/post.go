@@ -0,0 +1,104 @@
+package model
+
+import (
+	"context"
+	"time"
+)
+
+// Visibility controls who, besides the author, sees a post.
+type Visibility string
+
+const (
+	// VisibilityPublic posts are seen by every signed-in user.
+	VisibilityPublic Visibility = "public"
+	// VisibilityFollowers posts are seen by the author's followers.
+	VisibilityFollowers Visibility = "followers"
+	// VisibilityPrivate posts are seen by the author only.
+	VisibilityPrivate Visibility = "private"
+)
+
+// MediaType is the kind of media an attachment refers to.
+type MediaType string
+
+const (
+	// MediaTypeImage is a picture.
+	MediaTypeImage MediaType = "image"
+	// MediaTypeVideo is a video clip.
+	MediaTypeVideo MediaType = "video"
+)
+
+// Attachment references media uploaded elsewhere.
+type Attachment struct {
+	URL       string    // location of the media
+	MediaType MediaType // kind of media
+}
+
+// Post is a user's post.
+// - ID: unique identifier assigned upon creation.
+// - Attachments: media references in display order.
+// - UpdatedAt: equals CreatedAt until the post is edited.
+// - LikeCount, CommentCount: kept up to date by the repository.
+type Post struct {
+	ID           string       // unique post UUID
+	AuthorID     int64        // author's user ID
+	Text         string       // post text, may be empty if there are attachments
+	Attachments  []Attachment // media references
+	Visibility   Visibility   // who may see the post
+	CreatedAt    time.Time    // creation timestamp
+	UpdatedAt    time.Time    // last edit timestamp
+	LikeCount    int64        // number of users who like the post
+	CommentCount int64        // number of comments, replies included
+}
+
+// PostUpdate changes some fields of a post; nil fields keep their value.
+type PostUpdate struct {
+	Text        *string
+	Attachments *[]Attachment
+	Visibility  *Visibility
+}
+
+// PostCursor is the position after which a page of posts starts. It is
+// encoded into page tokens and bound to the author it was issued for.
+type PostCursor struct {
+	AuthorID  int64     `json:"u"`
+	CreatedAt time.Time `json:"t"`
+	ID        string    `json:"i"`
+}
+
+// PostListQuery selects one page of an author's posts, newest first.
+type PostListQuery struct {
+	AuthorID     int64        // author whose posts are listed
+	Visibilities []Visibility // only posts with one of these visibilities
+	Limit        int          // maximum number of posts
+	After        *PostCursor  // start after this post; nil for the first page
+}
+
+// PostRepository defines persistence operations for posts.
+type PostRepository interface {
+	// CreatePost stores a new Post and returns it populated with ID,
+	// CreatedAt and UpdatedAt.
+	CreatePost(ctx context.Context, post Post) (Post, error)
+
+	// GetPost returns the post with the given ID, or errs.ErrPostNotFound.
+	GetPost(ctx context.Context, postID string) (Post, error)
+
+	// UpdatePost applies update to the post of authorID and returns the
+	// result, or errs.ErrPostNotFound if authorID has no such post.
+	UpdatePost(ctx context.Context, postID string, authorID int64, update PostUpdate) (Post, error)
+
+	// DeletePost deletes the post of authorID, or returns
+	// errs.ErrPostNotFound if authorID has no such post.
+	DeletePost(ctx context.Context, postID string, authorID int64) error
+
+	// ListPosts returns one page of posts selected by query.
+	ListPosts(ctx context.Context, query PostListQuery) ([]Post, error)
+
+	// LikePost records that userID likes the post and returns its like
+	// count. Liking a post twice counts once. Returns errs.ErrPostNotFound
+	// if there is no such post.
+	LikePost(ctx context.Context, postID string, userID int64) (int64, error)
+
+	// UnlikePost removes the like of userID, if any, and returns the post's
+	// like count. Returns errs.ErrPostNotFound if there is no such post.
+	UnlikePost(ctx context.Context, postID string, userID int64) (int64, error)
+}
@@ -0,0 +1,22 @@
+package model
+
+import "context"
+
+// SocialGraph answers questions about the follow graph kept by user-service.
+type SocialGraph interface {
+	// IsFollowing reports whether followerID follows followeeID, or returns
+	// errs.ErrUserNotFound if either user does not exist.
+	IsFollowing(ctx context.Context, followerID, followeeID int64) (bool, error)
+
+	// FollowerCount returns how many followers userID has, or
+	// errs.ErrUserNotFound if the user does not exist.
+	FollowerCount(ctx context.Context, userID int64) (int64, error)
+
+	// ListFollowerIDs returns up to limit IDs of userID's followers greater
+	// than afterID, in ascending order.
+	ListFollowerIDs(ctx context.Context, userID, afterID int64, limit int) ([]int64, error)
+
+	// ListFollowingIDs returns up to limit IDs of the users userID follows
+	// greater than afterID, in ascending order.
+	ListFollowingIDs(ctx context.Context, userID, afterID int64, limit int) ([]int64, error)
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"context"
+	"time"
+)
+
+// Event types published by user-service that post-service acts on.
+const (
+	// UserEventDeleted is published when an account is deleted.
+	UserEventDeleted = "user.deleted"
+	// UserEventFollowed is published when UserID starts following
+	// SubjectUserID.
+	UserEventFollowed = "user.followed"
+	// UserEventUnfollowed is published when UserID stops following
+	// SubjectUserID.
+	UserEventUnfollowed = "user.unfollowed"
+)
+
+// UserEvent is an account or follow event published by user-service.
+// - ID: monotonically increasing cursor assigned by user-service.
+// - Type: event type, e.g. UserEventFollowed.
+// - UserID: the account the event is about; the follower for follow events.
+// - SubjectUserID: the followed user for follow events, 0 otherwise.
+// - OccurredAt: when the change happened.
+type UserEvent struct {
+	ID            int64     // event cursor
+	Type          string    // event type
+	UserID        int64     // affected user's ID
+	SubjectUserID int64     // other user involved
+	OccurredAt    time.Time // event timestamp
+}
+
+// UserEventSource reads the event feed of user-service.
+type UserEventSource interface {
+	// ListUserEvents returns up to limit events with an ID greater than
+	// afterID, oldest first.
+	ListUserEvents(ctx context.Context, afterID int64, limit int) ([]UserEvent, error)
+}
+
+// UserEventRepository applies user events to posts and feeds and remembers
+// how far the feed has been consumed. Applying an event twice must be
+// harmless, because the feed is delivered at least once.
+type UserEventRepository interface {
+	// UserEventCursor returns the ID of the last handled event, or 0 if none
+	// has been handled yet.
+	UserEventCursor(ctx context.Context) (int64, error)
+
+	// ApplyFollowed adds the backfill most recent posts of followeeID to the
+	// feed of followerID and advances the cursor to eventID, atomically.
+	ApplyFollowed(ctx context.Context, eventID, followerID, followeeID int64, backfill int) error
+
+	// ApplyUnfollowed removes the posts of followeeID from the feed of
+	// followerID and advances the cursor to eventID, atomically.
+	ApplyUnfollowed(ctx context.Context, eventID, followerID, followeeID int64) error
+
+	// ApplyUserDeleted deletes the user's posts, feed, likes and comments and
+	// advances the cursor to eventID, atomically.
+	ApplyUserDeleted(ctx context.Context, eventID, userID int64) error
+
+	// AdvanceUserEventCursor records eventID as handled without changing any
+	// data. It is used for event types post-service does not act on.
+	AdvanceUserEventCursor(ctx context.Context, eventID int64) error
+}
@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/model"
+)
+
+// commentColumns are the comments columns scanned by scanComment, in order,
+// followed by the reply count. Queries select them from comments AS c.
+const commentColumns = `id, post_id, parent_id, author_id, text, created_at,
+    (SELECT count(*) FROM comments AS r WHERE r.parent_id = c.id)`
+
+// CommentPostgres is a PostgreSQL implementation of model.CommentRepository.
+// The comment count of a post is changed in the same transaction as its
+// comments.
+type CommentPostgres struct {
+	db *sql.DB
+}
+
+// NewCommentPostgres creates a new CommentPostgres backed by the given SQL DB.
+func NewCommentPostgres(db *sql.DB) *CommentPostgres {
+	return &CommentPostgres{db: db}
+}
+
+// CreateComment inserts the comment under a new UUID and bumps the post's
+// comment count in one transaction. Returns ErrPostNotFound or
+// ErrCommentNotFound if the post or the parent comment is gone and
+// ErrDBFailure on database errors.
+func (r *CommentPostgres) CreateComment(ctx context.Context, comment model.Comment) (model.Comment, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return model.Comment{}, fmt.Errorf("%w: failed to begin transaction: %v", errs.ErrDBFailure, err)
+	}
+	defer tx.Rollback()
+
+	created, err := scanComment(tx.QueryRowContext(ctx, `
+        INSERT INTO comments AS c (id, post_id, parent_id, author_id, text)
+        VALUES ($1, $2, $3, $4, $5)
+        RETURNING `+commentColumns,
+		uuid.New().String(), comment.PostID, nullUUID(comment.ParentID), comment.AuthorID, comment.Text,
+	))
+	if isForeignKeyViolation(err) {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Constraint == "comments_parent_id_fkey" {
+			return model.Comment{}, errs.ErrCommentNotFound
+		}
+		return model.Comment{}, errs.ErrPostNotFound
+	}
+	if err != nil {
+		return model.Comment{}, fmt.Errorf("%w: failed to insert comment: %v", errs.ErrDBFailure, err)
+	}
+
+	if err := changeCommentCount(ctx, tx, comment.PostID, 1); err != nil {
+		return model.Comment{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return model.Comment{}, fmt.Errorf("%w: failed to commit comment: %v", errs.ErrDBFailure, err)
+	}
+	return created, nil
+}
+
+// GetComment reads the comment with its reply count. Returns
+// ErrCommentNotFound if there is no such comment and ErrDBFailure on database
+// errors.
+func (r *CommentPostgres) GetComment(ctx context.Context, commentID string) (model.Comment, error) {
+	comment, err := scanComment(r.db.QueryRowContext(ctx, `
+        SELECT `+commentColumns+` FROM comments AS c WHERE id = $1
+    `, commentID))
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return model.Comment{}, errs.ErrCommentNotFound
+	case err != nil:
+		return model.Comment{}, fmt.Errorf("%w: failed to read comment: %v", errs.ErrDBFailure, err)
+	}
+	return comment, nil
+}
+
+// DeleteComment deletes the comment and its replies and lowers the post's
+// comment count by as many in one transaction. The comment is locked first,
+// so that no reply can be added to it in between. Returns ErrCommentNotFound
+// if there is no such comment and ErrDBFailure on database errors.
+func (r *CommentPostgres) DeleteComment(ctx context.Context, commentID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%w: failed to begin transaction: %v", errs.ErrDBFailure, err)
+	}
+	defer tx.Rollback()
+
+	var postID string
+	err = tx.QueryRowContext(ctx, `SELECT post_id FROM comments WHERE id = $1 FOR UPDATE`, commentID).Scan(&postID)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return errs.ErrCommentNotFound
+	case err != nil:
+		return fmt.Errorf("%w: failed to lock comment: %v", errs.ErrDBFailure, err)
+	}
+
+	var deleted int64
+	err = tx.QueryRowContext(ctx, `
+        WITH removed AS (
+            DELETE FROM comments WHERE id = $1 OR parent_id = $1 RETURNING 1
+        )
+        SELECT count(*) FROM removed
+    `, commentID).Scan(&deleted)
+	if err != nil {
+		return fmt.Errorf("%w: failed to delete comment: %v", errs.ErrDBFailure, err)
+	}
+
+	if err := changeCommentCount(ctx, tx, postID, -deleted); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%w: failed to commit comment deletion: %v", errs.ErrDBFailure, err)
+	}
+	return nil
+}
+
+// ListComments reads one page of the top-level comments of the post, or of
+// the replies to query.ParentID, oldest first. Returns ErrDBFailure on
+// database errors.
+func (r *CommentPostgres) ListComments(ctx context.Context, query model.CommentListQuery) ([]model.Comment, error) {
+	filter, owner := `post_id = $1 AND parent_id IS NULL`, query.PostID
+	if query.ParentID != "" {
+		filter, owner = `parent_id = $1`, query.ParentID
+	}
+
+	var afterTime time.Time
+	var afterID string
+	if query.After != nil {
+		afterTime, afterID = query.After.CreatedAt, query.After.ID
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT `+commentColumns+`
+        FROM comments AS c
+        WHERE `+filter+`
+            AND (NOT $2 OR (created_at, id) > ($3::timestamp, $4::uuid))
+        ORDER BY created_at, id
+        LIMIT $5
+    `, owner, query.After != nil, afterTime, nullUUID(afterID), query.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to list comments: %v", errs.ErrDBFailure, err)
+	}
+	defer rows.Close()
+
+	comments := make([]model.Comment, 0, query.Limit)
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to scan comment: %v", errs.ErrDBFailure, err)
+		}
+		comments = append(comments, comment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: failed to list comments: %v", errs.ErrDBFailure, err)
+	}
+	return comments, nil
+}
+
+// changeCommentCount adds delta to the comment count of postID.
+func changeCommentCount(ctx context.Context, tx *sql.Tx, postID string, delta int64) error {
+	_, err := tx.ExecContext(ctx, `
+        UPDATE posts SET comment_count = comment_count + $2 WHERE id = $1
+    `, postID, delta)
+	if err != nil {
+		return fmt.Errorf("%w: failed to update comment count: %v", errs.ErrDBFailure, err)
+	}
+	return nil
+}
+
+// scanComment scans the commentColumns of a row into a model.Comment.
+func scanComment(row rowScanner) (model.Comment, error) {
+	var c model.Comment
+	var parentID sql.NullString
+	err := row.Scan(&c.ID, &c.PostID, &parentID, &c.AuthorID, &c.Text, &c.CreatedAt, &c.ReplyCount)
+	c.ParentID = parentID.String
+	return c, err
+}
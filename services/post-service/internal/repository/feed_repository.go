@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/model"
+)
+
+// FeedPostgres is a PostgreSQL implementation of model.FeedRepository.
+// Fanned-out posts are listed in feed_items; the state of each post's fan-out
+// is kept in posts.fan_out.
+type FeedPostgres struct {
+	db *sql.DB
+}
+
+// NewFeedPostgres creates a new FeedPostgres backed by the given SQL DB.
+func NewFeedPostgres(db *sql.DB) *FeedPostgres {
+	return &FeedPostgres{db: db}
+}
+
+// ListPendingFanOuts reads the oldest posts waiting to be fanned out. Returns
+// ErrDBFailure on database errors.
+func (r *FeedPostgres) ListPendingFanOuts(ctx context.Context, limit int) ([]model.FanOutTask, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT id, author_id
+        FROM posts
+        WHERE fan_out = 'pending'
+        ORDER BY created_at, id
+        LIMIT $1
+    `, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to list pending fan-outs: %v", errs.ErrDBFailure, err)
+	}
+	defer rows.Close()
+
+	tasks := make([]model.FanOutTask, 0, limit)
+	for rows.Next() {
+		var task model.FanOutTask
+		if err := rows.Scan(&task.PostID, &task.AuthorID); err != nil {
+			return nil, fmt.Errorf("%w: failed to scan fan-out: %v", errs.ErrDBFailure, err)
+		}
+		tasks = append(tasks, task)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: failed to list pending fan-outs: %v", errs.ErrDBFailure, err)
+	}
+	return tasks, nil
+}
+
+// AddFeedItems inserts one feed entry per user, copying the author and
+// creation time from the post so that feeds are paged without reading posts.
+// Returns ErrDBFailure on database errors.
+func (r *FeedPostgres) AddFeedItems(ctx context.Context, postID string, userIDs []int64) error {
+	_, err := r.db.ExecContext(ctx, `
+        INSERT INTO feed_items (user_id, post_id, author_id, created_at)
+        SELECT u.user_id, p.id, p.author_id, p.created_at
+        FROM unnest($2::bigint[]) AS u (user_id)
+        JOIN posts AS p ON p.id = $1
+        ON CONFLICT DO NOTHING
+    `, postID, pq.Array(userIDs))
+	if err != nil {
+		return fmt.Errorf("%w: failed to add feed items: %v", errs.ErrDBFailure, err)
+	}
+	return nil
+}
+
+// CompleteFanOut stores how the post reached feeds. Returns ErrDBFailure on
+// database errors.
+func (r *FeedPostgres) CompleteFanOut(ctx context.Context, postID string, fanOut model.FanOut) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE posts SET fan_out = $2 WHERE id = $1`, postID, fanOut)
+	if err != nil {
+		return fmt.Errorf("%w: failed to complete fan-out: %v", errs.ErrDBFailure, err)
+	}
+	return nil
+}
+
+// ListFeed merges the fanned-out entries of the feed with the posts of
+// followees that were not fanned out, each side limited to one page, and
+// reads the newest page of the union with attachments. Fanned-out entries
+// of authors no longer followed are skipped, and a post in both is listed
+// once. Returns ErrDBFailure on database errors.
+func (r *FeedPostgres) ListFeed(ctx context.Context, query model.FeedQuery) ([]model.Post, error) {
+	var afterTime time.Time
+	var afterID string
+	if query.After != nil {
+		afterTime, afterID = query.After.CreatedAt, query.After.ID
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT `+postColumns+`
+        FROM posts
+        WHERE id IN (
+            (SELECT f.post_id
+             FROM feed_items AS f
+             JOIN posts AS p ON p.id = f.post_id
+             WHERE f.user_id = $1
+                 AND f.author_id = ANY($2::bigint[])
+                 AND p.visibility <> 'private'
+                 AND (NOT $3 OR (f.created_at, f.post_id) < ($4::timestamp, $5::uuid))
+             ORDER BY f.created_at DESC, f.post_id DESC
+             LIMIT $6)
+            UNION
+            (SELECT id
+             FROM posts
+             WHERE author_id = ANY($2::bigint[])
+                 AND fan_out <> 'done'
+                 AND visibility <> 'private'
+                 AND (NOT $3 OR (created_at, id) < ($4::timestamp, $5::uuid))
+             ORDER BY created_at DESC, id DESC
+             LIMIT $6)
+        )
+        ORDER BY created_at DESC, id DESC
+        LIMIT $6
+    `, query.UserID, pq.Array(query.FolloweeIDs), query.After != nil, afterTime, nullUUID(afterID), query.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to list feed: %v", errs.ErrDBFailure, err)
+	}
+	defer rows.Close()
+
+	posts := make([]model.Post, 0, query.Limit)
+	for rows.Next() {
+		post, err := scanPost(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to scan post: %v", errs.ErrDBFailure, err)
+		}
+		posts = append(posts, post)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: failed to list feed: %v", errs.ErrDBFailure, err)
+	}
+
+	if err := loadAttachments(ctx, r.db, posts); err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
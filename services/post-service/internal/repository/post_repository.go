@@ -0,0 +1,342 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/model"
+)
+
+// postColumns are the posts columns scanned by scanPost, in order.
+const postColumns = `id, author_id, text, visibility, created_at, updated_at, like_count, comment_count`
+
+// PostPostgres is a PostgreSQL implementation of model.PostRepository.
+// Attachments live in post_attachments and are loaded with their posts.
+type PostPostgres struct {
+	db *sql.DB
+}
+
+// NewPostPostgres creates a new PostPostgres backed by the given SQL DB.
+func NewPostPostgres(db *sql.DB) *PostPostgres {
+	return &PostPostgres{db: db}
+}
+
+// CreatePost inserts the post and its attachments in one transaction under a
+// new UUID. Returns ErrDBFailure on database errors.
+func (r *PostPostgres) CreatePost(ctx context.Context, post model.Post) (model.Post, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return model.Post{}, fmt.Errorf("%w: failed to begin transaction: %v", errs.ErrDBFailure, err)
+	}
+	defer tx.Rollback()
+
+	created, err := scanPost(tx.QueryRowContext(ctx, `
+        INSERT INTO posts (id, author_id, text, visibility)
+        VALUES ($1, $2, $3, $4)
+        RETURNING `+postColumns,
+		uuid.New().String(), post.AuthorID, post.Text, post.Visibility,
+	))
+	if err != nil {
+		return model.Post{}, fmt.Errorf("%w: failed to insert post: %v", errs.ErrDBFailure, err)
+	}
+
+	if err := insertAttachments(ctx, tx, created.ID, post.Attachments); err != nil {
+		return model.Post{}, err
+	}
+	created.Attachments = post.Attachments
+
+	if err := tx.Commit(); err != nil {
+		return model.Post{}, fmt.Errorf("%w: failed to commit post: %v", errs.ErrDBFailure, err)
+	}
+	return created, nil
+}
+
+// GetPost reads the post and its attachments. Returns ErrPostNotFound if
+// there is no such post and ErrDBFailure on database errors.
+func (r *PostPostgres) GetPost(ctx context.Context, postID string) (model.Post, error) {
+	post, err := scanPost(r.db.QueryRowContext(ctx, `
+        SELECT `+postColumns+` FROM posts WHERE id = $1
+    `, postID))
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return model.Post{}, errs.ErrPostNotFound
+	case err != nil:
+		return model.Post{}, fmt.Errorf("%w: failed to read post: %v", errs.ErrDBFailure, err)
+	}
+
+	posts := []model.Post{post}
+	if err := loadAttachments(ctx, r.db, posts); err != nil {
+		return model.Post{}, err
+	}
+	return posts[0], nil
+}
+
+// UpdatePost changes the fields update sets, replacing all attachments if
+// update carries them, and bumps updated_at in one transaction. Returns
+// ErrPostNotFound if authorID has no such post and ErrDBFailure on database
+// errors.
+func (r *PostPostgres) UpdatePost(
+	ctx context.Context,
+	postID string,
+	authorID int64,
+	update model.PostUpdate,
+) (model.Post, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return model.Post{}, fmt.Errorf("%w: failed to begin transaction: %v", errs.ErrDBFailure, err)
+	}
+	defer tx.Rollback()
+
+	var text, visibility sql.NullString
+	if update.Text != nil {
+		text = sql.NullString{String: *update.Text, Valid: true}
+	}
+	if update.Visibility != nil {
+		visibility = sql.NullString{String: string(*update.Visibility), Valid: true}
+	}
+
+	post, err := scanPost(tx.QueryRowContext(ctx, `
+        UPDATE posts SET
+            text = COALESCE($3, text),
+            visibility = COALESCE($4, visibility),
+            updated_at = NOW()
+        WHERE id = $1 AND author_id = $2
+        RETURNING `+postColumns,
+		postID, authorID, text, visibility,
+	))
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return model.Post{}, errs.ErrPostNotFound
+	case err != nil:
+		return model.Post{}, fmt.Errorf("%w: failed to update post: %v", errs.ErrDBFailure, err)
+	}
+
+	if update.Attachments != nil {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM post_attachments WHERE post_id = $1`, postID); err != nil {
+			return model.Post{}, fmt.Errorf("%w: failed to delete attachments: %v", errs.ErrDBFailure, err)
+		}
+		if err := insertAttachments(ctx, tx, postID, *update.Attachments); err != nil {
+			return model.Post{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return model.Post{}, fmt.Errorf("%w: failed to commit post: %v", errs.ErrDBFailure, err)
+	}
+
+	posts := []model.Post{post}
+	if err := loadAttachments(ctx, r.db, posts); err != nil {
+		return model.Post{}, err
+	}
+	return posts[0], nil
+}
+
+// DeletePost deletes the post; its attachments go with it. Returns
+// ErrPostNotFound if authorID has no such post and ErrDBFailure on database
+// errors.
+func (r *PostPostgres) DeletePost(ctx context.Context, postID string, authorID int64) error {
+	result, err := r.db.ExecContext(ctx, `
+        DELETE FROM posts WHERE id = $1 AND author_id = $2
+    `, postID, authorID)
+	if err != nil {
+		return fmt.Errorf("%w: failed to delete post: %v", errs.ErrDBFailure, err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: failed to delete post: %v", errs.ErrDBFailure, err)
+	}
+	if n == 0 {
+		return errs.ErrPostNotFound
+	}
+	return nil
+}
+
+// ListPosts reads one page of the author's posts, newest first, with their
+// attachments. Returns ErrDBFailure on database errors.
+func (r *PostPostgres) ListPosts(ctx context.Context, query model.PostListQuery) ([]model.Post, error) {
+	visibilities := make([]string, 0, len(query.Visibilities))
+	for _, v := range query.Visibilities {
+		visibilities = append(visibilities, string(v))
+	}
+
+	var afterTime time.Time
+	var afterID string
+	if query.After != nil {
+		afterTime, afterID = query.After.CreatedAt, query.After.ID
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT `+postColumns+`
+        FROM posts
+        WHERE author_id = $1
+            AND visibility = ANY($2)
+            AND (NOT $3 OR (created_at, id) < ($4::timestamp, $5::uuid))
+        ORDER BY created_at DESC, id DESC
+        LIMIT $6
+    `, query.AuthorID, pq.Array(visibilities), query.After != nil, afterTime, nullUUID(afterID), query.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to list posts: %v", errs.ErrDBFailure, err)
+	}
+	defer rows.Close()
+
+	posts := make([]model.Post, 0, query.Limit)
+	for rows.Next() {
+		post, err := scanPost(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to scan post: %v", errs.ErrDBFailure, err)
+		}
+		posts = append(posts, post)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: failed to list posts: %v", errs.ErrDBFailure, err)
+	}
+
+	if err := loadAttachments(ctx, r.db, posts); err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+// LikePost records the like and bumps the post's like count in one
+// transaction; a like that already exists leaves the count unchanged.
+// Returns ErrPostNotFound if there is no such post and ErrDBFailure on
+// database errors.
+func (r *PostPostgres) LikePost(ctx context.Context, postID string, userID int64) (int64, error) {
+	return r.changeLike(ctx, postID, userID, `
+        INSERT INTO post_likes (post_id, user_id) VALUES ($1, $2) ON CONFLICT DO NOTHING
+    `, 1)
+}
+
+// UnlikePost removes the like and lowers the post's like count in one
+// transaction; a like that does not exist leaves the count unchanged.
+// Returns ErrPostNotFound if there is no such post and ErrDBFailure on
+// database errors.
+func (r *PostPostgres) UnlikePost(ctx context.Context, postID string, userID int64) (int64, error) {
+	return r.changeLike(ctx, postID, userID, `
+        DELETE FROM post_likes WHERE post_id = $1 AND user_id = $2
+    `, -1)
+}
+
+// changeLike runs stmt on the like of userID and, if it changed a row, adds
+// delta to the post's like count. It returns the resulting like count.
+func (r *PostPostgres) changeLike(ctx context.Context, postID string, userID int64, stmt string, delta int64) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("%w: failed to begin transaction: %v", errs.ErrDBFailure, err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, stmt, postID, userID)
+	if isForeignKeyViolation(err) {
+		return 0, errs.ErrPostNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("%w: failed to change like: %v", errs.ErrDBFailure, err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%w: failed to change like: %v", errs.ErrDBFailure, err)
+	}
+
+	var likeCount int64
+	err = tx.QueryRowContext(ctx, `
+        UPDATE posts SET like_count = like_count + $2 WHERE id = $1 RETURNING like_count
+    `, postID, n*delta).Scan(&likeCount)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return 0, errs.ErrPostNotFound
+	case err != nil:
+		return 0, fmt.Errorf("%w: failed to update like count: %v", errs.ErrDBFailure, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%w: failed to commit like: %v", errs.ErrDBFailure, err)
+	}
+	return likeCount, nil
+}
+
+// loadAttachments fills in the attachments of posts with a single query.
+func loadAttachments(ctx context.Context, db *sql.DB, posts []model.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(posts))
+	byID := make(map[string]*model.Post, len(posts))
+	for i := range posts {
+		ids[i] = posts[i].ID
+		byID[posts[i].ID] = &posts[i]
+	}
+
+	rows, err := db.QueryContext(ctx, `
+        SELECT post_id, url, media_type
+        FROM post_attachments
+        WHERE post_id = ANY($1::uuid[])
+        ORDER BY post_id, position
+    `, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("%w: failed to read attachments: %v", errs.ErrDBFailure, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var postID string
+		var a model.Attachment
+		if err := rows.Scan(&postID, &a.URL, &a.MediaType); err != nil {
+			return fmt.Errorf("%w: failed to scan attachment: %v", errs.ErrDBFailure, err)
+		}
+		if post, ok := byID[postID]; ok {
+			post.Attachments = append(post.Attachments, a)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%w: failed to read attachments: %v", errs.ErrDBFailure, err)
+	}
+	return nil
+}
+
+// insertAttachments stores attachments of postID in their given order.
+func insertAttachments(ctx context.Context, tx *sql.Tx, postID string, attachments []model.Attachment) error {
+	for i, a := range attachments {
+		_, err := tx.ExecContext(ctx, `
+            INSERT INTO post_attachments (post_id, position, url, media_type)
+            VALUES ($1, $2, $3, $4)
+        `, postID, i, a.URL, a.MediaType)
+		if err != nil {
+			return fmt.Errorf("%w: failed to insert attachment: %v", errs.ErrDBFailure, err)
+		}
+	}
+	return nil
+}
+
+// rowScanner is implemented by *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanPost scans the postColumns of a row into a model.Post.
+func scanPost(row rowScanner) (model.Post, error) {
+	var p model.Post
+	err := row.Scan(&p.ID, &p.AuthorID, &p.Text, &p.Visibility, &p.CreatedAt, &p.UpdatedAt, &p.LikeCount, &p.CommentCount)
+	return p, err
+}
+
+// isForeignKeyViolation reports whether err is a PostgreSQL foreign key
+// violation, which means a referenced row is gone.
+func isForeignKeyViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23503"
+}
+
+// nullUUID returns id, or NULL for the empty string, so that it can be cast
+// to uuid when there is no cursor.
+func nullUUID(id string) sql.NullString {
+	return sql.NullString{String: id, Valid: id != ""}
+}
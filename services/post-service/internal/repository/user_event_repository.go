@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/errs"
+)
+
+// UserEventPostgres is a PostgreSQL implementation of model.UserEventRepository.
+type UserEventPostgres struct {
+	db *sql.DB
+}
+
+// NewUserEventPostgres creates a new UserEventPostgres backed by the given SQL DB.
+func NewUserEventPostgres(db *sql.DB) *UserEventPostgres {
+	return &UserEventPostgres{db: db}
+}
+
+// UserEventCursor returns the ID of the last handled user event, or 0 if no
+// event has been handled yet. Returns ErrDBFailure on database errors.
+func (r *UserEventPostgres) UserEventCursor(ctx context.Context) (int64, error) {
+	var lastID int64
+	err := r.db.QueryRowContext(ctx, `SELECT last_event_id FROM user_event_cursor`).Scan(&lastID)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return 0, nil
+	case err != nil:
+		return 0, fmt.Errorf("%w: failed to read user event cursor: %v", errs.ErrDBFailure, err)
+	}
+	return lastID, nil
+}
+
+// ApplyFollowed copies the most recent posts of followeeID into the feed of
+// followerID and advances the cursor in a single transaction. Private posts
+// are left out, since feeds never show them. Returns ErrDBFailure on database
+// errors.
+func (r *UserEventPostgres) ApplyFollowed(ctx context.Context, eventID, followerID, followeeID int64, backfill int) error {
+	return r.apply(ctx, eventID, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+            INSERT INTO feed_items (user_id, post_id, author_id, created_at)
+            SELECT $1, id, author_id, created_at
+            FROM posts
+            WHERE author_id = $2 AND visibility <> 'private'
+            ORDER BY created_at DESC, id DESC
+            LIMIT $3
+            ON CONFLICT DO NOTHING
+        `, followerID, followeeID, backfill)
+		if err != nil {
+			return fmt.Errorf("%w: failed to backfill feed: %v", errs.ErrDBFailure, err)
+		}
+		return nil
+	})
+}
+
+// ApplyUnfollowed removes the posts of followeeID from the feed of followerID
+// and advances the cursor in a single transaction. Returns ErrDBFailure on
+// database errors.
+func (r *UserEventPostgres) ApplyUnfollowed(ctx context.Context, eventID, followerID, followeeID int64) error {
+	return r.apply(ctx, eventID, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+            DELETE FROM feed_items WHERE user_id = $1 AND author_id = $2
+        `, followerID, followeeID)
+		if err != nil {
+			return fmt.Errorf("%w: failed to remove unfollowed posts: %v", errs.ErrDBFailure, err)
+		}
+		return nil
+	})
+}
+
+// ApplyUserDeleted deletes the user's feed and posts, whose attachments,
+// feed entries, likes and comments go with them, then the user's likes and
+// comments on other posts, and advances the cursor in a single transaction.
+// The like and comment counts of those posts are lowered to match; the
+// user's comments are locked before they are counted, so that no reply can
+// be added to them in between. Returns ErrDBFailure on database errors.
+func (r *UserEventPostgres) ApplyUserDeleted(ctx context.Context, eventID, userID int64) error {
+	return r.apply(ctx, eventID, func(tx *sql.Tx) error {
+		statements := []string{
+			`DELETE FROM feed_items WHERE user_id = $1`,
+			`DELETE FROM posts WHERE author_id = $1`,
+			`WITH removed AS (DELETE FROM post_likes WHERE user_id = $1 RETURNING post_id)
+            UPDATE posts SET like_count = like_count - 1 WHERE id IN (SELECT post_id FROM removed)`,
+			`SELECT 1 FROM comments WHERE author_id = $1 FOR UPDATE`,
+			`UPDATE posts SET comment_count = comment_count - d.n
+            FROM (
+                SELECT post_id, count(*) AS n
+                FROM comments
+                WHERE author_id = $1 OR parent_id IN (SELECT id FROM comments WHERE author_id = $1)
+                GROUP BY post_id
+            ) AS d
+            WHERE posts.id = d.post_id`,
+			`DELETE FROM comments WHERE author_id = $1`,
+		}
+		for _, stmt := range statements {
+			if _, err := tx.ExecContext(ctx, stmt, userID); err != nil {
+				return fmt.Errorf("%w: failed to delete user's content: %v", errs.ErrDBFailure, err)
+			}
+		}
+		return nil
+	})
+}
+
+// AdvanceUserEventCursor records eventID as the last handled user event.
+// Returns ErrDBFailure on database errors.
+func (r *UserEventPostgres) AdvanceUserEventCursor(ctx context.Context, eventID int64) error {
+	return advanceCursor(ctx, r.db, eventID)
+}
+
+// apply runs change and advances the cursor to eventID in one transaction, so
+// a crash never leaves an event half applied.
+func (r *UserEventPostgres) apply(ctx context.Context, eventID int64, change func(tx *sql.Tx) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%w: failed to begin transaction: %v", errs.ErrDBFailure, err)
+	}
+	defer tx.Rollback()
+
+	if err := change(tx); err != nil {
+		return err
+	}
+	if err := advanceCursor(ctx, tx, eventID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%w: failed to commit transaction: %v", errs.ErrDBFailure, err)
+	}
+	return nil
+}
+
+// execer is satisfied by both *sql.DB and *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// advanceCursor moves the cursor forward to eventID. It never moves the cursor
+// backwards, so redelivered events cannot rewind it.
+func advanceCursor(ctx context.Context, db execer, eventID int64) error {
+	query := `
+        INSERT INTO user_event_cursor (id, last_event_id)
+        VALUES (TRUE, $1)
+        ON CONFLICT (id) DO UPDATE
+        SET last_event_id = GREATEST(user_event_cursor.last_event_id, EXCLUDED.last_event_id),
+            updated_at = NOW()
+    `
+	if _, err := db.ExecContext(ctx, query, eventID); err != nil {
+		return fmt.Errorf("%w: failed to advance user event cursor: %v", errs.ErrDBFailure, err)
+	}
+	return nil
+}
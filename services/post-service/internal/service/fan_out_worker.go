@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/model"
+)
+
+// FanOutWorker copies new posts into the home feeds of their author's
+// followers. Posts of authors with at least threshold followers are not
+// copied; FeedService merges them into feeds when they are read. A post stays
+// pending until it has reached every follower, so a failed fan-out is retried
+// on the next poll; adding a post to a feed twice is harmless.
+type FanOutWorker struct {
+	feedRepo     model.FeedRepository
+	graph        model.SocialGraph
+	threshold    int64
+	batchSize    int
+	pollInterval time.Duration
+	logger       *slog.Logger
+}
+
+// NewFanOutWorker constructs a FanOutWorker with the given dependencies.
+//
+//   - feedRepo:     reads pending posts and writes feed entries.
+//   - graph:        counts and lists followers from user-service.
+//   - threshold:    follower count from which posts are merged on read.
+//   - batchSize:    followers fetched and written at once, and posts per poll.
+//   - pollInterval: delay between polls once no post is pending.
+//   - logger:       structured logger for diagnostics.
+func NewFanOutWorker(
+	feedRepo model.FeedRepository,
+	graph model.SocialGraph,
+	threshold int64,
+	batchSize int,
+	pollInterval time.Duration,
+	logger *slog.Logger,
+) *FanOutWorker {
+	return &FanOutWorker{
+		feedRepo:     feedRepo,
+		graph:        graph,
+		threshold:    threshold,
+		batchSize:    batchSize,
+		pollInterval: pollInterval,
+		logger:       logger,
+	}
+}
+
+// Run fans out pending posts until ctx is cancelled. Full batches are
+// followed by an immediate poll; otherwise it waits pollInterval. Failures
+// are logged and retried on the next poll. It always returns nil so it can
+// run inside an errgroup.
+func (w *FanOutWorker) Run(ctx context.Context) error {
+	for {
+		handled, err := w.PollOnce(ctx)
+		if err != nil {
+			w.logger.Error("unable to fan out posts", slog.Any("error", err))
+		}
+
+		wait := w.pollInterval
+		if err == nil && handled == w.batchSize {
+			wait = 0
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait):
+		}
+	}
+}
+
+// PollOnce fans out the oldest pending posts in order. It returns how many
+// were handled before the first failure.
+func (w *FanOutWorker) PollOnce(ctx context.Context) (int, error) {
+	tasks, err := w.feedRepo.ListPendingFanOuts(ctx, w.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, task := range tasks {
+		if err := w.fanOut(ctx, task); err != nil {
+			return i, err
+		}
+	}
+	return len(tasks), nil
+}
+
+// fanOut adds one post to the feeds of its author's followers, or skips it if
+// the author has too many followers or no longer exists.
+func (w *FanOutWorker) fanOut(ctx context.Context, task model.FanOutTask) error {
+	count, err := w.graph.FollowerCount(ctx, task.AuthorID)
+	switch {
+	case errors.Is(err, errs.ErrUserNotFound):
+		return w.feedRepo.CompleteFanOut(ctx, task.PostID, model.FanOutSkipped)
+	case err != nil:
+		return err
+	case count >= w.threshold:
+		return w.feedRepo.CompleteFanOut(ctx, task.PostID, model.FanOutSkipped)
+	}
+
+	var afterID int64
+	for {
+		followerIDs, err := w.graph.ListFollowerIDs(ctx, task.AuthorID, afterID, w.batchSize)
+		if err != nil {
+			return err
+		}
+		if len(followerIDs) > 0 {
+			if err := w.feedRepo.AddFeedItems(ctx, task.PostID, followerIDs); err != nil {
+				return err
+			}
+		}
+		if len(followerIDs) < w.batchSize {
+			break
+		}
+		afterID = followerIDs[len(followerIDs)-1]
+	}
+
+	w.logger.Info("fanned out post", slog.String("post_id", task.PostID), slog.Int64("author_id", task.AuthorID))
+	return w.feedRepo.CompleteFanOut(ctx, task.PostID, model.FanOutDone)
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	postpb "github.com/mamataliev-dev/social-platform/api/gen/post/v1"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/mapper"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/model"
+)
+
+// followingPageSize is the number of followee IDs fetched per call to
+// user-service when a feed is read, the most it returns at once.
+const followingPageSize = 1000
+
+// FeedService implements the FeedServiceServer. A home feed holds the public
+// and followers-only posts of the users the caller follows. Most posts are
+// copied into the feeds of their author's followers by the FanOutWorker;
+// posts of authors with many followers, and posts not fanned out yet, are
+// merged in when the feed is read.
+type FeedService struct {
+	postpb.UnimplementedFeedServiceServer
+
+	feedRepo model.FeedRepository
+	graph    model.SocialGraph
+	mapper   mapper.PostMapper
+	logger   *slog.Logger
+}
+
+// NewFeedService constructs a FeedService with the given dependencies.
+//
+//   - feedRepo: interface for reading home feeds.
+//   - graph:    lists the users the caller follows from user-service.
+//   - mapper:   converts between gRPC messages and internal models.
+//   - logger:   structured logger for diagnostics.
+func NewFeedService(
+	feedRepo model.FeedRepository,
+	graph model.SocialGraph,
+	mapper mapper.PostMapper,
+	logger *slog.Logger,
+) *FeedService {
+	return &FeedService{
+		feedRepo: feedRepo,
+		graph:    graph,
+		mapper:   mapper,
+		logger:   logger,
+	}
+}
+
+// GetHomeFeed lists one page of the caller's home feed, newest first.
+// Returns InvalidArgument for page tokens issued to another user and
+// Unavailable if user-service cannot list whom the caller follows.
+func (s *FeedService) GetHomeFeed(ctx context.Context, req *postpb.GetHomeFeedRequest) (*postpb.GetHomeFeedResponse, error) {
+	caller, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	var after *model.FeedCursor
+	if req.GetPageToken() != "" {
+		var cursor model.FeedCursor
+		err := decodePageToken(req.GetPageToken(), &cursor)
+		if err != nil || cursor.UserID != caller || !validCursorID(cursor.ID) {
+			return nil, status.Error(codes.InvalidArgument, errs.ErrInvalidPageToken.Error())
+		}
+		after = &cursor
+	}
+
+	followeeIDs, err := s.followeeIDs(ctx, caller)
+	if err != nil {
+		s.logger.Error("unable to list followed users", slog.Int64("user_id", caller), slog.Any("error", err))
+		return nil, status.Error(codes.Unavailable, errs.ErrUserServiceUnavailable.Error())
+	}
+
+	posts, err := s.feedRepo.ListFeed(ctx, model.FeedQuery{
+		UserID:      caller,
+		FolloweeIDs: followeeIDs,
+		Limit:       pageSize + 1,
+		After:       after,
+	})
+	if err != nil {
+		s.logger.Error("unable to list feed", slog.Int64("user_id", caller), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	var nextPageToken string
+	if len(posts) > pageSize {
+		posts = posts[:pageSize]
+		last := posts[len(posts)-1]
+		nextPageToken = encodePageToken(model.FeedCursor{
+			UserID:    caller,
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		})
+	}
+
+	return s.mapper.ToGetHomeFeedResponse(posts, nextPageToken), nil
+}
+
+// followeeIDs fetches the IDs of every user userID follows, one page at a
+// time.
+func (s *FeedService) followeeIDs(ctx context.Context, userID int64) ([]int64, error) {
+	var ids []int64
+	var afterID int64
+	for {
+		page, err := s.graph.ListFollowingIDs(ctx, userID, afterID, followingPageSize)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, page...)
+		if len(page) < followingPageSize {
+			return ids, nil
+		}
+		afterID = page[len(page)-1]
+	}
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/errs"
+)
+
+// encodePageToken encodes a list cursor as an opaque, URL-safe page token.
+func encodePageToken(cursor any) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageToken parses a page token produced by encodePageToken into
+// cursor; returns ErrInvalidPageToken if it is malformed.
+func decodePageToken(token string, cursor any) error {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return errs.ErrInvalidPageToken
+	}
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return errs.ErrInvalidPageToken
+	}
+	return nil
+}
+
+// validCursorID reports whether id, the post or comment ID of a decoded
+// cursor, is a UUID. Tokens are not signed, so a forged ID must be refused
+// before it reaches the database.
+func validCursorID(id string) bool {
+	_, err := uuid.Parse(id)
+	return err == nil
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	postpb "github.com/mamataliev-dev/social-platform/api/gen/post/v1"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/model"
+)
+
+// LikePost likes a post the caller may see and returns it with its updated
+// like count. Liking a post twice is not an error.
+func (s *PostService) LikePost(ctx context.Context, req *postpb.LikePostRequest) (*postpb.Post, error) {
+	return s.changeLike(ctx, req.GetPostId(), s.postRepo.LikePost)
+}
+
+// UnlikePost takes back the caller's like of a post the caller may see and
+// returns the post with its updated like count. Unliking a post that is not
+// liked is not an error.
+func (s *PostService) UnlikePost(ctx context.Context, req *postpb.UnlikePostRequest) (*postpb.Post, error) {
+	return s.changeLike(ctx, req.GetPostId(), s.postRepo.UnlikePost)
+}
+
+// CreateComment comments on a post the caller may see, or replies to one of
+// its top-level comments. Returns InvalidArgument for blank text and for
+// replies to replies, and NotFound if the parent comment is not on the post.
+func (s *PostService) CreateComment(ctx context.Context, req *postpb.CreateCommentRequest) (*postpb.Comment, error) {
+	caller, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	comment := s.mapper.ToCommentModel(req)
+	comment.AuthorID = caller
+	if strings.TrimSpace(comment.Text) == "" {
+		return nil, status.Error(codes.InvalidArgument, errs.ErrEmptyComment.Error())
+	}
+
+	post, err := s.viewablePost(ctx, caller, comment.PostID)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.ParentID != "" {
+		parent, err := s.postComment(ctx, post.ID, comment.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent.ParentID != "" {
+			return nil, status.Error(codes.InvalidArgument, errs.ErrNestedReply.Error())
+		}
+	}
+
+	created, err := s.commentRepo.CreateComment(ctx, comment)
+	switch {
+	case errors.Is(err, errs.ErrPostNotFound):
+		return nil, status.Error(codes.NotFound, errs.ErrPostNotFound.Error())
+	case errors.Is(err, errs.ErrCommentNotFound):
+		return nil, status.Error(codes.NotFound, errs.ErrCommentNotFound.Error())
+	case err != nil:
+		s.logger.Error("unable to create comment", slog.String("post_id", post.ID), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	s.logger.Info("created comment", slog.String("comment_id", created.ID),
+		slog.String("post_id", post.ID), slog.Int64("author_id", caller))
+	return s.mapper.ToCommentResponse(created), nil
+}
+
+// ListComments lists one page of the top-level comments of a post the caller
+// may see, or of the replies to parent_comment_id, oldest first. Returns
+// NotFound if the parent comment is not on the post and InvalidArgument for
+// page tokens issued for another post or parent.
+func (s *PostService) ListComments(
+	ctx context.Context,
+	req *postpb.ListCommentsRequest,
+) (*postpb.ListCommentsResponse, error) {
+	caller, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	var after *model.CommentCursor
+	if req.GetPageToken() != "" {
+		var cursor model.CommentCursor
+		err := decodePageToken(req.GetPageToken(), &cursor)
+		if err != nil || cursor.PostID != req.GetPostId() || cursor.ParentID != req.GetParentCommentId() ||
+			!validCursorID(cursor.ID) {
+			return nil, status.Error(codes.InvalidArgument, errs.ErrInvalidPageToken.Error())
+		}
+		after = &cursor
+	}
+
+	post, err := s.viewablePost(ctx, caller, req.GetPostId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetParentCommentId() != "" {
+		if _, err := s.postComment(ctx, post.ID, req.GetParentCommentId()); err != nil {
+			return nil, err
+		}
+	}
+
+	comments, err := s.commentRepo.ListComments(ctx, model.CommentListQuery{
+		PostID:   post.ID,
+		ParentID: req.GetParentCommentId(),
+		Limit:    pageSize + 1,
+		After:    after,
+	})
+	if err != nil {
+		s.logger.Error("unable to list comments", slog.String("post_id", post.ID), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	var nextPageToken string
+	if len(comments) > pageSize {
+		comments = comments[:pageSize]
+		last := comments[len(comments)-1]
+		nextPageToken = encodePageToken(model.CommentCursor{
+			PostID:    post.ID,
+			ParentID:  req.GetParentCommentId(),
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		})
+	}
+
+	return s.mapper.ToListCommentsResponse(comments, nextPageToken), nil
+}
+
+// DeleteComment deletes a comment and its replies. The comment's author and
+// the post's author may delete it, even if the post has since been hidden
+// from the comment's author. Others get PermissionDenied if they can see the
+// post and NotFound otherwise.
+func (s *PostService) DeleteComment(
+	ctx context.Context,
+	req *postpb.DeleteCommentRequest,
+) (*postpb.DeleteCommentResponse, error) {
+	caller, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := s.getPost(ctx, req.GetPostId())
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.postComment(ctx, post.ID, req.GetCommentId())
+	if err != nil && status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+	if err != nil || (comment.AuthorID != caller && post.AuthorID != caller) {
+		// Whether the comment exists is only revealed to callers who may see
+		// the post.
+		visible, viewErr := s.canView(ctx, caller, post)
+		switch {
+		case viewErr != nil:
+			return nil, viewErr
+		case !visible:
+			return nil, status.Error(codes.NotFound, errs.ErrPostNotFound.Error())
+		case err != nil:
+			return nil, err
+		}
+		return nil, status.Error(codes.PermissionDenied, errs.ErrNotCommentAuthor.Error())
+	}
+
+	if err := s.commentRepo.DeleteComment(ctx, comment.ID); err != nil {
+		if errors.Is(err, errs.ErrCommentNotFound) {
+			return nil, status.Error(codes.NotFound, errs.ErrCommentNotFound.Error())
+		}
+		s.logger.Error("unable to delete comment", slog.String("comment_id", comment.ID), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	s.logger.Info("deleted comment", slog.String("comment_id", comment.ID),
+		slog.String("post_id", post.ID), slog.Int64("deleted_by", caller))
+	return &postpb.DeleteCommentResponse{}, nil
+}
+
+// changeLike applies change to the caller's like of a post the caller may
+// see and returns the post with the resulting like count.
+func (s *PostService) changeLike(
+	ctx context.Context,
+	postID string,
+	change func(ctx context.Context, postID string, userID int64) (int64, error),
+) (*postpb.Post, error) {
+	caller, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := s.viewablePost(ctx, caller, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	likeCount, err := change(ctx, post.ID, caller)
+	switch {
+	case errors.Is(err, errs.ErrPostNotFound):
+		return nil, status.Error(codes.NotFound, errs.ErrPostNotFound.Error())
+	case err != nil:
+		s.logger.Error("unable to change like", slog.String("post_id", post.ID), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	post.LikeCount = likeCount
+	return s.mapper.ToPostResponse(post), nil
+}
+
+// postComment loads a comment of the post, mapping repository errors to gRPC
+// statuses. Comments of other posts are reported as NotFound.
+func (s *PostService) postComment(ctx context.Context, postID, commentID string) (model.Comment, error) {
+	comment, err := s.commentRepo.GetComment(ctx, commentID)
+	switch {
+	case errors.Is(err, errs.ErrCommentNotFound):
+		return model.Comment{}, status.Error(codes.NotFound, errs.ErrCommentNotFound.Error())
+	case err != nil:
+		s.logger.Error("unable to read comment", slog.String("comment_id", commentID), slog.Any("error", err))
+		return model.Comment{}, status.Error(codes.Internal, errs.ErrInternal.Error())
+	case comment.PostID != postID:
+		return model.Comment{}, status.Error(codes.NotFound, errs.ErrCommentNotFound.Error())
+	}
+	return comment, nil
+}
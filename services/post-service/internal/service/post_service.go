@@ -0,0 +1,318 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	postpb "github.com/mamataliev-dev/social-platform/api/gen/post/v1"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/mapper"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/model"
+)
+
+// defaultPageSize is the number of posts listed when no page size is given.
+const defaultPageSize = 20
+
+// PostService implements the PostServiceServer. Posts are only ever shown to
+// callers their visibility allows: everyone for public posts, the author's
+// followers for followers-only posts and the author alone for private posts.
+// Posts a caller may not see are reported as not found, and so are their likes
+// and comments.
+type PostService struct {
+	postpb.UnimplementedPostServiceServer
+
+	postRepo    model.PostRepository
+	commentRepo model.CommentRepository
+	graph       model.SocialGraph
+	mapper      mapper.PostMapper
+	logger      *slog.Logger
+}
+
+// NewPostService constructs a PostService with the given dependencies.
+//
+//   - postRepo:    interface for persisting and retrieving posts and likes.
+//   - commentRepo: interface for persisting and retrieving comments.
+//   - graph:       asks user-service whether a viewer follows an author.
+//   - mapper:      converts between gRPC messages and internal models.
+//   - logger:      structured logger for diagnostics.
+func NewPostService(
+	postRepo model.PostRepository,
+	commentRepo model.CommentRepository,
+	graph model.SocialGraph,
+	mapper mapper.PostMapper,
+	logger *slog.Logger,
+) *PostService {
+	return &PostService{
+		postRepo:    postRepo,
+		commentRepo: commentRepo,
+		graph:       graph,
+		mapper:      mapper,
+		logger:      logger,
+	}
+}
+
+// CreatePost publishes a post by the caller. Returns InvalidArgument if the
+// post has neither text nor attachments.
+func (s *PostService) CreatePost(ctx context.Context, req *postpb.CreatePostRequest) (*postpb.Post, error) {
+	caller, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	post := s.mapper.ToPostModel(req)
+	post.AuthorID = caller
+	if isEmpty(post.Text, post.Attachments) {
+		return nil, status.Error(codes.InvalidArgument, errs.ErrEmptyPost.Error())
+	}
+
+	created, err := s.postRepo.CreatePost(ctx, post)
+	if err != nil {
+		s.logger.Error("unable to create post", slog.Int64("author_id", caller), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	s.logger.Info("created post", slog.String("post_id", created.ID), slog.Int64("author_id", caller))
+	return s.mapper.ToPostResponse(created), nil
+}
+
+// GetPost returns a post the caller may see, or NotFound.
+func (s *PostService) GetPost(ctx context.Context, req *postpb.GetPostRequest) (*postpb.Post, error) {
+	caller, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := s.viewablePost(ctx, caller, req.GetPostId())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.mapper.ToPostResponse(post), nil
+}
+
+// UpdatePost changes the fields the request sets on one of the caller's
+// posts. Returns PermissionDenied for posts of others the caller can see,
+// NotFound for the rest, and InvalidArgument if the post would be left
+// without text and attachments.
+func (s *PostService) UpdatePost(ctx context.Context, req *postpb.UpdatePostRequest) (*postpb.Post, error) {
+	caller, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := s.authorPost(ctx, caller, req.GetPostId())
+	if err != nil {
+		return nil, err
+	}
+
+	update := s.mapper.ToPostUpdate(req)
+	text, attachments := post.Text, post.Attachments
+	if update.Text != nil {
+		text = *update.Text
+	}
+	if update.Attachments != nil {
+		attachments = *update.Attachments
+	}
+	if isEmpty(text, attachments) {
+		return nil, status.Error(codes.InvalidArgument, errs.ErrEmptyPost.Error())
+	}
+
+	updated, err := s.postRepo.UpdatePost(ctx, post.ID, caller, update)
+	if err != nil {
+		if errors.Is(err, errs.ErrPostNotFound) {
+			return nil, status.Error(codes.NotFound, errs.ErrPostNotFound.Error())
+		}
+		s.logger.Error("unable to update post", slog.String("post_id", post.ID), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	s.logger.Info("updated post", slog.String("post_id", post.ID), slog.Int64("author_id", caller))
+	return s.mapper.ToPostResponse(updated), nil
+}
+
+// DeletePost deletes one of the caller's posts, with the same errors as
+// UpdatePost for posts of others.
+func (s *PostService) DeletePost(ctx context.Context, req *postpb.DeletePostRequest) (*postpb.DeletePostResponse, error) {
+	caller, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := s.authorPost(ctx, caller, req.GetPostId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.postRepo.DeletePost(ctx, post.ID, caller); err != nil {
+		if errors.Is(err, errs.ErrPostNotFound) {
+			return nil, status.Error(codes.NotFound, errs.ErrPostNotFound.Error())
+		}
+		s.logger.Error("unable to delete post", slog.String("post_id", post.ID), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	s.logger.Info("deleted post", slog.String("post_id", post.ID), slog.Int64("author_id", caller))
+	return &postpb.DeletePostResponse{}, nil
+}
+
+// ListUserPosts lists one page of the posts of user_id the caller may see,
+// newest first. Returns NotFound if user-service does not know the user and
+// InvalidArgument for page tokens issued for another user.
+func (s *PostService) ListUserPosts(
+	ctx context.Context,
+	req *postpb.ListUserPostsRequest,
+) (*postpb.ListUserPostsResponse, error) {
+	caller, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	var after *model.PostCursor
+	if req.GetPageToken() != "" {
+		var cursor model.PostCursor
+		err := decodePageToken(req.GetPageToken(), &cursor)
+		if err != nil || cursor.AuthorID != req.GetUserId() || !validCursorID(cursor.ID) {
+			return nil, status.Error(codes.InvalidArgument, errs.ErrInvalidPageToken.Error())
+		}
+		after = &cursor
+	}
+
+	visibilities, err := s.visibleTo(ctx, caller, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := s.postRepo.ListPosts(ctx, model.PostListQuery{
+		AuthorID:     req.GetUserId(),
+		Visibilities: visibilities,
+		Limit:        pageSize + 1,
+		After:        after,
+	})
+	if err != nil {
+		s.logger.Error("unable to list posts", slog.Int64("author_id", req.GetUserId()), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	var nextPageToken string
+	if len(posts) > pageSize {
+		posts = posts[:pageSize]
+		last := posts[len(posts)-1]
+		nextPageToken = encodePageToken(model.PostCursor{
+			AuthorID:  req.GetUserId(),
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		})
+	}
+
+	return s.mapper.ToListUserPostsResponse(posts, nextPageToken), nil
+}
+
+// getPost loads a post, mapping repository errors to gRPC statuses.
+func (s *PostService) getPost(ctx context.Context, postID string) (model.Post, error) {
+	post, err := s.postRepo.GetPost(ctx, postID)
+	switch {
+	case errors.Is(err, errs.ErrPostNotFound):
+		return model.Post{}, status.Error(codes.NotFound, errs.ErrPostNotFound.Error())
+	case err != nil:
+		s.logger.Error("unable to read post", slog.String("post_id", postID), slog.Any("error", err))
+		return model.Post{}, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+	return post, nil
+}
+
+// viewablePost loads a post viewerID may see; other posts are reported as
+// NotFound.
+func (s *PostService) viewablePost(ctx context.Context, viewerID int64, postID string) (model.Post, error) {
+	post, err := s.getPost(ctx, postID)
+	if err != nil {
+		return model.Post{}, err
+	}
+
+	visible, err := s.canView(ctx, viewerID, post)
+	if err != nil {
+		return model.Post{}, err
+	}
+	if !visible {
+		return model.Post{}, status.Error(codes.NotFound, errs.ErrPostNotFound.Error())
+	}
+	return post, nil
+}
+
+// authorPost loads a post userID wants to change. Posts of other authors
+// are refused with PermissionDenied if userID can see them and NotFound
+// otherwise, so that hidden posts stay hidden.
+func (s *PostService) authorPost(ctx context.Context, userID int64, postID string) (model.Post, error) {
+	post, err := s.getPost(ctx, postID)
+	if err != nil {
+		return model.Post{}, err
+	}
+	if post.AuthorID == userID {
+		return post, nil
+	}
+
+	visible, err := s.canView(ctx, userID, post)
+	if err != nil {
+		return model.Post{}, err
+	}
+	if visible {
+		return model.Post{}, status.Error(codes.PermissionDenied, errs.ErrNotPostAuthor.Error())
+	}
+	return model.Post{}, status.Error(codes.NotFound, errs.ErrPostNotFound.Error())
+}
+
+// canView reports whether viewerID may see post. Followers-only posts are
+// checked with user-service; if it cannot answer, the post is refused with
+// Unavailable rather than shown.
+func (s *PostService) canView(ctx context.Context, viewerID int64, post model.Post) (bool, error) {
+	switch {
+	case post.AuthorID == viewerID || post.Visibility == model.VisibilityPublic:
+		return true, nil
+	case post.Visibility != model.VisibilityFollowers:
+		return false, nil
+	}
+
+	following, err := s.graph.IsFollowing(ctx, viewerID, post.AuthorID)
+	switch {
+	case errors.Is(err, errs.ErrUserNotFound):
+		return false, nil
+	case err != nil:
+		s.logger.Error("unable to check following", slog.Any("error", err))
+		return false, status.Error(codes.Unavailable, errs.ErrUserServiceUnavailable.Error())
+	}
+	return following, nil
+}
+
+// visibleTo returns the visibilities of authorID's posts that viewerID may
+// see. Returns NotFound if user-service does not know either user.
+func (s *PostService) visibleTo(ctx context.Context, viewerID, authorID int64) ([]model.Visibility, error) {
+	if viewerID == authorID {
+		return []model.Visibility{model.VisibilityPublic, model.VisibilityFollowers, model.VisibilityPrivate}, nil
+	}
+
+	following, err := s.graph.IsFollowing(ctx, viewerID, authorID)
+	switch {
+	case errors.Is(err, errs.ErrUserNotFound):
+		return nil, status.Error(codes.NotFound, errs.ErrUserNotFound.Error())
+	case err != nil:
+		s.logger.Error("unable to check following", slog.Any("error", err))
+		return nil, status.Error(codes.Unavailable, errs.ErrUserServiceUnavailable.Error())
+	case following:
+		return []model.Visibility{model.VisibilityPublic, model.VisibilityFollowers}, nil
+	}
+	return []model.Visibility{model.VisibilityPublic}, nil
+}
+
+// isEmpty reports whether a post would have neither text nor attachments.
+func isEmpty(text string, attachments []model.Attachment) bool {
+	return strings.TrimSpace(text) == "" && len(attachments) == 0
+}
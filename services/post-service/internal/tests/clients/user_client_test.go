@@ -0,0 +1,119 @@
+package clients
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userpb "github.com/mamataliev-dev/social-platform/api/gen/user/v1"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/clients"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/config"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/tests/mocks"
+)
+
+// testConfig retries twice without delay.
+func testConfig() config.UserService {
+	return config.UserService{
+		RequestTimeout: time.Second,
+		MaxRetries:     2,
+		RetryBackoff:   time.Millisecond,
+	}
+}
+
+func newTestClient(cfg config.UserService) (*clients.UserClient, *mocks.InternalUserServiceClientMock) {
+	grpcClient := new(mocks.InternalUserServiceClientMock)
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
+	return clients.NewUserClient(grpcClient, cfg, logger), grpcClient
+}
+
+func following(followerID, followeeID int64) *userpb.CheckFollowingRequest {
+	return &userpb.CheckFollowingRequest{FollowerId: followerID, FolloweeId: followeeID}
+}
+
+// TestIsFollowing verifies the follow answer of user-service.
+func TestIsFollowing(t *testing.T) {
+	client, grpcClient := newTestClient(testConfig())
+	grpcClient.On("CheckFollowing", mock.Anything, following(2, 1)).
+		Return(&userpb.CheckFollowingResponse{Following: true}, nil).Once()
+
+	ok, err := client.IsFollowing(context.Background(), 2, 1)
+
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+// TestIsFollowing_RetriesTransientFailures verifies that Unavailable is
+// retried until user-service answers.
+func TestIsFollowing_RetriesTransientFailures(t *testing.T) {
+	client, grpcClient := newTestClient(testConfig())
+	grpcClient.On("CheckFollowing", mock.Anything, following(2, 1)).
+		Return(nil, status.Error(codes.Unavailable, "down")).Twice()
+	grpcClient.On("CheckFollowing", mock.Anything, following(2, 1)).
+		Return(&userpb.CheckFollowingResponse{Following: true}, nil).Once()
+
+	ok, err := client.IsFollowing(context.Background(), 2, 1)
+
+	require.NoError(t, err)
+	assert.True(t, ok)
+	grpcClient.AssertNumberOfCalls(t, "CheckFollowing", 3)
+}
+
+// TestIsFollowing_GivesUpAfterMaxRetries verifies that the last error is
+// returned once the retries are used up.
+func TestIsFollowing_GivesUpAfterMaxRetries(t *testing.T) {
+	client, grpcClient := newTestClient(testConfig())
+	grpcClient.On("CheckFollowing", mock.Anything, following(2, 1)).
+		Return(nil, status.Error(codes.Unavailable, "down"))
+
+	_, err := client.IsFollowing(context.Background(), 2, 1)
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	grpcClient.AssertNumberOfCalls(t, "CheckFollowing", 3)
+}
+
+// TestIsFollowing_NotFound verifies that NotFound is not retried and is
+// reported as ErrUserNotFound.
+func TestIsFollowing_NotFound(t *testing.T) {
+	client, grpcClient := newTestClient(testConfig())
+	grpcClient.On("CheckFollowing", mock.Anything, following(2, 99)).
+		Return(nil, status.Error(codes.NotFound, "user not found")).Once()
+
+	_, err := client.IsFollowing(context.Background(), 2, 99)
+
+	assert.ErrorIs(t, err, errs.ErrUserNotFound)
+	grpcClient.AssertNumberOfCalls(t, "CheckFollowing", 1)
+}
+
+// TestFollowerCount verifies that the count is read from the user's profile.
+func TestFollowerCount(t *testing.T) {
+	client, grpcClient := newTestClient(testConfig())
+	grpcClient.On("FetchUserProfileByID", mock.Anything, &userpb.FetchUserProfileByIDRequest{UserId: 1}).
+		Return(&userpb.UserProfile{UserId: 1, FollowerCount: 12000}, nil).Once()
+
+	count, err := client.FollowerCount(context.Background(), 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(12000), count)
+}
+
+// TestListFollowerIDs verifies that the page is requested with the cursor
+// and limit given.
+func TestListFollowerIDs(t *testing.T) {
+	client, grpcClient := newTestClient(testConfig())
+	grpcClient.On("ListFollowerIDs", mock.Anything, &userpb.ListFollowIDsRequest{UserId: 1, AfterId: 7, Limit: 500}).
+		Return(&userpb.ListFollowIDsResponse{UserIds: []int64{8, 12}}, nil).Once()
+
+	ids, err := client.ListFollowerIDs(context.Background(), 1, 7, 500)
+
+	require.NoError(t, err)
+	assert.Equal(t, []int64{8, 12}, ids)
+}
@@ -0,0 +1,134 @@
+package middleware_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	postpb "github.com/mamataliev-dev/social-platform/api/gen/post/v1"
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/middleware"
+)
+
+const testKeyID = "test-key"
+
+// testVerifier generates an Ed25519 key pair and returns a verifier that
+// trusts it along with a signer for test tokens.
+func testVerifier(t *testing.T) (*auth.Verifier, func(jwt.MapClaims) string) {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	keyfunc := func(token *jwt.Token) (interface{}, error) {
+		if token.Header["kid"] != testKeyID {
+			return nil, auth.ErrUnknownSigningKey
+		}
+		return pub, nil
+	}
+	sign := func(claims jwt.MapClaims) string {
+		claims["iss"] = "user-service"
+		claims["aud"] = "social-platform"
+		token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+		token.Header["kid"] = testKeyID
+		signed, err := token.SignedString(priv)
+		require.NoError(t, err)
+		return signed
+	}
+	verifier := auth.NewVerifier(keyfunc, auth.Config{Issuer: "user-service", Audience: "social-platform"})
+	return verifier, sign
+}
+
+// TestUnaryAuthInterceptor_MissingToken ensures that a request without an
+// authorization header fails with an Unauthenticated error.
+func TestUnaryAuthInterceptor_MissingToken(t *testing.T) {
+	verifier, _ := testVerifier(t)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs())
+	info := &grpc.UnaryServerInfo{FullMethod: "/post.v1.PostService/CreatePost"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "should not be called", nil
+	}
+
+	_, err := middleware.UnaryAuthInterceptor(verifier)(ctx, nil, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+// TestUnaryAuthInterceptor_EveryMethodHasPolicy ensures that every PostService
+// and FeedService RPC is listed in the permission map, and that methods outside it are denied.
+func TestUnaryAuthInterceptor_EveryMethodHasPolicy(t *testing.T) {
+	verifier, sign := testVerifier(t)
+	token := sign(jwt.MapClaims{"sub": "1", "exp": time.Now().Add(time.Hour).Unix()})
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	for _, desc := range []grpc.ServiceDesc{postpb.PostService_ServiceDesc, postpb.FeedService_ServiceDesc} {
+		for _, m := range desc.Methods {
+			method := "/" + desc.ServiceName + "/" + m.MethodName
+			_, err := middleware.UnaryAuthInterceptor(verifier)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+			assert.NoError(t, err, method)
+		}
+	}
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/post.v1.PostService/DeleteEverything"}
+	_, err := middleware.UnaryAuthInterceptor(verifier)(ctx, nil, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+// TestValidationInterceptor ensures that requests breaking their validation
+// rules are rejected before reaching the handler.
+func TestValidationInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/post.v1.PostService/GetPost"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	_, err := middleware.ValidationInterceptor(context.Background(), &postpb.GetPostRequest{PostId: "not-a-uuid"}, info, handler)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := middleware.ValidationInterceptor(context.Background(),
+		&postpb.GetPostRequest{PostId: "7b0c8f2e-3f7a-4d6a-9a51-0d1b2c3e4f5a"}, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+}
+
+// TestValidationInterceptor_AttachmentURL ensures that attachments must be
+// https URLs, so scripts and local files cannot be stored as media.
+func TestValidationInterceptor_AttachmentURL(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/post.v1.PostService/CreatePost"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	tests := []struct {
+		url      string
+		wantCode codes.Code
+	}{
+		{"https://cdn.example.com/a.png", codes.OK},
+		{"javascript:alert(1)", codes.InvalidArgument},
+		{"data:text/html;base64,PHNjcmlwdD4=", codes.InvalidArgument},
+		{"http://cdn.example.com/a.png", codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			req := &postpb.CreatePostRequest{Attachments: []*postpb.Attachment{
+				{Url: tt.url, MediaType: postpb.MediaType_MEDIA_TYPE_IMAGE},
+			}}
+
+			_, err := middleware.ValidationInterceptor(context.Background(), req, info, handler)
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
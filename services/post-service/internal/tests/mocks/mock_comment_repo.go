@@ -0,0 +1,39 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/model"
+)
+
+// CommentRepoMock is a testify mock for the CommentRepository interface.
+type CommentRepoMock struct {
+	mock.Mock
+}
+
+// CreateComment mocks storing a new comment.
+func (m *CommentRepoMock) CreateComment(ctx context.Context, comment model.Comment) (model.Comment, error) {
+	args := m.Called(ctx, comment)
+	return args.Get(0).(model.Comment), args.Error(1)
+}
+
+// GetComment mocks reading a comment by ID.
+func (m *CommentRepoMock) GetComment(ctx context.Context, commentID string) (model.Comment, error) {
+	args := m.Called(ctx, commentID)
+	return args.Get(0).(model.Comment), args.Error(1)
+}
+
+// DeleteComment mocks deleting a comment and its replies.
+func (m *CommentRepoMock) DeleteComment(ctx context.Context, commentID string) error {
+	args := m.Called(ctx, commentID)
+	return args.Error(0)
+}
+
+// ListComments mocks listing one page of comments.
+func (m *CommentRepoMock) ListComments(ctx context.Context, query model.CommentListQuery) ([]model.Comment, error) {
+	args := m.Called(ctx, query)
+	comments, _ := args.Get(0).([]model.Comment)
+	return comments, args.Error(1)
+}
@@ -0,0 +1,40 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/model"
+)
+
+// FeedRepoMock is a testify mock for the FeedRepository interface.
+type FeedRepoMock struct {
+	mock.Mock
+}
+
+// ListPendingFanOuts mocks reading posts waiting to be fanned out.
+func (m *FeedRepoMock) ListPendingFanOuts(ctx context.Context, limit int) ([]model.FanOutTask, error) {
+	args := m.Called(ctx, limit)
+	tasks, _ := args.Get(0).([]model.FanOutTask)
+	return tasks, args.Error(1)
+}
+
+// AddFeedItems mocks adding a post to feeds.
+func (m *FeedRepoMock) AddFeedItems(ctx context.Context, postID string, userIDs []int64) error {
+	args := m.Called(ctx, postID, userIDs)
+	return args.Error(0)
+}
+
+// CompleteFanOut mocks recording how a post reached feeds.
+func (m *FeedRepoMock) CompleteFanOut(ctx context.Context, postID string, fanOut model.FanOut) error {
+	args := m.Called(ctx, postID, fanOut)
+	return args.Error(0)
+}
+
+// ListFeed mocks reading one page of a home feed.
+func (m *FeedRepoMock) ListFeed(ctx context.Context, query model.FeedQuery) ([]model.Post, error) {
+	args := m.Called(ctx, query)
+	posts, _ := args.Get(0).([]model.Post)
+	return posts, args.Error(1)
+}
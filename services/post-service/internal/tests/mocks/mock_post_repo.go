@@ -0,0 +1,62 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/model"
+)
+
+// PostRepoMock is a testify mock for the PostRepository interface.
+type PostRepoMock struct {
+	mock.Mock
+}
+
+// CreatePost mocks storing a new post.
+func (m *PostRepoMock) CreatePost(ctx context.Context, post model.Post) (model.Post, error) {
+	args := m.Called(ctx, post)
+	return args.Get(0).(model.Post), args.Error(1)
+}
+
+// GetPost mocks reading a post by ID.
+func (m *PostRepoMock) GetPost(ctx context.Context, postID string) (model.Post, error) {
+	args := m.Called(ctx, postID)
+	return args.Get(0).(model.Post), args.Error(1)
+}
+
+// UpdatePost mocks changing a post of its author.
+func (m *PostRepoMock) UpdatePost(
+	ctx context.Context,
+	postID string,
+	authorID int64,
+	update model.PostUpdate,
+) (model.Post, error) {
+	args := m.Called(ctx, postID, authorID, update)
+	return args.Get(0).(model.Post), args.Error(1)
+}
+
+// DeletePost mocks deleting a post of its author.
+func (m *PostRepoMock) DeletePost(ctx context.Context, postID string, authorID int64) error {
+	args := m.Called(ctx, postID, authorID)
+	return args.Error(0)
+}
+
+// ListPosts mocks listing one page of an author's posts.
+func (m *PostRepoMock) ListPosts(ctx context.Context, query model.PostListQuery) ([]model.Post, error) {
+	args := m.Called(ctx, query)
+	posts, _ := args.Get(0).([]model.Post)
+	return posts, args.Error(1)
+}
+
+// LikePost mocks recording a like and returns the like count.
+func (m *PostRepoMock) LikePost(ctx context.Context, postID string, userID int64) (int64, error) {
+	args := m.Called(ctx, postID, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// UnlikePost mocks removing a like and returns the like count.
+func (m *PostRepoMock) UnlikePost(ctx context.Context, postID string, userID int64) (int64, error) {
+	args := m.Called(ctx, postID, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
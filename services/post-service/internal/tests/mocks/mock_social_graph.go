@@ -0,0 +1,38 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// SocialGraphMock is a testify mock for the SocialGraph interface.
+type SocialGraphMock struct {
+	mock.Mock
+}
+
+// IsFollowing mocks checking whether one user follows another.
+func (m *SocialGraphMock) IsFollowing(ctx context.Context, followerID, followeeID int64) (bool, error) {
+	args := m.Called(ctx, followerID, followeeID)
+	return args.Bool(0), args.Error(1)
+}
+
+// FollowerCount mocks reading a user's follower count.
+func (m *SocialGraphMock) FollowerCount(ctx context.Context, userID int64) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// ListFollowerIDs mocks listing one page of follower IDs.
+func (m *SocialGraphMock) ListFollowerIDs(ctx context.Context, userID, afterID int64, limit int) ([]int64, error) {
+	args := m.Called(ctx, userID, afterID, limit)
+	ids, _ := args.Get(0).([]int64)
+	return ids, args.Error(1)
+}
+
+// ListFollowingIDs mocks listing one page of followed user IDs.
+func (m *SocialGraphMock) ListFollowingIDs(ctx context.Context, userID, afterID int64, limit int) ([]int64, error) {
+	args := m.Called(ctx, userID, afterID, limit)
+	ids, _ := args.Get(0).([]int64)
+	return ids, args.Error(1)
+}
@@ -0,0 +1,56 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/model"
+)
+
+// UserEventRepoMock is a testify mock for the UserEventRepository interface.
+type UserEventRepoMock struct {
+	mock.Mock
+}
+
+// UserEventCursor mocks reading the last handled event ID.
+func (m *UserEventRepoMock) UserEventCursor(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// ApplyFollowed mocks backfilling a follower's feed.
+func (m *UserEventRepoMock) ApplyFollowed(ctx context.Context, eventID, followerID, followeeID int64, backfill int) error {
+	args := m.Called(ctx, eventID, followerID, followeeID, backfill)
+	return args.Error(0)
+}
+
+// ApplyUnfollowed mocks removing an author's posts from a feed.
+func (m *UserEventRepoMock) ApplyUnfollowed(ctx context.Context, eventID, followerID, followeeID int64) error {
+	args := m.Called(ctx, eventID, followerID, followeeID)
+	return args.Error(0)
+}
+
+// ApplyUserDeleted mocks deleting a deleted user's posts and feed.
+func (m *UserEventRepoMock) ApplyUserDeleted(ctx context.Context, eventID, userID int64) error {
+	args := m.Called(ctx, eventID, userID)
+	return args.Error(0)
+}
+
+// AdvanceUserEventCursor mocks recording an event as handled.
+func (m *UserEventRepoMock) AdvanceUserEventCursor(ctx context.Context, eventID int64) error {
+	args := m.Called(ctx, eventID)
+	return args.Error(0)
+}
+
+// UserEventSourceMock is a testify mock for the UserEventSource interface.
+type UserEventSourceMock struct {
+	mock.Mock
+}
+
+// ListUserEvents mocks fetching events from user-service.
+func (m *UserEventSourceMock) ListUserEvents(ctx context.Context, afterID int64, limit int) ([]model.UserEvent, error) {
+	args := m.Called(ctx, afterID, limit)
+	events, _ := args.Get(0).([]model.UserEvent)
+	return events, args.Error(1)
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	postpb "github.com/mamataliev-dev/social-platform/api/gen/post/v1"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/mapper"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/service"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/tests/mocks"
+)
+
+// newFeedService returns a FeedService with mocked storage and social graph.
+func newFeedService() (*service.FeedService, *mocks.FeedRepoMock, *mocks.SocialGraphMock) {
+	feedRepo := new(mocks.FeedRepoMock)
+	graph := new(mocks.SocialGraphMock)
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
+	return service.NewFeedService(feedRepo, graph, mapper.NewPostMapper(), logger), feedRepo, graph
+}
+
+// TestGetHomeFeed_Success verifies that the feed is read with every user the
+// caller follows, fetched page by page from user-service.
+func TestGetHomeFeed_Success(t *testing.T) {
+	svc, feedRepo, graph := newFeedService()
+
+	firstPage := make([]int64, 1000)
+	for i := range firstPage {
+		firstPage[i] = int64(i + 1)
+	}
+	graph.On("ListFollowingIDs", mock.Anything, followerID, int64(0), 1000).Return(firstPage, nil)
+	graph.On("ListFollowingIDs", mock.Anything, followerID, int64(1000), 1000).Return([]int64{1001}, nil)
+	feedRepo.On("ListFeed", mock.Anything, model.FeedQuery{
+		UserID:      followerID,
+		FolloweeIDs: append(firstPage, 1001),
+		Limit:       21,
+	}).Return([]model.Post{testPost(model.VisibilityFollowers)}, nil)
+
+	resp, err := svc.GetHomeFeed(userContext(followerID), &postpb.GetHomeFeedRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, resp.GetPosts(), 1)
+	assert.Equal(t, testPostID, resp.GetPosts()[0].GetId())
+	assert.Empty(t, resp.GetNextPageToken())
+	feedRepo.AssertExpectations(t)
+}
+
+// TestGetHomeFeed_Pages verifies that a full page returns a token that
+// continues after its last post and is only accepted from the same user.
+func TestGetHomeFeed_Pages(t *testing.T) {
+	svc, feedRepo, graph := newFeedService()
+	first, second := testPost(model.VisibilityPublic), testPost(model.VisibilityPublic)
+	second.ID = "0a7d2c1e-5b4f-4e3a-8c2d-1f0e9d8c7b6a"
+	second.CreatedAt = first.CreatedAt.Add(-time.Minute)
+
+	graph.On("ListFollowingIDs", mock.Anything, followerID, int64(0), 1000).Return([]int64{authorID}, nil)
+	feedRepo.On("ListFeed", mock.Anything, mock.MatchedBy(func(q model.FeedQuery) bool {
+		return q.After == nil
+	})).Return([]model.Post{first, second}, nil)
+	feedRepo.On("ListFeed", mock.Anything, mock.MatchedBy(func(q model.FeedQuery) bool {
+		return q.After != nil && q.After.ID == first.ID && q.After.CreatedAt.Equal(first.CreatedAt)
+	})).Return([]model.Post{second}, nil)
+
+	page, err := svc.GetHomeFeed(userContext(followerID), &postpb.GetHomeFeedRequest{PageSize: 1})
+	require.NoError(t, err)
+	require.Len(t, page.GetPosts(), 1)
+	token := page.GetNextPageToken()
+	require.NotEmpty(t, token)
+
+	page, err = svc.GetHomeFeed(userContext(followerID), &postpb.GetHomeFeedRequest{PageSize: 1, PageToken: token})
+	require.NoError(t, err)
+	assert.Equal(t, second.ID, page.GetPosts()[0].GetId())
+	assert.Empty(t, page.GetNextPageToken())
+
+	_, err = svc.GetHomeFeed(userContext(strangerID), &postpb.GetHomeFeedRequest{PageToken: token})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// TestGetHomeFeed_ForgedToken verifies that a page token whose post ID is not
+// a UUID is refused as InvalidArgument without querying the database.
+func TestGetHomeFeed_ForgedToken(t *testing.T) {
+	svc, feedRepo, _ := newFeedService()
+	token := pageToken(t, model.FeedCursor{UserID: followerID, CreatedAt: time.Now(), ID: "not-a-uuid"})
+
+	_, err := svc.GetHomeFeed(userContext(followerID), &postpb.GetHomeFeedRequest{PageToken: token})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	feedRepo.AssertNotCalled(t, "ListFeed", mock.Anything, mock.Anything)
+}
+
+// TestGetHomeFeed_UserServiceDown verifies that the feed is refused with
+// Unavailable when the followed users cannot be listed.
+func TestGetHomeFeed_UserServiceDown(t *testing.T) {
+	svc, feedRepo, graph := newFeedService()
+	graph.On("ListFollowingIDs", mock.Anything, followerID, int64(0), 1000).
+		Return(nil, status.Error(codes.Unavailable, "down"))
+
+	_, err := svc.GetHomeFeed(userContext(followerID), &postpb.GetHomeFeedRequest{})
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	feedRepo.AssertNotCalled(t, "ListFeed", mock.Anything, mock.Anything)
+}
+
+// newFanOutWorker returns a FanOutWorker with a threshold of 100 followers and
+// batches of 2.
+func newFanOutWorker() (*service.FanOutWorker, *mocks.FeedRepoMock, *mocks.SocialGraphMock) {
+	feedRepo := new(mocks.FeedRepoMock)
+	graph := new(mocks.SocialGraphMock)
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
+	return service.NewFanOutWorker(feedRepo, graph, 100, 2, time.Second, logger), feedRepo, graph
+}
+
+// TestFanOut_CopiesToFollowers verifies that the post of an author below the
+// threshold is added to every follower's feed, batch by batch, before it is
+// marked done.
+func TestFanOut_CopiesToFollowers(t *testing.T) {
+	worker, feedRepo, graph := newFanOutWorker()
+
+	feedRepo.On("ListPendingFanOuts", mock.Anything, 2).
+		Return([]model.FanOutTask{{PostID: testPostID, AuthorID: authorID}}, nil)
+	graph.On("FollowerCount", mock.Anything, authorID).Return(int64(3), nil)
+	graph.On("ListFollowerIDs", mock.Anything, authorID, int64(0), 2).Return([]int64{2, 5}, nil)
+	graph.On("ListFollowerIDs", mock.Anything, authorID, int64(5), 2).Return([]int64{9}, nil)
+	feedRepo.On("AddFeedItems", mock.Anything, testPostID, []int64{2, 5}).Return(nil).Once()
+	feedRepo.On("AddFeedItems", mock.Anything, testPostID, []int64{9}).Return(nil).Once()
+	feedRepo.On("CompleteFanOut", mock.Anything, testPostID, model.FanOutDone).Return(nil).Once()
+
+	handled, err := worker.PollOnce(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, handled)
+	feedRepo.AssertExpectations(t)
+}
+
+// TestFanOut_SkipsPopularAuthors verifies that posts of authors at or above
+// the threshold are left to be merged on read.
+func TestFanOut_SkipsPopularAuthors(t *testing.T) {
+	worker, feedRepo, graph := newFanOutWorker()
+
+	feedRepo.On("ListPendingFanOuts", mock.Anything, 2).
+		Return([]model.FanOutTask{{PostID: testPostID, AuthorID: authorID}}, nil)
+	graph.On("FollowerCount", mock.Anything, authorID).Return(int64(100), nil)
+	feedRepo.On("CompleteFanOut", mock.Anything, testPostID, model.FanOutSkipped).Return(nil).Once()
+
+	_, err := worker.PollOnce(context.Background())
+
+	require.NoError(t, err)
+	feedRepo.AssertExpectations(t)
+	graph.AssertNotCalled(t, "ListFollowerIDs", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestFanOut_LeavesPostPendingOnFailure verifies that a post whose fan-out
+// fails part way stays pending, so the next poll retries it.
+func TestFanOut_LeavesPostPendingOnFailure(t *testing.T) {
+	worker, feedRepo, graph := newFanOutWorker()
+
+	feedRepo.On("ListPendingFanOuts", mock.Anything, 2).
+		Return([]model.FanOutTask{{PostID: testPostID, AuthorID: authorID}}, nil)
+	graph.On("FollowerCount", mock.Anything, authorID).Return(int64(3), nil)
+	graph.On("ListFollowerIDs", mock.Anything, authorID, int64(0), 2).Return([]int64{2, 5}, nil)
+	feedRepo.On("AddFeedItems", mock.Anything, testPostID, []int64{2, 5}).Return(errs.ErrDBFailure)
+
+	handled, err := worker.PollOnce(context.Background())
+
+	assert.ErrorIs(t, err, errs.ErrDBFailure)
+	assert.Equal(t, 0, handled)
+	feedRepo.AssertNotCalled(t, "CompleteFanOut", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	postpb "github.com/mamataliev-dev/social-platform/api/gen/post/v1"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/model"
+)
+
+const (
+	testCommentID = "0f4e2d1c-5b6a-4c3d-8e9f-1a2b3c4d5e6f"
+	testReplyID   = "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d"
+	otherPostID   = "c1d2e3f4-a5b6-4c7d-8e9f-0a1b2c3d4e5f"
+)
+
+func testComment(id, parentID string, author int64) model.Comment {
+	return model.Comment{
+		ID:        id,
+		PostID:    testPostID,
+		ParentID:  parentID,
+		AuthorID:  author,
+		Text:      "nice",
+		CreatedAt: time.Date(2025, 7, 23, 16, 0, 0, 0, time.UTC),
+	}
+}
+
+// TestLikePost_Success verifies that the post is returned with the like count
+// after the caller's like.
+func TestLikePost_Success(t *testing.T) {
+	svc, postRepo, _ := newPostService()
+	postRepo.On("GetPost", mock.Anything, testPostID).Return(testPost(model.VisibilityPublic), nil)
+	postRepo.On("LikePost", mock.Anything, testPostID, strangerID).Return(int64(5), nil)
+
+	resp, err := svc.LikePost(userContext(strangerID), &postpb.LikePostRequest{PostId: testPostID})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), resp.GetLikeCount())
+	postRepo.AssertExpectations(t)
+}
+
+// TestLikePost_Hidden verifies that a post the caller may not see cannot be
+// liked and is reported as not found.
+func TestLikePost_Hidden(t *testing.T) {
+	svc, postRepo, _ := newPostService()
+	postRepo.On("GetPost", mock.Anything, testPostID).Return(testPost(model.VisibilityFollowers), nil)
+
+	_, err := svc.LikePost(userContext(strangerID), &postpb.LikePostRequest{PostId: testPostID})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	postRepo.AssertNotCalled(t, "LikePost", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestUnlikePost_Success verifies that the post is returned with the like
+// count after the caller's like is taken back.
+func TestUnlikePost_Success(t *testing.T) {
+	svc, postRepo, _ := newPostService()
+	postRepo.On("GetPost", mock.Anything, testPostID).Return(testPost(model.VisibilityFollowers), nil)
+	postRepo.On("UnlikePost", mock.Anything, testPostID, followerID).Return(int64(0), nil)
+
+	resp, err := svc.UnlikePost(userContext(followerID), &postpb.UnlikePostRequest{PostId: testPostID})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), resp.GetLikeCount())
+	postRepo.AssertExpectations(t)
+}
+
+// TestCreateComment_Success verifies that top-level comments and replies to
+// them are stored under the caller.
+func TestCreateComment_Success(t *testing.T) {
+	tests := []struct {
+		name     string
+		parentID string
+	}{
+		{"top-level comment", ""},
+		{"reply", testCommentID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, postRepo, commentRepo, _ := newPostServiceWithComments()
+			postRepo.On("GetPost", mock.Anything, testPostID).Return(testPost(model.VisibilityPublic), nil)
+			commentRepo.On("GetComment", mock.Anything, testCommentID).
+				Return(testComment(testCommentID, "", authorID), nil).Maybe()
+			commentRepo.On("CreateComment", mock.Anything, model.Comment{
+				PostID:   testPostID,
+				ParentID: tt.parentID,
+				AuthorID: strangerID,
+				Text:     "nice",
+			}).Return(testComment(testReplyID, tt.parentID, strangerID), nil)
+
+			resp, err := svc.CreateComment(userContext(strangerID), &postpb.CreateCommentRequest{
+				PostId:          testPostID,
+				Text:            "nice",
+				ParentCommentId: tt.parentID,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, testReplyID, resp.GetId())
+			assert.Equal(t, tt.parentID, resp.GetParentCommentId())
+			commentRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// TestCreateComment_InvalidParent verifies that comments are threaded one
+// level deep and that the parent must be a comment on the same post.
+func TestCreateComment_InvalidParent(t *testing.T) {
+	tests := []struct {
+		name     string
+		parent   model.Comment
+		wantCode codes.Code
+	}{
+		{"reply to a reply", testComment(testReplyID, testCommentID, authorID), codes.InvalidArgument},
+		{"comment on another post", func() model.Comment {
+			c := testComment(testReplyID, "", authorID)
+			c.PostID = otherPostID
+			return c
+		}(), codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, postRepo, commentRepo, _ := newPostServiceWithComments()
+			postRepo.On("GetPost", mock.Anything, testPostID).Return(testPost(model.VisibilityPublic), nil)
+			commentRepo.On("GetComment", mock.Anything, testReplyID).Return(tt.parent, nil)
+
+			_, err := svc.CreateComment(userContext(strangerID), &postpb.CreateCommentRequest{
+				PostId:          testPostID,
+				Text:            "nice",
+				ParentCommentId: testReplyID,
+			})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			commentRepo.AssertNotCalled(t, "CreateComment", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// TestCreateComment_Blank verifies that a comment needs text.
+func TestCreateComment_Blank(t *testing.T) {
+	svc, _, commentRepo, _ := newPostServiceWithComments()
+
+	_, err := svc.CreateComment(userContext(strangerID), &postpb.CreateCommentRequest{PostId: testPostID, Text: "  "})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	commentRepo.AssertNotCalled(t, "CreateComment", mock.Anything, mock.Anything)
+}
+
+// TestListComments_Pages verifies that a full page yields a token for the
+// same post and parent, and that the token is refused for other replies.
+func TestListComments_Pages(t *testing.T) {
+	svc, postRepo, commentRepo, _ := newPostServiceWithComments()
+	postRepo.On("GetPost", mock.Anything, testPostID).Return(testPost(model.VisibilityPublic), nil)
+	commentRepo.On("ListComments", mock.Anything, mock.MatchedBy(func(q model.CommentListQuery) bool {
+		return q.PostID == testPostID && q.ParentID == "" && q.Limit == 2 && q.After == nil
+	})).Return([]model.Comment{
+		testComment(testCommentID, "", authorID),
+		testComment(testReplyID, "", strangerID),
+	}, nil)
+
+	resp, err := svc.ListComments(userContext(strangerID), &postpb.ListCommentsRequest{
+		PostId:   testPostID,
+		PageSize: 1,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.GetComments(), 1)
+	assert.Equal(t, testCommentID, resp.GetComments()[0].GetId())
+	require.NotEmpty(t, resp.GetNextPageToken())
+
+	_, err = svc.ListComments(userContext(strangerID), &postpb.ListCommentsRequest{
+		PostId:          testPostID,
+		ParentCommentId: testCommentID,
+		PageToken:       resp.GetNextPageToken(),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// TestListComments_ForgedToken verifies that a page token whose comment ID is
+// not a UUID is refused as InvalidArgument without querying the database.
+func TestListComments_ForgedToken(t *testing.T) {
+	svc, _, commentRepo, _ := newPostServiceWithComments()
+	token := pageToken(t, model.CommentCursor{PostID: testPostID, CreatedAt: time.Now(), ID: "not-a-uuid"})
+
+	_, err := svc.ListComments(userContext(strangerID), &postpb.ListCommentsRequest{
+		PostId:    testPostID,
+		PageToken: token,
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	commentRepo.AssertNotCalled(t, "ListComments", mock.Anything, mock.Anything)
+}
+
+// TestDeleteComment_Permissions verifies that the comment's author and the
+// post's author may delete a comment, and that others are refused without
+// revealing hidden posts.
+func TestDeleteComment_Permissions(t *testing.T) {
+	tests := []struct {
+		name       string
+		visibility model.Visibility
+		caller     int64
+		wantCode   codes.Code
+	}{
+		{"comment author", model.VisibilityFollowers, followerID, codes.OK},
+		{"post author", model.VisibilityFollowers, authorID, codes.OK},
+		{"stranger on public post", model.VisibilityPublic, strangerID, codes.PermissionDenied},
+		{"stranger on hidden post", model.VisibilityFollowers, strangerID, codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, postRepo, commentRepo, _ := newPostServiceWithComments()
+			postRepo.On("GetPost", mock.Anything, testPostID).Return(testPost(tt.visibility), nil)
+			commentRepo.On("GetComment", mock.Anything, testCommentID).
+				Return(testComment(testCommentID, "", followerID), nil)
+			commentRepo.On("DeleteComment", mock.Anything, testCommentID).Return(nil).Maybe()
+
+			_, err := svc.DeleteComment(userContext(tt.caller), &postpb.DeleteCommentRequest{
+				PostId:    testPostID,
+				CommentId: testCommentID,
+			})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				commentRepo.AssertCalled(t, "DeleteComment", mock.Anything, testCommentID)
+			} else {
+				commentRepo.AssertNotCalled(t, "DeleteComment", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
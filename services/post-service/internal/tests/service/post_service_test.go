@@ -0,0 +1,379 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	postpb "github.com/mamataliev-dev/social-platform/api/gen/post/v1"
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/mapper"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/service"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/tests/mocks"
+)
+
+const (
+	authorID   int64 = 1
+	followerID int64 = 2
+	strangerID int64 = 3
+
+	testPostID = "7b0c8f2e-3f7a-4d6a-9a51-0d1b2c3e4f5a"
+)
+
+// pageToken encodes cursor the way the services encode page tokens, so tests
+// can forge tokens the services never issued.
+func pageToken(t *testing.T, cursor any) string {
+	t.Helper()
+	data, err := json.Marshal(cursor)
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// userContext returns a context carrying the access token claims of userID.
+func userContext(userID int64) context.Context {
+	return auth.ContextWithClaims(context.Background(), &auth.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: strconv.FormatInt(userID, 10)},
+	})
+}
+
+// newPostService returns a PostService with mocked storage and a social graph
+// in which followerID follows authorID and strangerID does not.
+func newPostService() (*service.PostService, *mocks.PostRepoMock, *mocks.SocialGraphMock) {
+	svc, postRepo, _, graph := newPostServiceWithComments()
+	return svc, postRepo, graph
+}
+
+// newPostServiceWithComments is newPostService that also returns the mocked
+// comment storage.
+func newPostServiceWithComments() (
+	*service.PostService,
+	*mocks.PostRepoMock,
+	*mocks.CommentRepoMock,
+	*mocks.SocialGraphMock,
+) {
+	postRepo := new(mocks.PostRepoMock)
+	commentRepo := new(mocks.CommentRepoMock)
+	graph := new(mocks.SocialGraphMock)
+	graph.On("IsFollowing", mock.Anything, followerID, authorID).Return(true, nil).Maybe()
+	graph.On("IsFollowing", mock.Anything, strangerID, authorID).Return(false, nil).Maybe()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
+	svc := service.NewPostService(postRepo, commentRepo, graph, mapper.NewPostMapper(), logger)
+	return svc, postRepo, commentRepo, graph
+}
+
+func testPost(visibility model.Visibility) model.Post {
+	createdAt := time.Date(2025, 7, 23, 15, 0, 0, 0, time.UTC)
+	return model.Post{
+		ID:          testPostID,
+		AuthorID:    authorID,
+		Text:        "hello",
+		Attachments: []model.Attachment{{URL: "https://cdn.example.com/a.png", MediaType: model.MediaTypeImage}},
+		Visibility:  visibility,
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+	}
+}
+
+// TestCreatePost_Success verifies that the post is stored under the caller
+// and is public unless the request says otherwise.
+func TestCreatePost_Success(t *testing.T) {
+	svc, postRepo, _ := newPostService()
+	want := testPost(model.VisibilityPublic)
+
+	postRepo.On("CreatePost", mock.Anything, model.Post{
+		AuthorID:    authorID,
+		Text:        want.Text,
+		Attachments: want.Attachments,
+		Visibility:  model.VisibilityPublic,
+	}).Return(want, nil)
+
+	resp, err := svc.CreatePost(userContext(authorID), &postpb.CreatePostRequest{
+		Text: "hello",
+		Attachments: []*postpb.Attachment{
+			{Url: "https://cdn.example.com/a.png", MediaType: postpb.MediaType_MEDIA_TYPE_IMAGE},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, testPostID, resp.GetId())
+	assert.Equal(t, authorID, resp.GetAuthorId())
+	assert.Equal(t, postpb.PostVisibility_POST_VISIBILITY_PUBLIC, resp.GetVisibility())
+	assert.Len(t, resp.GetAttachments(), 1)
+	postRepo.AssertExpectations(t)
+}
+
+// TestCreatePost_Empty verifies that a post needs text or attachments.
+func TestCreatePost_Empty(t *testing.T) {
+	svc, postRepo, _ := newPostService()
+
+	_, err := svc.CreatePost(userContext(authorID), &postpb.CreatePostRequest{Text: "   "})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	postRepo.AssertNotCalled(t, "CreatePost", mock.Anything, mock.Anything)
+}
+
+// TestCreatePost_Unauthenticated verifies that a caller without a verified
+// token is rejected.
+func TestCreatePost_Unauthenticated(t *testing.T) {
+	svc, _, _ := newPostService()
+
+	_, err := svc.CreatePost(context.Background(), &postpb.CreatePostRequest{Text: "hello"})
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+// TestGetPost_Visibility verifies who can see posts of each visibility, and
+// that hidden posts are reported as not found.
+func TestGetPost_Visibility(t *testing.T) {
+	tests := []struct {
+		name       string
+		visibility model.Visibility
+		viewer     int64
+		wantCode   codes.Code
+	}{
+		{"public to stranger", model.VisibilityPublic, strangerID, codes.OK},
+		{"followers to follower", model.VisibilityFollowers, followerID, codes.OK},
+		{"followers to stranger", model.VisibilityFollowers, strangerID, codes.NotFound},
+		{"private to follower", model.VisibilityPrivate, followerID, codes.NotFound},
+		{"private to author", model.VisibilityPrivate, authorID, codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, postRepo, _ := newPostService()
+			postRepo.On("GetPost", mock.Anything, testPostID).Return(testPost(tt.visibility), nil)
+
+			resp, err := svc.GetPost(userContext(tt.viewer), &postpb.GetPostRequest{PostId: testPostID})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, testPostID, resp.GetId())
+			}
+		})
+	}
+}
+
+// TestGetPost_UserServiceDown verifies that a followers-only post is not
+// shown when user-service cannot confirm the follow.
+func TestGetPost_UserServiceDown(t *testing.T) {
+	postRepo := new(mocks.PostRepoMock)
+	graph := new(mocks.SocialGraphMock)
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
+	svc := service.NewPostService(postRepo, new(mocks.CommentRepoMock), graph, mapper.NewPostMapper(), logger)
+
+	postRepo.On("GetPost", mock.Anything, testPostID).Return(testPost(model.VisibilityFollowers), nil)
+	graph.On("IsFollowing", mock.Anything, followerID, authorID).Return(false, status.Error(codes.Unavailable, "down"))
+
+	_, err := svc.GetPost(userContext(followerID), &postpb.GetPostRequest{PostId: testPostID})
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+// TestGetPost_NotFound verifies that an unknown post results in NotFound.
+func TestGetPost_NotFound(t *testing.T) {
+	svc, postRepo, _ := newPostService()
+	postRepo.On("GetPost", mock.Anything, testPostID).Return(model.Post{}, errs.ErrPostNotFound)
+
+	_, err := svc.GetPost(userContext(authorID), &postpb.GetPostRequest{PostId: testPostID})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// TestUpdatePost_Success verifies that only the fields set in the request are
+// passed on to the repository.
+func TestUpdatePost_Success(t *testing.T) {
+	svc, postRepo, _ := newPostService()
+	text := "edited"
+	visibility := model.VisibilityFollowers
+	updated := testPost(model.VisibilityFollowers)
+	updated.Text = text
+
+	postRepo.On("GetPost", mock.Anything, testPostID).Return(testPost(model.VisibilityPublic), nil)
+	postRepo.On("UpdatePost", mock.Anything, testPostID, authorID, model.PostUpdate{
+		Text:       &text,
+		Visibility: &visibility,
+	}).Return(updated, nil)
+
+	resp, err := svc.UpdatePost(userContext(authorID), &postpb.UpdatePostRequest{
+		PostId:     testPostID,
+		Text:       &text,
+		Visibility: postpb.PostVisibility_POST_VISIBILITY_FOLLOWERS,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, text, resp.GetText())
+	postRepo.AssertExpectations(t)
+}
+
+// TestUpdatePost_WouldBeEmpty verifies that removing both the text and the
+// attachments of a post is rejected.
+func TestUpdatePost_WouldBeEmpty(t *testing.T) {
+	svc, postRepo, _ := newPostService()
+	text := ""
+	postRepo.On("GetPost", mock.Anything, testPostID).Return(testPost(model.VisibilityPublic), nil)
+
+	_, err := svc.UpdatePost(userContext(authorID), &postpb.UpdatePostRequest{
+		PostId:      testPostID,
+		Text:        &text,
+		Attachments: &postpb.AttachmentList{},
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	postRepo.AssertNotCalled(t, "UpdatePost", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestUpdatePost_NotAuthor verifies that others cannot edit a post, and that
+// posts hidden from them are reported as not found rather than forbidden.
+func TestUpdatePost_NotAuthor(t *testing.T) {
+	tests := []struct {
+		name       string
+		visibility model.Visibility
+		wantCode   codes.Code
+	}{
+		{"visible", model.VisibilityPublic, codes.PermissionDenied},
+		{"hidden", model.VisibilityPrivate, codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, postRepo, _ := newPostService()
+			text := "edited"
+			postRepo.On("GetPost", mock.Anything, testPostID).Return(testPost(tt.visibility), nil)
+
+			_, err := svc.UpdatePost(userContext(strangerID), &postpb.UpdatePostRequest{PostId: testPostID, Text: &text})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			postRepo.AssertNotCalled(t, "UpdatePost", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// TestDeletePost_Success verifies that the author can delete their post.
+func TestDeletePost_Success(t *testing.T) {
+	svc, postRepo, _ := newPostService()
+	postRepo.On("GetPost", mock.Anything, testPostID).Return(testPost(model.VisibilityPrivate), nil)
+	postRepo.On("DeletePost", mock.Anything, testPostID, authorID).Return(nil)
+
+	_, err := svc.DeletePost(userContext(authorID), &postpb.DeletePostRequest{PostId: testPostID})
+
+	require.NoError(t, err)
+	postRepo.AssertExpectations(t)
+}
+
+// TestDeletePost_NotAuthor verifies that others cannot delete a post.
+func TestDeletePost_NotAuthor(t *testing.T) {
+	svc, postRepo, _ := newPostService()
+	postRepo.On("GetPost", mock.Anything, testPostID).Return(testPost(model.VisibilityFollowers), nil)
+
+	_, err := svc.DeletePost(userContext(followerID), &postpb.DeletePostRequest{PostId: testPostID})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	postRepo.AssertNotCalled(t, "DeletePost", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestListUserPosts_Visibilities verifies which posts each viewer is shown.
+func TestListUserPosts_Visibilities(t *testing.T) {
+	tests := []struct {
+		name   string
+		viewer int64
+		want   []model.Visibility
+	}{
+		{"author", authorID, []model.Visibility{model.VisibilityPublic, model.VisibilityFollowers, model.VisibilityPrivate}},
+		{"follower", followerID, []model.Visibility{model.VisibilityPublic, model.VisibilityFollowers}},
+		{"stranger", strangerID, []model.Visibility{model.VisibilityPublic}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, postRepo, _ := newPostService()
+			postRepo.On("ListPosts", mock.Anything, model.PostListQuery{
+				AuthorID:     authorID,
+				Visibilities: tt.want,
+				Limit:        21,
+			}).Return([]model.Post{testPost(model.VisibilityPublic)}, nil)
+
+			resp, err := svc.ListUserPosts(userContext(tt.viewer), &postpb.ListUserPostsRequest{UserId: authorID})
+
+			require.NoError(t, err)
+			assert.Len(t, resp.GetPosts(), 1)
+			assert.Empty(t, resp.GetNextPageToken())
+			postRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// TestListUserPosts_Pages verifies that a full page returns a token that
+// continues after its last post and is only accepted for the same author.
+func TestListUserPosts_Pages(t *testing.T) {
+	svc, postRepo, _ := newPostService()
+	first, second := testPost(model.VisibilityPublic), testPost(model.VisibilityPublic)
+	second.ID = "0a7d2c1e-5b4f-4e3a-8c2d-1f0e9d8c7b6a"
+	second.CreatedAt = first.CreatedAt.Add(-time.Minute)
+
+	postRepo.On("ListPosts", mock.Anything, mock.MatchedBy(func(q model.PostListQuery) bool {
+		return q.After == nil
+	})).Return([]model.Post{first, second}, nil)
+	postRepo.On("ListPosts", mock.Anything, mock.MatchedBy(func(q model.PostListQuery) bool {
+		return q.After != nil && q.After.ID == first.ID && q.After.CreatedAt.Equal(first.CreatedAt)
+	})).Return([]model.Post{second}, nil)
+
+	page, err := svc.ListUserPosts(userContext(authorID), &postpb.ListUserPostsRequest{UserId: authorID, PageSize: 1})
+	require.NoError(t, err)
+	require.Len(t, page.GetPosts(), 1)
+	token := page.GetNextPageToken()
+	require.NotEmpty(t, token)
+
+	page, err = svc.ListUserPosts(userContext(authorID), &postpb.ListUserPostsRequest{
+		UserId:    authorID,
+		PageSize:  1,
+		PageToken: token,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, second.ID, page.GetPosts()[0].GetId())
+	assert.Empty(t, page.GetNextPageToken())
+
+	_, err = svc.ListUserPosts(userContext(authorID), &postpb.ListUserPostsRequest{
+		UserId:    strangerID,
+		PageToken: token,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// TestListUserPosts_ForgedToken verifies that a page token whose post ID is
+// not a UUID is refused as InvalidArgument without querying the database.
+func TestListUserPosts_ForgedToken(t *testing.T) {
+	svc, postRepo, _ := newPostService()
+	token := pageToken(t, model.PostCursor{AuthorID: authorID, CreatedAt: time.Now(), ID: "not-a-uuid"})
+
+	_, err := svc.ListUserPosts(userContext(authorID), &postpb.ListUserPostsRequest{UserId: authorID, PageToken: token})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	postRepo.AssertNotCalled(t, "ListPosts", mock.Anything, mock.Anything)
+}
+
+// TestListUserPosts_UnknownUser verifies that listing the posts of a user
+// user-service does not know results in NotFound.
+func TestListUserPosts_UnknownUser(t *testing.T) {
+	svc, postRepo, graph := newPostService()
+	graph.On("IsFollowing", mock.Anything, authorID, int64(99)).Return(false, errs.ErrUserNotFound)
+
+	_, err := svc.ListUserPosts(userContext(authorID), &postpb.ListUserPostsRequest{UserId: 99})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	postRepo.AssertNotCalled(t, "ListPosts", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/service"
+	"github.com/mamataliev-dev/social-platform/services/post-service/internal/tests/mocks"
+)
+
+// newTestConsumer builds a UserEventConsumer with a batch size of 10, a
+// backfill of 50 posts and a discarding logger.
+func newTestConsumer(source *mocks.UserEventSourceMock, repo *mocks.UserEventRepoMock) *service.UserEventConsumer {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
+	return service.NewUserEventConsumer(source, repo, time.Second, 10, 50, logger)
+}
+
+// TestPollOnce_AppliesEventsInOrder verifies that events after the stored
+// cursor are applied in order and unknown event types only advance the cursor.
+func TestPollOnce_AppliesEventsInOrder(t *testing.T) {
+	source := new(mocks.UserEventSourceMock)
+	repo := new(mocks.UserEventRepoMock)
+	consumer := newTestConsumer(source, repo)
+
+	events := []model.UserEvent{
+		{ID: 6, Type: model.UserEventFollowed, UserID: followerID, SubjectUserID: authorID},
+		{ID: 7, Type: model.UserEventUnfollowed, UserID: strangerID, SubjectUserID: authorID},
+		{ID: 8, Type: model.UserEventDeleted, UserID: 42},
+		{ID: 9, Type: "user.renamed", UserID: 43},
+	}
+	repo.On("UserEventCursor", mock.Anything).Return(int64(5), nil)
+	source.On("ListUserEvents", mock.Anything, int64(5), 10).Return(events, nil)
+	repo.On("ApplyFollowed", mock.Anything, int64(6), followerID, authorID, 50).Return(nil).Once()
+	repo.On("ApplyUnfollowed", mock.Anything, int64(7), strangerID, authorID).Return(nil).Once()
+	repo.On("ApplyUserDeleted", mock.Anything, int64(8), int64(42)).Return(nil).Once()
+	repo.On("AdvanceUserEventCursor", mock.Anything, int64(9)).Return(nil).Once()
+
+	handled, err := consumer.PollOnce(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, handled)
+	repo.AssertExpectations(t)
+}
+
+// TestPollOnce_StopsAtFailedEvent verifies that a failing event stops the
+// batch, so later events are not applied before it.
+func TestPollOnce_StopsAtFailedEvent(t *testing.T) {
+	source := new(mocks.UserEventSourceMock)
+	repo := new(mocks.UserEventRepoMock)
+	consumer := newTestConsumer(source, repo)
+
+	events := []model.UserEvent{
+		{ID: 1, Type: model.UserEventFollowed, UserID: followerID, SubjectUserID: authorID},
+		{ID: 2, Type: model.UserEventUnfollowed, UserID: followerID, SubjectUserID: authorID},
+	}
+	repo.On("UserEventCursor", mock.Anything).Return(int64(0), nil)
+	source.On("ListUserEvents", mock.Anything, int64(0), 10).Return(events, nil)
+	repo.On("ApplyFollowed", mock.Anything, int64(1), followerID, authorID, 50).Return(errs.ErrDBFailure)
+
+	handled, err := consumer.PollOnce(context.Background())
+
+	assert.ErrorIs(t, err, errs.ErrDBFailure)
+	assert.Equal(t, 0, handled)
+	repo.AssertNotCalled(t, "ApplyUnfollowed", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
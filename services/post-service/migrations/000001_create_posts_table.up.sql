@@ -0,0 +1,13 @@
+CREATE TABLE posts
+(
+    id         UUID PRIMARY KEY,
+    author_id  BIGINT      NOT NULL,
+    text       TEXT        NOT NULL DEFAULT '',
+    visibility VARCHAR(16) NOT NULL DEFAULT 'public'
+        CHECK (visibility IN ('public', 'followers', 'private')),
+    created_at TIMESTAMP   NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP   NOT NULL DEFAULT NOW()
+);
+
+-- Serves ListUserPosts, newest first.
+CREATE INDEX idx_posts_author ON posts (author_id, created_at DESC, id DESC);
@@ -0,0 +1,9 @@
+-- References to media uploaded elsewhere, in display order.
+CREATE TABLE post_attachments
+(
+    post_id    UUID        NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    position   SMALLINT    NOT NULL,
+    url        TEXT        NOT NULL,
+    media_type VARCHAR(16) NOT NULL CHECK (media_type IN ('image', 'video')),
+    PRIMARY KEY (post_id, position)
+);
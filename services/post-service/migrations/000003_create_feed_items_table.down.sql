@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS feed_items;
+
+DROP INDEX IF EXISTS idx_posts_author_pulled;
+DROP INDEX IF EXISTS idx_posts_fan_out_pending;
+
+ALTER TABLE posts DROP COLUMN IF EXISTS fan_out;
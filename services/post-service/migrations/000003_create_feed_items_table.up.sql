@@ -0,0 +1,30 @@
+-- How a post reaches home feeds: 'pending' until the fan-out worker has
+-- handled it, then 'done' once it is in its followers' feed_items, or
+-- 'skipped' if its author had too many followers, in which case it is merged
+-- into feeds when they are read.
+ALTER TABLE posts
+    ADD COLUMN fan_out VARCHAR(16) NOT NULL DEFAULT 'pending'
+        CHECK (fan_out IN ('pending', 'done', 'skipped'));
+
+-- Serves the fan-out worker, oldest first.
+CREATE INDEX idx_posts_fan_out_pending ON posts (created_at, id) WHERE fan_out = 'pending';
+
+-- Serves the posts merged into home feeds on read.
+CREATE INDEX idx_posts_author_pulled ON posts (author_id, created_at DESC, id DESC) WHERE fan_out <> 'done';
+
+-- Fanned-out home feed entries. created_at is the post's, so that a feed is
+-- paged without touching posts.
+CREATE TABLE feed_items
+(
+    user_id    BIGINT    NOT NULL,
+    post_id    UUID      NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    author_id  BIGINT    NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, post_id)
+);
+
+-- Serves GetHomeFeed, newest first.
+CREATE INDEX idx_feed_items_user ON feed_items (user_id, created_at DESC, post_id DESC);
+
+-- Serves removing an author's posts from a feed on unfollow.
+CREATE INDEX idx_feed_items_user_author ON feed_items (user_id, author_id);
@@ -0,0 +1,7 @@
+-- How far the user-service event feed has been consumed.
+CREATE TABLE user_event_cursor
+(
+    id            BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    last_event_id BIGINT    NOT NULL,
+    updated_at    TIMESTAMP NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS comments;
+DROP TABLE IF EXISTS post_likes;
+
+ALTER TABLE posts
+    DROP COLUMN IF EXISTS comment_count,
+    DROP COLUMN IF EXISTS like_count;
@@ -0,0 +1,38 @@
+-- Denormalized counts shown on every post. They change in the same
+-- transaction as the likes and comments they count.
+ALTER TABLE posts
+    ADD COLUMN like_count    BIGINT NOT NULL DEFAULT 0 CHECK (like_count >= 0),
+    ADD COLUMN comment_count BIGINT NOT NULL DEFAULT 0 CHECK (comment_count >= 0);
+
+-- One row per user who likes a post.
+CREATE TABLE post_likes
+(
+    post_id    UUID      NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    user_id    BIGINT    NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (post_id, user_id)
+);
+
+-- Serves removing a deleted user's likes.
+CREATE INDEX idx_post_likes_user ON post_likes (user_id);
+
+-- Comments, threaded one level deep: parent_id is NULL for top-level
+-- comments and names a top-level comment for replies.
+CREATE TABLE comments
+(
+    id         UUID PRIMARY KEY,
+    post_id    UUID      NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    parent_id  UUID REFERENCES comments (id) ON DELETE CASCADE,
+    author_id  BIGINT    NOT NULL,
+    text       TEXT      NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Serves ListComments for top-level comments, oldest first.
+CREATE INDEX idx_comments_post ON comments (post_id, created_at, id) WHERE parent_id IS NULL;
+
+-- Serves ListComments for replies, oldest first, and reply counts.
+CREATE INDEX idx_comments_parent ON comments (parent_id, created_at, id) WHERE parent_id IS NOT NULL;
+
+-- Serves removing a deleted user's comments.
+CREATE INDEX idx_comments_author ON comments (author_id);
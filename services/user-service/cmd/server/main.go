@@ -21,18 +21,24 @@ import (
 	"github.com/joho/godotenv"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
+	socialpb "github.com/mamataliev-dev/social-platform/api/gen/social/v1"
 	userpb "github.com/mamataliev-dev/social-platform/api/gen/user/v1"
 	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+	"github.com/mamataliev-dev/social-platform/pkg/grpctransport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/clients"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/config"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/logger"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/mailer"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/mapper"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/middleware"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/oidc"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/repository"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/security"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/service"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
 )
 
 // main is the entrypoint for the user-service application. It delegates startup
@@ -43,6 +49,13 @@ func main() {
 	}
 }
 
+// serviceName identifies user-service in service tokens: it is the audience of
+// tokens sent to this service and the issuer of tokens it sends.
+const serviceName = "user-service"
+
+// chatServiceName is the audience of service tokens sent to chat-service.
+const chatServiceName = "chat-service"
+
 // run loads configuration, sets up logging, initializes the database and all
 // service dependencies, and starts both gRPC and HTTP REST servers. It handles
 // graceful shutdown on interrupt signals. All dependencies are injected via
@@ -72,37 +85,150 @@ func run() error {
 	defer db.Close()
 
 	// Initialize dependencies
-	secretKey := []byte(os.Getenv("SECRET_KEY"))
+	keySet, err := security.LoadKeySet(cfg.JWT.KeysDir, cfg.JWT.SigningKeyID)
+	if err != nil {
+		slog.Error("failed to load JWT signing keys", "error", err)
+		return err
+	}
 	tokenTTL := 15 * time.Minute
 
-	hasher := security.BcryptHasher{}
-	jwtGen := security.NewJWTGenerator(secretKey, tokenTTL)
+	secretBox, err := security.NewSecretBox(cfg.MFA.EncryptionKey)
+	if err != nil {
+		slog.Error("failed to initialize MFA secret encryption", "error", err)
+		return err
+	}
+
+	hasher, err := security.NewHasher(cfg.PasswordHashing)
+	if err != nil {
+		slog.Error("failed to initialize password hasher", "error", err)
+		return err
+	}
+
+	policy, err := security.NewPasswordPolicy(cfg.PasswordPolicy)
+	if err != nil {
+		slog.Error("failed to initialize password policy", "error", err)
+		return err
+	}
+
+	jwtGen := security.NewJWTGenerator(keySet, tokenTTL, cfg.JWT.Issuer, cfg.JWT.Audience)
+	verifier := auth.NewVerifier(keySet.Keyfunc, auth.Config{
+		Issuer:   cfg.JWT.Issuer,
+		Audience: cfg.JWT.Audience,
+		Leeway:   cfg.JWT.Leeway,
+	})
 	converter := mapper.NewMapper()
+	mail := mailer.NewMailer(cfg.Mailer)
+	oauthProviders := oidc.NewRegistry(cfg.OAuth.Providers, &http.Client{Timeout: 10 * time.Second})
 
 	userRepo := repository.NewUserPostgres(db)
 	authRepo := repository.NewAuthPostgres(db)
 	tokenRepo := repository.NewTokenPostgres(db)
+	verifyRepo := repository.NewVerificationPostgres(db)
+	mfaRepo := repository.NewMFAPostgres(db, secretBox)
+	identityRepo := repository.NewIdentityPostgres(db)
+	attemptRepo := repository.NewLoginAttemptPostgres(db)
+	resetRepo := repository.NewPasswordResetPostgres(db)
+	accountRepo := repository.NewAccountPostgres(db)
+	exportRepo := repository.NewDataExportPostgres(db)
+	adminRepo := repository.NewAdminPostgres(db)
+	followRepo := repository.NewFollowPostgres(db)
+	friendRepo := repository.NewFriendPostgres(db)
+
+	// Service tokens; a missing or short secret disables internal RPCs
+	serviceSigner, err := auth.NewServiceTokenSigner(cfg.Internal.ServiceToken, serviceName)
+	if err != nil {
+		slog.Warn("calls to other services will not be authenticated", "error", err)
+	}
+	serviceVerifier, err := auth.NewServiceTokenVerifier(cfg.Internal.ServiceToken, serviceName, cfg.JWT.Leeway)
+	if err != nil {
+		slog.Warn("internal RPCs will reject every call", "error", err)
+	}
 
-	authSvc := service.NewAuthService(authRepo, userRepo, tokenRepo, jwtGen, hasher, converter)
+	// TLS, keepalive and stream limits for both gRPC servers and all clients
+	transport, err := grpctransport.New(grpctransport.Config{
+		TLS: grpctransport.TLSConfig{
+			Enabled:        cfg.GRPC.TLS.Enabled,
+			CertFile:       cfg.GRPC.TLS.CertFile,
+			KeyFile:        cfg.GRPC.TLS.KeyFile,
+			CAFile:         cfg.GRPC.TLS.CAFile,
+			ClientCAFile:   cfg.GRPC.TLS.ClientCAFile,
+			ReloadInterval: cfg.GRPC.TLS.ReloadInterval,
+		},
+		Keepalive: grpctransport.KeepaliveConfig{
+			Time:    cfg.GRPC.Keepalive.Time,
+			Timeout: cfg.GRPC.Keepalive.Timeout,
+		},
+		MaxConcurrentStreams: cfg.GRPC.MaxConcurrentStreams,
+	})
+	if err != nil {
+		slog.Error("failed to configure gRPC transport", "error", err)
+		return err
+	}
+
+	chatClient, err := clients.NewChatServiceClient(cfg.ChatService.Addr, transport, serviceSigner, chatServiceName)
+	if err != nil {
+		slog.Error("failed to create chat-service client", "error", err)
+		return err
+	}
+	defer chatClient.Close()
+
+	authSvc := service.NewAuthService(service.AuthDeps{
+		AuthRepo:     authRepo,
+		UserRepo:     userRepo,
+		TokenRepo:    tokenRepo,
+		VerifyRepo:   verifyRepo,
+		MFARepo:      mfaRepo,
+		IdentityRepo: identityRepo,
+		AttemptRepo:  attemptRepo,
+		ResetRepo:    resetRepo,
+		AccountRepo:  accountRepo,
+		ExportRepo:   exportRepo,
+		JWTGen:       jwtGen,
+		Hasher:       hasher,
+		Policy:       policy,
+		Converter:    converter,
+		Mailer:       mail,
+		OAuth:        oauthProviders,
+		Config: service.AuthConfig{
+			EmailVerification: cfg.EmailVerification,
+			MFA:               cfg.MFA,
+			OAuth:             cfg.OAuth,
+			LoginThrottle:     cfg.LoginThrottle,
+			PasswordReset:     cfg.PasswordReset,
+			AccountDeletion:   cfg.AccountDeletion,
+			DataExport:        cfg.DataExport,
+		},
+	})
 	publicUserSvc := service.NewUserService(userRepo, converter)
-	internalUserSvc := service.NewInternalUserService(userRepo, converter)
+	internalUserSvc := service.NewInternalUserService(userRepo, accountRepo, followRepo, friendRepo, converter)
+	adminSvc := service.NewAdminService(adminRepo, tokenRepo, converter)
+	socialSvc := service.NewSocialGraphService(userRepo, followRepo, friendRepo, converter)
+	purger := service.NewAccountPurger(accountRepo, accountRepo, cfg.AccountDeletion)
+	exporter := service.NewDataExporter(exportRepo, authRepo, tokenRepo, chatClient, cfg.DataExport)
 
 	// Context and signal handling
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
 	// gRPC server setup
-	grpcServer := grpc.NewServer(
+	trustedProxies, err := utils.ParseTrustedProxies(cfg.GRPC.TrustedProxies)
+	if err != nil {
+		slog.Error("failed to parse trusted proxies", "error", err)
+		return err
+	}
+	grpcServer := grpc.NewServer(append(transport.ServerOptions(false),
 		grpc.ChainUnaryInterceptor(
+			middleware.ClientInfoInterceptor(trustedProxies),
 			middleware.ValidationInterceptor(),
 			middleware.TimeoutInterceptor,
-			middleware.UnaryAuthInterceptor,
+			middleware.UnaryAuthInterceptor(verifier),
 		),
-	)
+	)...)
 
 	userauthpb.RegisterAuthServiceServer(grpcServer, authSvc)
+	userauthpb.RegisterAdminServiceServer(grpcServer, adminSvc)
 	userpb.RegisterUserServiceServer(grpcServer, publicUserSvc)
-	userpb.RegisterInternalUserServiceServer(grpcServer, internalUserSvc)
+	socialpb.RegisterSocialGraphServiceServer(grpcServer, socialSvc)
 	reflection.Register(grpcServer)
 
 	grpcAddr := fmt.Sprintf("%s:%d", cfg.GRPC.Host, cfg.GRPC.Port)
@@ -112,19 +238,50 @@ func run() error {
 		return err
 	}
 
+	// Internal gRPC server for other services, never exposed via the gateway
+	internalServer := grpc.NewServer(append(transport.ServerOptions(true),
+		grpc.ChainUnaryInterceptor(
+			middleware.ValidationInterceptor(),
+			middleware.TimeoutInterceptor,
+			middleware.ServiceTokenInterceptor(serviceVerifier),
+		),
+	)...)
+	userpb.RegisterInternalUserServiceServer(internalServer, internalUserSvc)
+
+	internalAddr := fmt.Sprintf("%s:%d", cfg.Internal.Host, cfg.Internal.Port)
+	internalLis, err := net.Listen("tcp", internalAddr)
+	if err != nil {
+		slog.Error("failed to listen for internal gRPC", "error", err)
+		return err
+	}
+
 	// HTTP REST Gateway setup
 	mux := runtime.NewServeMux()
-	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	opts := transport.DialOptions(cfg.GRPC.TLS.ServerName)
 	if err := userauthpb.RegisterAuthServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
 		slog.Error("failed to register auth gateway", "error", err)
 		return err
 	}
+	if err := userauthpb.RegisterAdminServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		slog.Error("failed to register admin gateway", "error", err)
+		return err
+	}
 	if err := userpb.RegisterUserServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
 		slog.Error("failed to register user gateway", "error", err)
 		return err
 	}
-	if err := userpb.RegisterInternalUserServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
-		slog.Error("failed to register internal user gateway", "error", err)
+	if err := socialpb.RegisterSocialGraphServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		slog.Error("failed to register social graph gateway", "error", err)
+		return err
+	}
+	if err := mux.HandlePath(http.MethodGet, "/.well-known/jwks.json", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		security.JWKSHandler(keySet)(w, r)
+	}); err != nil {
+		slog.Error("failed to register JWKS endpoint", "error", err)
+		return err
+	}
+	if err := mux.HandlePath(http.MethodGet, service.DataExportDownloadPath, service.DataExportDownloadHandler(exportRepo, verifier, slog.Default().With("handler", "data_export_download"))); err != nil {
+		slog.Error("failed to register data export download endpoint", "error", err)
 		return err
 	}
 	httpServer := &http.Server{
@@ -138,10 +295,25 @@ func run() error {
 		slog.Info("starting gRPC server", "addr", grpcAddr)
 		return grpcServer.Serve(lis)
 	})
+	eg.Go(func() error {
+		slog.Info("starting internal gRPC server", "addr", internalAddr)
+		return internalServer.Serve(internalLis)
+	})
 	eg.Go(func() error {
 		slog.Info("starting HTTP REST server", "addr", httpServer.Addr)
 		return httpServer.ListenAndServe()
 	})
+	eg.Go(func() error {
+		return transport.Run(egCtx)
+	})
+	eg.Go(func() error {
+		slog.Info("starting deleted account purger", "interval", cfg.AccountDeletion.PurgeInterval)
+		return purger.Run(egCtx)
+	})
+	eg.Go(func() error {
+		slog.Info("starting data exporter", "interval", cfg.DataExport.PollInterval)
+		return exporter.Run(egCtx)
+	})
 
 	// Wait for shutdown signal
 	<-egCtx.Done()
@@ -151,6 +323,7 @@ func run() error {
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 	grpcServer.GracefulStop()
+	internalServer.GracefulStop()
 	if err := httpServer.Shutdown(shutdownCtx); err != nil {
 		slog.Error("HTTP server shutdown failed", "error", err)
 	}
@@ -0,0 +1,87 @@
+// Package clients provides gRPC clients for the other services of the
+// platform. Internal RPCs authenticate with signed service tokens.
+package clients
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+
+	chatpb "github.com/mamataliev-dev/social-platform/api/gen/chat/v1"
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+	"github.com/mamataliev-dev/social-platform/pkg/grpctransport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+)
+
+// ChatClient calls the InternalChatService of chat-service. It implements
+// model.ChatDataSource.
+type ChatClient struct {
+	conn   *grpc.ClientConn
+	client chatpb.InternalChatServiceClient
+}
+
+// NewChatServiceClient connects to chat-service at addr with the TLS and
+// keepalive settings of transport. Every call carries a token from signer
+// addressed to audience; a nil signer sends none.
+func NewChatServiceClient(
+	addr string,
+	transport *grpctransport.Transport,
+	signer *auth.ServiceTokenSigner,
+	audience string,
+) (*ChatClient, error) {
+	opts := transport.DialOptions("")
+	if signer != nil {
+		opts = append(opts, grpc.WithPerRPCCredentials(signer.Credentials(audience)))
+	}
+
+	conn, err := grpc.NewClient(fmt.Sprintf("dns:///%s", addr), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChatClient{
+		conn:   conn,
+		client: chatpb.NewInternalChatServiceClient(conn),
+	}, nil
+}
+
+// ExportUserChatData fetches the rooms the user belongs to and the messages
+// they sent.
+func (c *ChatClient) ExportUserChatData(ctx context.Context, userID int64) (model.ChatData, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	resp, err := c.client.ExportUserChatData(ctx, &chatpb.ExportUserChatDataRequest{UserId: userID})
+	if err != nil {
+		return model.ChatData{}, err
+	}
+
+	data := model.ChatData{
+		Rooms:    make([]model.ChatRoom, 0, len(resp.GetRooms())),
+		Messages: make([]model.ChatMessage, 0, len(resp.GetMessages())),
+	}
+	for _, r := range resp.GetRooms() {
+		data.Rooms = append(data.Rooms, model.ChatRoom{
+			ID:            r.GetId(),
+			InitiatorID:   r.GetInitiatorId(),
+			ParticipantID: r.GetParticipantId(),
+			CreatedAt:     r.GetCreatedAt().AsTime(),
+		})
+	}
+	for _, m := range resp.GetMessages() {
+		data.Messages = append(data.Messages, model.ChatMessage{
+			ID:        m.GetId(),
+			RoomID:    m.GetRoomId(),
+			Content:   m.GetContent(),
+			CreatedAt: m.GetTimestamp().AsTime(),
+		})
+	}
+	return data, nil
+}
+
+// Close closes the underlying connection.
+func (c *ChatClient) Close() error {
+	return c.conn.Close()
+}
@@ -24,6 +24,19 @@ type Config struct {
 	Security Security   `yaml:"security"`
 	Logging  Logging    `yaml:"logging"`
 	JWT      JWT        `yaml:"jwt"`
+
+	Mailer            Mailer            `yaml:"mailer"`
+	EmailVerification EmailVerification `yaml:"email_verification"`
+	PasswordReset     PasswordReset     `yaml:"password_reset"`
+	PasswordPolicy    PasswordPolicy    `yaml:"password_policy"`
+	MFA               MFA               `yaml:"mfa"`
+	LoginThrottle     LoginThrottle     `yaml:"login_throttle"`
+	PasswordHashing   PasswordHashing   `yaml:"password_hashing"`
+	OAuth             OAuth             `yaml:"oauth"`
+	AccountDeletion   AccountDeletion   `yaml:"account_deletion"`
+	DataExport        DataExport        `yaml:"data_export"`
+	Internal          Internal          `yaml:"internal"`
+	ChatService       ChatService       `yaml:"chat_service"`
 }
 
 // Server contains HTTP server configuration parameters.
@@ -39,11 +52,23 @@ type KeepaliveConfig struct {
 	Timeout time.Duration `yaml:"timeout"`
 }
 
-// TLSConfig holds TLS settings for secure gRPC communication.
+// TLSConfig holds TLS settings for secure gRPC communication. CertFile and
+// KeyFile are the service's certificate, served on both gRPC listeners and
+// presented as a client certificate when dialing; they are reloaded every
+// ReloadInterval if changed. CAFile verifies the servers the service dials
+// (system roots when empty), and ServerName is the name the gateway expects
+// in the certificate of its own gRPC server. Setting ClientCAFile requires
+// clients of the internal listener to present a certificate signed by it
+// (mTLS); the public listener never asks for one. Enabling TLS
+// also makes the service dial chat-service over TLS.
 type TLSConfig struct {
-	Enabled  bool   `yaml:"enabled"`
-	CertFile string `yaml:"cert_file"`
-	KeyFile  string `yaml:"key_file"`
+	Enabled        bool          `yaml:"enabled"`
+	CertFile       string        `yaml:"cert_file"`
+	KeyFile        string        `yaml:"key_file"`
+	CAFile         string        `yaml:"ca_file"`
+	ClientCAFile   string        `yaml:"client_ca_file"`
+	ServerName     string        `yaml:"server_name"`
+	ReloadInterval time.Duration `yaml:"reload_interval"`
 }
 
 // GRPCConfig contains gRPC server configuration parameters.
@@ -53,6 +78,10 @@ type GRPCConfig struct {
 	MaxConcurrentStreams uint32          `yaml:"max_concurrent_streams"`
 	Keepalive            KeepaliveConfig `yaml:"keepalive"`
 	TLS                  TLSConfig       `yaml:"tls"`
+
+	// TrustedProxies lists the IPs or CIDR prefixes of proxies, including the
+	// in-process grpc-gateway, whose x-forwarded-for entries are believed.
+	TrustedProxies []string `yaml:"trusted_proxies"`
 }
 
 // Database holds database connection configuration.
@@ -66,10 +95,166 @@ type Database struct {
 	SSLMode  string `yaml:"sslmode"`
 }
 
-// JWT contains JWT signing and expiry configuration.
+// JWT contains JWT signing and expiry configuration. Every "<kid>.pem" private
+// key in KeysDir is published in the JWKS, so verifiers accept tokens from any
+// of them; SigningKeyID selects the one used to sign new tokens. Rotating keys
+// means adding a file, switching SigningKeyID, and removing the old file once
+// its tokens have expired.
+//
+// Issuer and Audience are stamped into every access token and checked by all
+// verifiers; Leeway is the clock skew tolerated when checking token times.
 type JWT struct {
-	Secret       string `yaml:"secret"`
-	ExpiresInMin int    `yaml:"expires_in_minutes"`
+	ExpiresInMin int           `yaml:"expires_in_minutes"`
+	KeysDir      string        `yaml:"keys_dir"`
+	SigningKeyID string        `yaml:"signing_key_id"`
+	Issuer       string        `yaml:"issuer"`
+	Audience     string        `yaml:"audience"`
+	Leeway       time.Duration `yaml:"leeway"`
+}
+
+// Mailer configures outbound email delivery. Driver selects the transport:
+// "smtp" delivers through the configured relay, anything else logs messages.
+type Mailer struct {
+	Driver   string `yaml:"driver"`
+	From     string `yaml:"from"`
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+}
+
+// EmailVerification controls the lifetime and resend throttling of email
+// verification tokens, and the link embedded in verification emails.
+type EmailVerification struct {
+	TokenTTL       time.Duration `yaml:"token_ttl"`
+	ResendCooldown time.Duration `yaml:"resend_cooldown"`
+	LinkBaseURL    string        `yaml:"link_base_url"`
+}
+
+// PasswordReset controls the lifetime and request throttling of password reset
+// tokens, and the link embedded in reset emails.
+type PasswordReset struct {
+	TokenTTL       time.Duration `yaml:"token_ttl"`
+	ResendCooldown time.Duration `yaml:"resend_cooldown"`
+	LinkBaseURL    string        `yaml:"link_base_url"`
+}
+
+// PasswordPolicy defines the rules new passwords must satisfy. Lengths count
+// characters, not bytes. BreachedPasswordsFile optionally names a local copy
+// of the Have I Been Pwned SHA-1 list: a "HASH:COUNT" file or a directory of
+// k-anonymity range files. Passwords found in it are rejected without any
+// network call.
+type PasswordPolicy struct {
+	MinLength             int    `yaml:"min_length"`
+	MaxLength             int    `yaml:"max_length"`
+	RequireUpper          bool   `yaml:"require_upper"`
+	RequireLower          bool   `yaml:"require_lower"`
+	RequireDigit          bool   `yaml:"require_digit"`
+	RequireSymbol         bool   `yaml:"require_symbol"`
+	BreachedPasswordsFile string `yaml:"breached_passwords_file"`
+}
+
+// MFA configures TOTP two-factor authentication. Issuer is the account label
+// shown by authenticator apps, EncryptionKey the base64-encoded 32-byte
+// AES-256 key that TOTP secrets are encrypted with at rest. ChallengeTTL and
+// MaxChallengeAttempts bound how long and how often the MFA token returned by
+// Login may be redeemed; RecoveryCodes is how many codes ConfirmTOTP issues.
+type MFA struct {
+	Issuer               string        `yaml:"issuer"`
+	EncryptionKey        string        `yaml:"encryption_key"`
+	ChallengeTTL         time.Duration `yaml:"challenge_ttl"`
+	MaxChallengeAttempts int           `yaml:"max_challenge_attempts"`
+	RecoveryCodes        int           `yaml:"recovery_codes"`
+}
+
+// LoginThrottle configures brute-force protection for Login. Failed attempts
+// are counted per account and per client IP and forgotten after FailureWindow
+// without failures. Once MaxAccountFailures or MaxIPFailures is reached, the
+// account or IP is locked for BaseLockout, doubling with every further failure
+// up to MaxLockout.
+type LoginThrottle struct {
+	MaxAccountFailures int           `yaml:"max_account_failures"`
+	MaxIPFailures      int           `yaml:"max_ip_failures"`
+	FailureWindow      time.Duration `yaml:"failure_window"`
+	BaseLockout        time.Duration `yaml:"base_lockout"`
+	MaxLockout         time.Duration `yaml:"max_lockout"`
+}
+
+// PasswordHashing selects how new password hashes are created. Algorithm is
+// "argon2id" (the default) or "bcrypt". Hashes of either algorithm keep
+// verifying, and Login re-hashes those that do not match the current settings.
+type PasswordHashing struct {
+	Algorithm  string   `yaml:"algorithm"`
+	BcryptCost int      `yaml:"bcrypt_cost"`
+	Argon2id   Argon2id `yaml:"argon2id"`
+}
+
+// Argon2id holds the Argon2id cost parameters. Memory is in KiB; SaltLength
+// and KeyLength are in bytes.
+type Argon2id struct {
+	Memory      uint32 `yaml:"memory"`
+	Iterations  uint32 `yaml:"iterations"`
+	Parallelism uint8  `yaml:"parallelism"`
+	SaltLength  uint32 `yaml:"salt_length"`
+	KeyLength   uint32 `yaml:"key_length"`
+}
+
+// AccountDeletion controls the lifecycle of deleted accounts. Deleted accounts
+// are deactivated immediately and purged once GracePeriod has passed; the purge
+// runs every PurgeInterval. Account events consumed by other services are kept
+// for EventRetention, and longer until every consumer has handled them.
+type AccountDeletion struct {
+	GracePeriod    time.Duration `yaml:"grace_period"`
+	PurgeInterval  time.Duration `yaml:"purge_interval"`
+	EventRetention time.Duration `yaml:"event_retention"`
+}
+
+// DataExport controls asynchronous data exports. A worker checks for queued
+// exports every PollInterval and retries exports whose worker has not finished
+// within StaleAfter; finished archives can be downloaded for DownloadTTL.
+type DataExport struct {
+	PollInterval time.Duration `yaml:"poll_interval"`
+	StaleAfter   time.Duration `yaml:"stale_after"`
+	DownloadTTL  time.Duration `yaml:"download_ttl"`
+}
+
+// Internal configures service-to-service RPCs. They are served on a separate
+// listener at Host:Port that must not be reachable from outside the cluster.
+// ServiceToken is the shared secret (at least 32 bytes) that service tokens
+// are signed with; a missing or short secret rejects every internal call.
+type Internal struct {
+	Host         string `yaml:"host"`
+	Port         int    `yaml:"port"`
+	ServiceToken string `yaml:"service_token"`
+}
+
+// ChatService locates chat-service, which is called with tokens signed with
+// Internal.ServiceToken.
+type ChatService struct {
+	Addr string `yaml:"addr"`
+}
+
+// OAuth configures social login through OpenID Connect providers. StateTTL
+// bounds how long a login started with BeginOAuthLogin may take to complete.
+type OAuth struct {
+	StateTTL  time.Duration  `yaml:"state_ttl"`
+	Providers []OIDCProvider `yaml:"providers"`
+
+	// ReauthWindow is how recently an account without a password must have
+	// signed in to delete itself or set a password.
+	ReauthWindow time.Duration `yaml:"reauth_window"`
+}
+
+// OIDCProvider describes one OpenID Connect identity provider. Endpoints are
+// discovered from Issuer; RedirectURL is the client page that receives the
+// authorization code and must be registered with the provider.
+type OIDCProvider struct {
+	Name         string   `yaml:"name"`
+	Issuer       string   `yaml:"issuer"`
+	ClientID     string   `yaml:"client_id"`
+	ClientSecret string   `yaml:"client_secret"`
+	RedirectURL  string   `yaml:"redirect_url"`
+	Scopes       []string `yaml:"scopes"`
 }
 
 // Security holds security-related configuration, such as allowed CORS origins.
@@ -5,12 +5,63 @@ package domain
 import "time"
 
 type CreateTokenPairInput struct {
-	UserID   int64
-	Nickname string
+	UserID        int64
+	Nickname      string
+	EmailVerified bool
+	SessionID     string
+	Roles         []string // Elevated roles; every user implicitly holds "user"
 }
 
+// SaveRefreshTokenInput carries a refresh token digest and the session it belongs to.
+// A zero CreatedAt starts a new session; rotations pass the original value.
 type SaveRefreshTokenInput struct {
 	UserID    int64
-	Token     string
+	TokenHash string
+	ExpiresAt time.Time
+	SessionID string
+	Client    ClientInfo
+	CreatedAt time.Time
+}
+
+// ClientInfo describes the device making a request, as observed from gRPC metadata.
+type ClientInfo struct {
+	UserAgent string
+	IPAddress string
+}
+
+// SaveVerificationTokenInput carries the digest of a newly issued
+// verification token. A positive Cooldown skips the save if the user was
+// issued another token within it.
+type SaveVerificationTokenInput struct {
+	UserID    int64
+	TokenHash string
+	ExpiresAt time.Time
+	Cooldown  time.Duration
+}
+
+// SavePasswordResetTokenInput carries the digest of a newly issued password
+// reset token. A positive Cooldown skips the save if the user was issued
+// another reset token within it.
+type SavePasswordResetTokenInput struct {
+	UserID    int64
+	TokenHash string
+	ExpiresAt time.Time
+	Cooldown  time.Duration
+}
+
+// SaveOAuthStateInput carries a pending social login: the digest of its state
+// parameter plus the PKCE verifier and nonce needed to complete it.
+type SaveOAuthStateInput struct {
+	StateHash    string
+	Provider     string
+	CodeVerifier string
+	Nonce        string
+	ExpiresAt    time.Time
+}
+
+// SaveMFAChallengeInput carries the digest of a pending MFA login challenge.
+type SaveMFAChallengeInput struct {
+	UserID    int64
+	TokenHash string
 	ExpiresAt time.Time
 }
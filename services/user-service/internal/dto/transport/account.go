@@ -0,0 +1,17 @@
+// Package transport defines DTOs for transport-level account lifecycle
+// operations in the user-service. It supports Single Responsibility and
+// Open/Closed principles.
+package transport
+
+import "time"
+
+// DeleteMyAccountRequest is what your HTTP handler binds on POST v1/auth/account/delete
+type DeleteMyAccountRequest struct {
+	Password string `json:"password"`
+}
+
+// DeleteMyAccountResponse confirms the deletion and when it becomes permanent
+type DeleteMyAccountResponse struct {
+	Message    string    `json:"message"`
+	PurgeAfter time.Time `json:"purge_after"`
+}
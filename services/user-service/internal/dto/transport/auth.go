@@ -22,3 +22,25 @@ type LoginRequest struct {
 type LogoutResponse struct {
 	Message string `json:"message"`
 }
+
+// VerifyEmailRequest is what your HTTP handler binds on POST v1/auth/verify-email
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+// VerifyEmailResponse confirms a successful email verification
+type VerifyEmailResponse struct {
+	Message string `json:"message"`
+}
+
+// ResendVerificationEmailRequest is what your HTTP handler binds on
+// POST v1/auth/verify-email/resend
+type ResendVerificationEmailRequest struct {
+	Email string `json:"email"`
+}
+
+// ResendVerificationEmailResponse is returned regardless of whether the
+// address belongs to an account, so it cannot be used for enumeration
+type ResendVerificationEmailResponse struct {
+	Message string `json:"message"`
+}
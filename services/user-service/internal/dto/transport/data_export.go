@@ -0,0 +1,61 @@
+// Package transport defines DTOs for the data export endpoints and the JSON
+// files inside the export archive. It supports Single Responsibility and
+// Open/Closed principles.
+package transport
+
+import "time"
+
+// GetDataExportRequest is what your HTTP handler binds on GET v1/auth/account/exports/{job_id}
+type GetDataExportRequest struct {
+	JobID string `json:"job_id"`
+}
+
+// DataExportJob reports the state of a data export
+type DataExportJob struct {
+	JobID       string    `json:"job_id"`
+	Status      string    `json:"status"`
+	CreatedAt   time.Time `json:"created_at"`
+	CompletedAt time.Time `json:"completed_at"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	DownloadURL string    `json:"download_url"`
+}
+
+// ExportProfile is profile.json in the export archive
+type ExportProfile struct {
+	ID              int64      `json:"id"`
+	Username        string     `json:"username"`
+	Nickname        string     `json:"nickname"`
+	Email           string     `json:"email"`
+	Bio             string     `json:"bio"`
+	AvatarURL       string     `json:"avatar_url"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at"`
+	LastLoginAt     *time.Time `json:"last_login_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+// ExportSession is an entry of sessions.json in the export archive
+type ExportSession struct {
+	ID         string    `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// ExportChatRoom is an entry of chat/rooms.json in the export archive
+type ExportChatRoom struct {
+	ID            string    `json:"id"`
+	InitiatorID   int64     `json:"initiator_id"`
+	ParticipantID int64     `json:"participant_id"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// ExportChatMessage is an entry of chat/messages.json in the export archive
+type ExportChatMessage struct {
+	ID        string    `json:"id"`
+	RoomID    string    `json:"room_id"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
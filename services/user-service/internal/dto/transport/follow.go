@@ -0,0 +1,67 @@
+// Package transport defines DTOs for transport-level follow graph operations
+// in the user-service. It supports Single Responsibility and Open/Closed
+// principles.
+package transport
+
+import "time"
+
+// FollowState is how one user is connected to another.
+type FollowState string
+
+const (
+	// FollowStateNone means neither following nor a pending request.
+	FollowStateNone FollowState = "none"
+	// FollowStateRequested means a follow request awaits approval.
+	FollowStateRequested FollowState = "requested"
+	// FollowStateFollowing means following.
+	FollowStateFollowing FollowState = "following"
+)
+
+// Relationship describes how UserID and OtherUserID are connected: Following
+// is how UserID relates to OtherUserID and FollowedBy the reverse.
+type Relationship struct {
+	UserID      int64
+	OtherUserID int64
+	Following   FollowState
+	FollowedBy  FollowState
+}
+
+// Follow list names, used to bind page tokens to the list they page through.
+const (
+	FollowListFollowers = "followers"
+	FollowListFollowing = "following"
+	FollowListRequests  = "requests"
+)
+
+// FollowCursor is the position after the last entry of a follow list page. It
+// is handed to clients as an opaque page token and is only valid for the list
+// List of UserID.
+type FollowCursor struct {
+	List      string    `json:"l"`
+	UserID    int64     `json:"u"`
+	CreatedAt time.Time `json:"t"`
+	ID        int64     `json:"i"`
+}
+
+// FollowListQuery selects one page of a follow list of UserID, newest first.
+// After is nil for the first page.
+type FollowListQuery struct {
+	UserID int64
+	Limit  int
+	After  *FollowCursor
+}
+
+// FollowEntry is one entry of a follow list: the other user and when the
+// follow or follow request was made.
+type FollowEntry struct {
+	Profile   UserProfileResponse
+	CreatedAt time.Time
+}
+
+// ListFollowsRequest represents the parameters of
+// GET /v1/users/{user_id}/followers and GET /v1/users/{user_id}/following.
+type ListFollowsRequest struct {
+	UserID    int64  `json:"user_id"`
+	PageSize  int    `json:"page_size"`
+	PageToken string `json:"page_token"`
+}
@@ -0,0 +1,78 @@
+// Package transport defines DTOs for transport-level friendship operations in
+// the user-service. It supports Single Responsibility and Open/Closed
+// principles.
+package transport
+
+import "time"
+
+// FriendshipState is how one user is connected to another as a friend.
+type FriendshipState string
+
+const (
+	// FriendshipStateNone means neither friends nor a pending request.
+	FriendshipStateNone FriendshipState = "none"
+	// FriendshipStateRequestSent means the user asked the other to be friends.
+	FriendshipStateRequestSent FriendshipState = "request_sent"
+	// FriendshipStateRequestReceived means the other user asked the user.
+	FriendshipStateRequestReceived FriendshipState = "request_received"
+	// FriendshipStateFriends means friends.
+	FriendshipStateFriends FriendshipState = "friends"
+)
+
+// Friendship describes how UserID is connected to OtherUserID as a friend.
+type Friendship struct {
+	UserID      int64
+	OtherUserID int64
+	State       FriendshipState
+}
+
+// Friend list names, used to bind page tokens to the list they page through.
+const (
+	FriendListFriends  = "friends"
+	FriendListMutual   = "mutual_friends"
+	FriendListRequests = "friend_requests"
+)
+
+// FriendCursor is the position after the last entry of a friend list page. It
+// is handed to clients as an opaque page token and is only valid for the list
+// List of UserID, and OtherUserID for mutual friends.
+type FriendCursor struct {
+	List        string    `json:"l"`
+	UserID      int64     `json:"u"`
+	OtherUserID int64     `json:"o,omitempty"`
+	CreatedAt   time.Time `json:"t"`
+	ID          int64     `json:"i"`
+}
+
+// FriendListQuery selects one page of a friend list of UserID, newest first.
+// OtherUserID is only set for mutual friends; After is nil for the first page.
+type FriendListQuery struct {
+	UserID      int64
+	OtherUserID int64
+	Limit       int
+	After       *FriendCursor
+}
+
+// FriendEntry is one entry of a friend list: the other user and when the
+// friendship started or the request was sent.
+type FriendEntry struct {
+	Profile   UserProfileResponse
+	CreatedAt time.Time
+}
+
+// FriendSuggestion is a friend of friends and the number of friends they have
+// in common with the user the suggestion is for.
+type FriendSuggestion struct {
+	Profile           UserProfileResponse
+	MutualFriendCount int
+}
+
+// ListFriendsRequest represents the parameters of
+// GET /v1/users/{user_id}/friends and
+// GET /v1/users/{user_id}/mutual-friends/{other_user_id}.
+type ListFriendsRequest struct {
+	UserID      int64  `json:"user_id"`
+	OtherUserID int64  `json:"other_user_id"`
+	PageSize    int    `json:"page_size"`
+	PageToken   string `json:"page_token"`
+}
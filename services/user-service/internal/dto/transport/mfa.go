@@ -0,0 +1,26 @@
+// Package transport defines DTOs for transport-level two-factor authentication
+// operations in the user-service. It supports Single Responsibility and
+// Open/Closed principles.
+package transport
+
+// VerifyMFARequest is what your HTTP handler binds on POST v1/auth/mfa/verify
+type VerifyMFARequest struct {
+	MFAToken string `json:"mfa_token"`
+	Code     string `json:"code"`
+}
+
+// EnrollTOTPResponse carries a pending TOTP secret for authenticator apps
+type EnrollTOTPResponse struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauth_uri"`
+}
+
+// ConfirmTOTPResponse carries the recovery codes issued when TOTP is enabled
+type ConfirmTOTPResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// DisableTOTPResponse confirms that two-factor authentication was turned off
+type DisableTOTPResponse struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,17 @@
+// Package transport defines DTOs for transport-level social login operations in
+// the user-service. It supports Single Responsibility and Open/Closed
+// principles.
+package transport
+
+// BeginOAuthLoginResponse carries the provider URL the client must visit
+type BeginOAuthLoginResponse struct {
+	AuthorizationURL string `json:"authorization_url"`
+	State            string `json:"state"`
+}
+
+// CompleteOAuthLoginRequest is what your HTTP handler binds on POST v1/auth/oauth/{provider}/complete
+type CompleteOAuthLoginRequest struct {
+	Provider string `json:"provider"`
+	State    string `json:"state"`
+	Code     string `json:"code"`
+}
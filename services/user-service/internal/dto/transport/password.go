@@ -0,0 +1,38 @@
+// Package transport defines DTOs for transport-level password management
+// operations in the user-service. It supports Single Responsibility and
+// Open/Closed principles.
+package transport
+
+// ChangePasswordRequest is what your HTTP handler binds on POST v1/auth/password/change
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
+
+// ChangePasswordResponse confirms a successful password change
+type ChangePasswordResponse struct {
+	Message string `json:"message"`
+}
+
+// RequestPasswordResetRequest is what your HTTP handler binds on
+// POST v1/auth/password/reset/request
+type RequestPasswordResetRequest struct {
+	Email string `json:"email"`
+}
+
+// RequestPasswordResetResponse is returned regardless of whether the address
+// belongs to an account, so it cannot be used for enumeration
+type RequestPasswordResetResponse struct {
+	Message string `json:"message"`
+}
+
+// ResetPasswordRequest is what your HTTP handler binds on POST v1/auth/password/reset
+type ResetPasswordRequest struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"new_password"`
+}
+
+// ResetPasswordResponse confirms a successful password reset
+type ResetPasswordResponse struct {
+	Message string `json:"message"`
+}
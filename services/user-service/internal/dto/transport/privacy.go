@@ -0,0 +1,33 @@
+// Package transport defines DTOs for transport-level profile privacy
+// operations in the user-service. It supports Single Responsibility by keeping
+// visibility settings apart from the profile data they govern.
+package transport
+
+// ProfileVisibility controls who, besides the owner, sees a profile field in
+// public responses. Values match the *_visibility columns of the users table.
+type ProfileVisibility string
+
+const (
+	// VisibilityEveryone shows the field to every signed-in user.
+	VisibilityEveryone ProfileVisibility = "everyone"
+	// VisibilityOnlyMe shows the field to its owner only.
+	VisibilityOnlyMe ProfileVisibility = "only_me"
+)
+
+// PrivacySettings holds a user's per-field visibility settings and whether
+// following them requires their approval.
+type PrivacySettings struct {
+	Email          ProfileVisibility `json:"email"`
+	LastLogin      ProfileVisibility `json:"last_login"`
+	Bio            ProfileVisibility `json:"bio"`
+	PrivateAccount bool              `json:"private_account"`
+}
+
+// UpdatePrivacySettingsRequest represents the body of
+// PATCH /v1/users/me/privacy. Empty and nil fields keep their current value.
+type UpdatePrivacySettingsRequest struct {
+	Email          ProfileVisibility `json:"email"`
+	LastLogin      ProfileVisibility `json:"last_login"`
+	Bio            ProfileVisibility `json:"bio"`
+	PrivateAccount *bool             `json:"private_account"`
+}
@@ -10,3 +10,11 @@ type TokenResponse struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
 }
+
+type RevokeSessionResponse struct {
+	Message string `json:"message"`
+}
+
+type RevokeAllOtherSessionsResponse struct {
+	RevokedCount int64 `json:"revoked_count"`
+}
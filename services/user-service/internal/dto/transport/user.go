@@ -15,6 +15,14 @@ type UserProfileResponse struct {
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 	LastLogin time.Time `json:"last_login"`
+
+	FollowerCount  int64 `json:"follower_count"`
+	FollowingCount int64 `json:"following_count"`
+
+	EmailVerifiedAt time.Time       `json:"-"`
+	Roles           []string        `json:"-"`
+	SuspendedAt     time.Time       `json:"-"`
+	Privacy         PrivacySettings `json:"-"`
 }
 
 // FetchUserByNicknameRequest represents the HTTP path parameters for
@@ -35,3 +43,32 @@ type FetchUserByEmailRequest struct {
 type FetchUserByIDRequest struct {
 	UserId int64 `json:"user_id"`
 }
+
+// SearchUsersRequest represents the query parameters for GET /v1/users:search.
+type SearchUsersRequest struct {
+	Query     string `json:"query"`
+	PageSize  int    `json:"page_size"`
+	PageToken string `json:"page_token"`
+}
+
+// SearchCursor is the position after the last hit of a search page. It is
+// handed to clients as an opaque page token and is only valid for Query.
+type SearchCursor struct {
+	Query string  `json:"q"`
+	Score float64 `json:"s"`
+	ID    int64   `json:"i"`
+}
+
+// UserSearchQuery selects one page of search hits from the repository. Query
+// is lower-cased; After, when set, skips the hits up to and including it.
+type UserSearchQuery struct {
+	Query string
+	Limit int
+	After *SearchCursor
+}
+
+// UserSearchHit is a profile matched by a search with its relevance score.
+type UserSearchHit struct {
+	Profile UserProfileResponse
+	Score   float64
+}
@@ -20,13 +20,44 @@ var (
 	ErrMissingAuthToken = errors.New("authorization token is not supplied")
 	// ErrInvalidToken indicates an invalid JWT token.
 	ErrInvalidToken = errors.New("invalid token")
+	// ErrMissingServiceToken indicates a service call without a service token.
+	ErrMissingServiceToken = errors.New("service token is not supplied")
+	// ErrInvalidServiceToken indicates a service token that does not match.
+	ErrInvalidServiceToken = errors.New("invalid service token")
+	// ErrPermissionDenied indicates a caller whose roles do not allow the method.
+	ErrPermissionDenied = errors.New("permission denied")
 	// ErrTokenSigningFailed indicates a JWT signing failure.
 	ErrTokenSigningFailed = errors.New("jwt signing failed")
+	// ErrUnknownSigningKey indicates a JWT whose "kid" is not in the key set.
+	ErrUnknownSigningKey = errors.New("unknown signing key")
+	// ErrInvalidSigningKey indicates a key file that is not a supported private key.
+	ErrInvalidSigningKey = errors.New("invalid signing key")
 	// ErrTokenNotFound indicates a missing refresh token.
 	ErrTokenNotFound = errors.New("token not found")
 
+	// ErrRefreshTokenReused indicates a rotated-out refresh token was presented again.
+	ErrRefreshTokenReused = errors.New("refresh token reuse detected")
+	// ErrSessionNotFound indicates a session that does not exist or belongs to another user.
+	ErrSessionNotFound = errors.New("session not found")
+
 	// ErrUserNotFound indicates that a user was not found in the database.
 	ErrUserNotFound = errors.New("user not found")
+	// ErrInvalidPageToken indicates a page token that is malformed or belongs to another query.
+	ErrInvalidPageToken = errors.New("invalid page token")
+	// ErrCannotFollowSelf indicates an attempt to follow one's own account.
+	ErrCannotFollowSelf = errors.New("users cannot follow themselves")
+	// ErrFollowRequestNotFound indicates there is no pending follow request from the given user.
+	ErrFollowRequestNotFound = errors.New("follow request not found")
+	// ErrCannotFriendSelf indicates a friend request to one's own account.
+	ErrCannotFriendSelf = errors.New("users cannot befriend themselves")
+	// ErrFriendRequestNotFound indicates there is no pending friend request from the given user.
+	ErrFriendRequestNotFound = errors.New("friend request not found")
+	// ErrPrivateAccount indicates a follow or friend list of a private account the caller may not see.
+	ErrPrivateAccount = errors.New("this account is private")
+	// ErrAccountSuspended indicates a sign-in to an account suspended by a moderator.
+	ErrAccountSuspended = errors.New("account is suspended")
+	// ErrCannotModerateUser indicates an attempt to suspend oneself or an administrator.
+	ErrCannotModerateUser = errors.New("this account cannot be suspended")
 
 	// ErrEmailTaken indicates that the email is already registered.
 	ErrEmailTaken = errors.New("email already taken")
@@ -35,8 +66,70 @@ var (
 
 	// ErrInvalidPassword indicates an invalid password attempt.
 	ErrInvalidPassword = errors.New("invalid password")
+	// ErrRecentLoginRequired indicates that an account without a password must
+	// sign in again before a sensitive change.
+	ErrRecentLoginRequired = errors.New("sign in again to confirm this change")
+	// ErrInvalidCredentials is the single error returned by Login for an unknown
+	// email or a wrong password, so responses do not reveal which accounts exist.
+	ErrInvalidCredentials = errors.New("invalid email or password")
+	// ErrLoginLocked indicates too many recent failed logins for the account or client.
+	ErrLoginLocked = errors.New("too many failed login attempts, try again later")
 	// ErrHashingFailed indicates a password hashing failure.
 	ErrHashingFailed = errors.New("hashing failed")
+	// ErrWeakPassword indicates a new password that violates the password policy.
+	ErrWeakPassword = errors.New("password does not meet the password policy")
+	// ErrBreachedPassword indicates a new password that appears in a known data breach.
+	ErrBreachedPassword = errors.New("password appears in a known data breach, choose a different one")
+	// ErrInvalidBreachedList indicates a breached password list file that could not be parsed.
+	ErrInvalidBreachedList = errors.New("invalid breached password list")
+	// ErrUnknownHashAlgorithm indicates a password hashing algorithm that is not supported.
+	ErrUnknownHashAlgorithm = errors.New("unknown password hashing algorithm")
+	// ErrInvalidHashParams indicates password hashing parameters that are missing or out of range.
+	ErrInvalidHashParams = errors.New("invalid password hashing parameters")
+
+	// ErrInvalidVerificationToken indicates an unknown or expired email verification token.
+	ErrInvalidVerificationToken = errors.New("invalid or expired verification token")
+	// ErrVerificationThrottled indicates a verification email was requested too soon after the previous one.
+	ErrVerificationThrottled = errors.New("verification email recently sent, try again later")
+	// ErrPasswordResetThrottled indicates a password reset was requested too soon after the previous one.
+	ErrPasswordResetThrottled = errors.New("password reset recently requested, try again later")
+	// ErrInvalidResetToken indicates an unknown or expired password reset token.
+	ErrInvalidResetToken = errors.New("invalid or expired password reset token")
+	// ErrExportNotFound indicates a data export that does not exist, belongs to
+	// another user, or has no downloadable archive.
+	ErrExportNotFound = errors.New("data export not found")
+	// ErrMailDeliveryFailed indicates an outbound email could not be delivered.
+	ErrMailDeliveryFailed = errors.New("mail delivery failed")
+
+	// ErrTOTPAlreadyEnabled indicates TOTP enrollment for an account that already has it enabled.
+	ErrTOTPAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+	// ErrTOTPNotEnrolled indicates an account without a (confirmed) TOTP enrollment.
+	ErrTOTPNotEnrolled = errors.New("two-factor authentication is not enabled")
+	// ErrInvalidMFACode indicates a wrong, expired, replayed or already used TOTP or recovery code.
+	ErrInvalidMFACode = errors.New("invalid two-factor authentication code")
+	// ErrInvalidMFAChallenge indicates an unknown, expired or exhausted MFA challenge token.
+	ErrInvalidMFAChallenge = errors.New("invalid or expired MFA challenge")
+	// ErrInvalidEncryptionKey indicates a secret encryption key that is not 32 base64-encoded bytes.
+	ErrInvalidEncryptionKey = errors.New("invalid encryption key")
+	// ErrDecryptionFailed indicates a stored secret that could not be decrypted.
+	ErrDecryptionFailed = errors.New("decryption failed")
+
+	// ErrUnknownOAuthProvider indicates a social login with a provider that is not configured.
+	ErrUnknownOAuthProvider = errors.New("unknown identity provider")
+	// ErrInvalidOAuthState indicates an unknown, expired or already used OAuth state.
+	ErrInvalidOAuthState = errors.New("invalid or expired OAuth state")
+	// ErrOAuthExchangeFailed indicates the provider rejected the authorization code.
+	ErrOAuthExchangeFailed = errors.New("authorization code exchange failed")
+	// ErrInvalidIDToken indicates an ID token that failed signature, issuer, audience, expiry or nonce checks.
+	ErrInvalidIDToken = errors.New("invalid ID token")
+	// ErrOIDCDiscoveryFailed indicates the provider's OpenID configuration could not be loaded.
+	ErrOIDCDiscoveryFailed = errors.New("OpenID provider discovery failed")
+	// ErrOAuthEmailNotVerified indicates the provider did not assert a verified email address.
+	ErrOAuthEmailNotVerified = errors.New("identity provider did not return a verified email")
+	// ErrAccountEmailNotVerified indicates an existing account that cannot be linked until its email is verified.
+	ErrAccountEmailNotVerified = errors.New("an account with this email exists but its email is not verified")
+	// ErrIdentityNotFound indicates no account is linked to an external identity.
+	ErrIdentityNotFound = errors.New("external identity not found")
 
 	// ErrInvalidArgument indicates invalid input data (validation failed).
 	ErrInvalidArgument = errors.New("invalid argument")
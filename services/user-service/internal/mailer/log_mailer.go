@@ -0,0 +1,22 @@
+// Package mailer provides a logging Mailer for development environments.
+// It supports Single Responsibility and Liskov Substitution principles.
+package mailer
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+)
+
+// tokenParam matches the value of a "token" query parameter in emailed links.
+var tokenParam = regexp.MustCompile(`([?&]token=)[^&\s]+`)
+
+// LogMailer writes messages to the structured log instead of delivering them.
+// Tokens in links are redacted, since logs must never hold usable credentials.
+type LogMailer struct{}
+
+func (LogMailer) Send(_ context.Context, msg Message) error {
+	body := tokenParam.ReplaceAllString(msg.Body, "${1}[REDACTED]")
+	slog.Info("email not delivered (log mailer)", "to", msg.To, "subject", msg.Subject, "body", body)
+	return nil
+}
@@ -0,0 +1,35 @@
+// Package mailer provides abstractions and implementations for sending
+// transactional email. It supports Dependency Inversion and Interface
+// Segregation principles so services never depend on a concrete transport.
+package mailer
+
+import (
+	"context"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/config"
+)
+
+// Message is a plain-text email addressed to a single recipient.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer defines how outbound email is delivered. It enables Dependency
+// Inversion and Liskov Substitution for email transports.
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// NewMailer selects a Mailer implementation based on cfg.Driver. The "smtp"
+// driver relays through the configured server; any other value falls back to
+// LogMailer, which is suitable for local development.
+func NewMailer(cfg config.Mailer) Mailer {
+	switch cfg.Driver {
+	case "smtp":
+		return NewSMTPMailer(cfg)
+	default:
+		return LogMailer{}
+	}
+}
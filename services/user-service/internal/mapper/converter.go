@@ -4,6 +4,7 @@
 package mapper
 
 import (
+	socialpb "github.com/mamataliev-dev/social-platform/api/gen/social/v1"
 	userpb "github.com/mamataliev-dev/social-platform/api/gen/user/v1"
 	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
@@ -19,7 +20,51 @@ type Converter interface {
 	ToFetchUserByNicknameRequest(*userpb.FetchUserProfileByNicknameRequest) transport.FetchUserByNicknameRequest
 	ToFetchUserByIDRequest(*userpb.FetchUserProfileByIDRequest) transport.FetchUserByIDRequest
 	ToFetchUserProfileResponse(transport.UserProfileResponse) *userpb.UserProfile
+	ToSearchUsersRequest(*userpb.SearchUsersRequest) transport.SearchUsersRequest
+	ToSearchUsersResponse(hits []transport.UserSearchHit, nextPageToken string, viewerID int64) *userpb.SearchUsersResponse
+	ToPublicUserProfileResponse(u transport.UserProfileResponse, viewerID int64) *userpb.UserProfile
+	ToUpdatePrivacySettingsRequest(*userpb.UpdatePrivacySettingsRequest) transport.UpdatePrivacySettingsRequest
+	ToPrivacySettingsResponse(transport.PrivacySettings) *userpb.PrivacySettings
 	ToGetRefreshTokenRequest(*userauthpb.RefreshTokenPayload) transport.RefreshTokenRequest
 	ToLogoutResponse(transport.LogoutResponse) *userauthpb.LogoutResponse
 	ToRefreshTokenRequest(req *userauthpb.RefreshTokenPayload) transport.RefreshTokenRequest
+	ToVerifyEmailRequest(*userauthpb.VerifyEmailRequest) transport.VerifyEmailRequest
+	ToVerifyEmailResponse(transport.VerifyEmailResponse) *userauthpb.VerifyEmailResponse
+	ToResendVerificationEmailRequest(*userauthpb.ResendVerificationEmailRequest) transport.ResendVerificationEmailRequest
+	ToResendVerificationEmailResponse(transport.ResendVerificationEmailResponse) *userauthpb.ResendVerificationEmailResponse
+	ToListSessionsResponse(sessions []model.Session, currentSessionID string) *userauthpb.ListSessionsResponse
+	ToRevokeSessionResponse(transport.RevokeSessionResponse) *userauthpb.RevokeSessionResponse
+	ToRevokeAllOtherSessionsResponse(transport.RevokeAllOtherSessionsResponse) *userauthpb.RevokeAllOtherSessionsResponse
+	ToMFAChallengeResponse(mfaToken string) *userauthpb.AuthTokenResponse
+	ToVerifyMFARequest(*userauthpb.VerifyMFARequest) transport.VerifyMFARequest
+	ToEnrollTOTPResponse(transport.EnrollTOTPResponse) *userauthpb.EnrollTOTPResponse
+	ToConfirmTOTPResponse(transport.ConfirmTOTPResponse) *userauthpb.ConfirmTOTPResponse
+	ToDisableTOTPResponse(transport.DisableTOTPResponse) *userauthpb.DisableTOTPResponse
+	ToBeginOAuthLoginResponse(transport.BeginOAuthLoginResponse) *userauthpb.BeginOAuthLoginResponse
+	ToCompleteOAuthLoginRequest(*userauthpb.CompleteOAuthLoginRequest) transport.CompleteOAuthLoginRequest
+	ToChangePasswordRequest(*userauthpb.ChangePasswordRequest) transport.ChangePasswordRequest
+	ToChangePasswordResponse(transport.ChangePasswordResponse) *userauthpb.ChangePasswordResponse
+	ToRequestPasswordResetRequest(*userauthpb.RequestPasswordResetRequest) transport.RequestPasswordResetRequest
+	ToRequestPasswordResetResponse(transport.RequestPasswordResetResponse) *userauthpb.RequestPasswordResetResponse
+	ToResetPasswordRequest(*userauthpb.ResetPasswordRequest) transport.ResetPasswordRequest
+	ToResetPasswordResponse(transport.ResetPasswordResponse) *userauthpb.ResetPasswordResponse
+	ToDeleteMyAccountRequest(*userauthpb.DeleteMyAccountRequest) transport.DeleteMyAccountRequest
+	ToDeleteMyAccountResponse(transport.DeleteMyAccountResponse) *userauthpb.DeleteMyAccountResponse
+	ToListUserEventsResponse([]model.UserEvent) *userpb.ListUserEventsResponse
+	ToBatchFetchUserProfilesResponse(users []transport.UserProfileResponse, missingUserIDs []int64) *userpb.BatchFetchUserProfilesResponse
+	ToGetDataExportRequest(*userauthpb.GetDataExportRequest) transport.GetDataExportRequest
+	ToDataExportJobResponse(transport.DataExportJob) *userauthpb.DataExportJob
+	ToAdminUserResponse(model.AdminUser) *userauthpb.AdminUser
+	ToListUsersResponse([]model.AdminUser) *userauthpb.ListUsersResponse
+	ToForceLogoutResponse(revokedSessions int64) *userauthpb.ForceLogoutResponse
+	ToListFollowsRequest(*socialpb.ListFollowsRequest) transport.ListFollowsRequest
+	ToRelationshipResponse(transport.Relationship) *socialpb.Relationship
+	ToListFollowsResponse(entries []transport.FollowEntry, nextPageToken string, viewerID int64) *socialpb.ListFollowsResponse
+	ToListFollowRequestsResponse(entries []transport.FollowEntry, nextPageToken string, viewerID int64) *socialpb.ListFollowRequestsResponse
+	ToListFriendsRequest(*socialpb.ListFriendsRequest) transport.ListFriendsRequest
+	ToListMutualFriendsRequest(*socialpb.ListMutualFriendsRequest) transport.ListFriendsRequest
+	ToFriendshipResponse(transport.Friendship) *socialpb.Friendship
+	ToListFriendsResponse(entries []transport.FriendEntry, nextPageToken string, viewerID int64) *socialpb.ListFriendsResponse
+	ToListFriendRequestsResponse(entries []transport.FriendEntry, nextPageToken string, viewerID int64) *socialpb.ListFriendRequestsResponse
+	ToListFriendSuggestionsResponse(suggestions []transport.FriendSuggestion, viewerID int64) *socialpb.ListFriendSuggestionsResponse
 }
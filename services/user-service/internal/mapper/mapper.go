@@ -9,6 +9,7 @@ import (
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	socialpb "github.com/mamataliev-dev/social-platform/api/gen/social/v1"
 	userpb "github.com/mamataliev-dev/social-platform/api/gen/user/v1"
 	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
@@ -95,7 +96,9 @@ func (m *Mapper) ToFetchUserByIDRequest(req *userpb.FetchUserProfileByIDRequest)
 
 // ToFetchUserProfileResponse maps a transport UserProfileResponse DTO to a gRPC UserProfile.
 // It handles the conversion of domain data, including timestamps, into the
-// protobuf format expected by gRPC clients.
+// protobuf format expected by gRPC clients. Every field is included, so it is
+// only meant for the owner and trusted internal callers; public responses use
+// ToPublicUserProfileResponse.
 func (m *Mapper) ToFetchUserProfileResponse(u transport.UserProfileResponse) *userpb.UserProfile {
 	return &userpb.UserProfile{
 		UserId:    u.ID,
@@ -107,6 +110,112 @@ func (m *Mapper) ToFetchUserProfileResponse(u transport.UserProfileResponse) *us
 		CreatedAt: timestampOrNil(u.CreatedAt),
 		UpdatedAt: timestampOrNil(u.UpdatedAt),
 		LastLogin: timestampOrNil(u.LastLogin),
+
+		FollowerCount:  u.FollowerCount,
+		FollowingCount: u.FollowingCount,
+		PrivateAccount: u.Privacy.PrivateAccount,
+	}
+}
+
+// ToPublicUserProfileResponse maps a profile as seen by the user viewerID. The
+// owner sees every field; anyone else does not see the email, last login or
+// bio unless the owner's privacy settings make them visible to everyone.
+func (m *Mapper) ToPublicUserProfileResponse(u transport.UserProfileResponse, viewerID int64) *userpb.UserProfile {
+	resp := m.ToFetchUserProfileResponse(u)
+	if viewerID == u.ID {
+		return resp
+	}
+	if u.Privacy.Email != transport.VisibilityEveryone {
+		resp.Email = ""
+	}
+	if u.Privacy.LastLogin != transport.VisibilityEveryone {
+		resp.LastLogin = nil
+	}
+	if u.Privacy.Bio != transport.VisibilityEveryone {
+		resp.Bio = ""
+	}
+	return resp
+}
+
+// ToSearchUsersRequest maps a gRPC SearchUsersRequest to a transport
+// SearchUsersRequest DTO.
+func (m *Mapper) ToSearchUsersRequest(req *userpb.SearchUsersRequest) transport.SearchUsersRequest {
+	if req == nil {
+		return transport.SearchUsersRequest{}
+	}
+	return transport.SearchUsersRequest{
+		Query:     req.GetQuery(),
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	}
+}
+
+// ToSearchUsersResponse maps a page of search hits, as seen by the user
+// viewerID, to a gRPC SearchUsersResponse.
+func (m *Mapper) ToSearchUsersResponse(
+	hits []transport.UserSearchHit,
+	nextPageToken string,
+	viewerID int64,
+) *userpb.SearchUsersResponse {
+	resp := &userpb.SearchUsersResponse{
+		Users:         make([]*userpb.UserProfile, 0, len(hits)),
+		NextPageToken: nextPageToken,
+	}
+	for _, hit := range hits {
+		resp.Users = append(resp.Users, m.ToPublicUserProfileResponse(hit.Profile, viewerID))
+	}
+	return resp
+}
+
+// ToUpdatePrivacySettingsRequest maps a gRPC UpdatePrivacySettingsRequest to a
+// transport UpdatePrivacySettingsRequest DTO. Unspecified fields become empty
+// and are left unchanged.
+func (m *Mapper) ToUpdatePrivacySettingsRequest(req *userpb.UpdatePrivacySettingsRequest) transport.UpdatePrivacySettingsRequest {
+	if req == nil {
+		return transport.UpdatePrivacySettingsRequest{}
+	}
+	return transport.UpdatePrivacySettingsRequest{
+		Email:     toProfileVisibility(req.GetEmail()),
+		LastLogin: toProfileVisibility(req.GetLastLogin()),
+		Bio:       toProfileVisibility(req.GetBio()),
+
+		PrivateAccount: req.PrivateAccount,
+	}
+}
+
+// ToPrivacySettingsResponse maps transport PrivacySettings to a gRPC PrivacySettings.
+func (m *Mapper) ToPrivacySettingsResponse(settings transport.PrivacySettings) *userpb.PrivacySettings {
+	return &userpb.PrivacySettings{
+		Email:     fromProfileVisibility(settings.Email),
+		LastLogin: fromProfileVisibility(settings.LastLogin),
+		Bio:       fromProfileVisibility(settings.Bio),
+
+		PrivateAccount: settings.PrivateAccount,
+	}
+}
+
+// toProfileVisibility converts a gRPC visibility to its stored value, or ""
+// if it is unspecified.
+func toProfileVisibility(v userpb.ProfileFieldVisibility) transport.ProfileVisibility {
+	switch v {
+	case userpb.ProfileFieldVisibility_PROFILE_FIELD_VISIBILITY_EVERYONE:
+		return transport.VisibilityEveryone
+	case userpb.ProfileFieldVisibility_PROFILE_FIELD_VISIBILITY_ONLY_ME:
+		return transport.VisibilityOnlyMe
+	default:
+		return ""
+	}
+}
+
+// fromProfileVisibility converts a stored visibility to its gRPC value.
+func fromProfileVisibility(v transport.ProfileVisibility) userpb.ProfileFieldVisibility {
+	switch v {
+	case transport.VisibilityEveryone:
+		return userpb.ProfileFieldVisibility_PROFILE_FIELD_VISIBILITY_EVERYONE
+	case transport.VisibilityOnlyMe:
+		return userpb.ProfileFieldVisibility_PROFILE_FIELD_VISIBILITY_ONLY_ME
+	default:
+		return userpb.ProfileFieldVisibility_PROFILE_FIELD_VISIBILITY_UNSPECIFIED
 	}
 }
 
@@ -133,3 +242,467 @@ func (m *Mapper) ToRefreshTokenRequest(req *userauthpb.RefreshTokenPayload) tran
 		RefreshToken: req.GetRefreshToken(),
 	}
 }
+
+// ToVerifyEmailRequest maps a gRPC VerifyEmailRequest to a transport
+// VerifyEmailRequest DTO.
+func (m *Mapper) ToVerifyEmailRequest(req *userauthpb.VerifyEmailRequest) transport.VerifyEmailRequest {
+	if req == nil {
+		return transport.VerifyEmailRequest{}
+	}
+	return transport.VerifyEmailRequest{Token: req.GetToken()}
+}
+
+// ToVerifyEmailResponse maps a transport VerifyEmailResponse DTO to a gRPC VerifyEmailResponse.
+func (m *Mapper) ToVerifyEmailResponse(resp transport.VerifyEmailResponse) *userauthpb.VerifyEmailResponse {
+	return &userauthpb.VerifyEmailResponse{
+		Message: resp.Message,
+	}
+}
+
+// ToResendVerificationEmailRequest maps a gRPC ResendVerificationEmailRequest
+// to a transport ResendVerificationEmailRequest DTO.
+func (m *Mapper) ToResendVerificationEmailRequest(req *userauthpb.ResendVerificationEmailRequest) transport.ResendVerificationEmailRequest {
+	if req == nil {
+		return transport.ResendVerificationEmailRequest{}
+	}
+	return transport.ResendVerificationEmailRequest{Email: req.GetEmail()}
+}
+
+// ToResendVerificationEmailResponse maps a transport ResendVerificationEmailResponse
+// DTO to a gRPC ResendVerificationEmailResponse.
+func (m *Mapper) ToResendVerificationEmailResponse(resp transport.ResendVerificationEmailResponse) *userauthpb.ResendVerificationEmailResponse {
+	return &userauthpb.ResendVerificationEmailResponse{
+		Message: resp.Message,
+	}
+}
+
+// ToListSessionsResponse maps domain Sessions to a gRPC ListSessionsResponse,
+// marking the session whose ID matches currentSessionID.
+func (m *Mapper) ToListSessionsResponse(sessions []model.Session, currentSessionID string) *userauthpb.ListSessionsResponse {
+	resp := &userauthpb.ListSessionsResponse{
+		Sessions: make([]*userauthpb.Session, 0, len(sessions)),
+	}
+	for _, s := range sessions {
+		resp.Sessions = append(resp.Sessions, &userauthpb.Session{
+			SessionId:  s.ID,
+			UserAgent:  s.UserAgent,
+			IpAddress:  s.IPAddress,
+			Current:    s.ID == currentSessionID,
+			CreatedAt:  timestampOrNil(s.CreatedAt),
+			LastUsedAt: timestampOrNil(s.LastUsedAt),
+			ExpiresAt:  timestampOrNil(s.ExpiresAt),
+		})
+	}
+	return resp
+}
+
+// ToRevokeSessionResponse maps a transport RevokeSessionResponse DTO to a gRPC RevokeSessionResponse.
+func (m *Mapper) ToRevokeSessionResponse(resp transport.RevokeSessionResponse) *userauthpb.RevokeSessionResponse {
+	return &userauthpb.RevokeSessionResponse{
+		Message: resp.Message,
+	}
+}
+
+// ToRevokeAllOtherSessionsResponse maps a transport RevokeAllOtherSessionsResponse
+// DTO to a gRPC RevokeAllOtherSessionsResponse.
+func (m *Mapper) ToRevokeAllOtherSessionsResponse(resp transport.RevokeAllOtherSessionsResponse) *userauthpb.RevokeAllOtherSessionsResponse {
+	return &userauthpb.RevokeAllOtherSessionsResponse{
+		RevokedCount: resp.RevokedCount,
+	}
+}
+
+// ToMFAChallengeResponse maps an MFA challenge token to a gRPC AuthTokenResponse
+// that carries no tokens and tells the client to call VerifyMFA.
+func (m *Mapper) ToMFAChallengeResponse(mfaToken string) *userauthpb.AuthTokenResponse {
+	return &userauthpb.AuthTokenResponse{
+		MfaRequired: true,
+		MfaToken:    mfaToken,
+	}
+}
+
+// ToVerifyMFARequest maps a gRPC VerifyMFARequest to a transport VerifyMFARequest DTO.
+func (m *Mapper) ToVerifyMFARequest(req *userauthpb.VerifyMFARequest) transport.VerifyMFARequest {
+	if req == nil {
+		return transport.VerifyMFARequest{}
+	}
+	return transport.VerifyMFARequest{
+		MFAToken: req.GetMfaToken(),
+		Code:     req.GetCode(),
+	}
+}
+
+// ToEnrollTOTPResponse maps a transport EnrollTOTPResponse DTO to a gRPC EnrollTOTPResponse.
+func (m *Mapper) ToEnrollTOTPResponse(resp transport.EnrollTOTPResponse) *userauthpb.EnrollTOTPResponse {
+	return &userauthpb.EnrollTOTPResponse{
+		Secret:     resp.Secret,
+		OtpauthUri: resp.OTPAuthURI,
+	}
+}
+
+// ToConfirmTOTPResponse maps a transport ConfirmTOTPResponse DTO to a gRPC ConfirmTOTPResponse.
+func (m *Mapper) ToConfirmTOTPResponse(resp transport.ConfirmTOTPResponse) *userauthpb.ConfirmTOTPResponse {
+	return &userauthpb.ConfirmTOTPResponse{
+		RecoveryCodes: resp.RecoveryCodes,
+	}
+}
+
+// ToDisableTOTPResponse maps a transport DisableTOTPResponse DTO to a gRPC DisableTOTPResponse.
+func (m *Mapper) ToDisableTOTPResponse(resp transport.DisableTOTPResponse) *userauthpb.DisableTOTPResponse {
+	return &userauthpb.DisableTOTPResponse{
+		Message: resp.Message,
+	}
+}
+
+// ToBeginOAuthLoginResponse maps a transport BeginOAuthLoginResponse DTO to a gRPC BeginOAuthLoginResponse.
+func (m *Mapper) ToBeginOAuthLoginResponse(resp transport.BeginOAuthLoginResponse) *userauthpb.BeginOAuthLoginResponse {
+	return &userauthpb.BeginOAuthLoginResponse{
+		AuthorizationUrl: resp.AuthorizationURL,
+		State:            resp.State,
+	}
+}
+
+// ToCompleteOAuthLoginRequest maps a gRPC CompleteOAuthLoginRequest to a transport CompleteOAuthLoginRequest DTO.
+func (m *Mapper) ToCompleteOAuthLoginRequest(req *userauthpb.CompleteOAuthLoginRequest) transport.CompleteOAuthLoginRequest {
+	if req == nil {
+		return transport.CompleteOAuthLoginRequest{}
+	}
+	return transport.CompleteOAuthLoginRequest{
+		Provider: req.GetProvider(),
+		State:    req.GetState(),
+		Code:     req.GetCode(),
+	}
+}
+
+// ToChangePasswordRequest maps a gRPC ChangePasswordRequest to a transport ChangePasswordRequest DTO.
+func (m *Mapper) ToChangePasswordRequest(req *userauthpb.ChangePasswordRequest) transport.ChangePasswordRequest {
+	if req == nil {
+		return transport.ChangePasswordRequest{}
+	}
+	return transport.ChangePasswordRequest{
+		CurrentPassword: req.GetCurrentPassword(),
+		NewPassword:     req.GetNewPassword(),
+	}
+}
+
+// ToChangePasswordResponse maps a transport ChangePasswordResponse DTO to a gRPC ChangePasswordResponse.
+func (m *Mapper) ToChangePasswordResponse(resp transport.ChangePasswordResponse) *userauthpb.ChangePasswordResponse {
+	return &userauthpb.ChangePasswordResponse{
+		Message: resp.Message,
+	}
+}
+
+// ToRequestPasswordResetRequest maps a gRPC RequestPasswordResetRequest to a
+// transport RequestPasswordResetRequest DTO.
+func (m *Mapper) ToRequestPasswordResetRequest(req *userauthpb.RequestPasswordResetRequest) transport.RequestPasswordResetRequest {
+	if req == nil {
+		return transport.RequestPasswordResetRequest{}
+	}
+	return transport.RequestPasswordResetRequest{Email: req.GetEmail()}
+}
+
+// ToRequestPasswordResetResponse maps a transport RequestPasswordResetResponse
+// DTO to a gRPC RequestPasswordResetResponse.
+func (m *Mapper) ToRequestPasswordResetResponse(resp transport.RequestPasswordResetResponse) *userauthpb.RequestPasswordResetResponse {
+	return &userauthpb.RequestPasswordResetResponse{
+		Message: resp.Message,
+	}
+}
+
+// ToResetPasswordRequest maps a gRPC ResetPasswordRequest to a transport ResetPasswordRequest DTO.
+func (m *Mapper) ToResetPasswordRequest(req *userauthpb.ResetPasswordRequest) transport.ResetPasswordRequest {
+	if req == nil {
+		return transport.ResetPasswordRequest{}
+	}
+	return transport.ResetPasswordRequest{
+		Token:       req.GetToken(),
+		NewPassword: req.GetNewPassword(),
+	}
+}
+
+// ToResetPasswordResponse maps a transport ResetPasswordResponse DTO to a gRPC ResetPasswordResponse.
+func (m *Mapper) ToResetPasswordResponse(resp transport.ResetPasswordResponse) *userauthpb.ResetPasswordResponse {
+	return &userauthpb.ResetPasswordResponse{
+		Message: resp.Message,
+	}
+}
+
+// ToDeleteMyAccountRequest maps a gRPC DeleteMyAccountRequest to a transport DeleteMyAccountRequest DTO.
+func (m *Mapper) ToDeleteMyAccountRequest(req *userauthpb.DeleteMyAccountRequest) transport.DeleteMyAccountRequest {
+	if req == nil {
+		return transport.DeleteMyAccountRequest{}
+	}
+	return transport.DeleteMyAccountRequest{Password: req.GetPassword()}
+}
+
+// ToDeleteMyAccountResponse maps a transport DeleteMyAccountResponse DTO to a gRPC DeleteMyAccountResponse.
+func (m *Mapper) ToDeleteMyAccountResponse(resp transport.DeleteMyAccountResponse) *userauthpb.DeleteMyAccountResponse {
+	return &userauthpb.DeleteMyAccountResponse{
+		Message:    resp.Message,
+		PurgeAfter: timestampOrNil(resp.PurgeAfter),
+	}
+}
+
+// ToBatchFetchUserProfilesResponse maps the profiles found by a batch lookup
+// and the IDs that were not found to a gRPC BatchFetchUserProfilesResponse.
+func (m *Mapper) ToBatchFetchUserProfilesResponse(
+	users []transport.UserProfileResponse,
+	missingUserIDs []int64,
+) *userpb.BatchFetchUserProfilesResponse {
+	resp := &userpb.BatchFetchUserProfilesResponse{
+		Profiles:       make([]*userpb.UserProfile, 0, len(users)),
+		MissingUserIds: missingUserIDs,
+	}
+	for _, u := range users {
+		resp.Profiles = append(resp.Profiles, m.ToFetchUserProfileResponse(u))
+	}
+	return resp
+}
+
+// ToListUserEventsResponse maps domain UserEvents to a gRPC ListUserEventsResponse.
+func (m *Mapper) ToListUserEventsResponse(events []model.UserEvent) *userpb.ListUserEventsResponse {
+	resp := &userpb.ListUserEventsResponse{
+		Events: make([]*userpb.UserEvent, 0, len(events)),
+	}
+	for _, e := range events {
+		resp.Events = append(resp.Events, &userpb.UserEvent{
+			Id:            e.ID,
+			Type:          e.Type,
+			UserId:        e.UserID,
+			OccurredAt:    timestampOrNil(e.OccurredAt),
+			SubjectUserId: e.SubjectUserID,
+		})
+	}
+	return resp
+}
+
+// ToGetDataExportRequest maps a gRPC GetDataExportRequest to a transport GetDataExportRequest DTO.
+func (m *Mapper) ToGetDataExportRequest(req *userauthpb.GetDataExportRequest) transport.GetDataExportRequest {
+	if req == nil {
+		return transport.GetDataExportRequest{}
+	}
+	return transport.GetDataExportRequest{JobID: req.GetJobId()}
+}
+
+// ToDataExportJobResponse maps a transport DataExportJob DTO to a gRPC DataExportJob.
+func (m *Mapper) ToDataExportJobResponse(job transport.DataExportJob) *userauthpb.DataExportJob {
+	return &userauthpb.DataExportJob{
+		JobId:       job.JobID,
+		Status:      job.Status,
+		CreatedAt:   timestampOrNil(job.CreatedAt),
+		CompletedAt: timestampOrNil(job.CompletedAt),
+		ExpiresAt:   timestampOrNil(job.ExpiresAt),
+		DownloadUrl: job.DownloadURL,
+	}
+}
+
+// ToAdminUserResponse maps a domain AdminUser to a gRPC AdminUser.
+func (m *Mapper) ToAdminUserResponse(u model.AdminUser) *userauthpb.AdminUser {
+	return &userauthpb.AdminUser{
+		UserId:          u.ID,
+		Nickname:        u.Nickname,
+		Email:           u.Email,
+		Roles:           u.Roles,
+		CreatedAt:       timestampOrNil(u.CreatedAt),
+		SuspendedAt:     timestampOrNil(u.SuspendedAt),
+		SuspendedReason: u.SuspendedReason,
+	}
+}
+
+// ToListUsersResponse maps domain AdminUsers to a gRPC ListUsersResponse.
+func (m *Mapper) ToListUsersResponse(users []model.AdminUser) *userauthpb.ListUsersResponse {
+	resp := &userauthpb.ListUsersResponse{
+		Users: make([]*userauthpb.AdminUser, 0, len(users)),
+	}
+	for _, u := range users {
+		resp.Users = append(resp.Users, m.ToAdminUserResponse(u))
+	}
+	return resp
+}
+
+// ToForceLogoutResponse maps the number of revoked sessions to a gRPC ForceLogoutResponse.
+func (m *Mapper) ToForceLogoutResponse(revokedSessions int64) *userauthpb.ForceLogoutResponse {
+	return &userauthpb.ForceLogoutResponse{RevokedSessions: revokedSessions}
+}
+
+// ToListFollowsRequest maps a gRPC ListFollowsRequest to a transport
+// ListFollowsRequest DTO.
+func (m *Mapper) ToListFollowsRequest(req *socialpb.ListFollowsRequest) transport.ListFollowsRequest {
+	if req == nil {
+		return transport.ListFollowsRequest{}
+	}
+	return transport.ListFollowsRequest{
+		UserID:    req.GetUserId(),
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	}
+}
+
+// ToRelationshipResponse maps a transport Relationship DTO to a gRPC Relationship.
+func (m *Mapper) ToRelationshipResponse(rel transport.Relationship) *socialpb.Relationship {
+	return &socialpb.Relationship{
+		UserId:      rel.UserID,
+		OtherUserId: rel.OtherUserID,
+		Following:   toFollowState(rel.Following),
+		FollowedBy:  toFollowState(rel.FollowedBy),
+	}
+}
+
+// ToListFollowsResponse maps a page of followers or followed users, as seen by
+// the user viewerID, to a gRPC ListFollowsResponse.
+func (m *Mapper) ToListFollowsResponse(
+	entries []transport.FollowEntry,
+	nextPageToken string,
+	viewerID int64,
+) *socialpb.ListFollowsResponse {
+	return &socialpb.ListFollowsResponse{
+		Follows:       m.toFollows(entries, viewerID),
+		NextPageToken: nextPageToken,
+	}
+}
+
+// ToListFollowRequestsResponse maps a page of pending follow requests, as seen
+// by the user viewerID, to a gRPC ListFollowRequestsResponse.
+func (m *Mapper) ToListFollowRequestsResponse(
+	entries []transport.FollowEntry,
+	nextPageToken string,
+	viewerID int64,
+) *socialpb.ListFollowRequestsResponse {
+	return &socialpb.ListFollowRequestsResponse{
+		Requests:      m.toFollows(entries, viewerID),
+		NextPageToken: nextPageToken,
+	}
+}
+
+// toFollows maps follow list entries with public profiles.
+func (m *Mapper) toFollows(entries []transport.FollowEntry, viewerID int64) []*socialpb.Follow {
+	follows := make([]*socialpb.Follow, 0, len(entries))
+	for _, e := range entries {
+		follows = append(follows, &socialpb.Follow{
+			User:      m.ToPublicUserProfileResponse(e.Profile, viewerID),
+			CreatedAt: timestampOrNil(e.CreatedAt),
+		})
+	}
+	return follows
+}
+
+// toFollowState converts a transport FollowState to its gRPC value.
+func toFollowState(state transport.FollowState) socialpb.FollowState {
+	switch state {
+	case transport.FollowStateNone:
+		return socialpb.FollowState_FOLLOW_STATE_NONE
+	case transport.FollowStateRequested:
+		return socialpb.FollowState_FOLLOW_STATE_REQUESTED
+	case transport.FollowStateFollowing:
+		return socialpb.FollowState_FOLLOW_STATE_FOLLOWING
+	default:
+		return socialpb.FollowState_FOLLOW_STATE_UNSPECIFIED
+	}
+}
+
+// ToListFriendsRequest maps a gRPC ListFriendsRequest to a transport
+// ListFriendsRequest DTO.
+func (m *Mapper) ToListFriendsRequest(req *socialpb.ListFriendsRequest) transport.ListFriendsRequest {
+	if req == nil {
+		return transport.ListFriendsRequest{}
+	}
+	return transport.ListFriendsRequest{
+		UserID:    req.GetUserId(),
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	}
+}
+
+// ToListMutualFriendsRequest maps a gRPC ListMutualFriendsRequest to a
+// transport ListFriendsRequest DTO.
+func (m *Mapper) ToListMutualFriendsRequest(req *socialpb.ListMutualFriendsRequest) transport.ListFriendsRequest {
+	if req == nil {
+		return transport.ListFriendsRequest{}
+	}
+	return transport.ListFriendsRequest{
+		UserID:      req.GetUserId(),
+		OtherUserID: req.GetOtherUserId(),
+		PageSize:    int(req.GetPageSize()),
+		PageToken:   req.GetPageToken(),
+	}
+}
+
+// ToFriendshipResponse maps a transport Friendship DTO to a gRPC Friendship.
+func (m *Mapper) ToFriendshipResponse(f transport.Friendship) *socialpb.Friendship {
+	return &socialpb.Friendship{
+		UserId:      f.UserID,
+		OtherUserId: f.OtherUserID,
+		State:       toFriendshipState(f.State),
+	}
+}
+
+// ToListFriendsResponse maps a page of friends or mutual friends, as seen by
+// the user viewerID, to a gRPC ListFriendsResponse.
+func (m *Mapper) ToListFriendsResponse(
+	entries []transport.FriendEntry,
+	nextPageToken string,
+	viewerID int64,
+) *socialpb.ListFriendsResponse {
+	return &socialpb.ListFriendsResponse{
+		Friends:       m.toFriends(entries, viewerID),
+		NextPageToken: nextPageToken,
+	}
+}
+
+// ToListFriendRequestsResponse maps a page of pending friend requests, as seen
+// by the user viewerID, to a gRPC ListFriendRequestsResponse.
+func (m *Mapper) ToListFriendRequestsResponse(
+	entries []transport.FriendEntry,
+	nextPageToken string,
+	viewerID int64,
+) *socialpb.ListFriendRequestsResponse {
+	return &socialpb.ListFriendRequestsResponse{
+		Requests:      m.toFriends(entries, viewerID),
+		NextPageToken: nextPageToken,
+	}
+}
+
+// ToListFriendSuggestionsResponse maps friend suggestions, as seen by the user
+// viewerID, to a gRPC ListFriendSuggestionsResponse.
+func (m *Mapper) ToListFriendSuggestionsResponse(
+	suggestions []transport.FriendSuggestion,
+	viewerID int64,
+) *socialpb.ListFriendSuggestionsResponse {
+	resp := &socialpb.ListFriendSuggestionsResponse{
+		Suggestions: make([]*socialpb.FriendSuggestion, 0, len(suggestions)),
+	}
+	for _, s := range suggestions {
+		resp.Suggestions = append(resp.Suggestions, &socialpb.FriendSuggestion{
+			User:              m.ToPublicUserProfileResponse(s.Profile, viewerID),
+			MutualFriendCount: int32(s.MutualFriendCount),
+		})
+	}
+	return resp
+}
+
+// toFriends maps friend list entries with public profiles.
+func (m *Mapper) toFriends(entries []transport.FriendEntry, viewerID int64) []*socialpb.Friend {
+	friends := make([]*socialpb.Friend, 0, len(entries))
+	for _, e := range entries {
+		friends = append(friends, &socialpb.Friend{
+			User:      m.ToPublicUserProfileResponse(e.Profile, viewerID),
+			CreatedAt: timestampOrNil(e.CreatedAt),
+		})
+	}
+	return friends
+}
+
+// toFriendshipState converts a transport FriendshipState to its gRPC value.
+func toFriendshipState(state transport.FriendshipState) socialpb.FriendshipState {
+	switch state {
+	case transport.FriendshipStateNone:
+		return socialpb.FriendshipState_FRIENDSHIP_STATE_NONE
+	case transport.FriendshipStateRequestSent:
+		return socialpb.FriendshipState_FRIENDSHIP_STATE_REQUEST_SENT
+	case transport.FriendshipStateRequestReceived:
+		return socialpb.FriendshipState_FRIENDSHIP_STATE_REQUEST_RECEIVED
+	case transport.FriendshipStateFriends:
+		return socialpb.FriendshipState_FRIENDSHIP_STATE_FRIENDS
+	default:
+		return socialpb.FriendshipState_FRIENDSHIP_STATE_UNSPECIFIED
+	}
+}
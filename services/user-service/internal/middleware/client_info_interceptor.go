@@ -0,0 +1,21 @@
+// Package middleware provides a gRPC interceptor that resolves the caller's
+// device metadata once per request. It supports Single Responsibility.
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
+)
+
+// ClientInfoInterceptor returns an interceptor that attaches the caller's user
+// agent and IP address to the context. x-forwarded-for is only believed when
+// it was appended by one of trusted, so clients cannot spoof the IP address
+// that login throttling and sessions record.
+func ClientInfoInterceptor(trusted utils.TrustedProxies) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(utils.ContextWithClientInfo(ctx, utils.ResolveClientInfo(ctx, trusted)), req)
+	}
+}
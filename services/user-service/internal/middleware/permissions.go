@@ -0,0 +1,52 @@
+// Package middleware declares which roles may call each authenticated RPC.
+// Keeping the policy in one table supports the Open/Closed principle: new
+// RPCs are granted access here without touching the interceptors.
+package middleware
+
+import "github.com/mamataliev-dev/social-platform/pkg/auth"
+
+// staffRoles may moderate accounts.
+var staffRoles = []string{auth.RoleModerator, auth.RoleAdmin}
+
+// methodPermissions lists the roles allowed to call every method that requires
+// a user JWT. Public endpoints are not listed; any other method missing from
+// the table is denied. Internal RPCs are served on a separate listener and
+// authenticated by ServiceTokenInterceptor.
+var methodPermissions = auth.MethodPolicy{
+	"/user.auth.v1.AuthService/EnrollTOTP":             {auth.RoleUser},
+	"/user.auth.v1.AuthService/ConfirmTOTP":            {auth.RoleUser},
+	"/user.auth.v1.AuthService/DisableTOTP":            {auth.RoleUser},
+	"/user.auth.v1.AuthService/ListSessions":           {auth.RoleUser},
+	"/user.auth.v1.AuthService/RevokeSession":          {auth.RoleUser},
+	"/user.auth.v1.AuthService/RevokeAllOtherSessions": {auth.RoleUser},
+	"/user.auth.v1.AuthService/ChangePassword":         {auth.RoleUser},
+	"/user.auth.v1.AuthService/DeleteMyAccount":        {auth.RoleUser},
+	"/user.auth.v1.AuthService/ExportMyData":           {auth.RoleUser},
+	"/user.auth.v1.AuthService/GetDataExport":          {auth.RoleUser},
+
+	"/user.v1.UserService/FetchUserProfileByNickname": {auth.RoleUser},
+	"/user.v1.UserService/SearchUsers":                {auth.RoleUser},
+	"/user.v1.UserService/GetPrivacySettings":         {auth.RoleUser},
+	"/user.v1.UserService/UpdatePrivacySettings":      {auth.RoleUser},
+
+	"/social.v1.SocialGraphService/Follow":                 {auth.RoleUser},
+	"/social.v1.SocialGraphService/Unfollow":               {auth.RoleUser},
+	"/social.v1.SocialGraphService/ListFollowers":          {auth.RoleUser},
+	"/social.v1.SocialGraphService/ListFollowing":          {auth.RoleUser},
+	"/social.v1.SocialGraphService/GetRelationship":        {auth.RoleUser},
+	"/social.v1.SocialGraphService/ListFollowRequests":     {auth.RoleUser},
+	"/social.v1.SocialGraphService/ApproveFollowRequest":   {auth.RoleUser},
+	"/social.v1.SocialGraphService/DenyFollowRequest":      {auth.RoleUser},
+	"/social.v1.SocialGraphService/SendFriendRequest":      {auth.RoleUser},
+	"/social.v1.SocialGraphService/RespondToFriendRequest": {auth.RoleUser},
+	"/social.v1.SocialGraphService/ListFriendRequests":     {auth.RoleUser},
+	"/social.v1.SocialGraphService/RemoveFriend":           {auth.RoleUser},
+	"/social.v1.SocialGraphService/ListFriends":            {auth.RoleUser},
+	"/social.v1.SocialGraphService/ListMutualFriends":      {auth.RoleUser},
+	"/social.v1.SocialGraphService/ListFriendSuggestions":  {auth.RoleUser},
+
+	"/user.auth.v1.AdminService/ListUsers":     staffRoles,
+	"/user.auth.v1.AdminService/SuspendUser":   staffRoles,
+	"/user.auth.v1.AdminService/UnsuspendUser": staffRoles,
+	"/user.auth.v1.AdminService/ForceLogout":   {auth.RoleAdmin},
+}
@@ -0,0 +1,45 @@
+// Package middleware provides gRPC interceptors for service-to-service
+// authentication. It supports Single Responsibility and Open/Closed principles.
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
+)
+
+// ServiceTokenInterceptor returns an interceptor for the internal gRPC server
+// that requires a signed service token on every call. Tokens are checked by
+// verifier (signature, audience and a lifetime of at most
+// auth.ServiceTokenTTL), and the name of the calling service is attached to
+// the context; a nil verifier rejects every call.
+func ServiceTokenInterceptor(verifier *auth.ServiceTokenVerifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, errs.ErrMissingMetadata.Error())
+		}
+
+		values := md[auth.ServiceTokenHeader]
+		if len(values) == 0 {
+			return nil, status.Error(codes.Unauthenticated, errs.ErrMissingServiceToken.Error())
+		}
+
+		if verifier == nil {
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidServiceToken.Error())
+		}
+		caller, err := verifier.Verify(values[0])
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidServiceToken.Error())
+		}
+
+		return handler(utils.ContextWithCallerService(ctx, caller), req)
+	}
+}
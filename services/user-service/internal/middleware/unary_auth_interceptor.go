@@ -4,53 +4,80 @@ package middleware
 
 import (
 	"context"
-	"os"
+	"log/slog"
 	"strings"
 
-	"github.com/golang-jwt/jwt/v5"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
 )
 
-func UnaryAuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+// UnaryAuthInterceptor returns an interceptor that enforces JWT authentication
+// on every non-public method of the public gRPC server. Tokens are checked by
+// the shared verifier (signature, algorithm, expiry, issuer and audience), the
+// caller's roles are checked against methodPermissions, and the caller's
+// identity is attached to the context for the services.
+func UnaryAuthInterceptor(verifier *auth.Verifier) grpc.UnaryServerInterceptor {
 	publicEndpoints := map[string]bool{
-		"/user.auth.v1.AuthService/Register":     true,
-		"/user.auth.v1.AuthService/Login":        true,
-		"/user.auth.v1.AuthService/Logout":       true,
-		"/user.auth.v1.AuthService/RefreshToken": true,
+		"/user.auth.v1.AuthService/Register":                true,
+		"/user.auth.v1.AuthService/Login":                   true,
+		"/user.auth.v1.AuthService/Logout":                  true,
+		"/user.auth.v1.AuthService/RefreshToken":            true,
+		"/user.auth.v1.AuthService/VerifyEmail":             true,
+		"/user.auth.v1.AuthService/ResendVerificationEmail": true,
+		"/user.auth.v1.AuthService/VerifyMFA":               true,
+		"/user.auth.v1.AuthService/BeginOAuthLogin":         true,
+		"/user.auth.v1.AuthService/CompleteOAuthLogin":      true,
+		"/user.auth.v1.AuthService/RequestPasswordReset":    true,
+		"/user.auth.v1.AuthService/ResetPassword":           true,
 	}
 
-	if publicEndpoints[info.FullMethod] {
-		return handler(ctx, req)
-	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if publicEndpoints[info.FullMethod] {
+			return handler(ctx, req)
+		}
 
-	var jwtSecret = []byte(os.Getenv("JWT_SECRET"))
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, errs.ErrMissingMetadata.Error())
+		}
 
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingMetadata.Error())
-	}
+		authHeader := md["authorization"]
+		if len(authHeader) == 0 {
+			return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+		}
 
-	authHeader := md["authorization"]
-	if len(authHeader) == 0 {
-		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
-	}
+		tokenStr := strings.TrimPrefix(authHeader[0], "Bearer ")
+		claims, err := verifier.Verify(tokenStr)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidToken.Error())
+		}
 
-	tokenStr := strings.TrimPrefix(authHeader[0], "Bearer ")
-	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, status.Error(codes.Unauthenticated, errs.ErrUnexpectedSigningMethod.Error())
+		if err := methodPermissions.Authorize(info.FullMethod, claims); err != nil {
+			slog.Warn("security event: permission denied",
+				"event", "permission_denied",
+				"method", info.FullMethod,
+				"subject", claims.Subject,
+				"reason", err,
+			)
+			return nil, status.Error(codes.PermissionDenied, errs.ErrPermissionDenied.Error())
 		}
-		return jwtSecret, nil
-	})
 
-	if err != nil || !token.Valid {
-		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidToken.Error())
+		ctx = auth.ContextWithClaims(ctx, claims)
+		ctx = utils.ContextWithAuthInfo(ctx, authInfoFromClaims(claims))
+
+		return handler(ctx, req)
 	}
+}
 
-	return handler(ctx, req)
+// authInfoFromClaims extracts the caller's user ID and session ID. The verifier
+// has already ensured that the subject is a valid user ID.
+func authInfoFromClaims(claims *auth.Claims) utils.AuthInfo {
+	userID, _ := claims.UserID()
+	return utils.AuthInfo{UserID: userID, SessionID: claims.SessionID}
 }
@@ -0,0 +1,62 @@
+// Package model defines the account lifecycle events and the repository
+// interfaces for account deletion. It enables Dependency Inversion and Liskov
+// Substitution for account storage.
+package model
+
+import (
+	"context"
+	"time"
+)
+
+// UserEventDeleted is emitted when a user deletes their account. Consumers
+// must remove or anonymize the user's data.
+const UserEventDeleted = "user.deleted"
+
+// UserEventFollowed and UserEventUnfollowed are emitted when UserID starts or
+// stops following SubjectUserID.
+const (
+	UserEventFollowed   = "user.followed"
+	UserEventUnfollowed = "user.unfollowed"
+)
+
+// UserEvent records a change to an account that other services react to.
+type UserEvent struct {
+	ID            int64     // Monotonically increasing cursor
+	Type          string    // Event type, e.g. UserEventDeleted
+	UserID        int64     // The account the event is about
+	SubjectUserID int64     // The other account involved, 0 if none
+	OccurredAt    time.Time // When the change happened
+}
+
+// AccountRepository defines how accounts are deleted: deactivated first, and
+// purged for good after a grace period.
+type AccountRepository interface {
+	// SoftDeleteUser deactivates the user, revokes all of their sessions and
+	// outstanding tokens, drops their data exports, follows and friendships
+	// and records a UserEventDeleted event, atomically. Returns the deletion
+	// time, or ErrUserNotFound if the user does not exist or is already
+	// deleted.
+	SoftDeleteUser(ctx context.Context, userID int64) (time.Time, error)
+
+	// PurgeDeletedUsers permanently removes users deleted before cutoff
+	// together with their dependent rows and returns how many were removed.
+	PurgeDeletedUsers(ctx context.Context, cutoff time.Time) (int64, error)
+}
+
+// UserEventRepository defines read access to, and retention of, the account
+// event log that other services consume.
+type UserEventRepository interface {
+	// ListUserEvents returns up to limit events with an ID greater than
+	// afterID, in ascending ID order. It stops before a gap in IDs that an
+	// uncommitted transaction may still fill, so a consumer's cursor never
+	// moves past an event it has not seen.
+	ListUserEvents(ctx context.Context, afterID int64, limit int) ([]UserEvent, error)
+
+	// SaveUserEventCursor records that consumer has handled every event up to
+	// and including afterID.
+	SaveUserEventCursor(ctx context.Context, consumer string, afterID int64) error
+
+	// PruneUserEvents removes events that occurred before cutoff and that
+	// every known consumer has handled, and returns how many were removed.
+	PruneUserEvents(ctx context.Context, cutoff time.Time) (int64, error)
+}
@@ -0,0 +1,40 @@
+// Package model defines the account view used by moderators and the repository
+// interface for account moderation. It enables Dependency Inversion and Liskov
+// Substitution for moderation storage.
+package model
+
+import (
+	"context"
+	"time"
+)
+
+// AdminUser is an account as shown to moderators and administrators.
+type AdminUser struct {
+	ID              int64     // Unique identifier
+	Nickname        string    // Public nickname
+	Email           string    // Account email address
+	Roles           []string  // Elevated roles; every account implicitly holds "user"
+	CreatedAt       time.Time // Account creation timestamp
+	SuspendedAt     time.Time // Timestamp of suspension; zero while active
+	SuspendedReason string    // Reason given by the moderator; empty while active
+}
+
+// AdminRepository defines account listing and suspension for moderators.
+// Deleted accounts are treated as not found.
+type AdminRepository interface {
+	// ListUsers returns up to limit accounts with an ID greater than afterID,
+	// in ascending ID order.
+	ListUsers(ctx context.Context, afterID int64, limit int) ([]AdminUser, error)
+
+	// GetUser returns a single account; ErrUserNotFound if it does not exist.
+	GetUser(ctx context.Context, userID int64) (AdminUser, error)
+
+	// SuspendUser marks the account suspended with reason and revokes all of
+	// its sessions, atomically. Suspending an already suspended account only
+	// updates the reason. Returns ErrUserNotFound if the account does not exist.
+	SuspendUser(ctx context.Context, userID int64, reason string) (AdminUser, error)
+
+	// UnsuspendUser lifts a suspension; ErrUserNotFound if the account does
+	// not exist.
+	UnsuspendUser(ctx context.Context, userID int64) (AdminUser, error)
+}
@@ -0,0 +1,89 @@
+// Package model defines the data export job and the repository interfaces
+// used to build it. It enables Dependency Inversion and Liskov Substitution
+// for export storage and for the chat-service data source.
+package model
+
+import (
+	"context"
+	"time"
+)
+
+// Data export job states.
+const (
+	DataExportPending    = "pending"
+	DataExportProcessing = "processing"
+	DataExportReady      = "ready"
+	DataExportFailed     = "failed"
+)
+
+// DataExport is an asynchronous job building a zip archive of a user's data.
+type DataExport struct {
+	ID          string    // Job UUID
+	UserID      int64     // The exported account
+	Status      string    // One of the DataExport* states
+	CreatedAt   time.Time // When the export was requested
+	CompletedAt time.Time // When the job finished; zero while running
+	ExpiresAt   time.Time // When the archive is deleted; zero while running
+}
+
+// DataExportRepository defines persistence for data export jobs and their
+// archives.
+type DataExportRepository interface {
+	// CreateDataExport queues a new export for the user, or returns the
+	// user's pending or processing export if there is one.
+	CreateDataExport(ctx context.Context, userID int64) (DataExport, error)
+
+	// GetDataExport returns the user's export with the given ID, or
+	// ErrExportNotFound.
+	GetDataExport(ctx context.Context, id string, userID int64) (DataExport, error)
+
+	// ClaimDataExport marks the oldest pending export, or a processing export
+	// started before staleBefore, as processing and returns it. Returns
+	// ErrExportNotFound if there is nothing to do.
+	ClaimDataExport(ctx context.Context, staleBefore time.Time) (DataExport, error)
+
+	// CompleteDataExport stores the archive and marks the export ready until
+	// expiresAt.
+	CompleteDataExport(ctx context.Context, id string, archive []byte, expiresAt time.Time) error
+
+	// FailDataExport marks the export failed; the record is kept until
+	// expiresAt.
+	FailDataExport(ctx context.Context, id string, expiresAt time.Time) error
+
+	// FetchDataExportArchive returns the archive of the user's ready,
+	// unexpired export, or ErrExportNotFound.
+	FetchDataExportArchive(ctx context.Context, id string, userID int64) ([]byte, error)
+
+	// PruneDataExports removes exports that expired before cutoff and returns
+	// how many were removed.
+	PruneDataExports(ctx context.Context, cutoff time.Time) (int64, error)
+}
+
+// ChatRoom is a chat room the exported user belongs to.
+type ChatRoom struct {
+	ID            string    // Room UUID
+	InitiatorID   int64     // Room creator; 0 if the account was deleted
+	ParticipantID int64     // Other participant; 0 if the account was deleted
+	CreatedAt     time.Time // Room creation time
+}
+
+// ChatMessage is a chat message the exported user sent.
+type ChatMessage struct {
+	ID        string    // Message UUID
+	RoomID    string    // Room the message was posted in
+	Content   string    // Message text
+	CreatedAt time.Time // When the message was sent
+}
+
+// ChatData is the user's data held by chat-service.
+type ChatData struct {
+	Rooms    []ChatRoom
+	Messages []ChatMessage
+}
+
+// ChatDataSource fetches a user's data from chat-service.
+type ChatDataSource interface {
+	// ExportUserChatData returns the rooms the user belongs to and the
+	// messages they sent.
+	ExportUserChatData(ctx context.Context, userID int64) (ChatData, error)
+}
@@ -0,0 +1,57 @@
+// Package model defines the persistence contract for the follow graph. It
+// enables Dependency Inversion by abstracting storage of follows and follow
+// requests, supporting Interface Segregation and Liskov Substitution.
+package model
+
+import (
+	"context"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+)
+
+// FollowRepository stores who follows whom and pending follow requests, and
+// keeps the follower and following counts of every user in step. Deleted
+// accounts are treated as not found and never listed.
+type FollowRepository interface {
+	// Follow makes followerID follow followeeID, or records a follow request if
+	// followeeID is a private account. Existing follows and requests are left
+	// as they are. A new follow records a UserEventFollowed event. Returns
+	// ErrUserNotFound if followeeID is not a live, unsuspended account.
+	Follow(ctx context.Context, followerID, followeeID int64) error
+
+	// Unfollow removes the follow of followerID on followeeID and any pending
+	// request between them in that direction, recording a UserEventUnfollowed
+	// event if a follow was removed. Missing rows are not an error.
+	Unfollow(ctx context.Context, followerID, followeeID int64) error
+
+	// GetRelationship reports how userID and otherUserID are connected;
+	// returns ErrUserNotFound unless both are live accounts.
+	GetRelationship(ctx context.Context, userID, otherUserID int64) (transport.Relationship, error)
+
+	// ListFollowers returns one page of the live followers of query.UserID.
+	ListFollowers(ctx context.Context, query transport.FollowListQuery) ([]transport.FollowEntry, error)
+
+	// ListFollowing returns one page of the live users query.UserID follows.
+	ListFollowing(ctx context.Context, query transport.FollowListQuery) ([]transport.FollowEntry, error)
+
+	// ListFollowerIDs returns up to limit IDs of userID's followers greater
+	// than afterID, in ascending order.
+	ListFollowerIDs(ctx context.Context, userID, afterID int64, limit int) ([]int64, error)
+
+	// ListFollowingIDs returns up to limit IDs of the users userID follows
+	// greater than afterID, in ascending order.
+	ListFollowingIDs(ctx context.Context, userID, afterID int64, limit int) ([]int64, error)
+
+	// ListFollowRequests returns one page of pending requests to follow
+	// query.UserID; entries carry the requester.
+	ListFollowRequests(ctx context.Context, query transport.FollowListQuery) ([]transport.FollowEntry, error)
+
+	// ApproveFollowRequest turns the pending request of requesterID into a
+	// follow of targetID, recording a UserEventFollowed event; returns
+	// ErrFollowRequestNotFound if there is none.
+	ApproveFollowRequest(ctx context.Context, targetID, requesterID int64) error
+
+	// DenyFollowRequest drops the pending request of requesterID to follow
+	// targetID; returns ErrFollowRequestNotFound if there is none.
+	DenyFollowRequest(ctx context.Context, targetID, requesterID int64) error
+}
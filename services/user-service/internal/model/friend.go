@@ -0,0 +1,50 @@
+// Package model defines the persistence contract for friendships. It enables
+// Dependency Inversion by abstracting storage of friendships and friend
+// requests, supporting Interface Segregation and Liskov Substitution.
+package model
+
+import (
+	"context"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+)
+
+// FriendRepository stores mutual friendships and pending friend requests.
+// Deleted accounts are treated as not found and never listed.
+type FriendRepository interface {
+	// SendFriendRequest records a friend request from requesterID to
+	// addresseeID, or makes them friends if addresseeID has already asked
+	// requesterID. Existing friendships and requests are left as they are.
+	// Returns ErrUserNotFound if addresseeID is not a live, unsuspended
+	// account.
+	SendFriendRequest(ctx context.Context, requesterID, addresseeID int64) error
+
+	// RespondToFriendRequest drops the pending request of requesterID to
+	// addresseeID and, if accept is set, makes them friends. Returns
+	// ErrFriendRequestNotFound if there is no such request.
+	RespondToFriendRequest(ctx context.Context, addresseeID, requesterID int64, accept bool) error
+
+	// RemoveFriend ends the friendship of userID and friendID and drops the
+	// pending request of userID to friendID. Missing rows are not an error.
+	RemoveFriend(ctx context.Context, userID, friendID int64) error
+
+	// GetFriendship reports how userID is connected to otherUserID; returns
+	// ErrUserNotFound unless both are live accounts.
+	GetFriendship(ctx context.Context, userID, otherUserID int64) (transport.Friendship, error)
+
+	// ListFriends returns one page of the live friends of query.UserID.
+	ListFriends(ctx context.Context, query transport.FriendListQuery) ([]transport.FriendEntry, error)
+
+	// ListMutualFriends returns one page of the live users who are friends
+	// with both query.UserID and query.OtherUserID.
+	ListMutualFriends(ctx context.Context, query transport.FriendListQuery) ([]transport.FriendEntry, error)
+
+	// ListFriendRequests returns one page of pending friend requests to
+	// query.UserID; entries carry the requester.
+	ListFriendRequests(ctx context.Context, query transport.FriendListQuery) ([]transport.FriendEntry, error)
+
+	// ListFriendSuggestions returns up to limit live, unsuspended friends of
+	// the friends of userID who are neither userID's friends nor involved in a
+	// pending request with userID, most mutual friends first.
+	ListFriendSuggestions(ctx context.Context, userID int64, limit int) ([]transport.FriendSuggestion, error)
+}
@@ -0,0 +1,49 @@
+// Package model defines external identity and OAuth login state models and the
+// repository interface for social login. It enables Dependency Inversion and
+// Liskov Substitution for identity storage.
+package model
+
+import (
+	"context"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+)
+
+// ExternalIdentity links an account at an OpenID Connect provider, identified
+// by the provider's stable subject, to a local user.
+type ExternalIdentity struct {
+	Provider string
+	Subject  string
+	UserID   int64
+	Email    string
+}
+
+// OAuthState is a social login started by BeginOAuthLogin and awaiting the
+// provider's redirect.
+type OAuthState struct {
+	Provider     string
+	CodeVerifier string
+	Nonce        string
+}
+
+// IdentityRepository defines how external identities and pending OAuth logins
+// are stored. OAuth state values are persisted as digests only.
+type IdentityRepository interface {
+	// SaveOAuthState persists a pending social login.
+	SaveOAuthState(ctx context.Context, input domain.SaveOAuthStateInput) error
+
+	// ConsumeOAuthState deletes and returns an unexpired pending login, or
+	// returns ErrInvalidOAuthState, so each state completes at most one login.
+	ConsumeOAuthState(ctx context.Context, stateHash string) (OAuthState, error)
+
+	// FindUserByIdentity returns the user linked to provider and subject, or
+	// ErrIdentityNotFound.
+	FindUserByIdentity(ctx context.Context, provider, subject string) (User, error)
+
+	// LinkIdentity links an external identity to an existing user.
+	LinkIdentity(ctx context.Context, identity ExternalIdentity) error
+
+	// CreateUserWithIdentity atomically creates a user without a password and
+	// links identity to it. Returns ErrEmailTaken or ErrNicknameTaken on conflicts.
+	CreateUserWithIdentity(ctx context.Context, user User, identity ExternalIdentity) (User, error)
+}
@@ -0,0 +1,41 @@
+// Package model defines the failed-login tracking model and the repository
+// interface for brute-force protection. It enables Dependency Inversion and
+// Liskov Substitution for login attempt storage.
+package model
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// LoginScopeAccount counts failures against the email a login targeted.
+	LoginScopeAccount = "account"
+	// LoginScopeIP counts failures from the client IP a login came from.
+	LoginScopeIP = "ip"
+)
+
+// LoginAttemptKey identifies what failed logins are counted against. KeyHash
+// is a digest of the email or IP so that attempted addresses are not stored.
+type LoginAttemptKey struct {
+	Scope   string
+	KeyHash string
+}
+
+// LoginAttemptRepository defines how failed login attempts and lockouts are
+// tracked.
+type LoginAttemptRepository interface {
+	// LoginLockedUntil returns the latest lockout end among keys, or the zero
+	// time if none of them is locked.
+	LoginLockedUntil(ctx context.Context, keys []LoginAttemptKey) (time.Time, error)
+
+	// RecordLoginFailure counts a failed attempt against key and returns the
+	// failures recorded since the counter last went quiet for window.
+	RecordLoginFailure(ctx context.Context, key LoginAttemptKey, window time.Duration) (int, error)
+
+	// LockLogin rejects logins for key until the given time.
+	LockLogin(ctx context.Context, key LoginAttemptKey, until time.Time) error
+
+	// ResetLoginFailures clears the failure counter and lockout for key.
+	ResetLoginFailures(ctx context.Context, key LoginAttemptKey) error
+}
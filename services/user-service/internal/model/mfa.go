@@ -0,0 +1,64 @@
+// Package model defines the TOTP enrollment and MFA challenge models and the
+// repository interface for two-factor authentication state. It enables
+// Dependency Inversion and Liskov Substitution for MFA storage.
+package model
+
+import (
+	"context"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+)
+
+// TOTPEnrollment is a user's TOTP shared secret. Two-factor authentication is
+// enforced only once the enrollment is confirmed.
+type TOTPEnrollment struct {
+	UserID       int64
+	Secret       string
+	Confirmed    bool
+	LastUsedStep int64
+}
+
+// MFAChallenge is a pending login that still needs a second factor.
+type MFAChallenge struct {
+	UserID   int64
+	Attempts int
+}
+
+// MFARepository defines how TOTP secrets, recovery codes and MFA login
+// challenges are stored. Recovery codes and challenge tokens are persisted as
+// digests only.
+type MFARepository interface {
+	// SaveTOTPSecret stores a pending (unconfirmed) secret for the user, replacing
+	// any previous pending one. Returns ErrTOTPAlreadyEnabled if TOTP is confirmed.
+	SaveTOTPSecret(ctx context.Context, userID int64, secret string) error
+
+	// GetTOTP returns the user's enrollment, or ErrTOTPNotEnrolled if none exists.
+	GetTOTP(ctx context.Context, userID int64) (TOTPEnrollment, error)
+
+	// EnableTOTP confirms a pending enrollment, records step as used and replaces
+	// the user's recovery codes with codeHashes, atomically.
+	EnableTOTP(ctx context.Context, userID int64, step int64, codeHashes []string) error
+
+	// MarkTOTPStepUsed records step as the latest used one. Returns
+	// ErrInvalidMFACode if it is not newer than the last used step (replay).
+	MarkTOTPStepUsed(ctx context.Context, userID int64, step int64) error
+
+	// DeleteTOTP removes the user's enrollment, recovery codes and challenges.
+	DeleteTOTP(ctx context.Context, userID int64) error
+
+	// ConsumeRecoveryCode marks an unused recovery code as used. Returns
+	// ErrInvalidMFACode if the digest is unknown or already used.
+	ConsumeRecoveryCode(ctx context.Context, userID int64, codeHash string) error
+
+	// SaveMFAChallenge persists the digest of a new MFA login challenge.
+	SaveMFAChallenge(ctx context.Context, input domain.SaveMFAChallengeInput) error
+
+	// ClaimMFAChallengeAttempt counts one code attempt against an unexpired
+	// challenge and returns it, atomically. Returns ErrInvalidMFAChallenge if
+	// the challenge is unknown, expired or already used maxAttempts times.
+	ClaimMFAChallengeAttempt(ctx context.Context, tokenHash string, maxAttempts int) (MFAChallenge, error)
+
+	// DeleteMFAChallenge redeems a challenge. Returns ErrInvalidMFAChallenge if it
+	// no longer exists, so each challenge completes at most one login.
+	DeleteMFAChallenge(ctx context.Context, tokenHash string) error
+}
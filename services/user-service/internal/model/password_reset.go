@@ -0,0 +1,29 @@
+// Package model defines the repository interface for password reset tokens.
+// It enables Dependency Inversion and Liskov Substitution for reset storage.
+package model
+
+import (
+	"context"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+)
+
+// PasswordResetRepository defines how password reset tokens are stored and
+// redeemed. Tokens are persisted as digests only; the raw value exists solely
+// in the email.
+type PasswordResetRepository interface {
+	// SavePasswordResetToken persists a new reset token digest for a user.
+	// Returns ErrPasswordResetThrottled, without saving, if another reset token
+	// was issued to the user within input.Cooldown.
+	SavePasswordResetToken(ctx context.Context, input domain.SavePasswordResetTokenInput) error
+
+	// GetPasswordResetToken returns the owner of an unexpired token digest, or
+	// ErrInvalidResetToken if the digest is unknown or expired.
+	GetPasswordResetToken(ctx context.Context, tokenHash string) (int64, error)
+
+	// ConsumePasswordResetToken redeems an unexpired token digest: it stores
+	// passwordHash for the owner, removes all of their outstanding reset tokens
+	// and revokes all of their sessions. Returns the user ID, or
+	// ErrInvalidResetToken if the digest is unknown or expired.
+	ConsumePasswordResetToken(ctx context.Context, tokenHash, passwordHash string) (int64, error)
+}
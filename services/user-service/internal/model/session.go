@@ -0,0 +1,17 @@
+// Package model defines the session entity exposed through refresh token
+// storage. A session is the device-level view of a refresh token and survives
+// token rotation.
+package model
+
+import "time"
+
+// Session describes a signed-in device backed by a refresh token.
+type Session struct {
+	ID         string    // Stable session UUID, preserved across rotation
+	UserID     int64     // Owner of the session
+	UserAgent  string    // Client user agent at last use
+	IPAddress  string    // Client IP address at last use
+	CreatedAt  time.Time // When the user signed in on this device
+	LastUsedAt time.Time // When the session last issued tokens
+	ExpiresAt  time.Time // When the current refresh token expires
+}
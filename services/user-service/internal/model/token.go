@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
-	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
 )
 
 // TokenPair represents a pair of access and refresh tokens with expiry.
@@ -18,12 +17,33 @@ type TokenPair struct {
 	ExpiresAt    time.Time
 }
 
-// TokenRepository defines how we store and retrieve refresh tokens.
+// TokenRepository defines how we store and retrieve refresh tokens. Tokens are
+// only ever handled as SHA-256 digests (see security.HashToken), so the raw
+// value never reaches storage. They are grouped into families by session:
+// rotation retires the old token instead of deleting it, so a replayed token
+// can be detected and its family revoked.
 // It enables Dependency Inversion and Liskov Substitution for token storage.
 type TokenRepository interface {
 	SaveRefreshToken(ctx context.Context, input domain.SaveRefreshTokenInput) error
-	GetRefreshToken(ctx context.Context, input transport.RefreshTokenRequest) (string, error)
-	DeleteRefreshToken(ctx context.Context, input transport.RefreshTokenRequest) error
+	// GetRefreshToken returns the session of an unexpired token. A token that was
+	// already rotated yields its session together with ErrRefreshTokenReused.
+	GetRefreshToken(ctx context.Context, tokenHash string) (Session, error)
+	// RotateRefreshToken retires an active token while keeping it on record for
+	// reuse detection; returns ErrRefreshTokenReused if it was already retired.
+	RotateRefreshToken(ctx context.Context, tokenHash string) error
+	// DeleteRefreshToken revokes the whole session (token family) of an active token.
+	DeleteRefreshToken(ctx context.Context, tokenHash string) error
+
+	// ListSessions returns the user's unexpired sessions, most recently used first.
+	ListSessions(ctx context.Context, userID int64) ([]Session, error)
+	// DeleteSession revokes one session owned by the user; returns ErrSessionNotFound otherwise.
+	DeleteSession(ctx context.Context, userID int64, sessionID string) error
+	// DeleteOtherSessions revokes every session of the user except keepSessionID
+	// and returns how many were removed.
+	DeleteOtherSessions(ctx context.Context, userID int64, keepSessionID string) (int64, error)
+	// DeleteAllSessions revokes every session of the user and returns how many
+	// were removed.
+	DeleteAllSessions(ctx context.Context, userID int64) (int64, error)
 }
 
 // JWTGeneratorInterface handles creation of token pairs.
@@ -13,16 +13,29 @@ import (
 // User represents a domain user entity.
 // Fields with pointer types are optional (maybe nil).
 type User struct {
-	ID           int64     // Unique identifier
-	Username     string    // Chosen display name
-	Email        string    // User's email address
-	PasswordHash string    // Hashed password (never exposed)
-	Nickname     string    // Unique nickname for public profile lookup
-	Bio          string    // Public biography
-	AvatarURL    string    // Avatar image URL
-	LastLogin    time.Time // Timestamp of last login, if any
-	CreatedAt    time.Time // Account creation timestamp
-	UpdatedAt    time.Time // Timestamp of last profile update
+	ID              int64     // Unique identifier
+	Username        string    // Chosen display name
+	Email           string    // User's email address
+	PasswordHash    string    // Hashed password (never exposed)
+	Nickname        string    // Unique nickname for public profile lookup
+	Bio             string    // Public biography
+	AvatarURL       string    // Avatar image URL
+	LastLogin       time.Time // Timestamp of last login, if any
+	EmailVerifiedAt time.Time // Timestamp of email confirmation; zero while unverified
+	Roles           []string  // Elevated roles such as "admin"; every user implicitly holds "user"
+	SuspendedAt     time.Time // Timestamp of suspension by a moderator; zero while active
+	CreatedAt       time.Time // Account creation timestamp
+	UpdatedAt       time.Time // Timestamp of last profile update
+}
+
+// IsEmailVerified reports whether the user has confirmed their email address.
+func (u User) IsEmailVerified() bool {
+	return !u.EmailVerifiedAt.IsZero()
+}
+
+// IsSuspended reports whether a moderator has suspended the account.
+func (u User) IsSuspended() bool {
+	return !u.SuspendedAt.IsZero()
 }
 
 // AuthRepository defines methods for user authentication persistence.
@@ -31,12 +44,23 @@ type AuthRepository interface {
 	// CreateUser persists a new user and returns the created entity with ID and timestamps populated.
 	CreateUser(ctx context.Context, user User) (User, error)
 
-	// FetchUserByEmail retrieves a user by email; returns ErrUserNotFound if no record exists.
+	// FetchUserByEmail retrieves a user by email, ignoring case; returns ErrUserNotFound if no record exists
+	// or the account is deleted.
 	// INTERNAL: used by AuthService.Login for password validation.
 	FetchUserByEmail(ctx context.Context, email string) (User, error)
+
+	// FetchUserCredentials retrieves the identifiers and password hash of a user
+	// by ID; returns ErrUserNotFound if no record exists or the account is deleted.
+	// INTERNAL: used by AuthService password changes and resets.
+	FetchUserCredentials(ctx context.Context, userID int64) (User, error)
+
+	// UpdatePasswordHash replaces the user's password hash with newHash if it
+	// still equals oldHash, so a concurrent password change is never undone.
+	UpdatePasswordHash(ctx context.Context, userID int64, oldHash, newHash string) error
 }
 
-// UserRepository defines read-only retrieval by ID, Nickname or Email.
+// UserRepository defines retrieval by ID, Nickname or Email and access to
+// profile privacy settings. Deleted accounts are treated as not found.
 // It supports Interface Segregation and Liskov Substitution for user data access.
 type UserRepository interface {
 	// FetchUserByNickname looks up a public user profile by nickname.
@@ -44,4 +68,19 @@ type UserRepository interface {
 
 	// FetchUserByID retrieves a user by their numeric ID (domain uses only).
 	FetchUserByID(ctx context.Context, input transport.FetchUserByIDRequest) (transport.UserProfileResponse, error)
+
+	// FetchUsersByIDs retrieves the live users among userIDs, ordered by ID.
+	// Unknown and deleted users are left out (domain uses only).
+	FetchUsersByIDs(ctx context.Context, userIDs []int64) ([]transport.UserProfileResponse, error)
+
+	// SearchUsers returns one page of live, unsuspended users matching the
+	// query by nickname or username, best match first.
+	SearchUsers(ctx context.Context, input transport.UserSearchQuery) ([]transport.UserSearchHit, error)
+
+	// FetchPrivacySettings returns the profile privacy settings of a user.
+	FetchPrivacySettings(ctx context.Context, userID int64) (transport.PrivacySettings, error)
+
+	// UpdatePrivacySettings applies the non-empty fields of input and returns
+	// the resulting settings.
+	UpdatePrivacySettings(ctx context.Context, userID int64, input transport.UpdatePrivacySettingsRequest) (transport.PrivacySettings, error)
 }
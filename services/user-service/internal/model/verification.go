@@ -0,0 +1,23 @@
+// Package model defines the repository interface for email verification tokens.
+// It enables Dependency Inversion and Liskov Substitution for verification storage.
+package model
+
+import (
+	"context"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+)
+
+// VerificationRepository defines how email verification tokens are stored and redeemed.
+// Tokens are persisted as digests only; the raw value exists solely in the email.
+type VerificationRepository interface {
+	// SaveVerificationToken persists a new verification token digest for a user.
+	// Returns ErrVerificationThrottled, without saving, if another token was
+	// issued to the user within input.Cooldown.
+	SaveVerificationToken(ctx context.Context, input domain.SaveVerificationTokenInput) error
+
+	// ConsumeVerificationToken redeems an unexpired token digest, marks the owner's
+	// email as verified and removes all of their outstanding tokens. Returns the
+	// user ID, or ErrInvalidVerificationToken if the digest is unknown or expired.
+	ConsumeVerificationToken(ctx context.Context, tokenHash string) (int64, error)
+}
@@ -0,0 +1,229 @@
+// Package oidc implements the client side of the OpenID Connect authorization
+// code flow with PKCE: provider discovery, building authorization URLs,
+// exchanging codes and verifying ID tokens. It supports Single Responsibility
+// and Dependency Inversion principles.
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/config"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+)
+
+const (
+	// jwksCacheTTL is how long provider signing keys are cached.
+	jwksCacheTTL = time.Hour
+	// idTokenLeeway is the clock skew tolerated when checking ID token times.
+	idTokenLeeway = time.Minute
+	// maxResponseSize bounds provider responses read into memory.
+	maxResponseSize = 1 << 20
+)
+
+var defaultScopes = []string{"openid", "email", "profile"}
+
+// Identity is the verified subset of ID token claims used to sign a user in.
+type Identity struct {
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+	Picture           string
+}
+
+// idTokenClaims are the standard OpenID Connect claims read from ID tokens.
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce             string `json:"nonce"`
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	Name              string `json:"name"`
+	PreferredUsername string `json:"preferred_username"`
+	Picture           string `json:"picture"`
+}
+
+// discovery is the subset of the OpenID Provider Metadata this client needs.
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Provider is a configured OpenID Connect provider. Its metadata is discovered
+// on first use and cached for the lifetime of the process.
+type Provider struct {
+	cfg        config.OIDCProvider
+	httpClient *http.Client
+
+	mu   sync.Mutex
+	meta *discovery
+	keys *auth.JWKSClient
+}
+
+// NewProvider creates a provider from cfg that talks to it over httpClient.
+func NewProvider(cfg config.OIDCProvider, httpClient *http.Client) *Provider {
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = defaultScopes
+	}
+	return &Provider{cfg: cfg, httpClient: httpClient}
+}
+
+// Name returns the provider's configured name.
+func (p *Provider) Name() string {
+	return p.cfg.Name
+}
+
+// AuthCodeURL returns the URL that starts an authorization code flow bound to
+// state and nonce, with codeChallenge as the S256 PKCE challenge.
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	params := url.Values{}
+	params.Set("response_type", "code")
+	params.Set("client_id", p.cfg.ClientID)
+	params.Set("redirect_uri", p.cfg.RedirectURL)
+	params.Set("scope", strings.Join(p.cfg.Scopes, " "))
+	params.Set("state", state)
+	params.Set("nonce", nonce)
+	params.Set("code_challenge", codeChallenge)
+	params.Set("code_challenge_method", "S256")
+
+	separator := "?"
+	if strings.Contains(meta.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+	return meta.AuthorizationEndpoint + separator + params.Encode(), nil
+}
+
+// Exchange redeems an authorization code using the PKCE codeVerifier and
+// returns the identity asserted by the verified ID token. The token must be
+// signed by the provider, issued to this client, unexpired and carry nonce.
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (Identity, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return Identity{}, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.cfg.RedirectURL)
+	form.Set("client_id", p.cfg.ClientID)
+	form.Set("client_secret", p.cfg.ClientSecret)
+	form.Set("code_verifier", codeVerifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, meta.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return Identity{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	var tokens struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := p.doJSON(req, &tokens); err != nil {
+		return Identity{}, fmt.Errorf("%w: %v", errs.ErrOAuthExchangeFailed, err)
+	}
+	if tokens.IDToken == "" {
+		return Identity{}, fmt.Errorf("%w: response has no id_token", errs.ErrOAuthExchangeFailed)
+	}
+
+	return p.verifyIDToken(tokens.IDToken, nonce)
+}
+
+// verifyIDToken checks the ID token's signature and claims.
+func (p *Provider) verifyIDToken(raw, nonce string) (Identity, error) {
+	var claims idTokenClaims
+	_, err := jwt.ParseWithClaims(raw, &claims, p.keys.Keyfunc,
+		jwt.WithValidMethods(auth.SigningMethods),
+		jwt.WithIssuer(p.meta.Issuer),
+		jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(idTokenLeeway),
+	)
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: %v", errs.ErrInvalidIDToken, err)
+	}
+	if claims.Nonce == "" || claims.Nonce != nonce {
+		return Identity{}, fmt.Errorf("%w: nonce mismatch", errs.ErrInvalidIDToken)
+	}
+	if claims.Subject == "" {
+		return Identity{}, fmt.Errorf("%w: missing subject", errs.ErrInvalidIDToken)
+	}
+
+	return Identity{
+		Subject:           claims.Subject,
+		Email:             strings.ToLower(claims.Email),
+		EmailVerified:     claims.EmailVerified,
+		Name:              claims.Name,
+		PreferredUsername: claims.PreferredUsername,
+		Picture:           claims.Picture,
+	}, nil
+}
+
+// discover loads the provider metadata once; failures are retried on the
+// next call.
+func (p *Provider) discover(ctx context.Context) (*discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.meta != nil {
+		return p.meta, nil
+	}
+
+	wellKnown := strings.TrimSuffix(p.cfg.Issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, wellKnown, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var meta discovery
+	if err := p.doJSON(req, &meta); err != nil {
+		return nil, fmt.Errorf("%w: %v", errs.ErrOIDCDiscoveryFailed, err)
+	}
+	if meta.Issuer != p.cfg.Issuer {
+		return nil, fmt.Errorf("%w: issuer %q does not match %q", errs.ErrOIDCDiscoveryFailed, meta.Issuer, p.cfg.Issuer)
+	}
+	if meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JWKSURI == "" {
+		return nil, fmt.Errorf("%w: incomplete provider metadata", errs.ErrOIDCDiscoveryFailed)
+	}
+
+	p.meta = &meta
+	p.keys = auth.NewJWKSClient(meta.JWKSURI, jwksCacheTTL)
+	return p.meta, nil
+}
+
+// doJSON performs req and decodes a successful JSON response into dst.
+func (p *Provider) doJSON(req *http.Request, dst any) error {
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned status %d: %s", req.URL.Path, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return json.Unmarshal(body, dst)
+}
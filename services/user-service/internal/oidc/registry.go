@@ -0,0 +1,34 @@
+// Package oidc provides the registry of configured identity providers, looked
+// up by the name clients use in social login requests.
+package oidc
+
+import (
+	"net/http"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/config"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+)
+
+// Registry holds the configured identity providers by name.
+type Registry struct {
+	providers map[string]*Provider
+}
+
+// NewRegistry creates a provider for every entry of cfgs. All providers share
+// httpClient.
+func NewRegistry(cfgs []config.OIDCProvider, httpClient *http.Client) *Registry {
+	r := &Registry{providers: make(map[string]*Provider, len(cfgs))}
+	for _, cfg := range cfgs {
+		r.providers[cfg.Name] = NewProvider(cfg, httpClient)
+	}
+	return r
+}
+
+// Provider returns the provider called name, or ErrUnknownOAuthProvider.
+func (r *Registry) Provider(name string) (*Provider, error) {
+	p, ok := r.providers[name]
+	if !ok {
+		return nil, errs.ErrUnknownOAuthProvider
+	}
+	return p, nil
+}
@@ -0,0 +1,188 @@
+// Package repository implements persistence logic for account deletion and the
+// account event log. It provides concrete implementations of AccountRepository
+// and UserEventRepository, following Dependency Inversion and Liskov
+// Substitution principles.
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+)
+
+type AccountPostgres struct {
+	DB *sql.DB
+}
+
+func NewAccountPostgres(db *sql.DB) *AccountPostgres {
+	return &AccountPostgres{DB: db}
+}
+
+// SoftDeleteUser stamps users.deleted_at, drops every credential that could
+// still act for the user, removes the user from the follow graph and their
+// friendships, and appends a user.deleted event.
+func (r *AccountPostgres) SoftDeleteUser(ctx context.Context, userID int64) (time.Time, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return time.Time{}, errs.ErrDBFailure
+	}
+	defer tx.Rollback()
+
+	var deletedAt time.Time
+	err = tx.QueryRowContext(ctx, `
+		UPDATE users SET deleted_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING deleted_at
+	`, userID).Scan(&deletedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return time.Time{}, errs.ErrUserNotFound
+		}
+		return time.Time{}, errs.ErrDBFailure
+	}
+
+	for _, query := range []string{
+		`DELETE FROM refresh_tokens WHERE user_id = $1`,
+		`DELETE FROM email_verification_tokens WHERE user_id = $1`,
+		`DELETE FROM password_reset_tokens WHERE user_id = $1`,
+		`DELETE FROM mfa_challenges WHERE user_id = $1`,
+		`DELETE FROM data_exports WHERE user_id = $1`,
+		`DELETE FROM friendships WHERE user_id = $1 OR friend_id = $1`,
+		`DELETE FROM friend_requests WHERE requester_id = $1 OR addressee_id = $1`,
+	} {
+		if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+			return time.Time{}, errs.ErrDBFailure
+		}
+	}
+	if err := removeFollowGraph(ctx, tx, userID); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := appendUserEvent(ctx, tx, model.UserEventDeleted, userID, 0); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return time.Time{}, errs.ErrDBFailure
+	}
+	return deletedAt, nil
+}
+
+// PurgeDeletedUsers hard-deletes users whose grace period has ended; dependent
+// rows go with them through ON DELETE CASCADE.
+func (r *AccountPostgres) PurgeDeletedUsers(ctx context.Context, cutoff time.Time) (int64, error) {
+	query := `DELETE FROM users WHERE deleted_at IS NOT NULL AND deleted_at < $1`
+
+	result, err := r.DB.ExecContext(ctx, query, cutoff)
+	if err != nil {
+		return 0, errs.ErrDBFailure
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, errs.ErrDBFailure
+	}
+	return affected, nil
+}
+
+// userEventGapGrace is how long a gap in event IDs is assumed to belong to a
+// transaction that has not committed yet. Events are appended in short
+// transactions, so older gaps come from rollbacks and are skipped.
+const userEventGapGrace = 30 * time.Second
+
+// ListUserEvents returns the next page of events after the cursor. Event IDs
+// are taken from a sequence when the event is inserted but become visible at
+// commit, so a lower ID can appear after a higher one; the page therefore ends
+// before any gap that is younger than userEventGapGrace.
+func (r *AccountPostgres) ListUserEvents(ctx context.Context, afterID int64, limit int) ([]model.UserEvent, error) {
+	query := `
+		SELECT id, type, user_id, subject_user_id, occurred_at,
+		       occurred_at < NOW() - make_interval(secs => $3)
+		FROM user_events
+		WHERE id > $1
+		ORDER BY id
+		LIMIT $2
+	`
+
+	rows, err := r.DB.QueryContext(ctx, query, afterID, limit, userEventGapGrace.Seconds())
+	if err != nil {
+		return nil, errs.ErrDBFailure
+	}
+	defer rows.Close()
+
+	var events []model.UserEvent
+	next := afterID + 1
+	for rows.Next() {
+		var e model.UserEvent
+		var subjectUserID sql.NullInt64
+		var settled bool
+		if err := rows.Scan(&e.ID, &e.Type, &e.UserID, &subjectUserID, &e.OccurredAt, &settled); err != nil {
+			return nil, errs.ErrDBFailure
+		}
+		if e.ID != next && !settled {
+			break
+		}
+		e.SubjectUserID = subjectUserID.Int64
+		events = append(events, e)
+		next = e.ID + 1
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errs.ErrDBFailure
+	}
+	return events, nil
+}
+
+// SaveUserEventCursor upserts the consumer's position in the event log.
+func (r *AccountPostgres) SaveUserEventCursor(ctx context.Context, consumer string, afterID int64) error {
+	query := `
+		INSERT INTO user_event_consumers (consumer, last_event_id)
+		VALUES ($1, $2)
+		ON CONFLICT (consumer) DO UPDATE
+		SET last_event_id = EXCLUDED.last_event_id, updated_at = NOW()
+	`
+
+	if _, err := r.DB.ExecContext(ctx, query, consumer, afterID); err != nil {
+		return errs.ErrDBFailure
+	}
+	return nil
+}
+
+// PruneUserEvents deletes events older than the retention cutoff that the
+// slowest consumer has already handled. Without any known consumer, events
+// are pruned by age alone.
+func (r *AccountPostgres) PruneUserEvents(ctx context.Context, cutoff time.Time) (int64, error) {
+	query := `
+		DELETE FROM user_events
+		WHERE occurred_at < $1
+		  AND id <= COALESCE((SELECT MIN(last_event_id) FROM user_event_consumers), id)
+	`
+
+	result, err := r.DB.ExecContext(ctx, query, cutoff)
+	if err != nil {
+		return 0, errs.ErrDBFailure
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, errs.ErrDBFailure
+	}
+	return affected, nil
+}
+
+// appendUserEvent records an event stamped with the transaction's start time,
+// the same NOW() the change itself is stamped with. No lock is taken:
+// ListUserEvents holds consumers back at gaps left by transactions that have
+// not committed yet. subjectUserID 0 is stored as NULL.
+func appendUserEvent(ctx context.Context, tx *sql.Tx, eventType string, userID, subjectUserID int64) error {
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO user_events (type, user_id, subject_user_id)
+		VALUES ($1, $2, NULLIF($3::bigint, 0))
+	`, eventType, userID, subjectUserID); err != nil {
+		return errs.ErrDBFailure
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+// Package repository implements persistence logic for account moderation. It
+// provides a concrete implementation of AdminRepository, following Dependency
+// Inversion and Liskov Substitution principles.
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+)
+
+// adminUserColumns selects the fields scanned by scanAdminUser.
+const adminUserColumns = `
+	id, nickname, email,
+	ARRAY(SELECT role FROM user_roles WHERE user_id = users.id ORDER BY role),
+	created_at, suspended_at, suspended_reason
+`
+
+type AdminPostgres struct {
+	DB *sql.DB
+}
+
+func NewAdminPostgres(db *sql.DB) *AdminPostgres {
+	return &AdminPostgres{DB: db}
+}
+
+// ListUsers returns the next page of live accounts after the cursor.
+func (r *AdminPostgres) ListUsers(ctx context.Context, afterID int64, limit int) ([]model.AdminUser, error) {
+	query := `SELECT ` + adminUserColumns + ` FROM users
+		WHERE id > $1 AND deleted_at IS NULL
+		ORDER BY id
+		LIMIT $2
+	`
+
+	rows, err := r.DB.QueryContext(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, errs.ErrDBFailure
+	}
+	defer rows.Close()
+
+	var users []model.AdminUser
+	for rows.Next() {
+		u, err := scanAdminUser(rows)
+		if err != nil {
+			return nil, errs.ErrDBFailure
+		}
+		users = append(users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errs.ErrDBFailure
+	}
+	return users, nil
+}
+
+// GetUser retrieves a single live account.
+func (r *AdminPostgres) GetUser(ctx context.Context, userID int64) (model.AdminUser, error) {
+	return getAdminUser(ctx, r.DB, userID)
+}
+
+// SuspendUser stamps users.suspended_at, keeping the original time if the
+// account was already suspended, and drops its refresh tokens so that no
+// session outlives the suspension.
+func (r *AdminPostgres) SuspendUser(ctx context.Context, userID int64, reason string) (model.AdminUser, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return model.AdminUser{}, errs.ErrDBFailure
+	}
+	defer tx.Rollback()
+
+	if err := execUserUpdate(ctx, tx, `
+		UPDATE users SET suspended_at = COALESCE(suspended_at, NOW()), suspended_reason = $2
+		WHERE id = $1 AND deleted_at IS NULL
+	`, userID, reason); err != nil {
+		return model.AdminUser{}, err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM refresh_tokens WHERE user_id = $1`, userID); err != nil {
+		return model.AdminUser{}, errs.ErrDBFailure
+	}
+
+	u, err := getAdminUser(ctx, tx, userID)
+	if err != nil {
+		return model.AdminUser{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return model.AdminUser{}, errs.ErrDBFailure
+	}
+	return u, nil
+}
+
+// UnsuspendUser clears the suspension fields.
+func (r *AdminPostgres) UnsuspendUser(ctx context.Context, userID int64) (model.AdminUser, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return model.AdminUser{}, errs.ErrDBFailure
+	}
+	defer tx.Rollback()
+
+	if err := execUserUpdate(ctx, tx, `
+		UPDATE users SET suspended_at = NULL, suspended_reason = ''
+		WHERE id = $1 AND deleted_at IS NULL
+	`, userID); err != nil {
+		return model.AdminUser{}, err
+	}
+
+	u, err := getAdminUser(ctx, tx, userID)
+	if err != nil {
+		return model.AdminUser{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return model.AdminUser{}, errs.ErrDBFailure
+	}
+	return u, nil
+}
+
+// queryRower is satisfied by both *sql.DB and *sql.Tx.
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// getAdminUser loads one live account through db or an open transaction.
+func getAdminUser(ctx context.Context, db queryRower, userID int64) (model.AdminUser, error) {
+	query := `SELECT ` + adminUserColumns + ` FROM users WHERE id = $1 AND deleted_at IS NULL`
+
+	u, err := scanAdminUser(db.QueryRowContext(ctx, query, userID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.AdminUser{}, errs.ErrUserNotFound
+		}
+		return model.AdminUser{}, errs.ErrDBFailure
+	}
+	return u, nil
+}
+
+// execUserUpdate runs an UPDATE on a single user and returns ErrUserNotFound
+// when no row matched.
+func execUserUpdate(ctx context.Context, tx *sql.Tx, query string, args ...any) error {
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	if affected == 0 {
+		return errs.ErrUserNotFound
+	}
+	return nil
+}
+
+// scanAdminUser scans a row selected with adminUserColumns.
+func scanAdminUser(row rowScanner) (model.AdminUser, error) {
+	var u model.AdminUser
+	var suspendedAt sql.NullTime
+	if err := row.Scan(
+		&u.ID,
+		&u.Nickname,
+		&u.Email,
+		pq.Array(&u.Roles),
+		&u.CreatedAt,
+		&suspendedAt,
+		&u.SuspendedReason,
+	); err != nil {
+		return model.AdminUser{}, err
+	}
+	u.SuspendedAt = suspendedAt.Time
+	return u, nil
+}
@@ -41,38 +41,50 @@ func (r *AuthPostgres) CreateUser(ctx context.Context, u model.User) (model.User
 	).Scan(&u.ID, &u.CreatedAt)
 
 	if err != nil {
-		var pqErr *pq.Error
-		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
-			switch pqErr.Constraint {
-			case "users_email_key":
-				return model.User{}, fmt.Errorf("%w", errs.ErrEmailTaken)
-			case "users_nickname_key":
-				return model.User{}, fmt.Errorf("%w", errs.ErrNicknameTaken)
-			default:
-				return model.User{}, fmt.Errorf("duplicate constraint %q violated", pqErr.Constraint)
-			}
-		}
-
-		return model.User{}, errs.ErrDBFailure
+		return model.User{}, mapUserInsertError(err)
 	}
 
 	return u, nil
 }
 
-// FetchUserByEmail retrieves a user by their email address.
+// mapUserInsertError translates unique constraint violations on users into
+// ErrEmailTaken or ErrNicknameTaken, and any other failure into ErrDBFailure.
+func mapUserInsertError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		switch pqErr.Constraint {
+		case "users_email_lower_key":
+			return fmt.Errorf("%w", errs.ErrEmailTaken)
+		case "users_nickname_key":
+			return fmt.Errorf("%w", errs.ErrNicknameTaken)
+		default:
+			return fmt.Errorf("duplicate constraint %q violated", pqErr.Constraint)
+		}
+	}
+
+	return errs.ErrDBFailure
+}
+
+// FetchUserByEmail retrieves a user by their email address, ignoring case.
 // INTERNAL USE ONLY: called by AuthService.Login to load a user’s stored password hash.
 func (r *AuthPostgres) FetchUserByEmail(ctx context.Context, email string) (model.User, error) {
 	query := `
-		SELECT id, nickname, email, password_hash FROM users
-		WHERE email = $1
+		SELECT id, nickname, email, password_hash, email_verified_at, suspended_at,
+			ARRAY(SELECT role FROM user_roles WHERE user_id = users.id ORDER BY role)
+		FROM users
+		WHERE lower(email) = lower($1) AND deleted_at IS NULL
 	`
 
 	var u model.User
+	var verifiedAt, suspendedAt sql.NullTime
 	err := r.DB.QueryRowContext(ctx, query, email).Scan(
 		&u.ID,
 		&u.Nickname,
 		&u.Email,
 		&u.PasswordHash,
+		&verifiedAt,
+		&suspendedAt,
+		pq.Array(&u.Roles),
 	)
 
 	if err != nil {
@@ -81,6 +93,55 @@ func (r *AuthPostgres) FetchUserByEmail(ctx context.Context, email string) (mode
 		}
 		return model.User{}, errs.ErrDBFailure
 	}
+	u.EmailVerifiedAt = verifiedAt.Time
+	u.SuspendedAt = suspendedAt.Time
 
 	return u, nil
 }
+
+// FetchUserCredentials retrieves a user's identifiers and password hash by ID.
+// INTERNAL USE ONLY: called by AuthService to verify and replace passwords.
+func (r *AuthPostgres) FetchUserCredentials(ctx context.Context, userID int64) (model.User, error) {
+	query := `
+		SELECT id, nickname, email, password_hash, email_verified_at, suspended_at,
+			ARRAY(SELECT role FROM user_roles WHERE user_id = users.id ORDER BY role)
+		FROM users
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	var u model.User
+	var verifiedAt, suspendedAt sql.NullTime
+	err := r.DB.QueryRowContext(ctx, query, userID).Scan(
+		&u.ID,
+		&u.Nickname,
+		&u.Email,
+		&u.PasswordHash,
+		&verifiedAt,
+		&suspendedAt,
+		pq.Array(&u.Roles),
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.User{}, errs.ErrUserNotFound
+		}
+		return model.User{}, errs.ErrDBFailure
+	}
+	u.EmailVerifiedAt = verifiedAt.Time
+	u.SuspendedAt = suspendedAt.Time
+
+	return u, nil
+}
+
+// UpdatePasswordHash swaps in a new hash unless the stored one changed meanwhile.
+func (r *AuthPostgres) UpdatePasswordHash(ctx context.Context, userID int64, oldHash, newHash string) error {
+	query := `
+		UPDATE users SET password_hash = $3
+		WHERE id = $1 AND password_hash = $2
+	`
+
+	if _, err := r.DB.ExecContext(ctx, query, userID, oldHash, newHash); err != nil {
+		return errs.ErrDBFailure
+	}
+	return nil
+}
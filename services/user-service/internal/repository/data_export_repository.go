@@ -0,0 +1,168 @@
+// Package repository implements persistence logic for data export jobs. It
+// provides a concrete implementation of DataExportRepository, following
+// Dependency Inversion and Liskov Substitution principles.
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+)
+
+type DataExportPostgres struct {
+	DB *sql.DB
+}
+
+func NewDataExportPostgres(db *sql.DB) *DataExportPostgres {
+	return &DataExportPostgres{DB: db}
+}
+
+// dataExportColumns lists the columns scanned by scanDataExport.
+const dataExportColumns = `id, user_id, status, created_at, completed_at, expires_at`
+
+// CreateDataExport inserts a pending export unless the user already has an
+// unfinished one, in which case that export is returned. The partial unique
+// index on unfinished exports keeps concurrent requests from both inserting.
+func (r *DataExportPostgres) CreateDataExport(ctx context.Context, userID int64) (model.DataExport, error) {
+	insert := `
+		INSERT INTO data_exports (id, user_id)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) WHERE status IN ('pending', 'processing') DO NOTHING
+		RETURNING ` + dataExportColumns
+
+	export, err := scanDataExport(r.DB.QueryRowContext(ctx, insert, uuid.New().String(), userID))
+	if err == nil {
+		return export, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return model.DataExport{}, errs.ErrDBFailure
+	}
+
+	active := `
+		SELECT ` + dataExportColumns + ` FROM data_exports
+		WHERE user_id = $1 AND status IN ('pending', 'processing')
+	`
+	export, err = scanDataExport(r.DB.QueryRowContext(ctx, active, userID))
+	if err != nil {
+		return model.DataExport{}, errs.ErrDBFailure
+	}
+	return export, nil
+}
+
+// GetDataExport returns the user's export with the given ID.
+func (r *DataExportPostgres) GetDataExport(ctx context.Context, id string, userID int64) (model.DataExport, error) {
+	query := `
+		SELECT ` + dataExportColumns + ` FROM data_exports
+		WHERE id = $1 AND user_id = $2
+	`
+
+	export, err := scanDataExport(r.DB.QueryRowContext(ctx, query, id, userID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.DataExport{}, errs.ErrExportNotFound
+		}
+		return model.DataExport{}, errs.ErrDBFailure
+	}
+	return export, nil
+}
+
+// ClaimDataExport locks the oldest runnable export with SKIP LOCKED, so
+// several workers never claim the same job, and marks it processing.
+func (r *DataExportPostgres) ClaimDataExport(ctx context.Context, staleBefore time.Time) (model.DataExport, error) {
+	query := `
+		UPDATE data_exports SET status = 'processing', started_at = NOW()
+		WHERE id = (
+			SELECT id FROM data_exports
+			WHERE status = 'pending'
+			   OR (status = 'processing' AND started_at < $1)
+			ORDER BY created_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + dataExportColumns
+
+	export, err := scanDataExport(r.DB.QueryRowContext(ctx, query, staleBefore))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.DataExport{}, errs.ErrExportNotFound
+		}
+		return model.DataExport{}, errs.ErrDBFailure
+	}
+	return export, nil
+}
+
+// CompleteDataExport stores the finished archive and marks the export ready.
+func (r *DataExportPostgres) CompleteDataExport(ctx context.Context, id string, archive []byte, expiresAt time.Time) error {
+	query := `
+		UPDATE data_exports
+		SET status = 'ready', archive = $2, completed_at = NOW(), expires_at = $3
+		WHERE id = $1
+	`
+
+	if _, err := r.DB.ExecContext(ctx, query, id, archive, expiresAt); err != nil {
+		return errs.ErrDBFailure
+	}
+	return nil
+}
+
+// FailDataExport marks the export failed.
+func (r *DataExportPostgres) FailDataExport(ctx context.Context, id string, expiresAt time.Time) error {
+	query := `
+		UPDATE data_exports
+		SET status = 'failed', completed_at = NOW(), expires_at = $2
+		WHERE id = $1
+	`
+
+	if _, err := r.DB.ExecContext(ctx, query, id, expiresAt); err != nil {
+		return errs.ErrDBFailure
+	}
+	return nil
+}
+
+// FetchDataExportArchive returns the archive of the user's ready export.
+func (r *DataExportPostgres) FetchDataExportArchive(ctx context.Context, id string, userID int64) ([]byte, error) {
+	query := `
+		SELECT archive FROM data_exports
+		WHERE id = $1 AND user_id = $2 AND status = 'ready' AND expires_at > NOW()
+	`
+
+	var archive []byte
+	if err := r.DB.QueryRowContext(ctx, query, id, userID).Scan(&archive); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrExportNotFound
+		}
+		return nil, errs.ErrDBFailure
+	}
+	return archive, nil
+}
+
+// PruneDataExports deletes finished exports that expired before cutoff.
+func (r *DataExportPostgres) PruneDataExports(ctx context.Context, cutoff time.Time) (int64, error) {
+	res, err := r.DB.ExecContext(ctx, `DELETE FROM data_exports WHERE expires_at < $1`, cutoff)
+	if err != nil {
+		return 0, errs.ErrDBFailure
+	}
+	n, _ := res.RowsAffected()
+	return n, nil
+}
+
+// scanDataExport reads a row selected with dataExportColumns.
+func scanDataExport(row *sql.Row) (model.DataExport, error) {
+	var (
+		export      model.DataExport
+		completedAt sql.NullTime
+		expiresAt   sql.NullTime
+	)
+	if err := row.Scan(&export.ID, &export.UserID, &export.Status, &export.CreatedAt, &completedAt, &expiresAt); err != nil {
+		return model.DataExport{}, err
+	}
+	export.CompletedAt = completedAt.Time
+	export.ExpiresAt = expiresAt.Time
+	return export, nil
+}
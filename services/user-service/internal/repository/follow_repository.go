@@ -0,0 +1,431 @@
+// Package repository implements persistence logic for the follow graph. It
+// provides a concrete implementation of FollowRepository, following Dependency
+// Inversion and Liskov Substitution principles.
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+)
+
+type FollowPostgres struct {
+	DB *sql.DB
+}
+
+func NewFollowPostgres(db *sql.DB) *FollowPostgres {
+	return &FollowPostgres{DB: db}
+}
+
+// Follow makes followerID follow followeeID, or files a follow request if
+// followeeID is private. Both accounts are share-locked first so that neither
+// can be deleted, nor followeeID's privacy changed, until the follow is
+// committed.
+func (r *FollowPostgres) Follow(ctx context.Context, followerID, followeeID int64) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	defer tx.Rollback()
+
+	private, err := lockUserPair(ctx, tx, followerID, followeeID)
+	if err != nil {
+		return err
+	}
+
+	if private {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO follow_requests (requester_id, target_id)
+			SELECT $1, $2
+			WHERE NOT EXISTS (SELECT 1 FROM follows WHERE follower_id = $1 AND followee_id = $2)
+			ON CONFLICT DO NOTHING
+		`, followerID, followeeID)
+		if err != nil {
+			return errs.ErrDBFailure
+		}
+	} else if err := insertFollow(ctx, tx, followerID, followeeID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errs.ErrDBFailure
+	}
+	return nil
+}
+
+// Unfollow deletes the follow and any pending request from followerID to
+// followeeID, decrementing the counts and recording a user.unfollowed event
+// only if a follow was actually removed.
+func (r *FollowPostgres) Unfollow(ctx context.Context, followerID, followeeID int64) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		DELETE FROM follows WHERE follower_id = $1 AND followee_id = $2
+	`, followerID, followeeID)
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return errs.ErrDBFailure
+	} else if n > 0 {
+		if err := adjustFollowCounts(ctx, tx, followerID, followeeID, -1); err != nil {
+			return err
+		}
+		if err := appendUserEvent(ctx, tx, model.UserEventUnfollowed, followerID, followeeID); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM follow_requests WHERE requester_id = $1 AND target_id = $2
+	`, followerID, followeeID); err != nil {
+		return errs.ErrDBFailure
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errs.ErrDBFailure
+	}
+	return nil
+}
+
+// GetRelationship reads the follows and follow requests between two live
+// accounts in both directions.
+func (r *FollowPostgres) GetRelationship(ctx context.Context, userID, otherUserID int64) (transport.Relationship, error) {
+	const query = `
+		SELECT
+			(SELECT count(*) FROM users WHERE id IN ($1, $2) AND deleted_at IS NULL),
+			EXISTS (SELECT 1 FROM follows WHERE follower_id = $1 AND followee_id = $2),
+			EXISTS (SELECT 1 FROM follow_requests WHERE requester_id = $1 AND target_id = $2),
+			EXISTS (SELECT 1 FROM follows WHERE follower_id = $2 AND followee_id = $1),
+			EXISTS (SELECT 1 FROM follow_requests WHERE requester_id = $2 AND target_id = $1)
+	`
+
+	var live int
+	var following, requested, followedBy, requestedBy bool
+	err := r.DB.QueryRowContext(ctx, query, userID, otherUserID).
+		Scan(&live, &following, &requested, &followedBy, &requestedBy)
+	if err != nil {
+		return transport.Relationship{}, errs.ErrDBFailure
+	}
+
+	want := 2
+	if userID == otherUserID {
+		want = 1
+	}
+	if live < want {
+		return transport.Relationship{}, errs.ErrUserNotFound
+	}
+
+	return transport.Relationship{
+		UserID:      userID,
+		OtherUserID: otherUserID,
+		Following:   followState(following, requested),
+		FollowedBy:  followState(followedBy, requestedBy),
+	}, nil
+}
+
+// ListFollowers returns the live followers of query.UserID, newest first.
+func (r *FollowPostgres) ListFollowers(ctx context.Context, query transport.FollowListQuery) ([]transport.FollowEntry, error) {
+	return r.listFollows(ctx, `
+		SELECT follower_id AS user_id, created_at AS followed_at FROM follows WHERE followee_id = $1
+	`, query)
+}
+
+// ListFollowing returns the live users query.UserID follows, newest first.
+func (r *FollowPostgres) ListFollowing(ctx context.Context, query transport.FollowListQuery) ([]transport.FollowEntry, error) {
+	return r.listFollows(ctx, `
+		SELECT followee_id AS user_id, created_at AS followed_at FROM follows WHERE follower_id = $1
+	`, query)
+}
+
+// ListFollowerIDs pages through the followers of userID by ID. Deleted
+// accounts have no follows left, so no join with users is needed.
+func (r *FollowPostgres) ListFollowerIDs(ctx context.Context, userID, afterID int64, limit int) ([]int64, error) {
+	return r.listFollowIDs(ctx, `
+		SELECT follower_id FROM follows
+		WHERE followee_id = $1 AND follower_id > $2
+		ORDER BY follower_id
+		LIMIT $3
+	`, userID, afterID, limit)
+}
+
+// ListFollowingIDs pages through the users userID follows by ID.
+func (r *FollowPostgres) ListFollowingIDs(ctx context.Context, userID, afterID int64, limit int) ([]int64, error) {
+	return r.listFollowIDs(ctx, `
+		SELECT followee_id FROM follows
+		WHERE follower_id = $1 AND followee_id > $2
+		ORDER BY followee_id
+		LIMIT $3
+	`, userID, afterID, limit)
+}
+
+// ListFollowRequests returns the live requesters waiting to follow
+// query.UserID, newest first.
+func (r *FollowPostgres) ListFollowRequests(ctx context.Context, query transport.FollowListQuery) ([]transport.FollowEntry, error) {
+	return r.listFollows(ctx, `
+		SELECT requester_id AS user_id, created_at AS followed_at FROM follow_requests WHERE target_id = $1
+	`, query)
+}
+
+// ApproveFollowRequest deletes the pending request and inserts the follow in
+// one transaction.
+func (r *FollowPostgres) ApproveFollowRequest(ctx context.Context, targetID, requesterID int64) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	defer tx.Rollback()
+
+	if _, err := lockUserPair(ctx, tx, requesterID, targetID); err != nil {
+		return err
+	}
+	if err := deleteFollowRequest(ctx, tx, targetID, requesterID); err != nil {
+		return err
+	}
+	if err := insertFollow(ctx, tx, requesterID, targetID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errs.ErrDBFailure
+	}
+	return nil
+}
+
+// DenyFollowRequest deletes the pending request.
+func (r *FollowPostgres) DenyFollowRequest(ctx context.Context, targetID, requesterID int64) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	defer tx.Rollback()
+
+	if err := deleteFollowRequest(ctx, tx, targetID, requesterID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errs.ErrDBFailure
+	}
+	return nil
+}
+
+// listFollows pages through the users selected by source, a query on $1 that
+// yields user_id and followed_at columns, joining their live profiles.
+func (r *FollowPostgres) listFollows(ctx context.Context, source string, input transport.FollowListQuery) ([]transport.FollowEntry, error) {
+	query := `
+		SELECT ` + userProfileColumns + `, f.followed_at
+		FROM (` + source + `) AS f
+		JOIN users ON users.id = f.user_id
+		WHERE users.deleted_at IS NULL
+			AND (NOT $2 OR (f.followed_at, f.user_id) < ($3::timestamp, $4::bigint))
+		ORDER BY f.followed_at DESC, f.user_id DESC
+		LIMIT $5
+	`
+
+	var afterTime time.Time
+	var afterID int64
+	if input.After != nil {
+		afterTime, afterID = input.After.CreatedAt, input.After.ID
+	}
+
+	rows, err := r.DB.QueryContext(ctx, query, input.UserID, input.After != nil, afterTime, afterID, input.Limit)
+	if err != nil {
+		return nil, errs.ErrDBFailure
+	}
+	defer rows.Close()
+
+	entries := make([]transport.FollowEntry, 0, input.Limit)
+	for rows.Next() {
+		var entry transport.FollowEntry
+		if entry.Profile, err = scanUserProfile(rows, &entry.CreatedAt); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errs.ErrDBFailure
+	}
+	return entries, nil
+}
+
+// listFollowIDs runs query, which selects a single ID column, on args.
+func (r *FollowPostgres) listFollowIDs(ctx context.Context, query string, args ...any) ([]int64, error) {
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errs.ErrDBFailure
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, errs.ErrDBFailure
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errs.ErrDBFailure
+	}
+	return ids, nil
+}
+
+// lockUserPair share-locks the live accounts of userID and targetID, the
+// user acting and the user acted on, and reports whether targetID is private.
+// Returns ErrUserNotFound unless both accounts are live and targetID is not
+// suspended.
+func lockUserPair(ctx context.Context, tx *sql.Tx, userID, targetID int64) (bool, error) {
+	const query = `
+		SELECT id, private_account, suspended_at IS NOT NULL
+		FROM users
+		WHERE id IN ($1, $2) AND deleted_at IS NULL
+		ORDER BY id
+		FOR SHARE
+	`
+
+	rows, err := tx.QueryContext(ctx, query, userID, targetID)
+	if err != nil {
+		return false, errs.ErrDBFailure
+	}
+	defer rows.Close()
+
+	var private, userFound, targetFound bool
+	for rows.Next() {
+		var id int64
+		var isPrivate, suspended bool
+		if err := rows.Scan(&id, &isPrivate, &suspended); err != nil {
+			return false, errs.ErrDBFailure
+		}
+		switch id {
+		case userID:
+			userFound = true
+		case targetID:
+			targetFound = !suspended
+			private = isPrivate
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return false, errs.ErrDBFailure
+	}
+	if !userFound || !targetFound {
+		return false, errs.ErrUserNotFound
+	}
+	return private, nil
+}
+
+// insertFollow records the follow, bumps both counts and appends a
+// user.followed event if it is new, and drops a request it supersedes.
+func insertFollow(ctx context.Context, tx *sql.Tx, followerID, followeeID int64) error {
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO follows (follower_id, followee_id) VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`, followerID, followeeID)
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return errs.ErrDBFailure
+	} else if n > 0 {
+		if err := adjustFollowCounts(ctx, tx, followerID, followeeID, 1); err != nil {
+			return err
+		}
+		if err := appendUserEvent(ctx, tx, model.UserEventFollowed, followerID, followeeID); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM follow_requests WHERE requester_id = $1 AND target_id = $2
+	`, followerID, followeeID); err != nil {
+		return errs.ErrDBFailure
+	}
+	return nil
+}
+
+// deleteFollowRequest removes the request of requesterID to follow targetID;
+// returns ErrFollowRequestNotFound if there is none.
+func deleteFollowRequest(ctx context.Context, tx *sql.Tx, targetID, requesterID int64) error {
+	result, err := tx.ExecContext(ctx, `
+		DELETE FROM follow_requests WHERE requester_id = $1 AND target_id = $2
+	`, requesterID, targetID)
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	if n == 0 {
+		return errs.ErrFollowRequestNotFound
+	}
+	return nil
+}
+
+// adjustFollowCounts adds delta to the following count of followerID and the
+// follower count of followeeID.
+func adjustFollowCounts(ctx context.Context, tx *sql.Tx, followerID, followeeID int64, delta int) error {
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO user_follow_counts (user_id, following_count) VALUES ($1, GREATEST($2, 0))
+		ON CONFLICT (user_id) DO UPDATE SET following_count = user_follow_counts.following_count + $2
+	`, followerID, delta); err != nil {
+		return errs.ErrDBFailure
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO user_follow_counts (user_id, follower_count) VALUES ($1, GREATEST($2, 0))
+		ON CONFLICT (user_id) DO UPDATE SET follower_count = user_follow_counts.follower_count + $2
+	`, followeeID, delta); err != nil {
+		return errs.ErrDBFailure
+	}
+	return nil
+}
+
+// removeFollowGraph deletes every follow and follow request involving userID
+// and takes the removed follows off the counts of the other users. The deleted
+// rows drive the decrements, so a concurrent unfollow is never counted twice.
+func removeFollowGraph(ctx context.Context, tx *sql.Tx, userID int64) error {
+	for _, query := range []string{
+		`WITH removed AS (
+			DELETE FROM follows WHERE follower_id = $1 OR followee_id = $1
+			RETURNING follower_id, followee_id
+		), decrements AS (
+			SELECT CASE WHEN follower_id = $1 THEN followee_id ELSE follower_id END AS user_id,
+				count(*) FILTER (WHERE follower_id = $1) AS followers,
+				count(*) FILTER (WHERE followee_id = $1) AS following
+			FROM removed
+			GROUP BY 1
+		)
+		UPDATE user_follow_counts AS c
+		SET follower_count = c.follower_count - d.followers,
+			following_count = c.following_count - d.following
+		FROM decrements AS d
+		WHERE c.user_id = d.user_id`,
+		`DELETE FROM follow_requests WHERE requester_id = $1 OR target_id = $1`,
+		`DELETE FROM user_follow_counts WHERE user_id = $1`,
+	} {
+		if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+			return errs.ErrDBFailure
+		}
+	}
+	return nil
+}
+
+// followState combines a follow and a pending request into a FollowState.
+func followState(following, requested bool) transport.FollowState {
+	switch {
+	case following:
+		return transport.FollowStateFollowing
+	case requested:
+		return transport.FollowStateRequested
+	default:
+		return transport.FollowStateNone
+	}
+}
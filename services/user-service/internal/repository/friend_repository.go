@@ -0,0 +1,312 @@
+// Package repository implements persistence logic for friendships. It provides
+// a concrete implementation of FriendRepository, following Dependency Inversion
+// and Liskov Substitution principles.
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+)
+
+type FriendPostgres struct {
+	DB *sql.DB
+}
+
+func NewFriendPostgres(db *sql.DB) *FriendPostgres {
+	return &FriendPostgres{DB: db}
+}
+
+// SendFriendRequest records the request, or accepts addresseeID's pending
+// request to requesterID instead. Both accounts are share-locked first so that
+// neither can be deleted until the request is committed. Two users asking each
+// other at the same time both end up with a pending request; accepting either
+// one clears both.
+func (r *FriendPostgres) SendFriendRequest(ctx context.Context, requesterID, addresseeID int64) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	defer tx.Rollback()
+
+	if _, err := lockUserPair(ctx, tx, requesterID, addresseeID); err != nil {
+		return err
+	}
+
+	err = deleteFriendRequest(ctx, tx, requesterID, addresseeID)
+	switch {
+	case err == nil:
+		if err := insertFriendship(ctx, tx, requesterID, addresseeID); err != nil {
+			return err
+		}
+	case errors.Is(err, errs.ErrFriendRequestNotFound):
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO friend_requests (requester_id, addressee_id)
+			SELECT $1, $2
+			WHERE NOT EXISTS (SELECT 1 FROM friendships WHERE user_id = $1 AND friend_id = $2)
+			ON CONFLICT DO NOTHING
+		`, requesterID, addresseeID)
+		if err != nil {
+			return errs.ErrDBFailure
+		}
+	default:
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errs.ErrDBFailure
+	}
+	return nil
+}
+
+// RespondToFriendRequest deletes the pending request and, when accepting,
+// inserts the friendship in the same transaction.
+func (r *FriendPostgres) RespondToFriendRequest(ctx context.Context, addresseeID, requesterID int64, accept bool) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	defer tx.Rollback()
+
+	if accept {
+		if _, err := lockUserPair(ctx, tx, addresseeID, requesterID); err != nil {
+			return err
+		}
+	}
+	if err := deleteFriendRequest(ctx, tx, addresseeID, requesterID); err != nil {
+		return err
+	}
+	if accept {
+		if err := insertFriendship(ctx, tx, addresseeID, requesterID); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errs.ErrDBFailure
+	}
+	return nil
+}
+
+// RemoveFriend deletes both directions of the friendship and the pending
+// request of userID to friendID.
+func (r *FriendPostgres) RemoveFriend(ctx context.Context, userID, friendID int64) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	defer tx.Rollback()
+
+	for _, query := range []string{
+		`DELETE FROM friendships WHERE (user_id = $1 AND friend_id = $2) OR (user_id = $2 AND friend_id = $1)`,
+		`DELETE FROM friend_requests WHERE requester_id = $1 AND addressee_id = $2`,
+	} {
+		if _, err := tx.ExecContext(ctx, query, userID, friendID); err != nil {
+			return errs.ErrDBFailure
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errs.ErrDBFailure
+	}
+	return nil
+}
+
+// GetFriendship reads the friendship and friend requests between two live
+// accounts.
+func (r *FriendPostgres) GetFriendship(ctx context.Context, userID, otherUserID int64) (transport.Friendship, error) {
+	const query = `
+		SELECT
+			(SELECT count(*) FROM users WHERE id IN ($1, $2) AND deleted_at IS NULL),
+			EXISTS (SELECT 1 FROM friendships WHERE user_id = $1 AND friend_id = $2),
+			EXISTS (SELECT 1 FROM friend_requests WHERE requester_id = $1 AND addressee_id = $2),
+			EXISTS (SELECT 1 FROM friend_requests WHERE requester_id = $2 AND addressee_id = $1)
+	`
+
+	var live int
+	var friends, sent, received bool
+	err := r.DB.QueryRowContext(ctx, query, userID, otherUserID).Scan(&live, &friends, &sent, &received)
+	if err != nil {
+		return transport.Friendship{}, errs.ErrDBFailure
+	}
+
+	want := 2
+	if userID == otherUserID {
+		want = 1
+	}
+	if live < want {
+		return transport.Friendship{}, errs.ErrUserNotFound
+	}
+
+	state := transport.FriendshipStateNone
+	switch {
+	case friends:
+		state = transport.FriendshipStateFriends
+	case sent:
+		state = transport.FriendshipStateRequestSent
+	case received:
+		state = transport.FriendshipStateRequestReceived
+	}
+	return transport.Friendship{UserID: userID, OtherUserID: otherUserID, State: state}, nil
+}
+
+// ListFriends returns the live friends of query.UserID, newest friendship
+// first.
+func (r *FriendPostgres) ListFriends(ctx context.Context, query transport.FriendListQuery) ([]transport.FriendEntry, error) {
+	return r.listFriends(ctx, `
+		SELECT friend_id AS user_id, created_at AS listed_at FROM friendships WHERE user_id = $1
+	`, query, query.UserID)
+}
+
+// ListMutualFriends returns the live friends query.UserID and
+// query.OtherUserID have in common, ordered by when the later of the two
+// friendships started, newest first.
+func (r *FriendPostgres) ListMutualFriends(ctx context.Context, query transport.FriendListQuery) ([]transport.FriendEntry, error) {
+	return r.listFriends(ctx, `
+		SELECT a.friend_id AS user_id, GREATEST(a.created_at, b.created_at) AS listed_at
+		FROM friendships AS a
+		JOIN friendships AS b ON b.user_id = $2 AND b.friend_id = a.friend_id
+		WHERE a.user_id = $1
+	`, query, query.UserID, query.OtherUserID)
+}
+
+// ListFriendRequests returns the live requesters waiting for query.UserID to
+// respond, newest first.
+func (r *FriendPostgres) ListFriendRequests(ctx context.Context, query transport.FriendListQuery) ([]transport.FriendEntry, error) {
+	return r.listFriends(ctx, `
+		SELECT requester_id AS user_id, created_at AS listed_at FROM friend_requests WHERE addressee_id = $1
+	`, query, query.UserID)
+}
+
+// ListFriendSuggestions counts, for every friend of a friend of userID, how
+// many of userID's friends they are friends with.
+func (r *FriendPostgres) ListFriendSuggestions(ctx context.Context, userID int64, limit int) ([]transport.FriendSuggestion, error) {
+	query := `
+		SELECT ` + userProfileColumns + `, s.mutual_friend_count
+		FROM (
+			SELECT b.friend_id AS user_id, count(*) AS mutual_friend_count
+			FROM friendships AS a
+			JOIN friendships AS b ON b.user_id = a.friend_id
+			WHERE a.user_id = $1
+				AND b.friend_id <> $1
+				AND NOT EXISTS (
+					SELECT 1 FROM friendships AS f WHERE f.user_id = $1 AND f.friend_id = b.friend_id
+				)
+				AND NOT EXISTS (
+					SELECT 1 FROM friend_requests AS fr
+					WHERE (fr.requester_id = $1 AND fr.addressee_id = b.friend_id)
+						OR (fr.requester_id = b.friend_id AND fr.addressee_id = $1)
+				)
+			GROUP BY b.friend_id
+		) AS s
+		JOIN users ON users.id = s.user_id
+		WHERE users.deleted_at IS NULL AND users.suspended_at IS NULL
+		ORDER BY s.mutual_friend_count DESC, s.user_id
+		LIMIT $2
+	`
+
+	rows, err := r.DB.QueryContext(ctx, query, userID, limit)
+	if err != nil {
+		return nil, errs.ErrDBFailure
+	}
+	defer rows.Close()
+
+	suggestions := make([]transport.FriendSuggestion, 0, limit)
+	for rows.Next() {
+		var s transport.FriendSuggestion
+		if s.Profile, err = scanUserProfile(rows, &s.MutualFriendCount); err != nil {
+			return nil, err
+		}
+		suggestions = append(suggestions, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errs.ErrDBFailure
+	}
+	return suggestions, nil
+}
+
+// listFriends pages through the users selected by source, a query on args
+// that yields user_id and listed_at columns, joining their live profiles. The
+// paging parameters are numbered after args.
+func (r *FriendPostgres) listFriends(
+	ctx context.Context,
+	source string,
+	input transport.FriendListQuery,
+	args ...any,
+) ([]transport.FriendEntry, error) {
+	n := len(args)
+	query := fmt.Sprintf(`
+		SELECT `+userProfileColumns+`, f.listed_at
+		FROM (%s) AS f
+		JOIN users ON users.id = f.user_id
+		WHERE users.deleted_at IS NULL
+			AND (NOT $%d OR (f.listed_at, f.user_id) < ($%d::timestamp, $%d::bigint))
+		ORDER BY f.listed_at DESC, f.user_id DESC
+		LIMIT $%d
+	`, source, n+1, n+2, n+3, n+4)
+
+	var afterTime time.Time
+	var afterID int64
+	if input.After != nil {
+		afterTime, afterID = input.After.CreatedAt, input.After.ID
+	}
+	args = append(args, input.After != nil, afterTime, afterID, input.Limit)
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errs.ErrDBFailure
+	}
+	defer rows.Close()
+
+	entries := make([]transport.FriendEntry, 0, input.Limit)
+	for rows.Next() {
+		var entry transport.FriendEntry
+		if entry.Profile, err = scanUserProfile(rows, &entry.CreatedAt); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errs.ErrDBFailure
+	}
+	return entries, nil
+}
+
+// insertFriendship records the friendship in both directions and drops any
+// request between the two users it supersedes.
+func insertFriendship(ctx context.Context, tx *sql.Tx, userID, friendID int64) error {
+	for _, query := range []string{
+		`INSERT INTO friendships (user_id, friend_id) VALUES ($1, $2), ($2, $1) ON CONFLICT DO NOTHING`,
+		`DELETE FROM friend_requests
+		WHERE (requester_id = $1 AND addressee_id = $2) OR (requester_id = $2 AND addressee_id = $1)`,
+	} {
+		if _, err := tx.ExecContext(ctx, query, userID, friendID); err != nil {
+			return errs.ErrDBFailure
+		}
+	}
+	return nil
+}
+
+// deleteFriendRequest removes the request of requesterID to addresseeID;
+// returns ErrFriendRequestNotFound if there is none.
+func deleteFriendRequest(ctx context.Context, tx *sql.Tx, addresseeID, requesterID int64) error {
+	result, err := tx.ExecContext(ctx, `
+		DELETE FROM friend_requests WHERE requester_id = $1 AND addressee_id = $2
+	`, requesterID, addresseeID)
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	if n == 0 {
+		return errs.ErrFriendRequestNotFound
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+// Package repository implements persistence logic for social login: external
+// identities linked to users and pending OAuth logins. It provides concrete
+// implementations of IdentityRepository, following Dependency Inversion and
+// Liskov Substitution principles.
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+)
+
+type IdentityPostgres struct {
+	DB *sql.DB
+}
+
+func NewIdentityPostgres(db *sql.DB) *IdentityPostgres {
+	return &IdentityPostgres{DB: db}
+}
+
+// SaveOAuthState stores a pending social login under the digest of its state.
+func (r *IdentityPostgres) SaveOAuthState(ctx context.Context, input domain.SaveOAuthStateInput) error {
+	query := `
+		INSERT INTO oauth_states (state_hash, provider, code_verifier, nonce, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	if _, err := r.DB.ExecContext(ctx, query,
+		input.StateHash,
+		input.Provider,
+		input.CodeVerifier,
+		input.Nonce,
+		input.ExpiresAt,
+	); err != nil {
+		return errs.ErrDBFailure
+	}
+	return nil
+}
+
+// ConsumeOAuthState deletes the pending login behind a state digest and returns it.
+func (r *IdentityPostgres) ConsumeOAuthState(ctx context.Context, stateHash string) (model.OAuthState, error) {
+	query := `
+		DELETE FROM oauth_states
+		WHERE state_hash = $1 AND expires_at > NOW()
+		RETURNING provider, code_verifier, nonce
+	`
+
+	var s model.OAuthState
+	if err := r.DB.QueryRowContext(ctx, query, stateHash).Scan(&s.Provider, &s.CodeVerifier, &s.Nonce); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.OAuthState{}, errs.ErrInvalidOAuthState
+		}
+		return model.OAuthState{}, errs.ErrDBFailure
+	}
+	return s, nil
+}
+
+// FindUserByIdentity loads the user an external identity is linked to.
+func (r *IdentityPostgres) FindUserByIdentity(ctx context.Context, provider, subject string) (model.User, error) {
+	query := `
+		SELECT u.id, u.nickname, u.email, u.email_verified_at, u.suspended_at,
+			ARRAY(SELECT role FROM user_roles WHERE user_id = u.id ORDER BY role)
+		FROM external_identities ei
+		JOIN users u ON u.id = ei.user_id
+		WHERE ei.provider = $1 AND ei.subject = $2 AND u.deleted_at IS NULL
+	`
+
+	var u model.User
+	var verifiedAt, suspendedAt sql.NullTime
+	err := r.DB.QueryRowContext(ctx, query, provider, subject).Scan(
+		&u.ID, &u.Nickname, &u.Email, &verifiedAt, &suspendedAt, pq.Array(&u.Roles),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.User{}, errs.ErrIdentityNotFound
+		}
+		return model.User{}, errs.ErrDBFailure
+	}
+	u.EmailVerifiedAt = verifiedAt.Time
+	u.SuspendedAt = suspendedAt.Time
+	return u, nil
+}
+
+// LinkIdentity links an external identity to an existing user.
+func (r *IdentityPostgres) LinkIdentity(ctx context.Context, identity model.ExternalIdentity) error {
+	query := `
+		INSERT INTO external_identities (provider, subject, user_id, email)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	if _, err := r.DB.ExecContext(ctx, query, identity.Provider, identity.Subject, identity.UserID, identity.Email); err != nil {
+		return errs.ErrDBFailure
+	}
+	return nil
+}
+
+// CreateUserWithIdentity creates a passwordless user whose email was verified
+// by the provider, and links the identity to it in the same transaction.
+func (r *IdentityPostgres) CreateUserWithIdentity(ctx context.Context, u model.User, identity model.ExternalIdentity) (model.User, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return model.User{}, errs.ErrDBFailure
+	}
+	defer tx.Rollback()
+
+	verifiedAt := sql.NullTime{Time: u.EmailVerifiedAt, Valid: !u.EmailVerifiedAt.IsZero()}
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO users
+		(username, email, password_hash, nickname, bio, avatar_url, email_verified_at)
+		VALUES ($1, $2, '', $3, '', $4, $5)
+		RETURNING id, created_at
+	`, u.Username, u.Email, u.Nickname, u.AvatarURL, verifiedAt).Scan(&u.ID, &u.CreatedAt)
+	if err != nil {
+		return model.User{}, mapUserInsertError(err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO external_identities (provider, subject, user_id, email)
+		VALUES ($1, $2, $3, $4)
+	`, identity.Provider, identity.Subject, u.ID, identity.Email); err != nil {
+		return model.User{}, errs.ErrDBFailure
+	}
+
+	if err := tx.Commit(); err != nil {
+		return model.User{}, errs.ErrDBFailure
+	}
+	return u, nil
+}
@@ -0,0 +1,86 @@
+// Package repository implements persistence logic for failed login tracking.
+// It provides concrete implementations of LoginAttemptRepository, following
+// Dependency Inversion and Liskov Substitution principles.
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+)
+
+type LoginAttemptPostgres struct {
+	DB *sql.DB
+}
+
+func NewLoginAttemptPostgres(db *sql.DB) *LoginAttemptPostgres {
+	return &LoginAttemptPostgres{DB: db}
+}
+
+// LoginLockedUntil returns the latest active lockout among keys.
+func (r *LoginAttemptPostgres) LoginLockedUntil(ctx context.Context, keys []model.LoginAttemptKey) (time.Time, error) {
+	query := `
+		SELECT MAX(locked_until) FROM login_attempts
+		WHERE scope = $1 AND key_hash = $2 AND locked_until > NOW()
+	`
+
+	var latest time.Time
+	for _, key := range keys {
+		var until sql.NullTime
+		if err := r.DB.QueryRowContext(ctx, query, key.Scope, key.KeyHash).Scan(&until); err != nil {
+			return time.Time{}, errs.ErrDBFailure
+		}
+		if until.Valid && until.Time.After(latest) {
+			latest = until.Time
+		}
+	}
+	return latest, nil
+}
+
+// RecordLoginFailure increments the failure counter, restarting it when the
+// previous failure is older than window.
+func (r *LoginAttemptPostgres) RecordLoginFailure(ctx context.Context, key model.LoginAttemptKey, window time.Duration) (int, error) {
+	query := `
+		INSERT INTO login_attempts (scope, key_hash, failures, last_failed_at)
+		VALUES ($1, $2, 1, NOW())
+		ON CONFLICT (scope, key_hash) DO UPDATE
+		SET failures = CASE
+				WHEN login_attempts.last_failed_at < NOW() - make_interval(secs => $3) THEN 1
+				ELSE login_attempts.failures + 1
+			END,
+			last_failed_at = NOW()
+		RETURNING failures
+	`
+
+	var failures int
+	if err := r.DB.QueryRowContext(ctx, query, key.Scope, key.KeyHash, window.Seconds()).Scan(&failures); err != nil {
+		return 0, errs.ErrDBFailure
+	}
+	return failures, nil
+}
+
+// LockLogin sets the lockout end for key.
+func (r *LoginAttemptPostgres) LockLogin(ctx context.Context, key model.LoginAttemptKey, until time.Time) error {
+	query := `
+		UPDATE login_attempts SET locked_until = $3
+		WHERE scope = $1 AND key_hash = $2
+	`
+
+	if _, err := r.DB.ExecContext(ctx, query, key.Scope, key.KeyHash, until); err != nil {
+		return errs.ErrDBFailure
+	}
+	return nil
+}
+
+// ResetLoginFailures forgets all failures recorded against key.
+func (r *LoginAttemptPostgres) ResetLoginFailures(ctx context.Context, key model.LoginAttemptKey) error {
+	query := `DELETE FROM login_attempts WHERE scope = $1 AND key_hash = $2`
+
+	if _, err := r.DB.ExecContext(ctx, query, key.Scope, key.KeyHash); err != nil {
+		return errs.ErrDBFailure
+	}
+	return nil
+}
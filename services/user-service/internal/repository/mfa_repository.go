@@ -0,0 +1,246 @@
+// Package repository implements persistence logic for two-factor
+// authentication: TOTP secrets, recovery codes and MFA login challenges. It
+// provides concrete implementations of MFARepository, following Dependency
+// Inversion and Liskov Substitution principles.
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/security"
+)
+
+// MFAPostgres stores TOTP secrets encrypted with Box, so a database leak alone
+// does not allow generating valid codes.
+type MFAPostgres struct {
+	DB  *sql.DB
+	Box *security.SecretBox
+}
+
+func NewMFAPostgres(db *sql.DB, box *security.SecretBox) *MFAPostgres {
+	return &MFAPostgres{DB: db, Box: box}
+}
+
+// SaveTOTPSecret upserts a pending enrollment unless TOTP is already confirmed.
+func (r *MFAPostgres) SaveTOTPSecret(ctx context.Context, userID int64, secret string) error {
+	sealed, err := r.Box.Seal(secret)
+	if err != nil {
+		slog.Error("failed to encrypt TOTP secret", "err", err)
+		return errs.ErrInternal
+	}
+
+	query := `
+		INSERT INTO user_totp (user_id, secret)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET secret = EXCLUDED.secret, last_used_step = 0, created_at = NOW()
+		WHERE user_totp.confirmed_at IS NULL
+	`
+
+	res, err := r.DB.ExecContext(ctx, query, userID, sealed)
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	if affected == 0 {
+		return errs.ErrTOTPAlreadyEnabled
+	}
+	return nil
+}
+
+// GetTOTP loads and decrypts the user's enrollment.
+func (r *MFAPostgres) GetTOTP(ctx context.Context, userID int64) (model.TOTPEnrollment, error) {
+	query := `
+		SELECT secret, confirmed_at IS NOT NULL, last_used_step
+		FROM user_totp
+		WHERE user_id = $1
+	`
+
+	e := model.TOTPEnrollment{UserID: userID}
+	var sealed string
+	err := r.DB.QueryRowContext(ctx, query, userID).Scan(&sealed, &e.Confirmed, &e.LastUsedStep)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.TOTPEnrollment{}, errs.ErrTOTPNotEnrolled
+		}
+		return model.TOTPEnrollment{}, errs.ErrDBFailure
+	}
+
+	if e.Secret, err = r.Box.Open(sealed); err != nil {
+		return model.TOTPEnrollment{}, err
+	}
+	return e, nil
+}
+
+// EnableTOTP confirms the pending enrollment and issues a fresh set of recovery codes.
+func (r *MFAPostgres) EnableTOTP(ctx context.Context, userID int64, step int64, codeHashes []string) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE user_totp SET confirmed_at = NOW(), last_used_step = $2
+		WHERE user_id = $1 AND confirmed_at IS NULL
+	`, userID, step)
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	if affected == 0 {
+		return errs.ErrTOTPAlreadyEnabled
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM mfa_recovery_codes WHERE user_id = $1`, userID); err != nil {
+		return errs.ErrDBFailure
+	}
+	for _, hash := range codeHashes {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO mfa_recovery_codes (code_hash, user_id) VALUES ($1, $2)
+		`, hash, userID); err != nil {
+			return errs.ErrDBFailure
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errs.ErrDBFailure
+	}
+	return nil
+}
+
+// MarkTOTPStepUsed advances last_used_step, refusing steps that were already used.
+func (r *MFAPostgres) MarkTOTPStepUsed(ctx context.Context, userID int64, step int64) error {
+	query := `
+		UPDATE user_totp SET last_used_step = $2
+		WHERE user_id = $1 AND last_used_step < $2
+	`
+
+	res, err := r.DB.ExecContext(ctx, query, userID, step)
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	if affected == 0 {
+		return errs.ErrInvalidMFACode
+	}
+	return nil
+}
+
+// DeleteTOTP disables two-factor authentication for the user.
+func (r *MFAPostgres) DeleteTOTP(ctx context.Context, userID int64) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	defer tx.Rollback()
+
+	for _, query := range []string{
+		`DELETE FROM mfa_challenges WHERE user_id = $1`,
+		`DELETE FROM mfa_recovery_codes WHERE user_id = $1`,
+		`DELETE FROM user_totp WHERE user_id = $1`,
+	} {
+		if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+			return errs.ErrDBFailure
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errs.ErrDBFailure
+	}
+	return nil
+}
+
+// ConsumeRecoveryCode marks one of the user's unused recovery codes as used.
+func (r *MFAPostgres) ConsumeRecoveryCode(ctx context.Context, userID int64, codeHash string) error {
+	query := `
+		UPDATE mfa_recovery_codes SET used_at = NOW()
+		WHERE code_hash = $1 AND user_id = $2 AND used_at IS NULL
+	`
+
+	res, err := r.DB.ExecContext(ctx, query, codeHash, userID)
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	if affected == 0 {
+		return errs.ErrInvalidMFACode
+	}
+	return nil
+}
+
+// SaveMFAChallenge stores the digest of a pending MFA login.
+func (r *MFAPostgres) SaveMFAChallenge(ctx context.Context, input domain.SaveMFAChallengeInput) error {
+	query := `
+		INSERT INTO mfa_challenges (token_hash, user_id, expires_at)
+		VALUES ($1, $2, $3)
+	`
+
+	if _, err := r.DB.ExecContext(ctx, query, input.TokenHash, input.UserID, input.ExpiresAt); err != nil {
+		return errs.ErrDBFailure
+	}
+	return nil
+}
+
+// ClaimMFAChallengeAttempt increments the attempt counter of an unexpired,
+// unexhausted challenge in a single statement, so concurrent guesses cannot
+// exceed maxAttempts.
+func (r *MFAPostgres) ClaimMFAChallengeAttempt(
+	ctx context.Context,
+	tokenHash string,
+	maxAttempts int,
+) (model.MFAChallenge, error) {
+	query := `
+		UPDATE mfa_challenges SET attempts = attempts + 1
+		WHERE token_hash = $1 AND expires_at > NOW() AND attempts < $2
+		RETURNING user_id, attempts
+	`
+
+	var c model.MFAChallenge
+	if err := r.DB.QueryRowContext(ctx, query, tokenHash, maxAttempts).Scan(&c.UserID, &c.Attempts); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.MFAChallenge{}, errs.ErrInvalidMFAChallenge
+		}
+		return model.MFAChallenge{}, errs.ErrDBFailure
+	}
+	return c, nil
+}
+
+// DeleteMFAChallenge removes a challenge once it is redeemed.
+func (r *MFAPostgres) DeleteMFAChallenge(ctx context.Context, tokenHash string) error {
+	res, err := r.DB.ExecContext(ctx, `DELETE FROM mfa_challenges WHERE token_hash = $1`, tokenHash)
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	if affected == 0 {
+		return errs.ErrInvalidMFAChallenge
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+// Package repository implements persistence logic for password reset tokens.
+// It provides concrete implementations of PasswordResetRepository, following
+// Dependency Inversion and Liskov Substitution principles.
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+)
+
+type PasswordResetPostgres struct {
+	DB *sql.DB
+}
+
+func NewPasswordResetPostgres(db *sql.DB) *PasswordResetPostgres {
+	return &PasswordResetPostgres{DB: db}
+}
+
+// SavePasswordResetToken stores the digest of a newly issued reset token
+// unless the user was issued another one within input.Cooldown. The user row
+// is locked first, so concurrent requests see each other's tokens and at most
+// one of them is saved per cooldown.
+func (r *PasswordResetPostgres) SavePasswordResetToken(ctx context.Context, input domain.SavePasswordResetTokenInput) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `SELECT 1 FROM users WHERE id = $1 FOR UPDATE`, input.UserID); err != nil {
+		return errs.ErrDBFailure
+	}
+
+	res, err := tx.ExecContext(ctx, `
+		INSERT INTO password_reset_tokens (token_hash, user_id, expires_at)
+		SELECT $1, $2, $3
+		WHERE NOT EXISTS (
+			SELECT 1 FROM password_reset_tokens
+			WHERE user_id = $2 AND created_at > NOW() - make_interval(secs => $4)
+		)
+	`, input.TokenHash, input.UserID, input.ExpiresAt, input.Cooldown.Seconds())
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	saved, err := res.RowsAffected()
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	if saved == 0 {
+		return errs.ErrPasswordResetThrottled
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errs.ErrDBFailure
+	}
+	return nil
+}
+
+// GetPasswordResetToken looks up the owner of an unexpired reset token.
+func (r *PasswordResetPostgres) GetPasswordResetToken(ctx context.Context, tokenHash string) (int64, error) {
+	query := `
+		SELECT user_id FROM password_reset_tokens
+		WHERE token_hash = $1 AND expires_at > NOW()
+	`
+
+	var userID int64
+	if err := r.DB.QueryRowContext(ctx, query, tokenHash).Scan(&userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, errs.ErrInvalidResetToken
+		}
+		return 0, errs.ErrDBFailure
+	}
+	return userID, nil
+}
+
+// ConsumePasswordResetToken atomically redeems a token: it deletes the matching
+// unexpired row, replaces users.password_hash, drops any sibling tokens and
+// revokes every refresh token of the owner.
+func (r *PasswordResetPostgres) ConsumePasswordResetToken(ctx context.Context, tokenHash, passwordHash string) (int64, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, errs.ErrDBFailure
+	}
+	defer tx.Rollback()
+
+	var userID int64
+	err = tx.QueryRowContext(ctx, `
+		DELETE FROM password_reset_tokens
+		WHERE token_hash = $1 AND expires_at > NOW()
+		RETURNING user_id
+	`, tokenHash).Scan(&userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, errs.ErrInvalidResetToken
+		}
+		return 0, errs.ErrDBFailure
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE users SET password_hash = $2 WHERE id = $1`, userID, passwordHash); err != nil {
+		return 0, errs.ErrDBFailure
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM password_reset_tokens WHERE user_id = $1`, userID); err != nil {
+		return 0, errs.ErrDBFailure
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM refresh_tokens WHERE user_id = $1`, userID); err != nil {
+		return 0, errs.ErrDBFailure
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, errs.ErrDBFailure
+	}
+	return userID, nil
+}
@@ -9,8 +9,8 @@ import (
 	"errors"
 
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
-	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
 )
 
 type TokenPostgres struct {
@@ -21,14 +21,25 @@ func NewTokenPostgres(db *sql.DB) *TokenPostgres {
 	return &TokenPostgres{DB: db}
 }
 
+// SaveRefreshToken stores a refresh token digest together with its session
+// metadata. A zero CreatedAt starts a new session timestamped at insert time.
 func (r *TokenPostgres) SaveRefreshToken(ctx context.Context, input domain.SaveRefreshTokenInput) error {
 	query := `
-        INSERT INTO refresh_tokens (token, user_id, expires_at)
-        VALUES ($1, $2, $3)
-        ON CONFLICT (token) DO NOTHING
+        INSERT INTO refresh_tokens (token_hash, user_id, expires_at, session_id, user_agent, ip_address, created_at, last_used_at)
+        VALUES ($1, $2, $3, $4, $5, $6, COALESCE($7, NOW()), NOW())
+        ON CONFLICT (token_hash) DO NOTHING
     `
 
-	_, err := r.DB.ExecContext(ctx, query, input.Token, input.UserID, input.ExpiresAt)
+	createdAt := sql.NullTime{Time: input.CreatedAt, Valid: !input.CreatedAt.IsZero()}
+	_, err := r.DB.ExecContext(ctx, query,
+		input.TokenHash,
+		input.UserID,
+		input.ExpiresAt,
+		input.SessionID,
+		input.Client.UserAgent,
+		input.Client.IPAddress,
+		createdAt,
+	)
 	if err != nil {
 		return errs.ErrDBFailure
 	}
@@ -36,27 +47,75 @@ func (r *TokenPostgres) SaveRefreshToken(ctx context.Context, input domain.SaveR
 	return nil
 }
 
-func (r *TokenPostgres) GetRefreshToken(ctx context.Context, input transport.RefreshTokenRequest) (string, error) {
+// GetRefreshToken returns the session behind an unexpired refresh token digest. If the
+// token has already been rotated, the session is returned with ErrRefreshTokenReused.
+func (r *TokenPostgres) GetRefreshToken(ctx context.Context, tokenHash string) (model.Session, error) {
 	query := `
-        SELECT user_id FROM refresh_tokens
-        WHERE token = $1 AND expires_at > NOW()
+        SELECT session_id, user_id, user_agent, ip_address, created_at, last_used_at, expires_at, rotated_at
+        FROM refresh_tokens
+        WHERE token_hash = $1 AND expires_at > NOW()
     `
 
-	var userID string
-	err := r.DB.QueryRowContext(ctx, query, input.RefreshToken).Scan(&userID)
+	var s model.Session
+	var rotatedAt sql.NullTime
+	err := r.DB.QueryRowContext(ctx, query, tokenHash).Scan(
+		&s.ID,
+		&s.UserID,
+		&s.UserAgent,
+		&s.IPAddress,
+		&s.CreatedAt,
+		&s.LastUsedAt,
+		&s.ExpiresAt,
+		&rotatedAt,
+	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return "", errs.ErrTokenNotFound
+			return model.Session{}, errs.ErrTokenNotFound
 		}
-		return "", errs.ErrDBFailure
+		return model.Session{}, errs.ErrDBFailure
 	}
-	return userID, nil
+	if rotatedAt.Valid {
+		return s, errs.ErrRefreshTokenReused
+	}
+	return s, nil
 }
 
-func (r *TokenPostgres) DeleteRefreshToken(ctx context.Context, input transport.RefreshTokenRequest) error {
-	query := `DELETE FROM refresh_tokens WHERE token = $1`
+// RotateRefreshToken retires an active refresh token. The row is kept until it
+// expires so that a later replay can be recognised as reuse.
+func (r *TokenPostgres) RotateRefreshToken(ctx context.Context, tokenHash string) error {
+	query := `
+        UPDATE refresh_tokens SET rotated_at = NOW()
+        WHERE token_hash = $1 AND rotated_at IS NULL
+    `
 
-	result, err := r.DB.ExecContext(ctx, query, input.RefreshToken)
+	result, err := r.DB.ExecContext(ctx, query, tokenHash)
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	if affected == 0 {
+		// Another request rotated this token first.
+		return errs.ErrRefreshTokenReused
+	}
+	return nil
+}
+
+// DeleteRefreshToken revokes the session that the given active token belongs
+// to, including its rotated-out predecessors.
+func (r *TokenPostgres) DeleteRefreshToken(ctx context.Context, tokenHash string) error {
+	query := `
+        DELETE FROM refresh_tokens
+        WHERE session_id = (
+            SELECT session_id FROM refresh_tokens
+            WHERE token_hash = $1 AND rotated_at IS NULL
+        )
+    `
+
+	result, err := r.DB.ExecContext(ctx, query, tokenHash)
 	if err != nil {
 		return errs.ErrDBFailure
 	}
@@ -72,3 +131,103 @@ func (r *TokenPostgres) DeleteRefreshToken(ctx context.Context, input transport.
 
 	return nil
 }
+
+// ListSessions returns the user's unexpired sessions, most recently used first.
+func (r *TokenPostgres) ListSessions(ctx context.Context, userID int64) ([]model.Session, error) {
+	query := `
+        SELECT session_id, user_id, user_agent, ip_address, created_at, last_used_at, expires_at
+        FROM refresh_tokens
+        WHERE user_id = $1 AND rotated_at IS NULL AND expires_at > NOW()
+        ORDER BY last_used_at DESC
+    `
+
+	rows, err := r.DB.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, errs.ErrDBFailure
+	}
+	defer rows.Close()
+
+	var sessions []model.Session
+	for rows.Next() {
+		s, err := scanSession(rows)
+		if err != nil {
+			return nil, errs.ErrDBFailure
+		}
+		sessions = append(sessions, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errs.ErrDBFailure
+	}
+	return sessions, nil
+}
+
+// DeleteSession revokes a single session and its token family, scoped to its owner.
+func (r *TokenPostgres) DeleteSession(ctx context.Context, userID int64, sessionID string) error {
+	query := `DELETE FROM refresh_tokens WHERE user_id = $1 AND session_id = $2`
+
+	result, err := r.DB.ExecContext(ctx, query, userID, sessionID)
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	if affected == 0 {
+		return errs.ErrSessionNotFound
+	}
+	return nil
+}
+
+// DeleteOtherSessions revokes all of the user's sessions except keepSessionID.
+func (r *TokenPostgres) DeleteOtherSessions(ctx context.Context, userID int64, keepSessionID string) (int64, error) {
+	query := `DELETE FROM refresh_tokens WHERE user_id = $1 AND session_id <> $2`
+
+	result, err := r.DB.ExecContext(ctx, query, userID, keepSessionID)
+	if err != nil {
+		return 0, errs.ErrDBFailure
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, errs.ErrDBFailure
+	}
+	return affected, nil
+}
+
+// DeleteAllSessions revokes all of the user's sessions.
+func (r *TokenPostgres) DeleteAllSessions(ctx context.Context, userID int64) (int64, error) {
+	query := `DELETE FROM refresh_tokens WHERE user_id = $1`
+
+	result, err := r.DB.ExecContext(ctx, query, userID)
+	if err != nil {
+		return 0, errs.ErrDBFailure
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, errs.ErrDBFailure
+	}
+	return affected, nil
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanSession scans a refresh token row into a Session.
+func scanSession(row rowScanner) (model.Session, error) {
+	var s model.Session
+	err := row.Scan(
+		&s.ID,
+		&s.UserID,
+		&s.UserAgent,
+		&s.IPAddress,
+		&s.CreatedAt,
+		&s.LastUsedAt,
+		&s.ExpiresAt,
+	)
+	return s, err
+}
@@ -8,7 +8,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/lib/pq"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
 )
@@ -21,12 +23,20 @@ func NewUserPostgres(db *sql.DB) *UserPostgres {
 	return &UserPostgres{DB: db}
 }
 
+// userProfileColumns are the columns read by scanUserProfile.
+const userProfileColumns = `
+	id, username, email, nickname, bio, avatar_url, last_login, created_at, updated_at, email_verified_at,
+	suspended_at, email_visibility, last_login_visibility, bio_visibility, private_account,
+	COALESCE((SELECT follower_count FROM user_follow_counts WHERE user_id = users.id), 0),
+	COALESCE((SELECT following_count FROM user_follow_counts WHERE user_id = users.id), 0),
+	ARRAY(SELECT role FROM user_roles WHERE user_id = users.id ORDER BY role)`
+
 // FetchUserByNickname retrieves a user by their unique nickname.
 func (r *UserPostgres) FetchUserByNickname(ctx context.Context, input transport.FetchUserByNicknameRequest) (transport.UserProfileResponse, error) {
 	const query = `
-        SELECT id, username, email, nickname, bio, avatar_url, last_login, created_at, updated_at
+        SELECT ` + userProfileColumns + `
         FROM users
-        WHERE nickname = $1
+        WHERE nickname = $1 AND deleted_at IS NULL
     `
 	row := r.DB.QueryRowContext(ctx, query, input.Nickname)
 
@@ -36,9 +46,9 @@ func (r *UserPostgres) FetchUserByNickname(ctx context.Context, input transport.
 // FetchUserByID retrieves a user by their id (domain uses only).
 func (r *UserPostgres) FetchUserByID(ctx context.Context, input transport.FetchUserByIDRequest) (transport.UserProfileResponse, error) {
 	query := `
-		SELECT id, username, email, nickname, bio, avatar_url, last_login, created_at, updated_at 
-		FROM users 
-		WHERE id = $1
+		SELECT ` + userProfileColumns + `
+		FROM users
+		WHERE id = $1 AND deleted_at IS NULL
 	`
 
 	row := r.DB.QueryRowContext(ctx, query, input.UserId)
@@ -46,10 +56,140 @@ func (r *UserPostgres) FetchUserByID(ctx context.Context, input transport.FetchU
 	return scanUserProfile(row)
 }
 
-// scanUserProfile scans a sql.Row into a UserProfileResponse.
-func scanUserProfile(row *sql.Row) (transport.UserProfileResponse, error) {
+// FetchUsersByIDs retrieves the live users among userIDs in a single query,
+// ordered by ID. IDs without a matching user are simply absent.
+func (r *UserPostgres) FetchUsersByIDs(ctx context.Context, userIDs []int64) ([]transport.UserProfileResponse, error) {
+	const query = `
+		SELECT ` + userProfileColumns + `
+		FROM users
+		WHERE id = ANY($1) AND deleted_at IS NULL
+		ORDER BY id
+	`
+
+	rows, err := r.DB.QueryContext(ctx, query, pq.Array(userIDs))
+	if err != nil {
+		return nil, errs.ErrDBFailure
+	}
+	defer rows.Close()
+
+	users := make([]transport.UserProfileResponse, 0, len(userIDs))
+	for rows.Next() {
+		u, err := scanUserProfile(rows)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errs.ErrDBFailure
+	}
+	return users, nil
+}
+
+// SearchUsers returns one page of live, unsuspended users whose nickname or
+// username starts with or is trigram-similar to input.Query. Hits are ranked
+// by an exact nickname match, then a prefix match, then similarity, with the
+// user ID breaking ties so that pages are stable.
+func (r *UserPostgres) SearchUsers(ctx context.Context, input transport.UserSearchQuery) ([]transport.UserSearchHit, error) {
+	const query = `
+		SELECT * FROM (
+			SELECT ` + userProfileColumns + `,
+				(CASE
+					WHEN lower(nickname) = $1 THEN 3
+					WHEN lower(nickname) LIKE $2 ESCAPE '\' OR lower(username) LIKE $2 ESCAPE '\' THEN 2
+					ELSE 0
+				END + GREATEST(similarity(lower(nickname), $1), similarity(lower(username), $1)))::float8 AS score
+			FROM users
+			WHERE deleted_at IS NULL AND suspended_at IS NULL
+				AND (lower(nickname) LIKE $2 ESCAPE '\' OR lower(username) LIKE $2 ESCAPE '\'
+					OR lower(nickname) % $1 OR lower(username) % $1)
+		) AS hits
+		WHERE NOT $3 OR score < $4 OR (score = $4 AND id > $5)
+		ORDER BY score DESC, id
+		LIMIT $6
+	`
+
+	var afterScore float64
+	var afterID int64
+	if input.After != nil {
+		afterScore, afterID = input.After.Score, input.After.ID
+	}
+	prefix := likeEscaper.Replace(input.Query) + "%"
+
+	rows, err := r.DB.QueryContext(ctx, query, input.Query, prefix, input.After != nil, afterScore, afterID, input.Limit)
+	if err != nil {
+		return nil, errs.ErrDBFailure
+	}
+	defer rows.Close()
+
+	hits := make([]transport.UserSearchHit, 0, input.Limit)
+	for rows.Next() {
+		var hit transport.UserSearchHit
+		if hit.Profile, err = scanUserProfile(rows, &hit.Score); err != nil {
+			return nil, err
+		}
+		hits = append(hits, hit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errs.ErrDBFailure
+	}
+	return hits, nil
+}
+
+// FetchPrivacySettings returns the profile privacy settings of a live user.
+func (r *UserPostgres) FetchPrivacySettings(ctx context.Context, userID int64) (transport.PrivacySettings, error) {
+	const query = `
+		SELECT email_visibility, last_login_visibility, bio_visibility, private_account
+		FROM users
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	var settings transport.PrivacySettings
+	err := r.DB.QueryRowContext(ctx, query, userID).
+		Scan(&settings.Email, &settings.LastLogin, &settings.Bio, &settings.PrivateAccount)
+	if err != nil {
+		return transport.PrivacySettings{}, mapDBError(err)
+	}
+	return settings, nil
+}
+
+// UpdatePrivacySettings applies the non-empty fields of input to a live
+// user's privacy settings and returns the resulting settings. Pending follow
+// requests are kept when an account becomes public.
+func (r *UserPostgres) UpdatePrivacySettings(
+	ctx context.Context,
+	userID int64,
+	input transport.UpdatePrivacySettingsRequest,
+) (transport.PrivacySettings, error) {
+	const query = `
+		UPDATE users
+		SET email_visibility      = COALESCE(NULLIF($2, ''), email_visibility),
+		    last_login_visibility = COALESCE(NULLIF($3, ''), last_login_visibility),
+		    bio_visibility        = COALESCE(NULLIF($4, ''), bio_visibility),
+		    private_account       = COALESCE($5, private_account)
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING email_visibility, last_login_visibility, bio_visibility, private_account
+	`
+
+	var settings transport.PrivacySettings
+	err := r.DB.QueryRowContext(ctx, query,
+		userID, string(input.Email), string(input.LastLogin), string(input.Bio), input.PrivateAccount,
+	).Scan(&settings.Email, &settings.LastLogin, &settings.Bio, &settings.PrivateAccount)
+	if err != nil {
+		return transport.PrivacySettings{}, mapDBError(err)
+	}
+	return settings, nil
+}
+
+// likeEscaper escapes the LIKE wildcards in user input.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// scanUserProfile scans a row selected with userProfileColumns into a
+// UserProfileResponse. Columns selected after them are scanned into extra.
+func scanUserProfile(row rowScanner, extra ...any) (transport.UserProfileResponse, error) {
 	var u transport.UserProfileResponse
-	if err := row.Scan(
+	var verifiedAt, suspendedAt sql.NullTime
+	dest := []any{
 		&u.ID,
 		&u.Username,
 		&u.Email,
@@ -59,9 +199,21 @@ func scanUserProfile(row *sql.Row) (transport.UserProfileResponse, error) {
 		&u.LastLogin,
 		&u.CreatedAt,
 		&u.UpdatedAt,
-	); err != nil {
+		&verifiedAt,
+		&suspendedAt,
+		&u.Privacy.Email,
+		&u.Privacy.LastLogin,
+		&u.Privacy.Bio,
+		&u.Privacy.PrivateAccount,
+		&u.FollowerCount,
+		&u.FollowingCount,
+		pq.Array(&u.Roles),
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
 		return transport.UserProfileResponse{}, mapDBError(err)
 	}
+	u.EmailVerifiedAt = verifiedAt.Time
+	u.SuspendedAt = suspendedAt.Time
 	return u, nil
 }
 
@@ -0,0 +1,100 @@
+// Package repository implements persistence logic for email verification tokens.
+// It provides concrete implementations of VerificationRepository, following
+// Dependency Inversion and Liskov Substitution principles.
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+)
+
+type VerificationPostgres struct {
+	DB *sql.DB
+}
+
+func NewVerificationPostgres(db *sql.DB) *VerificationPostgres {
+	return &VerificationPostgres{DB: db}
+}
+
+// SaveVerificationToken stores the digest of a newly issued verification token
+// unless the user was issued another one within input.Cooldown. The user row is
+// locked first, so concurrent requests see each other's tokens and at most one
+// of them is saved per cooldown.
+func (r *VerificationPostgres) SaveVerificationToken(ctx context.Context, input domain.SaveVerificationTokenInput) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `SELECT 1 FROM users WHERE id = $1 FOR UPDATE`, input.UserID); err != nil {
+		return errs.ErrDBFailure
+	}
+
+	res, err := tx.ExecContext(ctx, `
+		INSERT INTO email_verification_tokens (token_hash, user_id, expires_at)
+		SELECT $1, $2, $3
+		WHERE NOT EXISTS (
+			SELECT 1 FROM email_verification_tokens
+			WHERE user_id = $2 AND created_at > NOW() - make_interval(secs => $4)
+		)
+	`, input.TokenHash, input.UserID, input.ExpiresAt, input.Cooldown.Seconds())
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	saved, err := res.RowsAffected()
+	if err != nil {
+		return errs.ErrDBFailure
+	}
+	if saved == 0 {
+		return errs.ErrVerificationThrottled
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errs.ErrDBFailure
+	}
+	return nil
+}
+
+// ConsumeVerificationToken atomically redeems a token: it deletes the matching
+// unexpired row, stamps users.email_verified_at and drops any sibling tokens.
+func (r *VerificationPostgres) ConsumeVerificationToken(ctx context.Context, tokenHash string) (int64, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, errs.ErrDBFailure
+	}
+	defer tx.Rollback()
+
+	var userID int64
+	err = tx.QueryRowContext(ctx, `
+		DELETE FROM email_verification_tokens
+		WHERE token_hash = $1 AND expires_at > NOW()
+		RETURNING user_id
+	`, tokenHash).Scan(&userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, errs.ErrInvalidVerificationToken
+		}
+		return 0, errs.ErrDBFailure
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE users SET email_verified_at = NOW()
+		WHERE id = $1 AND email_verified_at IS NULL
+	`, userID); err != nil {
+		return 0, errs.ErrDBFailure
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM email_verification_tokens WHERE user_id = $1`, userID); err != nil {
+		return 0, errs.ErrDBFailure
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, errs.ErrDBFailure
+	}
+	return userID, nil
+}
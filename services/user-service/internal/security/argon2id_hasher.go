@@ -0,0 +1,119 @@
+// Package security provides Argon2id password hashing and verification for the
+// user-service. Hashes use the PHC string format, so they carry the algorithm
+// and parameters they were created with. It supports Dependency Inversion and
+// Single Responsibility principles.
+package security
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/config"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+)
+
+const (
+	// minArgon2idSaltLength and minArgon2idKeyLength follow RFC 9106.
+	minArgon2idSaltLength = 8
+	minArgon2idKeyLength  = 16
+)
+
+// Argon2idHasher hashes passwords with Argon2id using Params. Verification
+// uses the parameters encoded in each hash, so Params only affect new hashes.
+type Argon2idHasher struct {
+	Params config.Argon2id
+}
+
+// HashPassword returns an Argon2id hash in PHC format:
+// $argon2id$v=19$m=<memory>,t=<iterations>,p=<parallelism>$<salt>$<key>.
+func (h Argon2idHasher) HashPassword(password string) (string, error) {
+	if err := validateArgon2idParams(h.Params); err != nil {
+		return "", err
+	}
+
+	salt := make([]byte, h.Params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, h.Params.Iterations, h.Params.Memory, h.Params.Parallelism, h.Params.KeyLength)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		h.Params.Memory, h.Params.Iterations, h.Params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (Argon2idHasher) VerifyPassword(hash, password string) error {
+	params, salt, key, err := parseArgon2idHash(hash)
+	if err != nil {
+		return errs.ErrInvalidPassword
+	}
+
+	candidate := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+	if subtle.ConstantTimeCompare(candidate, key) != 1 {
+		return errs.ErrInvalidPassword
+	}
+	return nil
+}
+
+// NeedsRehash reports whether hash is malformed or was created with
+// parameters other than Params.
+func (h Argon2idHasher) NeedsRehash(hash string) bool {
+	params, _, _, err := parseArgon2idHash(hash)
+	return err != nil || params != h.Params
+}
+
+// parseArgon2idHash decodes a PHC-format Argon2id hash.
+func parseArgon2idHash(hash string) (config.Argon2id, []byte, []byte, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != "argon2id" {
+		return config.Argon2id{}, nil, nil, errs.ErrInvalidHashParams
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return config.Argon2id{}, nil, nil, errs.ErrInvalidHashParams
+	}
+
+	var params config.Argon2id
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return config.Argon2id{}, nil, nil, errs.ErrInvalidHashParams
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return config.Argon2id{}, nil, nil, errs.ErrInvalidHashParams
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return config.Argon2id{}, nil, nil, errs.ErrInvalidHashParams
+	}
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+
+	if err := validateArgon2idParams(params); err != nil {
+		return config.Argon2id{}, nil, nil, err
+	}
+	return params, salt, key, nil
+}
+
+// validateArgon2idParams rejects parameters that are unset or below the
+// minimums of RFC 9106.
+func validateArgon2idParams(p config.Argon2id) error {
+	switch {
+	case p.Iterations < 1, p.Parallelism < 1:
+		return errs.ErrInvalidHashParams
+	case p.Memory < 8*uint32(p.Parallelism):
+		return errs.ErrInvalidHashParams
+	case p.SaltLength < minArgon2idSaltLength, p.KeyLength < minArgon2idKeyLength:
+		return errs.ErrInvalidHashParams
+	}
+	return nil
+}
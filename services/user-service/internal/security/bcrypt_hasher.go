@@ -10,10 +10,14 @@ import (
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
 )
 
-type BcryptHasher struct{}
+// BcryptHasher hashes passwords with bcrypt at Cost, or bcrypt.DefaultCost if
+// Cost is zero.
+type BcryptHasher struct {
+	Cost int
+}
 
-func (BcryptHasher) HashPassword(password string) (string, error) {
-	bytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+func (h BcryptHasher) HashPassword(password string) (string, error) {
+	bytes, err := bcrypt.GenerateFromPassword([]byte(password), h.cost())
 	return string(bytes), err
 }
 
@@ -24,3 +28,16 @@ func (BcryptHasher) VerifyPassword(hash, password string) error {
 	}
 	return nil
 }
+
+// NeedsRehash reports whether hash is not a bcrypt hash at the configured cost.
+func (h BcryptHasher) NeedsRehash(hash string) bool {
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || cost != h.cost()
+}
+
+func (h BcryptHasher) cost() int {
+	if h.Cost == 0 {
+		return bcrypt.DefaultCost
+	}
+	return h.Cost
+}
@@ -0,0 +1,138 @@
+// Package security provides an offline breached-password check backed by a
+// local copy of the Have I Been Pwned k-anonymity dataset (SHA-1 password
+// hashes). It supports Single Responsibility and Open/Closed principles.
+package security
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+)
+
+// rangePrefixLength is the number of hex characters of a SHA-1 hash that name
+// a k-anonymity range file; its lines carry only the remaining characters.
+const rangePrefixLength = 5
+
+// BreachedPasswords is an in-memory set of SHA-1 password hashes. Lookups
+// hash the candidate locally, so passwords never leave the process.
+type BreachedPasswords struct {
+	hashes [][sha1.Size]byte
+}
+
+// LoadBreachedPasswords reads a breached password list from path. A file holds
+// full hashes; a directory holds k-anonymity range files named after the first
+// five hex characters of their hashes (e.g. "21BD1" or "21BD1.txt"), each
+// listing the remaining 35 characters, as served by the Pwned Passwords range
+// API.
+func LoadBreachedPasswords(path string) (*BreachedPasswords, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		return ParseBreachedPasswords(f)
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &BreachedPasswords{}
+	for _, entry := range entries {
+		prefix := strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))
+		if entry.IsDir() || len(prefix) != rangePrefixLength {
+			continue
+		}
+		if err := list.readRange(filepath.Join(path, entry.Name()), prefix); err != nil {
+			return nil, err
+		}
+	}
+	list.normalize()
+	return list, nil
+}
+
+// ParseBreachedPasswords reads one hex SHA-1 hash per line, optionally
+// followed by ":<count>" as in the Have I Been Pwned downloads. Blank lines and
+// lines starting with "#" are ignored.
+func ParseBreachedPasswords(r io.Reader) (*BreachedPasswords, error) {
+	list := &BreachedPasswords{}
+	if err := list.read(r, "", "input"); err != nil {
+		return nil, err
+	}
+	list.normalize()
+	return list, nil
+}
+
+// Contains reports whether password is on the list.
+func (b *BreachedPasswords) Contains(password string) bool {
+	hash := sha1.Sum([]byte(password))
+	_, found := slices.BinarySearchFunc(b.hashes, hash, compareHashes)
+	return found
+}
+
+// Len returns the number of distinct hashes on the list.
+func (b *BreachedPasswords) Len() int {
+	return len(b.hashes)
+}
+
+// readRange adds the suffixes listed in the range file at path to b.
+func (b *BreachedPasswords) readRange(path, prefix string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return b.read(f, prefix, filepath.Base(path))
+}
+
+// read adds the hashes in r to b, prepending prefix to every line. source
+// names r in parse errors.
+func (b *BreachedPasswords) read(r io.Reader, prefix, source string) error {
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		hexHash, _, _ := strings.Cut(text, ":")
+		hexHash = prefix + hexHash
+
+		var hash [sha1.Size]byte
+		if len(hexHash) != hex.EncodedLen(sha1.Size) {
+			return fmt.Errorf("%w: %s line %d", errs.ErrInvalidBreachedList, source, line)
+		}
+		if _, err := hex.Decode(hash[:], []byte(hexHash)); err != nil {
+			return fmt.Errorf("%w: %s line %d", errs.ErrInvalidBreachedList, source, line)
+		}
+		b.hashes = append(b.hashes, hash)
+	}
+	return scanner.Err()
+}
+
+// normalize sorts and de-duplicates the hashes for binary search.
+func (b *BreachedPasswords) normalize() {
+	slices.SortFunc(b.hashes, compareHashes)
+	b.hashes = slices.Compact(b.hashes)
+}
+
+func compareHashes(a, b [sha1.Size]byte) int {
+	return bytes.Compare(a[:], b[:])
+}
@@ -3,40 +3,52 @@
 package security
 
 import (
-	"crypto/rand"
-	"encoding/base64"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
 )
 
-const refreshTokenLength = 32
-
 type JWTGenerator struct {
-	SecretKey     []byte
+	Keys          *KeySet
 	TokenLifetime time.Duration
+	Issuer        string
+	Audience      string
 }
 
-func NewJWTGenerator(secretKey []byte, lifetime time.Duration) *JWTGenerator {
-	return &JWTGenerator{SecretKey: secretKey, TokenLifetime: lifetime}
+func NewJWTGenerator(keys *KeySet, lifetime time.Duration, issuer, audience string) *JWTGenerator {
+	return &JWTGenerator{Keys: keys, TokenLifetime: lifetime, Issuer: issuer, Audience: audience}
 }
 
 func (g *JWTGenerator) CreateTokenPair(user domain.CreateTokenPairInput) (model.TokenPair, error) {
 	// Access Token
-	claims := jwt.MapClaims{
-		"sub":      user.UserID,
-		"nickname": user.Nickname,
-		"exp":      time.Now().Add(g.TokenLifetime).Unix(),
-		"iat":      time.Now().Unix(),
+	now := time.Now()
+	claims := auth.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    g.Issuer,
+			Subject:   strconv.FormatInt(user.UserID, 10),
+			Audience:  jwt.ClaimStrings{g.Audience},
+			ExpiresAt: jwt.NewNumericDate(now.Add(g.TokenLifetime)),
+			NotBefore: jwt.NewNumericDate(now),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+		Nickname:      user.Nickname,
+		EmailVerified: user.EmailVerified,
+		SessionID:     user.SessionID,
+		Roles:         user.Roles,
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	accessToken, err := token.SignedString(g.SecretKey)
+	key := g.Keys.SigningKey()
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.ID
+	accessToken, err := token.SignedString(key.Private)
 	if err != nil {
 		return model.TokenPair{}, fmt.Errorf("%w", errs.ErrTokenSigningFailed)
 	}
@@ -54,9 +66,5 @@ func (g *JWTGenerator) CreateTokenPair(user domain.CreateTokenPairInput) (model.
 }
 
 func (g *JWTGenerator) GenerateRefreshToken() (string, error) {
-	bytes := make([]byte, refreshTokenLength)
-	if _, err := rand.Read(bytes); err != nil {
-		return "", fmt.Errorf("could not generate random bytes: %w", err)
-	}
-	return base64.URLEncoding.EncodeToString(bytes), nil
+	return GenerateOpaqueToken()
 }
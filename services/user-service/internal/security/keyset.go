@@ -0,0 +1,142 @@
+// Package security provides the asymmetric key set used to sign and verify
+// access tokens, loaded from PEM files to support key rotation. It supports
+// Single Responsibility and Open/Closed principles.
+package security
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+)
+
+// SigningKey is a private key identified by its JWT "kid".
+type SigningKey struct {
+	ID      string
+	Method  jwt.SigningMethod
+	Private crypto.Signer
+}
+
+// Public returns the public half of the key.
+func (k SigningKey) Public() crypto.PublicKey {
+	return k.Private.Public()
+}
+
+// KeySet holds every active key and the one currently used for signing.
+type KeySet struct {
+	signing SigningKey
+	keys    map[string]SigningKey
+}
+
+// NewKeySet builds a key set from keys, signing with the key whose ID is signingKeyID.
+func NewKeySet(signingKeyID string, keys ...SigningKey) (*KeySet, error) {
+	set := &KeySet{keys: make(map[string]SigningKey, len(keys))}
+	for _, k := range keys {
+		set.keys[k.ID] = k
+	}
+
+	signing, ok := set.keys[signingKeyID]
+	if !ok {
+		return nil, fmt.Errorf("signing key %q: %w", signingKeyID, errs.ErrUnknownSigningKey)
+	}
+	set.signing = signing
+	return set, nil
+}
+
+// LoadKeySet reads every "<kid>.pem" private key in dir. Keys other than the
+// signing key remain valid for verification, which allows overlapping rotation.
+func LoadKeySet(dir, signingKeyID string) (*KeySet, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]SigningKey, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		id := strings.TrimSuffix(filepath.Base(path), ".pem")
+		key, err := ParsePrivateKeyPEM(id, data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		keys = append(keys, key)
+	}
+
+	return NewKeySet(signingKeyID, keys...)
+}
+
+// ParsePrivateKeyPEM decodes a PKCS#8 RSA or Ed25519 key, or a PKCS#1 RSA key.
+// RSA keys sign with RS256 and Ed25519 keys with EdDSA.
+func ParsePrivateKeyPEM(id string, data []byte) (SigningKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return SigningKey{}, errs.ErrInvalidSigningKey
+	}
+
+	var parsed any
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return SigningKey{}, errs.ErrInvalidSigningKey
+	}
+	if err != nil {
+		return SigningKey{}, fmt.Errorf("%w: %v", errs.ErrInvalidSigningKey, err)
+	}
+
+	switch key := parsed.(type) {
+	case *rsa.PrivateKey:
+		return SigningKey{ID: id, Method: jwt.SigningMethodRS256, Private: key}, nil
+	case ed25519.PrivateKey:
+		return SigningKey{ID: id, Method: jwt.SigningMethodEdDSA, Private: key}, nil
+	default:
+		return SigningKey{}, errs.ErrInvalidSigningKey
+	}
+}
+
+// SigningKey returns the key new tokens are signed with.
+func (s *KeySet) SigningKey() SigningKey {
+	return s.signing
+}
+
+// Keys returns all keys in the set ordered by ID.
+func (s *KeySet) Keys() []SigningKey {
+	keys := make([]SigningKey, 0, len(s.keys))
+	for _, k := range s.keys {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].ID < keys[j].ID })
+	return keys
+}
+
+// Keyfunc resolves the verification key for a parsed token by its "kid",
+// rejecting tokens whose algorithm does not match that key.
+func (s *KeySet) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, auth.ErrUnknownSigningKey
+	}
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, auth.ErrUnexpectedSigningMethod
+	}
+	return key.Public(), nil
+}
@@ -0,0 +1,30 @@
+// Package security provides helpers for generating and digesting opaque,
+// high-entropy tokens such as refresh and email verification tokens. It
+// supports Single Responsibility principles.
+package security
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+const opaqueTokenLength = 32
+
+// GenerateOpaqueToken returns a URL-safe random token with 256 bits of entropy.
+func GenerateOpaqueToken() (string, error) {
+	bytes := make([]byte, opaqueTokenLength)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("could not generate random bytes: %w", err)
+	}
+	return base64.URLEncoding.EncodeToString(bytes), nil
+}
+
+// HashToken returns the hex-encoded SHA-256 digest of token. Opaque tokens are
+// persisted by digest so a database leak does not expose usable credentials.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
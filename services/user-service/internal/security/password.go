@@ -2,9 +2,92 @@
 // and verification. It supports Single Responsibility and Dependency Inversion principles.
 package security
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/config"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+)
+
+const (
+	// AlgorithmArgon2id identifies Argon2id hashes.
+	AlgorithmArgon2id = "argon2id"
+	// AlgorithmBcrypt identifies bcrypt hashes.
+	AlgorithmBcrypt = "bcrypt"
+)
+
 // Hasher defines methods for hashing and verifying passwords. It enables
 // Dependency Inversion and Interface Segregation for password security.
 type Hasher interface {
 	HashPassword(password string) (string, error)
 	VerifyPassword(hash, password string) error
+	// NeedsRehash reports whether hash was created with another algorithm or
+	// weaker parameters than HashPassword currently uses.
+	NeedsRehash(hash string) bool
+}
+
+// NewHasher returns a Hasher that creates hashes with cfg.Algorithm and
+// verifies hashes of every supported algorithm, identified by their prefix.
+func NewHasher(cfg config.PasswordHashing) (Hasher, error) {
+	h := &MultiHasher{
+		algorithm: cfg.Algorithm,
+		hashers: map[string]Hasher{
+			AlgorithmArgon2id: Argon2idHasher{Params: cfg.Argon2id},
+			AlgorithmBcrypt:   BcryptHasher{Cost: cfg.BcryptCost},
+		},
+	}
+	if h.algorithm == "" {
+		h.algorithm = AlgorithmArgon2id
+	}
+
+	switch h.algorithm {
+	case AlgorithmArgon2id:
+		if err := validateArgon2idParams(cfg.Argon2id); err != nil {
+			return nil, err
+		}
+	case AlgorithmBcrypt:
+	default:
+		return nil, fmt.Errorf("%w: %q", errs.ErrUnknownHashAlgorithm, cfg.Algorithm)
+	}
+	return h, nil
+}
+
+// MultiHasher hashes with one algorithm and verifies hashes of all of them,
+// so stored hashes can be migrated as users sign in.
+type MultiHasher struct {
+	algorithm string
+	hashers   map[string]Hasher
+}
+
+func (h *MultiHasher) HashPassword(password string) (string, error) {
+	return h.hashers[h.algorithm].HashPassword(password)
+}
+
+func (h *MultiHasher) VerifyPassword(hash, password string) error {
+	hasher, ok := h.hashers[HashAlgorithm(hash)]
+	if !ok {
+		return errs.ErrInvalidPassword
+	}
+	return hasher.VerifyPassword(hash, password)
+}
+
+func (h *MultiHasher) NeedsRehash(hash string) bool {
+	if HashAlgorithm(hash) != h.algorithm {
+		return true
+	}
+	return h.hashers[h.algorithm].NeedsRehash(hash)
+}
+
+// HashAlgorithm identifies the algorithm of a stored hash from its prefix, or
+// returns "" if it is not recognized.
+func HashAlgorithm(hash string) string {
+	switch {
+	case strings.HasPrefix(hash, "$argon2id$"):
+		return AlgorithmArgon2id
+	case strings.HasPrefix(hash, "$2a$"), strings.HasPrefix(hash, "$2b$"), strings.HasPrefix(hash, "$2y$"):
+		return AlgorithmBcrypt
+	default:
+		return ""
+	}
 }
@@ -0,0 +1,91 @@
+// Package security provides the password policy that new passwords are checked
+// against on registration, password change and reset. It supports Single
+// Responsibility and Open/Closed principles.
+package security
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/config"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+)
+
+// minIdentifierLength is the shortest email local part or nickname that is
+// searched for inside passwords; shorter ones would reject too much.
+const minIdentifierLength = 3
+
+// PasswordPolicy validates new passwords against configurable length and
+// character rules, the account's own identifiers and an optional breached
+// password list.
+type PasswordPolicy struct {
+	cfg      config.PasswordPolicy
+	breached *BreachedPasswords
+}
+
+// NewPasswordPolicy builds a policy from cfg, loading the breached password
+// list if one is configured.
+func NewPasswordPolicy(cfg config.PasswordPolicy) (*PasswordPolicy, error) {
+	p := &PasswordPolicy{cfg: cfg}
+	if cfg.BreachedPasswordsFile != "" {
+		list, err := LoadBreachedPasswords(cfg.BreachedPasswordsFile)
+		if err != nil {
+			return nil, err
+		}
+		p.breached = list
+	}
+	return p, nil
+}
+
+// Validate checks password for an account identified by email and nickname.
+// Violations wrap ErrWeakPassword with a description of the failed rule, or
+// are ErrBreachedPassword.
+func (p *PasswordPolicy) Validate(password, email, nickname string) error {
+	length := utf8.RuneCountInString(password)
+	if p.cfg.MinLength > 0 && length < p.cfg.MinLength {
+		return fmt.Errorf("%w: must be at least %d characters", errs.ErrWeakPassword, p.cfg.MinLength)
+	}
+	if p.cfg.MaxLength > 0 && length > p.cfg.MaxLength {
+		return fmt.Errorf("%w: must be at most %d characters", errs.ErrWeakPassword, p.cfg.MaxLength)
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r), unicode.IsSymbol(r), unicode.IsSpace(r):
+			symbol = true
+		}
+	}
+	switch {
+	case p.cfg.RequireUpper && !upper:
+		return fmt.Errorf("%w: must contain an uppercase letter", errs.ErrWeakPassword)
+	case p.cfg.RequireLower && !lower:
+		return fmt.Errorf("%w: must contain a lowercase letter", errs.ErrWeakPassword)
+	case p.cfg.RequireDigit && !digit:
+		return fmt.Errorf("%w: must contain a digit", errs.ErrWeakPassword)
+	case p.cfg.RequireSymbol && !symbol:
+		return fmt.Errorf("%w: must contain a symbol", errs.ErrWeakPassword)
+	}
+
+	lowered := strings.ToLower(password)
+	localPart, _, _ := strings.Cut(email, "@")
+	for _, identifier := range []string{localPart, nickname} {
+		identifier = strings.ToLower(strings.TrimSpace(identifier))
+		if utf8.RuneCountInString(identifier) >= minIdentifierLength && strings.Contains(lowered, identifier) {
+			return fmt.Errorf("%w: must not contain your email or nickname", errs.ErrWeakPassword)
+		}
+	}
+
+	if p.breached != nil && p.breached.Contains(password) {
+		return errs.ErrBreachedPassword
+	}
+	return nil
+}
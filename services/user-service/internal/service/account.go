@@ -0,0 +1,72 @@
+// Package service implements account deletion for AuthService. Deleted
+// accounts are soft-deleted first and purged by AccountPurger once the
+// configured grace period has passed.
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
+)
+
+// DeleteMyAccount deletes the authenticated user's account after confirming
+// the password, or a recent sign-in for accounts without one. The account
+// disappears immediately, every session is revoked and a user.deleted event is
+// recorded for other services; the row itself is purged after the grace
+// period. Returns Unauthenticated if the caller is not confirmed.
+func (s *AuthService) DeleteMyAccount(
+	ctx context.Context,
+	req *userauthpb.DeleteMyAccountRequest,
+) (*userauthpb.DeleteMyAccountResponse, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+	input := s.converter.ToDeleteMyAccountRequest(req)
+
+	user, err := s.authRepo.FetchUserCredentials(ctx, caller.UserID)
+	switch {
+	case errors.Is(err, errs.ErrUserNotFound):
+		return nil, status.Error(codes.NotFound, errs.ErrUserNotFound.Error())
+	case err != nil:
+		slog.Error("failed to fetch user for account deletion", "userID", caller.UserID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	err = s.confirmIdentity(ctx, caller, user, input.Password)
+	switch {
+	case errors.Is(err, errs.ErrInvalidPassword) || errors.Is(err, errs.ErrRecentLoginRequired):
+		slog.Warn("security event: account deletion without confirmation",
+			"event", "account_deletion_rejected",
+			"userID", user.ID,
+			"reason", err,
+		)
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	case err != nil:
+		slog.Error("failed to confirm identity for account deletion", "userID", user.ID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	deletedAt, err := s.accountRepo.SoftDeleteUser(ctx, user.ID)
+	switch {
+	case errors.Is(err, errs.ErrUserNotFound):
+		return nil, status.Error(codes.NotFound, errs.ErrUserNotFound.Error())
+	case err != nil:
+		slog.Error("failed to delete account", "userID", user.ID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	slog.Info("account deleted", "userID", user.ID)
+	return s.converter.ToDeleteMyAccountResponse(transport.DeleteMyAccountResponse{
+		Message:    "Account deleted successfully",
+		PurgeAfter: deletedAt.Add(s.deletionCfg.GracePeriod),
+	}), nil
+}
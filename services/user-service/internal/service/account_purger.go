@@ -0,0 +1,70 @@
+// Package service implements the background purge of deleted accounts. It
+// depends only on repository abstractions, so the schedule and the storage can
+// be tested independently.
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/config"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+)
+
+// AccountPurger permanently removes accounts whose deletion grace period has
+// passed and prunes account events older than the retention window.
+type AccountPurger struct {
+	accounts model.AccountRepository
+	events   model.UserEventRepository
+	cfg      config.AccountDeletion
+}
+
+// NewAccountPurger constructs an AccountPurger with its dependencies injected.
+func NewAccountPurger(
+	accounts model.AccountRepository,
+	events model.UserEventRepository,
+	cfg config.AccountDeletion,
+) *AccountPurger {
+	return &AccountPurger{
+		accounts: accounts,
+		events:   events,
+		cfg:      cfg,
+	}
+}
+
+// Run purges once immediately and then every PurgeInterval until ctx is
+// cancelled. It always returns nil so it can run inside an errgroup.
+func (p *AccountPurger) Run(ctx context.Context) error {
+	ticker := time.NewTicker(p.cfg.PurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		p.PurgeOnce(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeOnce removes accounts deleted more than GracePeriod before now and
+// events older than EventRetention that every consumer has handled. Failures
+// are logged and retried on the next run.
+func (p *AccountPurger) PurgeOnce(ctx context.Context, now time.Time) {
+	purged, err := p.accounts.PurgeDeletedUsers(ctx, now.Add(-p.cfg.GracePeriod))
+	if err != nil {
+		slog.Error("failed to purge deleted accounts", "err", err)
+	} else if purged > 0 {
+		slog.Info("purged deleted accounts", "count", purged)
+	}
+
+	pruned, err := p.events.PruneUserEvents(ctx, now.Add(-p.cfg.EventRetention))
+	if err != nil {
+		slog.Error("failed to prune user events", "err", err)
+	} else if pruned > 0 {
+		slog.Info("pruned user events", "count", pruned)
+	}
+}
@@ -0,0 +1,151 @@
+// Package service implements account moderation for moderators and
+// administrators. Access to each method is restricted by role in the auth
+// interceptor; the service only enforces rules about which accounts may be
+// moderated. It relies on repository and mapper abstractions, following SOLID
+// principles.
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/mapper"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
+)
+
+// AdminService lists, suspends and signs out accounts. It depends on the
+// AdminRepository, TokenRepository and Converter abstractions.
+type AdminService struct {
+	userauthpb.UnimplementedAdminServiceServer
+	adminRepo model.AdminRepository
+	tokenRepo model.TokenRepository
+	converter mapper.Converter
+}
+
+// NewAdminService constructs an AdminService with all required dependencies
+// injected. This follows Dependency Inversion by relying on abstractions.
+func NewAdminService(
+	adminRepo model.AdminRepository,
+	tokenRepo model.TokenRepository,
+	converter mapper.Converter,
+) *AdminService {
+	return &AdminService{
+		adminRepo: adminRepo,
+		tokenRepo: tokenRepo,
+		converter: converter,
+	}
+}
+
+// ListUsers returns up to limit accounts with IDs greater than after_id, in
+// ascending ID order. Returns Internal on storage errors.
+func (s *AdminService) ListUsers(ctx context.Context, req *userauthpb.ListUsersRequest) (*userauthpb.ListUsersResponse, error) {
+	users, err := s.adminRepo.ListUsers(ctx, req.GetAfterId(), int(req.GetLimit()))
+	if err != nil {
+		slog.Error("failed to list users", "afterID", req.GetAfterId(), "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	return s.converter.ToListUsersResponse(users), nil
+}
+
+// SuspendUser blocks sign-in and token refresh for an account and revokes all
+// of its sessions. Access tokens already issued remain valid until they
+// expire. Returns FailedPrecondition for the caller's own account or an
+// administrator, and NotFound for unknown accounts.
+func (s *AdminService) SuspendUser(ctx context.Context, req *userauthpb.SuspendUserRequest) (*userauthpb.AdminUser, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+	if req.GetUserId() == caller.UserID {
+		return nil, status.Error(codes.FailedPrecondition, errs.ErrCannotModerateUser.Error())
+	}
+
+	target, err := s.adminRepo.GetUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, adminRepoError("failed to fetch user for suspension", req.GetUserId(), err)
+	}
+	if slices.Contains(target.Roles, auth.RoleAdmin) {
+		return nil, status.Error(codes.FailedPrecondition, errs.ErrCannotModerateUser.Error())
+	}
+
+	user, err := s.adminRepo.SuspendUser(ctx, req.GetUserId(), req.GetReason())
+	if err != nil {
+		return nil, adminRepoError("failed to suspend user", req.GetUserId(), err)
+	}
+
+	slog.Warn("security event: account suspended",
+		"event", "account_suspended",
+		"userID", user.ID,
+		"by", caller.UserID,
+	)
+	return s.converter.ToAdminUserResponse(user), nil
+}
+
+// UnsuspendUser lets a suspended account sign in again. Returns NotFound for
+// unknown accounts.
+func (s *AdminService) UnsuspendUser(ctx context.Context, req *userauthpb.UnsuspendUserRequest) (*userauthpb.AdminUser, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+
+	user, err := s.adminRepo.UnsuspendUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, adminRepoError("failed to unsuspend user", req.GetUserId(), err)
+	}
+
+	slog.Warn("security event: account unsuspended",
+		"event", "account_unsuspended",
+		"userID", user.ID,
+		"by", caller.UserID,
+	)
+	return s.converter.ToAdminUserResponse(user), nil
+}
+
+// ForceLogout revokes every session of an account. Access tokens already
+// issued remain valid until they expire. Returns NotFound for unknown
+// accounts.
+func (s *AdminService) ForceLogout(ctx context.Context, req *userauthpb.ForceLogoutRequest) (*userauthpb.ForceLogoutResponse, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+
+	if _, err := s.adminRepo.GetUser(ctx, req.GetUserId()); err != nil {
+		return nil, adminRepoError("failed to fetch user for forced logout", req.GetUserId(), err)
+	}
+
+	revoked, err := s.tokenRepo.DeleteAllSessions(ctx, req.GetUserId())
+	if err != nil {
+		slog.Error("failed to revoke sessions", "userID", req.GetUserId(), "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	slog.Warn("security event: forced logout",
+		"event", "forced_logout",
+		"userID", req.GetUserId(),
+		"by", caller.UserID,
+		"revoked", revoked,
+	)
+	return s.converter.ToForceLogoutResponse(revoked), nil
+}
+
+// adminRepoError maps an AdminRepository error to a gRPC status, logging
+// unexpected failures.
+func adminRepoError(msg string, userID int64, err error) error {
+	if errors.Is(err, errs.ErrUserNotFound) {
+		return status.Error(codes.NotFound, errs.ErrUserNotFound.Error())
+	}
+	slog.Error(msg, "userID", userID, "err", err)
+	return status.Error(codes.Internal, errs.ErrInternal.Error())
+}
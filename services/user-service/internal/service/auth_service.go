@@ -8,51 +8,123 @@ import (
 	"context"
 	"errors"
 	"log/slog"
-	"strconv"
+	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/config"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/mailer"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/mapper"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/oidc"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/security"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
 )
 
-// AuthService orchestrates user registration, login, logout, and token refresh.
-// It depends on AuthRepository, UserRepository, TokenRepository, JWTGenerator,
-// Hasher, and Converter abstractions to keep business rules decoupled from storage.
+// AuthService orchestrates user registration, login, logout, token refresh,
+// email verification, password management, account deletion, data export,
+// two-factor authentication and social login. It depends on AuthRepository,
+// UserRepository, TokenRepository, VerificationRepository, MFARepository,
+// IdentityRepository, LoginAttemptRepository, PasswordResetRepository,
+// AccountRepository, DataExportRepository, JWTGenerator, Hasher,
+// PasswordPolicy, Converter and Mailer abstractions to keep business rules
+// decoupled from storage and transport.
 type AuthService struct {
 	userauthpb.UnimplementedAuthServiceServer
-	authRepo  model.AuthRepository
-	userRepo  model.UserRepository
-	tokenRepo model.TokenRepository
-	jwtGen    model.JWTGeneratorInterface
-	hasher    security.Hasher
-	converter mapper.Converter
+	authRepo     model.AuthRepository
+	userRepo     model.UserRepository
+	tokenRepo    model.TokenRepository
+	verifyRepo   model.VerificationRepository
+	mfaRepo      model.MFARepository
+	identityRepo model.IdentityRepository
+	attemptRepo  model.LoginAttemptRepository
+	resetRepo    model.PasswordResetRepository
+	accountRepo  model.AccountRepository
+	exportRepo   model.DataExportRepository
+	jwtGen       model.JWTGeneratorInterface
+	hasher       security.Hasher
+	policy       *security.PasswordPolicy
+	converter    mapper.Converter
+	mailer       mailer.Mailer
+	oauth        *oidc.Registry
+	verifyCfg    config.EmailVerification
+	mfaCfg       config.MFA
+	oauthCfg     config.OAuth
+	throttleCfg  config.LoginThrottle
+	resetCfg     config.PasswordReset
+	deletionCfg  config.AccountDeletion
+	exportCfg    config.DataExport
+
+	dummyHashOnce sync.Once
+	dummyHash     string
+}
+
+// AuthDeps groups the dependencies of an AuthService.
+type AuthDeps struct {
+	AuthRepo     model.AuthRepository
+	UserRepo     model.UserRepository
+	TokenRepo    model.TokenRepository
+	VerifyRepo   model.VerificationRepository
+	MFARepo      model.MFARepository
+	IdentityRepo model.IdentityRepository
+	AttemptRepo  model.LoginAttemptRepository
+	ResetRepo    model.PasswordResetRepository
+	AccountRepo  model.AccountRepository
+	ExportRepo   model.DataExportRepository
+	JWTGen       model.JWTGeneratorInterface
+	Hasher       security.Hasher
+	Policy       *security.PasswordPolicy
+	Converter    mapper.Converter
+	Mailer       mailer.Mailer
+	OAuth        *oidc.Registry
+	Config       AuthConfig
+}
+
+// AuthConfig groups the settings of the flows an AuthService implements.
+type AuthConfig struct {
+	EmailVerification config.EmailVerification
+	MFA               config.MFA
+	OAuth             config.OAuth
+	LoginThrottle     config.LoginThrottle
+	PasswordReset     config.PasswordReset
+	AccountDeletion   config.AccountDeletion
+	DataExport        config.DataExport
 }
 
 // NewAuthService constructs an AuthService with all required dependencies injected.
 // This follows Dependency Inversion—high‐level logic depends on abstractions.
-func NewAuthService(
-	authRepo model.AuthRepository,
-	userRepo model.UserRepository,
-	tokenRepo model.TokenRepository,
-	jwtGen model.JWTGeneratorInterface,
-	hasher security.Hasher,
-	converter mapper.Converter,
-) *AuthService {
+func NewAuthService(deps AuthDeps) *AuthService {
 	return &AuthService{
-		authRepo:  authRepo,
-		userRepo:  userRepo,
-		tokenRepo: tokenRepo,
-		jwtGen:    jwtGen,
-		hasher:    hasher,
-		converter: converter,
+		authRepo:     deps.AuthRepo,
+		userRepo:     deps.UserRepo,
+		tokenRepo:    deps.TokenRepo,
+		verifyRepo:   deps.VerifyRepo,
+		mfaRepo:      deps.MFARepo,
+		identityRepo: deps.IdentityRepo,
+		attemptRepo:  deps.AttemptRepo,
+		resetRepo:    deps.ResetRepo,
+		accountRepo:  deps.AccountRepo,
+		exportRepo:   deps.ExportRepo,
+		jwtGen:       deps.JWTGen,
+		hasher:       deps.Hasher,
+		policy:       deps.Policy,
+		converter:    deps.Converter,
+		mailer:       deps.Mailer,
+		oauth:        deps.OAuth,
+		verifyCfg:    deps.Config.EmailVerification,
+		mfaCfg:       deps.Config.MFA,
+		oauthCfg:     deps.Config.OAuth,
+		throttleCfg:  deps.Config.LoginThrottle,
+		resetCfg:     deps.Config.PasswordReset,
+		deletionCfg:  deps.Config.AccountDeletion,
+		exportCfg:    deps.Config.DataExport,
 	}
 }
 
@@ -60,12 +132,17 @@ func NewAuthService(
 var defaultTTL = 7 * 24 * time.Hour
 
 // Register creates a new user, generates an access/refresh token pair,
-// and persists the refresh token. Errors during hashing, persistence,
-// or token generation produce appropriate gRPC status codes.
+// persists the refresh token and sends an email verification link. Passwords
+// violating the password policy yield InvalidArgument. Errors during hashing, persistence, or token generation produce appropriate gRPC
+// status codes; a failed verification email is logged and can be re-requested.
 func (s *AuthService) Register(
 	ctx context.Context,
 	req *userauthpb.RegisterRequest,
 ) (*userauthpb.AuthTokenResponse, error) {
+	if err := s.checkPasswordPolicy(req.GetPassword(), req.GetEmail(), req.GetNickname()); err != nil {
+		return nil, err
+	}
+
 	hashedPwd, err := s.hasher.HashPassword(req.GetPassword())
 	if err != nil {
 		slog.Error("failed to hash password", "err", err)
@@ -82,74 +159,152 @@ func (s *AuthService) Register(
 		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
 	}
 
-	tokenPair, err := s.jwtGen.CreateTokenPair(domain.CreateTokenPairInput{
+	tokenPair, err := s.startSession(ctx, domain.CreateTokenPairInput{
 		UserID:   createdUser.ID,
 		Nickname: createdUser.Nickname,
 	})
 	if err != nil {
-		slog.Error("failed to generate token pair", "err", err)
-		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+		return nil, err
 	}
 
-	if err := s.tokenRepo.SaveRefreshToken(ctx, domain.SaveRefreshTokenInput{
-		UserID:    createdUser.ID,
-		Token:     tokenPair.RefreshToken,
-		ExpiresAt: time.Now().Add(defaultTTL),
-	}); err != nil {
-		slog.Error("failed to save refresh token", "err", err)
-		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	if err := s.sendVerificationEmail(ctx, createdUser, 0); err != nil {
+		slog.Error("failed to send verification email", "userID", createdUser.ID, "err", err)
 	}
 
 	return s.converter.ToAuthTokenResponse(tokenPair), nil
 }
 
 // Login validates user credentials, issues a new token pair, and stores the
-// refresh token. Returns Unauthenticated on bad password, NotFound if user
-// doesn’t exist, or Internal on other failures.
+// refresh token. Accounts with two-factor authentication enabled receive an
+// MFA challenge token instead, to be redeemed via VerifyMFA. Unknown emails and
+// wrong passwords both yield Unauthenticated with the same message and take
+// the same time; repeated failures lock the account and client IP with
+// ResourceExhausted. Returns Internal on other failures.
 func (s *AuthService) Login(
 	ctx context.Context,
 	req *userauthpb.LoginRequest,
 ) (*userauthpb.AuthTokenResponse, error) {
 	loginInput := s.converter.ToLoginRequest(req)
+	_, keys := loginAttemptKeys(loginInput.Email, utils.ClientInfoFromContext(ctx).IPAddress)
+
+	if err := s.checkLoginLockout(ctx, keys); err != nil {
+		return nil, err
+	}
+
 	user, err := s.authRepo.FetchUserByEmail(ctx, loginInput.Email)
-	if err != nil {
-		slog.Error("login failed: user not found", "err", err)
-		return nil, status.Error(codes.NotFound, errs.ErrUserNotFound.Error())
+	switch {
+	case errors.Is(err, errs.ErrUserNotFound):
+		s.burnPasswordCheck(req.GetPassword())
+		return nil, s.failLogin(ctx, keys)
+	case err != nil:
+		slog.Error("failed to fetch user for login", "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
 	}
 
 	if err := s.hasher.VerifyPassword(user.PasswordHash, req.GetPassword()); err != nil {
-		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidPassword.Error())
+		return nil, s.failLogin(ctx, keys)
+	}
+	s.upgradePasswordHash(ctx, user, req.GetPassword())
+
+	return s.completeLogin(ctx, user)
+}
+
+// upgradePasswordHash re-hashes password with the current algorithm and
+// parameters when the user's stored hash is outdated. Failures are only
+// logged, since the old hash keeps working.
+func (s *AuthService) upgradePasswordHash(ctx context.Context, user model.User, password string) {
+	if !s.hasher.NeedsRehash(user.PasswordHash) {
+		return
 	}
 
-	tokenPair, err := s.jwtGen.CreateTokenPair(domain.CreateTokenPairInput{
-		UserID:   user.ID,
-		Nickname: user.Nickname,
+	hash, err := s.hasher.HashPassword(password)
+	if err != nil {
+		slog.Error("failed to re-hash password", "userID", user.ID, "err", err)
+		return
+	}
+	if err := s.authRepo.UpdatePasswordHash(ctx, user.ID, user.PasswordHash, hash); err != nil {
+		slog.Error("failed to store re-hashed password", "userID", user.ID, "err", err)
+		return
+	}
+	slog.Info("upgraded password hash", "userID", user.ID)
+}
+
+// completeLogin finishes a login once the first factor is verified: suspended
+// accounts are refused, accounts with two-factor authentication receive an
+// MFA challenge, all others a new session. Failures recorded against the
+// account are only forgiven once every factor has been verified, so a known
+// password cannot be used to reset the second-factor lockout.
+func (s *AuthService) completeLogin(ctx context.Context, user model.User) (*userauthpb.AuthTokenResponse, error) {
+	if user.IsSuspended() {
+		return nil, rejectSuspendedLogin(user.ID)
+	}
+
+	enrollment, err := s.mfaRepo.GetTOTP(ctx, user.ID)
+	switch {
+	case err == nil && enrollment.Confirmed:
+		return s.issueMFAChallenge(ctx, user)
+	case err != nil && !errors.Is(err, errs.ErrTOTPNotEnrolled):
+		slog.Error("failed to check two-factor enrollment", "userID", user.ID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+	s.resetLoginFailures(ctx, user.ID, user.Email)
+
+	tokenPair, err := s.startSession(ctx, domain.CreateTokenPairInput{
+		UserID:        user.ID,
+		Nickname:      user.Nickname,
+		EmailVerified: user.IsEmailVerified(),
+		Roles:         user.Roles,
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ToAuthTokenResponse(tokenPair), nil
+}
+
+// rejectSuspendedLogin logs a sign-in to a suspended account and returns the
+// PermissionDenied status sent to the client.
+func rejectSuspendedLogin(userID int64) error {
+	slog.Warn("security event: login to suspended account",
+		"event", "suspended_login_rejected",
+		"userID", userID,
+	)
+	return status.Error(codes.PermissionDenied, errs.ErrAccountSuspended.Error())
+}
+
+// startSession opens a new session: it issues a token pair bound to a fresh
+// session ID and stores the refresh token digest with the caller's device
+// metadata. Errors are returned as gRPC statuses.
+func (s *AuthService) startSession(ctx context.Context, input domain.CreateTokenPairInput) (model.TokenPair, error) {
+	input.SessionID = uuid.NewString()
+	tokenPair, err := s.jwtGen.CreateTokenPair(input)
 	if err != nil {
 		slog.Error("failed to generate token pair", "err", err)
-		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+		return model.TokenPair{}, status.Error(codes.Internal, errs.ErrInternal.Error())
 	}
 
 	if err := s.tokenRepo.SaveRefreshToken(ctx, domain.SaveRefreshTokenInput{
-		UserID:    user.ID,
-		Token:     tokenPair.RefreshToken,
+		UserID:    input.UserID,
+		TokenHash: security.HashToken(tokenPair.RefreshToken),
 		ExpiresAt: time.Now().Add(defaultTTL),
+		SessionID: input.SessionID,
+		Client:    utils.ClientInfoFromContext(ctx),
 	}); err != nil {
 		slog.Error("failed to save refresh token", "err", err)
-		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+		return model.TokenPair{}, status.Error(codes.Internal, errs.ErrInternal.Error())
 	}
 
-	return s.converter.ToAuthTokenResponse(tokenPair), nil
+	return tokenPair, nil
 }
 
-// Logout deletes an existing refresh token, returning NotFound if absent,
+// Logout revokes the session of an existing refresh token, returning NotFound if absent,
 // or Internal on storage errors.
 func (s *AuthService) Logout(
 	ctx context.Context,
 	req *userauthpb.RefreshTokenPayload,
 ) (*userauthpb.LogoutResponse, error) {
 	input := s.converter.ToGetRefreshTokenRequest(req)
-	if err := s.tokenRepo.DeleteRefreshToken(ctx, input); err != nil {
+	if err := s.tokenRepo.DeleteRefreshToken(ctx, security.HashToken(input.RefreshToken)); err != nil {
 		if errors.Is(err, errs.ErrTokenNotFound) {
 			return nil, status.Error(codes.NotFound, errs.ErrTokenNotFound.Error())
 		}
@@ -161,17 +316,24 @@ func (s *AuthService) Logout(
 }
 
 // RefreshToken validates an old refresh token, loads the user profile,
-// revokes the old token, issues a new token pair, and persists the new
-// refresh token. It returns NotFound, Internal, or a valid AuthTokenResponse.
+// rotates the old token out, issues a new token pair, and persists the new
+// refresh token under the same session with the caller's current device
+// metadata. Presenting a token that was already rotated revokes the whole
+// session and returns Unauthenticated. Otherwise it returns NotFound,
+// Internal, or a valid AuthTokenResponse.
 func (s *AuthService) RefreshToken(
 	ctx context.Context,
 	req *userauthpb.RefreshTokenPayload,
 ) (*userauthpb.AuthTokenResponse, error) {
 	input := s.converter.ToRefreshTokenRequest(req)
+	tokenHash := security.HashToken(input.RefreshToken)
 
 	// 1) retrieve and validate existing token
-	userIDStr, err := s.tokenRepo.GetRefreshToken(ctx, input)
+	session, err := s.tokenRepo.GetRefreshToken(ctx, tokenHash)
 	if err != nil {
+		if errors.Is(err, errs.ErrRefreshTokenReused) {
+			return nil, s.revokeTokenFamily(ctx, session)
+		}
 		slog.Error("failed to fetch refresh token", "err", err)
 		if errors.Is(err, errs.ErrTokenNotFound) {
 			return nil, status.Error(codes.NotFound, errs.ErrTokenNotFound.Error())
@@ -179,31 +341,33 @@ func (s *AuthService) RefreshToken(
 		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
 	}
 
-	// 2) parse user ID
-	userID, err := strconv.ParseInt(userIDStr, 10, 64)
-	if err != nil {
-		slog.Error("invalid userID in refresh token", "userID", userIDStr, "err", err)
-		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
-	}
-
-	// 3) load user profile
-	profileReq := transport.FetchUserByIDRequest{UserId: userID}
+	// 2) load user profile
+	profileReq := transport.FetchUserByIDRequest{UserId: session.UserID}
 	userDTO, err := s.userRepo.FetchUserByID(ctx, profileReq)
 	if err != nil {
 		slog.Error("failed to get user by ID", "err", err)
 		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
 	}
+	if !userDTO.SuspendedAt.IsZero() {
+		return nil, rejectSuspendedLogin(userDTO.ID)
+	}
 
-	// 4) revoke old token
-	if err := s.tokenRepo.DeleteRefreshToken(ctx, input); err != nil {
-		slog.Error("failed to delete old refresh token", "err", err)
+	// 3) rotate old token out, keeping it on record for reuse detection
+	if err := s.tokenRepo.RotateRefreshToken(ctx, tokenHash); err != nil {
+		if errors.Is(err, errs.ErrRefreshTokenReused) {
+			return nil, s.revokeTokenFamily(ctx, session)
+		}
+		slog.Error("failed to rotate old refresh token", "err", err)
 		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
 	}
 
-	// 5) issue and persist new token pair
+	// 4) issue and persist new token pair within the same session
 	newPair, err := s.jwtGen.CreateTokenPair(domain.CreateTokenPairInput{
-		UserID:   userDTO.ID,
-		Nickname: userDTO.Nickname,
+		UserID:        userDTO.ID,
+		Nickname:      userDTO.Nickname,
+		EmailVerified: !userDTO.EmailVerifiedAt.IsZero(),
+		SessionID:     session.ID,
+		Roles:         userDTO.Roles,
 	})
 	if err != nil {
 		slog.Error("failed to generate new token pair", "err", err)
@@ -211,8 +375,11 @@ func (s *AuthService) RefreshToken(
 	}
 	if err := s.tokenRepo.SaveRefreshToken(ctx, domain.SaveRefreshTokenInput{
 		UserID:    userDTO.ID,
-		Token:     newPair.RefreshToken,
+		TokenHash: security.HashToken(newPair.RefreshToken),
 		ExpiresAt: time.Now().Add(defaultTTL),
+		SessionID: session.ID,
+		Client:    utils.ClientInfoFromContext(ctx),
+		CreatedAt: session.CreatedAt,
 	}); err != nil {
 		slog.Error("failed to save new refresh token", "err", err)
 		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
@@ -221,6 +388,26 @@ func (s *AuthService) RefreshToken(
 	return s.converter.ToAuthTokenResponse(newPair), nil
 }
 
+// revokeTokenFamily handles a replayed refresh token: the token was stolen or
+// the client is misbehaving, so every token in its session is revoked and the
+// caller must sign in again.
+func (s *AuthService) revokeTokenFamily(ctx context.Context, session model.Session) error {
+	client := utils.ClientInfoFromContext(ctx)
+	slog.Warn("security event: refresh token reuse detected, revoking session",
+		"event", "refresh_token_reuse",
+		"userID", session.UserID,
+		"sessionID", session.ID,
+		"userAgent", client.UserAgent,
+		"ipAddress", client.IPAddress,
+	)
+
+	if err := s.tokenRepo.DeleteSession(ctx, session.UserID, session.ID); err != nil && !errors.Is(err, errs.ErrSessionNotFound) {
+		slog.Error("failed to revoke refresh token family", "err", err)
+		return status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+	return status.Error(codes.Unauthenticated, errs.ErrRefreshTokenReused.Error())
+}
+
 // mapCreateUserError inspects repository errors for unique-constraint violations
 // and returns the appropriate gRPC AlreadyExists status. Returns nil otherwise.
 func mapCreateUserError(err error) error {
@@ -0,0 +1,83 @@
+// Package service implements data export requests for AuthService. Exports
+// are queued here and built in the background by DataExporter.
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
+)
+
+// DataExportDownloadPath is the gateway route serving finished export archives.
+const DataExportDownloadPath = "/v1/auth/account/exports/{job_id}/download"
+
+// ExportMyData queues an export of the authenticated user's data. If an export
+// is already pending or processing, that job is returned instead of a new one.
+func (s *AuthService) ExportMyData(
+	ctx context.Context,
+	_ *userauthpb.ExportMyDataRequest,
+) (*userauthpb.DataExportJob, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+
+	export, err := s.exportRepo.CreateDataExport(ctx, caller.UserID)
+	if err != nil {
+		slog.Error("failed to queue data export", "userID", caller.UserID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	slog.Info("data export requested", "userID", caller.UserID, "jobID", export.ID)
+	return s.converter.ToDataExportJobResponse(dataExportJob(export)), nil
+}
+
+// GetDataExport reports the state of one of the authenticated user's exports.
+// Returns NotFound for unknown IDs and for exports of other users.
+func (s *AuthService) GetDataExport(
+	ctx context.Context,
+	req *userauthpb.GetDataExportRequest,
+) (*userauthpb.DataExportJob, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+	input := s.converter.ToGetDataExportRequest(req)
+
+	export, err := s.exportRepo.GetDataExport(ctx, input.JobID, caller.UserID)
+	switch {
+	case errors.Is(err, errs.ErrExportNotFound):
+		return nil, status.Error(codes.NotFound, errs.ErrExportNotFound.Error())
+	case err != nil:
+		slog.Error("failed to fetch data export", "jobID", input.JobID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	return s.converter.ToDataExportJobResponse(dataExportJob(export)), nil
+}
+
+// dataExportJob converts an export to its transport form, adding the download
+// URL once the archive is ready.
+func dataExportJob(export model.DataExport) transport.DataExportJob {
+	job := transport.DataExportJob{
+		JobID:       export.ID,
+		Status:      export.Status,
+		CreatedAt:   export.CreatedAt,
+		CompletedAt: export.CompletedAt,
+		ExpiresAt:   export.ExpiresAt,
+	}
+	if export.Status == model.DataExportReady {
+		job.DownloadURL = strings.Replace(DataExportDownloadPath, "{job_id}", export.ID, 1)
+	}
+	return job
+}
@@ -0,0 +1,75 @@
+// Package service implements the HTTP download of finished data export
+// archives. gRPC cannot stream a raw zip through the gateway, so the archive
+// is served by a plain handler registered on the gateway mux.
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+)
+
+// DataExportDownloadHandler serves the archive of a ready export at
+// DataExportDownloadPath. The caller authenticates with the same bearer token
+// as the gRPC endpoints and can only download their own exports; unknown,
+// foreign, unfinished and expired exports and malformed job IDs all yield 404.
+// Failures are logged to logger.
+func DataExportDownloadHandler(
+	exports model.DataExportRepository,
+	verifier *auth.Verifier,
+	logger *slog.Logger,
+) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		tokenStr := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if tokenStr == "" {
+			http.Error(w, errs.ErrMissingAuthToken.Error(), http.StatusUnauthorized)
+			return
+		}
+		claims, err := verifier.Verify(tokenStr)
+		if err != nil {
+			http.Error(w, errs.ErrInvalidToken.Error(), http.StatusUnauthorized)
+			return
+		}
+		userID, err := claims.UserID()
+		if err != nil {
+			http.Error(w, errs.ErrInvalidToken.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		// Malformed IDs cannot name an export; the UUID column would reject them.
+		jobID := params["job_id"]
+		if _, err := uuid.Parse(jobID); err != nil {
+			http.Error(w, errs.ErrExportNotFound.Error(), http.StatusNotFound)
+			return
+		}
+
+		archive, err := exports.FetchDataExportArchive(r.Context(), jobID, userID)
+		switch {
+		case errors.Is(err, errs.ErrExportNotFound):
+			http.Error(w, errs.ErrExportNotFound.Error(), http.StatusNotFound)
+			return
+		case err != nil:
+			logger.Error("failed to fetch data export archive", "jobID", jobID, "err", err)
+			http.Error(w, errs.ErrInternal.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="data-export-%s.zip"`, jobID))
+		w.Header().Set("Content-Length", strconv.Itoa(len(archive)))
+		w.Header().Set("Cache-Control", "no-store")
+		if _, err := w.Write(archive); err != nil {
+			logger.Error("failed to write data export archive", "jobID", jobID, "err", err)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+// Package service implements the background worker that builds data export
+// archives. It collects the profile, the sessions and the chat-service data
+// of a user into a zip of JSON files.
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/config"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+)
+
+// DataExporter builds queued data exports. Jobs are claimed from the database,
+// so several instances can run side by side.
+type DataExporter struct {
+	exports   model.DataExportRepository
+	authRepo  model.AuthRepository
+	tokenRepo model.TokenRepository
+	chat      model.ChatDataSource
+	cfg       config.DataExport
+}
+
+// NewDataExporter constructs a DataExporter with its dependencies injected.
+func NewDataExporter(
+	exports model.DataExportRepository,
+	authRepo model.AuthRepository,
+	tokenRepo model.TokenRepository,
+	chat model.ChatDataSource,
+	cfg config.DataExport,
+) *DataExporter {
+	return &DataExporter{
+		exports:   exports,
+		authRepo:  authRepo,
+		tokenRepo: tokenRepo,
+		chat:      chat,
+		cfg:       cfg,
+	}
+}
+
+// Run processes queued exports until none are left, then waits PollInterval,
+// and prunes expired archives on every round, until ctx is cancelled. It
+// always returns nil so it can run inside an errgroup.
+func (e *DataExporter) Run(ctx context.Context) error {
+	ticker := time.NewTicker(e.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for ctx.Err() == nil {
+			processed, err := e.ProcessOnce(ctx, time.Now())
+			if err != nil {
+				slog.Error("failed to process data export", "err", err)
+			}
+			if !processed {
+				break
+			}
+		}
+
+		if pruned, err := e.exports.PruneDataExports(ctx, time.Now()); err != nil {
+			slog.Error("failed to prune data exports", "err", err)
+		} else if pruned > 0 {
+			slog.Info("pruned data exports", "count", pruned)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessOnce claims and builds a single export. It reports whether a job was
+// claimed; a job that cannot be built is marked failed.
+func (e *DataExporter) ProcessOnce(ctx context.Context, now time.Time) (bool, error) {
+	export, err := e.exports.ClaimDataExport(ctx, now.Add(-e.cfg.StaleAfter))
+	switch {
+	case errors.Is(err, errs.ErrExportNotFound):
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+
+	expiresAt := now.Add(e.cfg.DownloadTTL)
+	archive, err := e.buildArchive(ctx, export.UserID)
+	if err != nil {
+		slog.Error("failed to build data export", "jobID", export.ID, "userID", export.UserID, "err", err)
+		return true, e.exports.FailDataExport(ctx, export.ID, expiresAt)
+	}
+
+	if err := e.exports.CompleteDataExport(ctx, export.ID, archive, expiresAt); err != nil {
+		return true, err
+	}
+
+	slog.Info("data export ready", "jobID", export.ID, "userID", export.UserID, "bytes", len(archive))
+	return true, nil
+}
+
+// buildArchive collects the user's data and writes it as JSON files into a
+// zip archive.
+func (e *DataExporter) buildArchive(ctx context.Context, userID int64) ([]byte, error) {
+	user, err := e.authRepo.FetchUserCredentials(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := e.tokenRepo.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	chat, err := e.chat.ExportUserChatData(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []struct {
+		name    string
+		content any
+	}{
+		{"profile.json", exportProfile(user)},
+		{"sessions.json", exportSessions(sessions)},
+		{"chat/rooms.json", exportChatRooms(chat.Rooms)},
+		{"chat/messages.json", exportChatMessages(chat.Messages)},
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, f := range files {
+		w, err := zw.Create(f.name)
+		if err != nil {
+			return nil, err
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(f.content); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func exportProfile(u model.User) transport.ExportProfile {
+	return transport.ExportProfile{
+		ID:              u.ID,
+		Username:        u.Username,
+		Nickname:        u.Nickname,
+		Email:           u.Email,
+		Bio:             u.Bio,
+		AvatarURL:       u.AvatarURL,
+		EmailVerifiedAt: timeOrNil(u.EmailVerifiedAt),
+		LastLoginAt:     timeOrNil(u.LastLogin),
+		CreatedAt:       u.CreatedAt,
+		UpdatedAt:       u.UpdatedAt,
+	}
+}
+
+func exportSessions(sessions []model.Session) []transport.ExportSession {
+	out := make([]transport.ExportSession, 0, len(sessions))
+	for _, s := range sessions {
+		out = append(out, transport.ExportSession{
+			ID:         s.ID,
+			UserAgent:  s.UserAgent,
+			IPAddress:  s.IPAddress,
+			CreatedAt:  s.CreatedAt,
+			LastUsedAt: s.LastUsedAt,
+			ExpiresAt:  s.ExpiresAt,
+		})
+	}
+	return out
+}
+
+func exportChatRooms(rooms []model.ChatRoom) []transport.ExportChatRoom {
+	out := make([]transport.ExportChatRoom, 0, len(rooms))
+	for _, r := range rooms {
+		out = append(out, transport.ExportChatRoom{
+			ID:            r.ID,
+			InitiatorID:   r.InitiatorID,
+			ParticipantID: r.ParticipantID,
+			CreatedAt:     r.CreatedAt,
+		})
+	}
+	return out
+}
+
+func exportChatMessages(messages []model.ChatMessage) []transport.ExportChatMessage {
+	out := make([]transport.ExportChatMessage, 0, len(messages))
+	for _, m := range messages {
+		out = append(out, transport.ExportChatMessage{
+			ID:        m.ID,
+			RoomID:    m.RoomID,
+			Content:   m.Content,
+			CreatedAt: m.CreatedAt,
+		})
+	}
+	return out
+}
+
+// timeOrNil returns nil for the zero time so it is exported as JSON null.
+func timeOrNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,120 @@
+// Package service implements the email verification flow of AuthService:
+// issuing single-use tokens, redeeming them, and throttled resends.
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/mailer"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/security"
+)
+
+// resendVerificationMessage is returned for every resend request so the
+// endpoint does not reveal which addresses are registered or verified.
+const resendVerificationMessage = "If the address belongs to an unverified account, a verification email has been sent"
+
+// VerifyEmail redeems a verification token and marks the owner's email as
+// verified. Returns InvalidArgument for unknown or expired tokens, or Internal
+// on storage errors. Existing access tokens keep their claims until refreshed.
+func (s *AuthService) VerifyEmail(
+	ctx context.Context,
+	req *userauthpb.VerifyEmailRequest,
+) (*userauthpb.VerifyEmailResponse, error) {
+	input := s.converter.ToVerifyEmailRequest(req)
+
+	userID, err := s.verifyRepo.ConsumeVerificationToken(ctx, security.HashToken(input.Token))
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidVerificationToken) {
+			return nil, status.Error(codes.InvalidArgument, errs.ErrInvalidVerificationToken.Error())
+		}
+		slog.Error("failed to consume verification token", "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	slog.Info("email verified", "userID", userID)
+	return s.converter.ToVerifyEmailResponse(transport.VerifyEmailResponse{
+		Message: "Email verified successfully",
+	}), nil
+}
+
+// ResendVerificationEmail issues a new verification token for an unverified
+// account. Unknown and already verified addresses, and requests within the
+// configured cooldown of the previous token, receive the same response as
+// successful sends without an email being sent.
+func (s *AuthService) ResendVerificationEmail(
+	ctx context.Context,
+	req *userauthpb.ResendVerificationEmailRequest,
+) (*userauthpb.ResendVerificationEmailResponse, error) {
+	input := s.converter.ToResendVerificationEmailRequest(req)
+
+	user, err := s.authRepo.FetchUserByEmail(ctx, input.Email)
+	switch {
+	case errors.Is(err, errs.ErrUserNotFound):
+		return s.resendVerificationResponse(), nil
+	case err != nil:
+		slog.Error("failed to fetch user for verification resend", "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	case user.IsEmailVerified():
+		return s.resendVerificationResponse(), nil
+	}
+
+	err = s.sendVerificationEmail(ctx, user, s.verifyCfg.ResendCooldown)
+	switch {
+	case errors.Is(err, errs.ErrVerificationThrottled):
+		slog.Info("verification resend throttled", "userID", user.ID)
+	case err != nil:
+		slog.Error("failed to resend verification email", "userID", user.ID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	return s.resendVerificationResponse(), nil
+}
+
+func (s *AuthService) resendVerificationResponse() *userauthpb.ResendVerificationEmailResponse {
+	return s.converter.ToResendVerificationEmailResponse(transport.ResendVerificationEmailResponse{
+		Message: resendVerificationMessage,
+	})
+}
+
+// sendVerificationEmail generates a verification token, stores its digest with
+// the configured TTL, and mails the raw token to the user as a link. Returns
+// ErrVerificationThrottled, without sending, if the user was issued a token
+// within cooldown.
+func (s *AuthService) sendVerificationEmail(ctx context.Context, user model.User, cooldown time.Duration) error {
+	token, err := security.GenerateOpaqueToken()
+	if err != nil {
+		return err
+	}
+
+	if err := s.verifyRepo.SaveVerificationToken(ctx, domain.SaveVerificationTokenInput{
+		UserID:    user.ID,
+		TokenHash: security.HashToken(token),
+		ExpiresAt: time.Now().Add(s.verifyCfg.TokenTTL),
+		Cooldown:  cooldown,
+	}); err != nil {
+		return err
+	}
+
+	link := s.verifyCfg.LinkBaseURL + "?token=" + url.QueryEscape(token)
+	return s.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Confirm your email address",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nPlease confirm your email address by opening the link below:\n\n%s\n\nThe link expires in %s.\n",
+			user.Nickname, link, s.verifyCfg.TokenTTL,
+		),
+	})
+}
@@ -0,0 +1,273 @@
+// Package service implements friendships: friend requests, friend and mutual
+// friend lists and friend suggestions. It relies on repository and mapper
+// abstractions, following SOLID principles.
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	socialpb "github.com/mamataliev-dev/social-platform/api/gen/social/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
+)
+
+// defaultFriendSuggestions is the number of suggestions returned when none is
+// requested.
+const defaultFriendSuggestions = 20
+
+// SendFriendRequest asks user_id to become the caller's friend, or accepts the
+// pending request of user_id to the caller, and returns the caller's
+// friendship with the user. Returns InvalidArgument for the caller's own
+// account and NotFound for unknown, deleted or suspended accounts.
+func (s *SocialGraphService) SendFriendRequest(
+	ctx context.Context,
+	req *socialpb.SendFriendRequestRequest,
+) (*socialpb.Friendship, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+	if req.GetUserId() == caller.UserID {
+		return nil, status.Error(codes.InvalidArgument, errs.ErrCannotFriendSelf.Error())
+	}
+
+	if err := s.friendRepo.SendFriendRequest(ctx, caller.UserID, req.GetUserId()); err != nil {
+		slog.Error("failed to send friend request", "addressee", req.GetUserId(), "err", err)
+		return nil, utils.GrpcUserNotFoundError(err)
+	}
+
+	slog.Info("sent friend request", "requester", caller.UserID, "addressee", req.GetUserId())
+	return s.friendship(ctx, caller.UserID, req.GetUserId())
+}
+
+// RespondToFriendRequest accepts or declines the pending friend request of
+// requester_id and returns the caller's friendship with the requester.
+// Returns NotFound if there is no pending request from requester_id.
+func (s *SocialGraphService) RespondToFriendRequest(
+	ctx context.Context,
+	req *socialpb.RespondToFriendRequestRequest,
+) (*socialpb.Friendship, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+
+	err := s.friendRepo.RespondToFriendRequest(ctx, caller.UserID, req.GetRequesterId(), req.GetAccept())
+	if err != nil {
+		slog.Error("failed to respond to friend request", "requester", req.GetRequesterId(), "err", err)
+		if errors.Is(err, errs.ErrFriendRequestNotFound) {
+			return nil, status.Error(codes.NotFound, errs.ErrFriendRequestNotFound.Error())
+		}
+		return nil, utils.GrpcUserNotFoundError(err)
+	}
+
+	slog.Info("responded to friend request", "addressee", caller.UserID,
+		"requester", req.GetRequesterId(), "accepted", req.GetAccept())
+	return s.friendship(ctx, caller.UserID, req.GetRequesterId())
+}
+
+// ListFriendRequests lists pending friend requests to the caller, most recent
+// first.
+func (s *SocialGraphService) ListFriendRequests(
+	ctx context.Context,
+	req *socialpb.ListFriendRequestsRequest,
+) (*socialpb.ListFriendRequestsResponse, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+
+	entries, nextPageToken, err := s.pageFriends(ctx, transport.FriendListRequests, transport.ListFriendsRequest{
+		UserID:    caller.UserID,
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	}, s.friendRepo.ListFriendRequests)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ToListFriendRequestsResponse(entries, nextPageToken, caller.UserID), nil
+}
+
+// RemoveFriend ends the caller's friendship with user_id, or withdraws the
+// caller's pending request to user_id, and returns their friendship. Removing
+// a user who is not a friend is not an error.
+func (s *SocialGraphService) RemoveFriend(ctx context.Context, req *socialpb.RemoveFriendRequest) (*socialpb.Friendship, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+	if req.GetUserId() == caller.UserID {
+		return nil, status.Error(codes.InvalidArgument, errs.ErrCannotFriendSelf.Error())
+	}
+
+	if err := s.friendRepo.RemoveFriend(ctx, caller.UserID, req.GetUserId()); err != nil {
+		slog.Error("failed to remove friend", "friend", req.GetUserId(), "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	slog.Info("removed friend", "user", caller.UserID, "friend", req.GetUserId())
+	return s.friendship(ctx, caller.UserID, req.GetUserId())
+}
+
+// ListFriends lists the friends of user_id, most recent first.
+func (s *SocialGraphService) ListFriends(ctx context.Context, req *socialpb.ListFriendsRequest) (*socialpb.ListFriendsResponse, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+	input := s.converter.ToListFriendsRequest(req)
+
+	if err := s.checkFriendListAccess(ctx, caller.UserID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	entries, nextPageToken, err := s.pageFriends(ctx, transport.FriendListFriends, input, s.friendRepo.ListFriends)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ToListFriendsResponse(entries, nextPageToken, caller.UserID), nil
+}
+
+// ListMutualFriends lists the friends user_id and other_user_id have in
+// common. The caller must be allowed to see both friend lists.
+func (s *SocialGraphService) ListMutualFriends(
+	ctx context.Context,
+	req *socialpb.ListMutualFriendsRequest,
+) (*socialpb.ListFriendsResponse, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+	input := s.converter.ToListMutualFriendsRequest(req)
+
+	for _, ownerID := range []int64{input.UserID, input.OtherUserID} {
+		if err := s.checkFriendListAccess(ctx, caller.UserID, ownerID); err != nil {
+			return nil, err
+		}
+	}
+
+	entries, nextPageToken, err := s.pageFriends(ctx, transport.FriendListMutual, input, s.friendRepo.ListMutualFriends)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ToListFriendsResponse(entries, nextPageToken, caller.UserID), nil
+}
+
+// ListFriendSuggestions suggests friends of the caller's friends, most mutual
+// friends first.
+func (s *SocialGraphService) ListFriendSuggestions(
+	ctx context.Context,
+	req *socialpb.ListFriendSuggestionsRequest,
+) (*socialpb.ListFriendSuggestionsResponse, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+
+	limit := int(req.GetPageSize())
+	if limit <= 0 {
+		limit = defaultFriendSuggestions
+	}
+
+	suggestions, err := s.friendRepo.ListFriendSuggestions(ctx, caller.UserID, limit)
+	if err != nil {
+		slog.Error("failed to list friend suggestions", "user", caller.UserID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	return s.converter.ToListFriendSuggestionsResponse(suggestions, caller.UserID), nil
+}
+
+// checkFriendListAccess returns NotFound for unknown accounts and
+// PermissionDenied if ownerID is a private account that viewerID neither owns
+// nor is friends with.
+func (s *SocialGraphService) checkFriendListAccess(ctx context.Context, viewerID, ownerID int64) error {
+	owner, err := s.userRepo.FetchUserByID(ctx, transport.FetchUserByIDRequest{UserId: ownerID})
+	if err != nil {
+		slog.Error("failed to fetch friend list owner", "owner", ownerID, "err", err)
+		return utils.GrpcUserNotFoundError(err)
+	}
+	if !owner.Privacy.PrivateAccount || viewerID == ownerID {
+		return nil
+	}
+
+	friendship, err := s.friendRepo.GetFriendship(ctx, viewerID, ownerID)
+	if err != nil {
+		slog.Error("failed to get friendship", "err", err)
+		return utils.GrpcUserNotFoundError(err)
+	}
+	if friendship.State != transport.FriendshipStateFriends {
+		return status.Error(codes.PermissionDenied, errs.ErrPrivateAccount.Error())
+	}
+	return nil
+}
+
+// pageFriends fetches one page of the friend list list of input.UserID (and
+// input.OtherUserID for mutual friends) and the token for the next page. Page
+// tokens are bound to the list and users they were issued for.
+func (s *SocialGraphService) pageFriends(
+	ctx context.Context,
+	list string,
+	input transport.ListFriendsRequest,
+	fetch func(context.Context, transport.FriendListQuery) ([]transport.FriendEntry, error),
+) ([]transport.FriendEntry, string, error) {
+	pageSize := input.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultSocialPageSize
+	}
+
+	var after *transport.FriendCursor
+	if input.PageToken != "" {
+		var cursor transport.FriendCursor
+		err := decodePageToken(input.PageToken, &cursor)
+		if err != nil || cursor.List != list || cursor.UserID != input.UserID ||
+			cursor.OtherUserID != input.OtherUserID || cursor.ID <= 0 {
+			return nil, "", status.Error(codes.InvalidArgument, errs.ErrInvalidPageToken.Error())
+		}
+		after = &cursor
+	}
+
+	entries, err := fetch(ctx, transport.FriendListQuery{
+		UserID:      input.UserID,
+		OtherUserID: input.OtherUserID,
+		Limit:       pageSize + 1,
+		After:       after,
+	})
+	if err != nil {
+		slog.Error("failed to list friends", "list", list, "user", input.UserID, "err", err)
+		return nil, "", status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	var nextPageToken string
+	if len(entries) > pageSize {
+		entries = entries[:pageSize]
+		last := entries[len(entries)-1]
+		nextPageToken = encodePageToken(transport.FriendCursor{
+			List:        list,
+			UserID:      input.UserID,
+			OtherUserID: input.OtherUserID,
+			CreatedAt:   last.CreatedAt,
+			ID:          last.Profile.ID,
+		})
+	}
+	return entries, nextPageToken, nil
+}
+
+// friendship loads and maps the friendship of userID with otherUserID.
+func (s *SocialGraphService) friendship(ctx context.Context, userID, otherUserID int64) (*socialpb.Friendship, error) {
+	friendship, err := s.friendRepo.GetFriendship(ctx, userID, otherUserID)
+	if err != nil {
+		slog.Error("failed to get friendship", "err", err)
+		return nil, utils.GrpcUserNotFoundError(err)
+	}
+	return s.converter.ToFriendshipResponse(friendship), nil
+}
@@ -1,33 +1,53 @@
 // Package service implements the business logic for internal-only user profile
-// retrieval. It follows SOLID principles by focusing on a single responsibility
-// (fetching user data by ID) and relying on abstractions (repositories and mappers)
-// to invert dependencies. This service is not exposed to the public gateway.
+// retrieval, follow and friendship checks and the user event feed consumed by
+// other services. It follows SOLID
+// principles by relying on abstractions (repositories and mappers) to invert
+// dependencies. This service is not exposed to the public gateway.
 package service
 
 import (
 	"context"
 	"log/slog"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	userpb "github.com/mamataliev-dev/social-platform/api/gen/user/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/mapper"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
 )
 
-// InternalUserService handles internal, read-only access to user profiles by ID.
-// It is used by other services within the system and depends on the UserRepository
-// and Converter abstractions to remain decoupled from storage and transport details.
+// InternalUserService handles internal, read-only access to user profiles by ID,
+// follows, friendships and the user event feed. It is used by other services
+// within the system and depends on the UserRepository, UserEventRepository,
+// FollowRepository, FriendRepository and Converter abstractions to remain
+// decoupled from storage and transport details.
 type InternalUserService struct {
 	userpb.UnimplementedInternalUserServiceServer
 	repo      model.UserRepository
+	events    model.UserEventRepository
+	follows   model.FollowRepository
+	friends   model.FriendRepository
 	converter mapper.Converter
 }
 
 // NewInternalUserService constructs an InternalUserService with all required
 // dependencies injected. This follows Dependency Inversion by relying on abstractions.
-func NewInternalUserService(repo model.UserRepository, converter mapper.Converter) *InternalUserService {
+func NewInternalUserService(
+	repo model.UserRepository,
+	events model.UserEventRepository,
+	follows model.FollowRepository,
+	friends model.FriendRepository,
+	converter mapper.Converter,
+) *InternalUserService {
 	return &InternalUserService{
 		repo:      repo,
+		events:    events,
+		follows:   follows,
+		friends:   friends,
 		converter: converter,
 	}
 }
@@ -49,3 +69,126 @@ func (s *InternalUserService) FetchUserProfileByID(ctx context.Context, req *use
 	resp := s.converter.ToFetchUserProfileResponse(user)
 	return resp, nil
 }
+
+// BatchFetchUserProfiles retrieves the profiles of several users with a single
+// repository query. Duplicate IDs are looked up once; IDs of unknown or deleted
+// users are returned as missing, in request order. Returns Internal on
+// repository failures.
+func (s *InternalUserService) BatchFetchUserProfiles(
+	ctx context.Context,
+	req *userpb.BatchFetchUserProfilesRequest,
+) (*userpb.BatchFetchUserProfilesResponse, error) {
+	seen := make(map[int64]bool, len(req.GetUserIds()))
+	userIDs := make([]int64, 0, len(req.GetUserIds()))
+	for _, id := range req.GetUserIds() {
+		if !seen[id] {
+			seen[id] = true
+			userIDs = append(userIDs, id)
+		}
+	}
+
+	users, err := s.repo.FetchUsersByIDs(ctx, userIDs)
+	if err != nil {
+		slog.Error("failed to batch fetch users", "count", len(userIDs), "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	found := make(map[int64]bool, len(users))
+	for _, u := range users {
+		found[u.ID] = true
+	}
+	missing := make([]int64, 0, len(userIDs)-len(users))
+	for _, id := range userIDs {
+		if !found[id] {
+			missing = append(missing, id)
+		}
+	}
+
+	return s.converter.ToBatchFetchUserProfilesResponse(users, missing), nil
+}
+
+// ListUserEvents returns up to limit user lifecycle events with IDs greater than
+// after_id, oldest first. Consumers store the ID of the last event they handled
+// and pass it back as after_id, so delivery is at-least-once. after_id is
+// recorded as the calling service's cursor, and events are kept until every
+// consumer has moved past them.
+func (s *InternalUserService) ListUserEvents(ctx context.Context, req *userpb.ListUserEventsRequest) (*userpb.ListUserEventsResponse, error) {
+	if consumer, ok := utils.CallerServiceFromContext(ctx); ok {
+		if err := s.events.SaveUserEventCursor(ctx, consumer, req.GetAfterId()); err != nil {
+			slog.Error("failed to save user event cursor", "consumer", consumer, "err", err)
+		}
+	}
+
+	events, err := s.events.ListUserEvents(ctx, req.GetAfterId(), int(req.GetLimit()))
+	if err != nil {
+		slog.Error("failed to list user events", "afterID", req.GetAfterId(), "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	return s.converter.ToListUserEventsResponse(events), nil
+}
+
+// CheckFriendship reports whether user_id and other_user_id are friends.
+// Returns NotFound unless both accounts exist, or Internal on repository
+// failures.
+func (s *InternalUserService) CheckFriendship(
+	ctx context.Context,
+	req *userpb.CheckFriendshipRequest,
+) (*userpb.CheckFriendshipResponse, error) {
+	friendship, err := s.friends.GetFriendship(ctx, req.GetUserId(), req.GetOtherUserId())
+	if err != nil {
+		slog.Error("failed to check friendship", "err", err)
+		return nil, utils.GrpcUserNotFoundError(err)
+	}
+
+	return &userpb.CheckFriendshipResponse{
+		Friends: friendship.State == transport.FriendshipStateFriends,
+	}, nil
+}
+
+// CheckFollowing reports whether follower_id follows followee_id. Returns
+// NotFound unless both accounts exist, or Internal on repository failures.
+func (s *InternalUserService) CheckFollowing(
+	ctx context.Context,
+	req *userpb.CheckFollowingRequest,
+) (*userpb.CheckFollowingResponse, error) {
+	relationship, err := s.follows.GetRelationship(ctx, req.GetFollowerId(), req.GetFolloweeId())
+	if err != nil {
+		slog.Error("failed to check following", "err", err)
+		return nil, utils.GrpcUserNotFoundError(err)
+	}
+
+	return &userpb.CheckFollowingResponse{
+		Following: relationship.Following == transport.FollowStateFollowing,
+	}, nil
+}
+
+// ListFollowerIDs lists the IDs of user_id's followers after after_id, in
+// ascending order. Returns Internal on repository failures.
+func (s *InternalUserService) ListFollowerIDs(
+	ctx context.Context,
+	req *userpb.ListFollowIDsRequest,
+) (*userpb.ListFollowIDsResponse, error) {
+	ids, err := s.follows.ListFollowerIDs(ctx, req.GetUserId(), req.GetAfterId(), int(req.GetLimit()))
+	if err != nil {
+		slog.Error("failed to list follower ids", "user", req.GetUserId(), "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	return &userpb.ListFollowIDsResponse{UserIds: ids}, nil
+}
+
+// ListFollowingIDs lists the IDs of the users user_id follows after after_id,
+// in ascending order. Returns Internal on repository failures.
+func (s *InternalUserService) ListFollowingIDs(
+	ctx context.Context,
+	req *userpb.ListFollowIDsRequest,
+) (*userpb.ListFollowIDsResponse, error) {
+	ids, err := s.follows.ListFollowingIDs(ctx, req.GetUserId(), req.GetAfterId(), int(req.GetLimit()))
+	if err != nil {
+		slog.Error("failed to list following ids", "user", req.GetUserId(), "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	return &userpb.ListFollowIDsResponse{UserIds: ids}, nil
+}
@@ -0,0 +1,135 @@
+// Package service implements brute-force protection for AuthService logins:
+// failed password and second-factor attempts are counted per account and per
+// client IP, and keys that fail too often are locked with exponential backoff.
+package service
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/security"
+)
+
+// loginAttemptKeys returns the account key for email together with every key
+// a login for email from ip is counted against. Keys are tracked for unknown
+// emails too, so lockouts do not reveal which accounts exist.
+func loginAttemptKeys(email, ip string) (model.LoginAttemptKey, []model.LoginAttemptKey) {
+	account := model.LoginAttemptKey{
+		Scope:   model.LoginScopeAccount,
+		KeyHash: security.HashToken(strings.ToLower(strings.TrimSpace(email))),
+	}
+
+	keys := []model.LoginAttemptKey{account}
+	if ip != "" {
+		keys = append(keys, model.LoginAttemptKey{Scope: model.LoginScopeIP, KeyHash: security.HashToken(ip)})
+	}
+	return account, keys
+}
+
+// checkLoginLockout returns ResourceExhausted, with a retry-after header, while
+// any of keys is locked.
+func (s *AuthService) checkLoginLockout(ctx context.Context, keys []model.LoginAttemptKey) error {
+	until, err := s.attemptRepo.LoginLockedUntil(ctx, keys)
+	if err != nil {
+		slog.Error("failed to check login lockout", "err", err)
+		return status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	if wait := time.Until(until); wait > 0 {
+		retryAfter := strconv.Itoa(int(math.Ceil(wait.Seconds())))
+		_ = grpc.SetHeader(ctx, metadata.Pairs("retry-after", retryAfter))
+		return status.Error(codes.ResourceExhausted, errs.ErrLoginLocked.Error())
+	}
+	return nil
+}
+
+// failLogin records a failed attempt against keys and returns the uniform
+// invalid-credentials error.
+func (s *AuthService) failLogin(ctx context.Context, keys []model.LoginAttemptKey) error {
+	s.recordLoginFailure(ctx, keys)
+	return status.Error(codes.Unauthenticated, errs.ErrInvalidCredentials.Error())
+}
+
+// recordLoginFailure counts a failed password or second-factor attempt against
+// keys and locks the keys that reached their threshold.
+func (s *AuthService) recordLoginFailure(ctx context.Context, keys []model.LoginAttemptKey) {
+	for _, key := range keys {
+		failures, err := s.attemptRepo.RecordLoginFailure(ctx, key, s.throttleCfg.FailureWindow)
+		if err != nil {
+			slog.Error("failed to record login failure", "scope", key.Scope, "err", err)
+			continue
+		}
+
+		lockout := s.lockoutFor(key.Scope, failures)
+		if lockout == 0 {
+			continue
+		}
+		if err := s.attemptRepo.LockLogin(ctx, key, time.Now().Add(lockout)); err != nil {
+			slog.Error("failed to lock login", "scope", key.Scope, "err", err)
+			continue
+		}
+		slog.Warn("security event: repeated login failures, locking",
+			"event", "login_lockout",
+			"scope", key.Scope,
+			"failures", failures,
+			"lockout", lockout,
+		)
+	}
+}
+
+// resetLoginFailures forgives the failures recorded against the account of
+// email after a completed login. Failures are only logged.
+func (s *AuthService) resetLoginFailures(ctx context.Context, userID int64, email string) {
+	accountKey, _ := loginAttemptKeys(email, "")
+	if err := s.attemptRepo.ResetLoginFailures(ctx, accountKey); err != nil {
+		slog.Error("failed to reset login failures", "userID", userID, "err", err)
+	}
+}
+
+// lockoutFor returns how long a key of scope is locked after failures:
+// BaseLockout once the scope's threshold is reached, doubling with every
+// further failure up to MaxLockout.
+func (s *AuthService) lockoutFor(scope string, failures int) time.Duration {
+	threshold := s.throttleCfg.MaxAccountFailures
+	if scope == model.LoginScopeIP {
+		threshold = s.throttleCfg.MaxIPFailures
+	}
+	if threshold <= 0 || failures < threshold {
+		return 0
+	}
+
+	lockout := s.throttleCfg.BaseLockout
+	for i := threshold; i < failures && lockout < s.throttleCfg.MaxLockout; i++ {
+		lockout *= 2
+	}
+	return min(lockout, s.throttleCfg.MaxLockout)
+}
+
+// burnPasswordCheck verifies password against a throwaway hash so that logins
+// for unknown emails take as long as logins with a wrong password.
+func (s *AuthService) burnPasswordCheck(password string) {
+	s.dummyHashOnce.Do(func() {
+		secret, err := security.GenerateOpaqueToken()
+		if err == nil {
+			s.dummyHash, err = s.hasher.HashPassword(secret)
+		}
+		if err != nil {
+			slog.Error("failed to prepare dummy password hash", "err", err)
+		}
+	})
+
+	if s.dummyHash != "" {
+		_ = s.hasher.VerifyPassword(s.dummyHash, password)
+	}
+}
@@ -0,0 +1,280 @@
+// Package service implements TOTP two-factor authentication for AuthService:
+// enrolling and disabling an authenticator, and completing logins that were
+// paused by an MFA challenge.
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/security"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
+)
+
+// EnrollTOTP generates a new TOTP secret for the authenticated user and returns
+// it with an otpauth URI. The secret stays pending until ConfirmTOTP; enrolling
+// again before that replaces it. Returns FailedPrecondition if TOTP is already
+// enabled.
+func (s *AuthService) EnrollTOTP(
+	ctx context.Context,
+	_ *userauthpb.EnrollTOTPRequest,
+) (*userauthpb.EnrollTOTPResponse, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+
+	user, err := s.userRepo.FetchUserByID(ctx, transport.FetchUserByIDRequest{UserId: caller.UserID})
+	if err != nil {
+		slog.Error("failed to get user for TOTP enrollment", "userID", caller.UserID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	secret, err := security.GenerateTOTPSecret()
+	if err != nil {
+		slog.Error("failed to generate TOTP secret", "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	if err := s.mfaRepo.SaveTOTPSecret(ctx, caller.UserID, secret); err != nil {
+		if errors.Is(err, errs.ErrTOTPAlreadyEnabled) {
+			return nil, status.Error(codes.FailedPrecondition, errs.ErrTOTPAlreadyEnabled.Error())
+		}
+		slog.Error("failed to save TOTP secret", "userID", caller.UserID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	return s.converter.ToEnrollTOTPResponse(transport.EnrollTOTPResponse{
+		Secret:     secret,
+		OTPAuthURI: security.OTPAuthURI(s.mfaCfg.Issuer, user.Email, secret),
+	}), nil
+}
+
+// ConfirmTOTP enables two-factor authentication once the user proves their
+// authenticator produces valid codes, and returns freshly generated recovery
+// codes. Returns FailedPrecondition without a pending enrollment, or
+// InvalidArgument for a wrong code.
+func (s *AuthService) ConfirmTOTP(
+	ctx context.Context,
+	req *userauthpb.ConfirmTOTPRequest,
+) (*userauthpb.ConfirmTOTPResponse, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+
+	enrollment, err := s.mfaRepo.GetTOTP(ctx, caller.UserID)
+	switch {
+	case errors.Is(err, errs.ErrTOTPNotEnrolled):
+		return nil, status.Error(codes.FailedPrecondition, errs.ErrTOTPNotEnrolled.Error())
+	case err != nil:
+		slog.Error("failed to get TOTP enrollment", "userID", caller.UserID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	case enrollment.Confirmed:
+		return nil, status.Error(codes.FailedPrecondition, errs.ErrTOTPAlreadyEnabled.Error())
+	}
+
+	step, ok := security.ValidateTOTP(enrollment.Secret, req.GetCode(), time.Now())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, errs.ErrInvalidMFACode.Error())
+	}
+
+	recoveryCodes, err := security.GenerateRecoveryCodes(s.mfaCfg.RecoveryCodes)
+	if err != nil {
+		slog.Error("failed to generate recovery codes", "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+	codeHashes := make([]string, 0, len(recoveryCodes))
+	for _, code := range recoveryCodes {
+		codeHashes = append(codeHashes, security.HashToken(security.NormalizeRecoveryCode(code)))
+	}
+
+	if err := s.mfaRepo.EnableTOTP(ctx, caller.UserID, step, codeHashes); err != nil {
+		if errors.Is(err, errs.ErrTOTPAlreadyEnabled) {
+			return nil, status.Error(codes.FailedPrecondition, errs.ErrTOTPAlreadyEnabled.Error())
+		}
+		slog.Error("failed to enable TOTP", "userID", caller.UserID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	slog.Info("two-factor authentication enabled", "userID", caller.UserID)
+	return s.converter.ToConfirmTOTPResponse(transport.ConfirmTOTPResponse{
+		RecoveryCodes: recoveryCodes,
+	}), nil
+}
+
+// DisableTOTP turns off two-factor authentication after checking a current
+// TOTP or recovery code. Wrong codes count as failed logins of the account, so
+// a stolen access token cannot be used to guess codes. Returns
+// FailedPrecondition if TOTP is not enabled, InvalidArgument for a wrong code,
+// or ResourceExhausted while the account is locked.
+func (s *AuthService) DisableTOTP(
+	ctx context.Context,
+	req *userauthpb.DisableTOTPRequest,
+) (*userauthpb.DisableTOTPResponse, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+
+	user, err := s.userRepo.FetchUserByID(ctx, transport.FetchUserByIDRequest{UserId: caller.UserID})
+	if err != nil {
+		slog.Error("failed to get user for disabling TOTP", "userID", caller.UserID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+	_, keys := loginAttemptKeys(user.Email, utils.ClientInfoFromContext(ctx).IPAddress)
+	if err := s.checkLoginLockout(ctx, keys); err != nil {
+		return nil, err
+	}
+
+	enrollment, err := s.mfaRepo.GetTOTP(ctx, caller.UserID)
+	switch {
+	case errors.Is(err, errs.ErrTOTPNotEnrolled) || (err == nil && !enrollment.Confirmed):
+		return nil, status.Error(codes.FailedPrecondition, errs.ErrTOTPNotEnrolled.Error())
+	case err != nil:
+		slog.Error("failed to get TOTP enrollment", "userID", caller.UserID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	if err := s.checkSecondFactor(ctx, enrollment, req.GetCode()); err != nil {
+		if errors.Is(err, errs.ErrInvalidMFACode) {
+			s.recordLoginFailure(ctx, keys)
+			return nil, status.Error(codes.InvalidArgument, errs.ErrInvalidMFACode.Error())
+		}
+		slog.Error("failed to check second factor", "userID", caller.UserID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	if err := s.mfaRepo.DeleteTOTP(ctx, caller.UserID); err != nil {
+		slog.Error("failed to disable TOTP", "userID", caller.UserID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	slog.Info("two-factor authentication disabled", "userID", caller.UserID)
+	return s.converter.ToDisableTOTPResponse(transport.DisableTOTPResponse{
+		Message: "Two-factor authentication disabled",
+	}), nil
+}
+
+// VerifyMFA completes a login paused by an MFA challenge. The challenge token
+// is single-use and expires after the configured TTL or number of codes; each
+// attempt is claimed before the code is checked, so parallel guesses cannot
+// exceed the limit. Wrong codes also count as failed logins of the account,
+// so new challenges do not grant new guesses. Returns Unauthenticated for an
+// invalid challenge or code, or ResourceExhausted while the account is locked.
+func (s *AuthService) VerifyMFA(
+	ctx context.Context,
+	req *userauthpb.VerifyMFARequest,
+) (*userauthpb.AuthTokenResponse, error) {
+	input := s.converter.ToVerifyMFARequest(req)
+	tokenHash := security.HashToken(input.MFAToken)
+
+	challenge, err := s.mfaRepo.ClaimMFAChallengeAttempt(ctx, tokenHash, s.mfaCfg.MaxChallengeAttempts)
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidMFAChallenge) {
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidMFAChallenge.Error())
+		}
+		slog.Error("failed to claim MFA challenge attempt", "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	user, err := s.userRepo.FetchUserByID(ctx, transport.FetchUserByIDRequest{UserId: challenge.UserID})
+	if err != nil {
+		slog.Error("failed to get user by ID", "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+	_, keys := loginAttemptKeys(user.Email, utils.ClientInfoFromContext(ctx).IPAddress)
+	if err := s.checkLoginLockout(ctx, keys); err != nil {
+		return nil, err
+	}
+
+	enrollment, err := s.mfaRepo.GetTOTP(ctx, challenge.UserID)
+	switch {
+	case errors.Is(err, errs.ErrTOTPNotEnrolled) || (err == nil && !enrollment.Confirmed):
+		// Two-factor authentication was disabled after the challenge was issued.
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidMFAChallenge.Error())
+	case err != nil:
+		slog.Error("failed to get TOTP enrollment", "userID", challenge.UserID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	if err := s.checkSecondFactor(ctx, enrollment, input.Code); err != nil {
+		if !errors.Is(err, errs.ErrInvalidMFACode) {
+			slog.Error("failed to check second factor", "userID", challenge.UserID, "err", err)
+			return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+		}
+		s.recordLoginFailure(ctx, keys)
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidMFACode.Error())
+	}
+
+	if err := s.mfaRepo.DeleteMFAChallenge(ctx, tokenHash); err != nil {
+		if errors.Is(err, errs.ErrInvalidMFAChallenge) {
+			return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidMFAChallenge.Error())
+		}
+		slog.Error("failed to redeem MFA challenge", "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	if !user.SuspendedAt.IsZero() {
+		return nil, rejectSuspendedLogin(user.ID)
+	}
+	s.resetLoginFailures(ctx, user.ID, user.Email)
+
+	tokenPair, err := s.startSession(ctx, domain.CreateTokenPairInput{
+		UserID:        user.ID,
+		Nickname:      user.Nickname,
+		EmailVerified: !user.EmailVerifiedAt.IsZero(),
+		Roles:         user.Roles,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ToAuthTokenResponse(tokenPair), nil
+}
+
+// issueMFAChallenge pauses a login that passed the password check: it stores
+// the digest of a short-lived challenge token and returns the raw token. No
+// challenge is issued while the account is locked.
+func (s *AuthService) issueMFAChallenge(ctx context.Context, user model.User) (*userauthpb.AuthTokenResponse, error) {
+	_, keys := loginAttemptKeys(user.Email, utils.ClientInfoFromContext(ctx).IPAddress)
+	if err := s.checkLoginLockout(ctx, keys); err != nil {
+		return nil, err
+	}
+
+	token, err := security.GenerateOpaqueToken()
+	if err != nil {
+		slog.Error("failed to generate MFA challenge token", "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	if err := s.mfaRepo.SaveMFAChallenge(ctx, domain.SaveMFAChallengeInput{
+		UserID:    user.ID,
+		TokenHash: security.HashToken(token),
+		ExpiresAt: time.Now().Add(s.mfaCfg.ChallengeTTL),
+	}); err != nil {
+		slog.Error("failed to save MFA challenge", "userID", user.ID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	return s.converter.ToMFAChallengeResponse(token), nil
+}
+
+// checkSecondFactor accepts either a current TOTP code, which may be used only
+// once, or an unused recovery code. Returns ErrInvalidMFACode otherwise.
+func (s *AuthService) checkSecondFactor(ctx context.Context, enrollment model.TOTPEnrollment, code string) error {
+	if step, ok := security.ValidateTOTP(enrollment.Secret, code, time.Now()); ok {
+		return s.mfaRepo.MarkTOTPStepUsed(ctx, enrollment.UserID, step)
+	}
+	return s.mfaRepo.ConsumeRecoveryCode(ctx, enrollment.UserID, security.HashToken(security.NormalizeRecoveryCode(code)))
+}
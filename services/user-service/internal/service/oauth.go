@@ -0,0 +1,301 @@
+// Package service implements OpenID Connect social login for AuthService:
+// starting the authorization code flow, signing users in with the verified
+// identity the provider returns, and re-authenticating accounts that have no
+// password.
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/oidc"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/security"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
+)
+
+const (
+	// maxNicknameAttempts bounds how many nicknames are tried for a new
+	// social login account before giving up.
+	maxNicknameAttempts = 5
+	// maxBaseNicknameLength leaves room for a "_NNNN" suffix within the
+	// 30-character nickname limit.
+	maxBaseNicknameLength = 24
+	// minNicknameLength matches the nickname validation rule.
+	minNicknameLength = 3
+	// maxUsernameLength matches the users.username column.
+	maxUsernameLength = 64
+	// fallbackNickname is used when the identity offers nothing usable.
+	fallbackNickname = "user"
+)
+
+// BeginOAuthLogin starts a social login with the named provider. It stores a
+// single-use state bound to a PKCE verifier and nonce and returns the provider
+// authorization URL. Returns NotFound for unknown providers and Unavailable if
+// the provider cannot be reached.
+func (s *AuthService) BeginOAuthLogin(
+	ctx context.Context,
+	req *userauthpb.BeginOAuthLoginRequest,
+) (*userauthpb.BeginOAuthLoginResponse, error) {
+	provider, err := s.oauth.Provider(req.GetProvider())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, errs.ErrUnknownOAuthProvider.Error())
+	}
+
+	state, err := security.GenerateOpaqueToken()
+	if err != nil {
+		slog.Error("failed to generate OAuth state", "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+	verifier, err := oidc.GenerateCodeVerifier()
+	if err != nil {
+		slog.Error("failed to generate PKCE verifier", "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+	nonce, err := oidc.GenerateCodeVerifier()
+	if err != nil {
+		slog.Error("failed to generate OIDC nonce", "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	authURL, err := provider.AuthCodeURL(ctx, state, nonce, oidc.CodeChallengeS256(verifier))
+	if err != nil {
+		slog.Error("failed to build authorization URL", "provider", provider.Name(), "err", err)
+		return nil, status.Error(codes.Unavailable, errs.ErrOIDCDiscoveryFailed.Error())
+	}
+
+	if err := s.identityRepo.SaveOAuthState(ctx, domain.SaveOAuthStateInput{
+		StateHash:    security.HashToken(state),
+		Provider:     provider.Name(),
+		CodeVerifier: verifier,
+		Nonce:        nonce,
+		ExpiresAt:    time.Now().Add(s.oauthCfg.StateTTL),
+	}); err != nil {
+		slog.Error("failed to save OAuth state", "provider", provider.Name(), "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	return s.converter.ToBeginOAuthLoginResponse(transport.BeginOAuthLoginResponse{
+		AuthorizationURL: authURL,
+		State:            state,
+	}), nil
+}
+
+// CompleteOAuthLogin redeems the authorization code returned by the provider
+// and signs the user in. A known identity logs into its linked account; an
+// unknown one is linked to the account with the same verified email, or a new
+// account is created. Accounts with two-factor authentication still receive
+// an MFA challenge.
+func (s *AuthService) CompleteOAuthLogin(
+	ctx context.Context,
+	req *userauthpb.CompleteOAuthLoginRequest,
+) (*userauthpb.AuthTokenResponse, error) {
+	input := s.converter.ToCompleteOAuthLoginRequest(req)
+
+	provider, err := s.oauth.Provider(input.Provider)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, errs.ErrUnknownOAuthProvider.Error())
+	}
+
+	pending, err := s.identityRepo.ConsumeOAuthState(ctx, security.HashToken(input.State))
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidOAuthState) {
+			return nil, status.Error(codes.InvalidArgument, errs.ErrInvalidOAuthState.Error())
+		}
+		slog.Error("failed to consume OAuth state", "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+	if pending.Provider != provider.Name() {
+		return nil, status.Error(codes.InvalidArgument, errs.ErrInvalidOAuthState.Error())
+	}
+
+	identity, err := provider.Exchange(ctx, input.Code, pending.CodeVerifier, pending.Nonce)
+	switch {
+	case errors.Is(err, errs.ErrOAuthExchangeFailed):
+		slog.Warn("OAuth code exchange failed", "provider", provider.Name(), "err", err)
+		return nil, status.Error(codes.Unauthenticated, errs.ErrOAuthExchangeFailed.Error())
+	case errors.Is(err, errs.ErrInvalidIDToken):
+		slog.Warn("rejected ID token", "provider", provider.Name(), "err", err)
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidIDToken.Error())
+	case errors.Is(err, errs.ErrOIDCDiscoveryFailed):
+		slog.Error("OIDC discovery failed", "provider", provider.Name(), "err", err)
+		return nil, status.Error(codes.Unavailable, errs.ErrOIDCDiscoveryFailed.Error())
+	case err != nil:
+		slog.Error("failed to complete OAuth login", "provider", provider.Name(), "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	user, err := s.resolveOAuthUser(ctx, provider.Name(), identity)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.completeLogin(ctx, user)
+}
+
+// resolveOAuthUser finds or creates the local account for a verified identity.
+// Identities are linked to existing accounts only when both sides have
+// verified the email, so nobody can take over an account by registering its
+// address at a provider.
+func (s *AuthService) resolveOAuthUser(ctx context.Context, provider string, identity oidc.Identity) (model.User, error) {
+	user, err := s.identityRepo.FindUserByIdentity(ctx, provider, identity.Subject)
+	if err == nil {
+		return user, nil
+	}
+	if !errors.Is(err, errs.ErrIdentityNotFound) {
+		slog.Error("failed to look up external identity", "provider", provider, "err", err)
+		return model.User{}, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	if identity.Email == "" || !identity.EmailVerified {
+		return model.User{}, status.Error(codes.FailedPrecondition, errs.ErrOAuthEmailNotVerified.Error())
+	}
+
+	link := model.ExternalIdentity{
+		Provider: provider,
+		Subject:  identity.Subject,
+		Email:    identity.Email,
+	}
+
+	existing, err := s.authRepo.FetchUserByEmail(ctx, identity.Email)
+	switch {
+	case err == nil:
+		if !existing.IsEmailVerified() {
+			return model.User{}, status.Error(codes.FailedPrecondition, errs.ErrAccountEmailNotVerified.Error())
+		}
+		link.UserID = existing.ID
+		if err := s.identityRepo.LinkIdentity(ctx, link); err != nil {
+			slog.Error("failed to link external identity", "userID", existing.ID, "provider", provider, "err", err)
+			return model.User{}, status.Error(codes.Internal, errs.ErrInternal.Error())
+		}
+		slog.Info("linked external identity", "userID", existing.ID, "provider", provider)
+		return existing, nil
+	case !errors.Is(err, errs.ErrUserNotFound):
+		slog.Error("failed to fetch user by email", "err", err)
+		return model.User{}, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	return s.createOAuthUser(ctx, identity, link)
+}
+
+// createOAuthUser registers a passwordless account for identity, retrying with
+// a random suffix while the derived nickname is taken.
+func (s *AuthService) createOAuthUser(ctx context.Context, identity oidc.Identity, link model.ExternalIdentity) (model.User, error) {
+	base := baseNickname(identity)
+
+	for attempt := 0; attempt < maxNicknameAttempts; attempt++ {
+		nickname := base
+		if attempt > 0 {
+			nickname = fmt.Sprintf("%s_%04d", base, rand.IntN(10000))
+		}
+
+		username := identity.Name
+		if username == "" {
+			username = nickname
+		}
+
+		user, err := s.identityRepo.CreateUserWithIdentity(ctx, model.User{
+			Username:        truncateRunes(username, maxUsernameLength),
+			Email:           identity.Email,
+			Nickname:        nickname,
+			AvatarURL:       identity.Picture,
+			EmailVerifiedAt: time.Now(),
+		}, link)
+		switch {
+		case err == nil:
+			slog.Info("created user from external identity", "userID", user.ID, "provider", link.Provider)
+			return user, nil
+		case errors.Is(err, errs.ErrNicknameTaken):
+			continue
+		case errors.Is(err, errs.ErrEmailTaken):
+			return model.User{}, status.Error(codes.AlreadyExists, errs.ErrEmailTaken.Error())
+		default:
+			slog.Error("failed to create user from external identity", "provider", link.Provider, "err", err)
+			return model.User{}, status.Error(codes.Internal, errs.ErrInternal.Error())
+		}
+	}
+
+	slog.Error("could not find a free nickname for external identity", "provider", link.Provider, "base", base)
+	return model.User{}, status.Error(codes.Internal, errs.ErrInternal.Error())
+}
+
+// baseNickname derives a nickname from the identity's preferred username, the
+// local part of its email or its name, whichever yields a valid one first.
+func baseNickname(identity oidc.Identity) string {
+	localPart, _, _ := strings.Cut(identity.Email, "@")
+	for _, candidate := range []string{identity.PreferredUsername, localPart, identity.Name} {
+		if nickname := sanitizeNickname(candidate); len(nickname) >= minNicknameLength {
+			return nickname
+		}
+	}
+	return fallbackNickname
+}
+
+// sanitizeNickname lowercases s, maps separators to underscores and drops
+// every other character outside [a-z0-9_].
+func sanitizeNickname(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_':
+			b.WriteRune(r)
+		case r == '.', r == '-', r == ' ':
+			b.WriteByte('_')
+		}
+	}
+
+	nickname := strings.Trim(b.String(), "_")
+	if len(nickname) > maxBaseNicknameLength {
+		nickname = strings.TrimRight(nickname[:maxBaseNicknameLength], "_")
+	}
+	return nickname
+}
+
+// truncateRunes shortens s to at most n runes.
+func truncateRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
+
+// confirmIdentity re-authenticates the caller before a sensitive change.
+// Accounts with a password must enter it. Accounts created through social
+// login have none, so the caller's session must instead have been started by
+// a sign-in within the configured reauth window. Returns ErrInvalidPassword or
+// ErrRecentLoginRequired if the caller is not confirmed.
+func (s *AuthService) confirmIdentity(ctx context.Context, caller utils.AuthInfo, user model.User, password string) error {
+	if user.PasswordHash != "" {
+		if err := s.hasher.VerifyPassword(user.PasswordHash, password); err != nil {
+			return errs.ErrInvalidPassword
+		}
+		return nil
+	}
+
+	if caller.SessionID == "" {
+		return errs.ErrRecentLoginRequired
+	}
+	sessions, err := s.tokenRepo.ListSessions(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		if session.ID == caller.SessionID && time.Since(session.CreatedAt) < s.oauthCfg.ReauthWindow {
+			return nil
+		}
+	}
+	return errs.ErrRecentLoginRequired
+}
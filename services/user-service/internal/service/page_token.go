@@ -0,0 +1,30 @@
+// Package service provides the opaque page tokens used by paginated list
+// methods. Keeping the encoding in one place follows the Single Responsibility
+// principle; each method decides what its cursor holds and when it is valid.
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+)
+
+// encodePageToken turns a cursor into an opaque page token.
+func encodePageToken(cursor any) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageToken parses a page token produced by encodePageToken into
+// cursor; returns ErrInvalidPageToken if it is malformed.
+func decodePageToken(token string, cursor any) error {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return errs.ErrInvalidPageToken
+	}
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return errs.ErrInvalidPageToken
+	}
+	return nil
+}
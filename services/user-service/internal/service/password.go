@@ -0,0 +1,223 @@
+// Package service implements password management for AuthService: changing
+// the password of a signed-in user and the emailed password reset flow. Every
+// new password is checked against the configured password policy.
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/mailer"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/security"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
+)
+
+// requestPasswordResetMessage is returned for every reset request so the
+// endpoint does not reveal which addresses are registered.
+const requestPasswordResetMessage = "If the address belongs to an account, a password reset email has been sent"
+
+// ChangePassword replaces the authenticated user's password after confirming
+// the current one, and signs out every other session. Accounts without a
+// password set their first one after a recent sign-in instead. Returns
+// Unauthenticated if the caller is not confirmed and InvalidArgument if the
+// new password violates the password policy.
+func (s *AuthService) ChangePassword(
+	ctx context.Context,
+	req *userauthpb.ChangePasswordRequest,
+) (*userauthpb.ChangePasswordResponse, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+	input := s.converter.ToChangePasswordRequest(req)
+
+	user, err := s.authRepo.FetchUserCredentials(ctx, caller.UserID)
+	if err != nil {
+		slog.Error("failed to fetch user for password change", "userID", caller.UserID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	err = s.confirmIdentity(ctx, caller, user, input.CurrentPassword)
+	switch {
+	case errors.Is(err, errs.ErrInvalidPassword) || errors.Is(err, errs.ErrRecentLoginRequired):
+		slog.Warn("security event: password change without confirmation",
+			"event", "password_change_rejected",
+			"userID", user.ID,
+			"reason", err,
+		)
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	case err != nil:
+		slog.Error("failed to confirm identity for password change", "userID", user.ID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	if err := s.checkPasswordPolicy(input.NewPassword, user.Email, user.Nickname); err != nil {
+		return nil, err
+	}
+
+	hash, err := s.hasher.HashPassword(input.NewPassword)
+	if err != nil {
+		slog.Error("failed to hash password", "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+	if err := s.authRepo.UpdatePasswordHash(ctx, user.ID, user.PasswordHash, hash); err != nil {
+		slog.Error("failed to store changed password", "userID", user.ID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	var revoked int64
+	if caller.SessionID != "" {
+		revoked, err = s.tokenRepo.DeleteOtherSessions(ctx, user.ID, caller.SessionID)
+	} else {
+		revoked, err = s.tokenRepo.DeleteAllSessions(ctx, user.ID)
+	}
+	if err != nil {
+		slog.Error("failed to revoke sessions after password change", "userID", user.ID, "err", err)
+	}
+
+	slog.Info("password changed", "userID", user.ID, "revokedSessions", revoked)
+	return s.converter.ToChangePasswordResponse(transport.ChangePasswordResponse{
+		Message: "Password changed successfully",
+	}), nil
+}
+
+// RequestPasswordReset emails a single-use password reset link. Unknown
+// addresses and requests within the configured cooldown of the previous link
+// receive the same response as successful sends, so the endpoint cannot be
+// used to enumerate accounts.
+func (s *AuthService) RequestPasswordReset(
+	ctx context.Context,
+	req *userauthpb.RequestPasswordResetRequest,
+) (*userauthpb.RequestPasswordResetResponse, error) {
+	input := s.converter.ToRequestPasswordResetRequest(req)
+
+	user, err := s.authRepo.FetchUserByEmail(ctx, input.Email)
+	switch {
+	case errors.Is(err, errs.ErrUserNotFound):
+		return s.requestPasswordResetResponse(), nil
+	case err != nil:
+		slog.Error("failed to fetch user for password reset", "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	err = s.sendPasswordResetEmail(ctx, user)
+	switch {
+	case errors.Is(err, errs.ErrPasswordResetThrottled):
+		slog.Info("password reset throttled", "userID", user.ID)
+	case err != nil:
+		slog.Error("failed to send password reset email", "userID", user.ID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	return s.requestPasswordResetResponse(), nil
+}
+
+// ResetPassword redeems a password reset token and sets a new password. All of
+// the user's sessions are revoked and the account's login lockout is cleared.
+// Returns InvalidArgument for unknown or expired tokens and for passwords
+// violating the password policy.
+func (s *AuthService) ResetPassword(
+	ctx context.Context,
+	req *userauthpb.ResetPasswordRequest,
+) (*userauthpb.ResetPasswordResponse, error) {
+	input := s.converter.ToResetPasswordRequest(req)
+	tokenHash := security.HashToken(input.Token)
+
+	userID, err := s.resetRepo.GetPasswordResetToken(ctx, tokenHash)
+	if err != nil {
+		return nil, mapResetTokenError(err)
+	}
+
+	user, err := s.authRepo.FetchUserCredentials(ctx, userID)
+	if err != nil {
+		slog.Error("failed to fetch user for password reset", "userID", userID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	if err := s.checkPasswordPolicy(input.NewPassword, user.Email, user.Nickname); err != nil {
+		return nil, err
+	}
+
+	hash, err := s.hasher.HashPassword(input.NewPassword)
+	if err != nil {
+		slog.Error("failed to hash password", "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	if _, err := s.resetRepo.ConsumePasswordResetToken(ctx, tokenHash, hash); err != nil {
+		return nil, mapResetTokenError(err)
+	}
+
+	s.resetLoginFailures(ctx, user.ID, user.Email)
+
+	slog.Info("password reset", "userID", user.ID)
+	return s.converter.ToResetPasswordResponse(transport.ResetPasswordResponse{
+		Message: "Password reset successfully",
+	}), nil
+}
+
+func (s *AuthService) requestPasswordResetResponse() *userauthpb.RequestPasswordResetResponse {
+	return s.converter.ToRequestPasswordResetResponse(transport.RequestPasswordResetResponse{
+		Message: requestPasswordResetMessage,
+	})
+}
+
+// sendPasswordResetEmail generates a reset token, stores its digest with the
+// configured TTL, and mails the raw token to the user as a link. Returns
+// ErrPasswordResetThrottled, without sending, if the user was issued a reset
+// token within the configured cooldown.
+func (s *AuthService) sendPasswordResetEmail(ctx context.Context, user model.User) error {
+	token, err := security.GenerateOpaqueToken()
+	if err != nil {
+		return err
+	}
+
+	if err := s.resetRepo.SavePasswordResetToken(ctx, domain.SavePasswordResetTokenInput{
+		UserID:    user.ID,
+		TokenHash: security.HashToken(token),
+		ExpiresAt: time.Now().Add(s.resetCfg.TokenTTL),
+		Cooldown:  s.resetCfg.ResendCooldown,
+	}); err != nil {
+		return err
+	}
+
+	link := s.resetCfg.LinkBaseURL + "?token=" + url.QueryEscape(token)
+	return s.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nSomeone asked to reset the password of your account. If it was you, open the link below:\n\n%s\n\nThe link expires in %s. If you did not ask for this, you can ignore this email.\n",
+			user.Nickname, link, s.resetCfg.TokenTTL,
+		),
+	})
+}
+
+// checkPasswordPolicy validates a new password for the account identified by
+// email and nickname, returning InvalidArgument with the violated rule.
+func (s *AuthService) checkPasswordPolicy(password, email, nickname string) error {
+	if err := s.policy.Validate(password, email, nickname); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+// mapResetTokenError converts password reset repository errors to gRPC status errors.
+func mapResetTokenError(err error) error {
+	if errors.Is(err, errs.ErrInvalidResetToken) {
+		return status.Error(codes.InvalidArgument, errs.ErrInvalidResetToken.Error())
+	}
+	slog.Error("failed to redeem password reset token", "err", err)
+	return status.Error(codes.Internal, errs.ErrInternal.Error())
+}
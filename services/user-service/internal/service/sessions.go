@@ -0,0 +1,85 @@
+// Package service implements session management for AuthService: listing the
+// caller's signed-in devices and revoking them individually or in bulk.
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
+)
+
+// ListSessions returns the authenticated user's active sessions, flagging the
+// one the request was made from. Returns Unauthenticated without a caller
+// identity or Internal on storage errors.
+func (s *AuthService) ListSessions(
+	ctx context.Context,
+	_ *userauthpb.ListSessionsRequest,
+) (*userauthpb.ListSessionsResponse, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+
+	sessions, err := s.tokenRepo.ListSessions(ctx, caller.UserID)
+	if err != nil {
+		slog.Error("failed to list sessions", "userID", caller.UserID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	return s.converter.ToListSessionsResponse(sessions, caller.SessionID), nil
+}
+
+// RevokeSession signs out one of the authenticated user's devices. Returns
+// NotFound if the session does not exist or belongs to someone else.
+func (s *AuthService) RevokeSession(
+	ctx context.Context,
+	req *userauthpb.RevokeSessionRequest,
+) (*userauthpb.RevokeSessionResponse, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+
+	if err := s.tokenRepo.DeleteSession(ctx, caller.UserID, req.GetSessionId()); err != nil {
+		if errors.Is(err, errs.ErrSessionNotFound) {
+			return nil, status.Error(codes.NotFound, errs.ErrSessionNotFound.Error())
+		}
+		slog.Error("failed to revoke session", "userID", caller.UserID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	return s.converter.ToRevokeSessionResponse(transport.RevokeSessionResponse{
+		Message: "Session revoked",
+	}), nil
+}
+
+// RevokeAllOtherSessions signs out every device of the authenticated user
+// except the one making the request. The current session is identified by the
+// access token's "sid" claim.
+func (s *AuthService) RevokeAllOtherSessions(
+	ctx context.Context,
+	_ *userauthpb.RevokeAllOtherSessionsRequest,
+) (*userauthpb.RevokeAllOtherSessionsResponse, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok || caller.SessionID == "" {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidToken.Error())
+	}
+
+	revoked, err := s.tokenRepo.DeleteOtherSessions(ctx, caller.UserID, caller.SessionID)
+	if err != nil {
+		slog.Error("failed to revoke other sessions", "userID", caller.UserID, "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	return s.converter.ToRevokeAllOtherSessionsResponse(transport.RevokeAllOtherSessionsResponse{
+		RevokedCount: revoked,
+	}), nil
+}
@@ -0,0 +1,320 @@
+// Package service implements the follow graph: following, unfollowing, follow
+// lists and follow requests to private accounts. It relies on repository and
+// mapper abstractions, following SOLID principles.
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	socialpb "github.com/mamataliev-dev/social-platform/api/gen/social/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/mapper"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
+)
+
+// defaultSocialPageSize is used when a follow or friend list is requested
+// without a page size.
+const defaultSocialPageSize = 20
+
+// SocialGraphService lets users follow each other and become friends.
+// Following a private account files a follow request its owner approves or
+// denies, and the follow lists of a private account are only shown to its
+// owner and followers. It depends on the UserRepository, FollowRepository,
+// FriendRepository and Converter abstractions.
+type SocialGraphService struct {
+	socialpb.UnimplementedSocialGraphServiceServer
+	userRepo   model.UserRepository
+	followRepo model.FollowRepository
+	friendRepo model.FriendRepository
+	converter  mapper.Converter
+}
+
+// NewSocialGraphService constructs a SocialGraphService with all required
+// dependencies injected. This follows Dependency Inversion by relying on
+// abstractions.
+func NewSocialGraphService(
+	userRepo model.UserRepository,
+	followRepo model.FollowRepository,
+	friendRepo model.FriendRepository,
+	converter mapper.Converter,
+) *SocialGraphService {
+	return &SocialGraphService{
+		userRepo:   userRepo,
+		followRepo: followRepo,
+		friendRepo: friendRepo,
+		converter:  converter,
+	}
+}
+
+// Follow follows user_id, or sends a follow request if it is a private
+// account, and returns the caller's relationship with the user. Returns
+// InvalidArgument for the caller's own account and NotFound for unknown,
+// deleted or suspended accounts.
+func (s *SocialGraphService) Follow(ctx context.Context, req *socialpb.FollowRequest) (*socialpb.Relationship, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+	if req.GetUserId() == caller.UserID {
+		return nil, status.Error(codes.InvalidArgument, errs.ErrCannotFollowSelf.Error())
+	}
+
+	if err := s.followRepo.Follow(ctx, caller.UserID, req.GetUserId()); err != nil {
+		slog.Error("failed to follow user", "followee", req.GetUserId(), "err", err)
+		return nil, utils.GrpcUserNotFoundError(err)
+	}
+
+	slog.Info("followed user", "follower", caller.UserID, "followee", req.GetUserId())
+	return s.relationship(ctx, caller.UserID, req.GetUserId())
+}
+
+// Unfollow stops following user_id or withdraws a pending follow request, and
+// returns the caller's relationship with the user. Unfollowing a user who is
+// not followed is not an error.
+func (s *SocialGraphService) Unfollow(ctx context.Context, req *socialpb.UnfollowRequest) (*socialpb.Relationship, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+	if req.GetUserId() == caller.UserID {
+		return nil, status.Error(codes.InvalidArgument, errs.ErrCannotFollowSelf.Error())
+	}
+
+	if err := s.followRepo.Unfollow(ctx, caller.UserID, req.GetUserId()); err != nil {
+		slog.Error("failed to unfollow user", "followee", req.GetUserId(), "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	slog.Info("unfollowed user", "follower", caller.UserID, "followee", req.GetUserId())
+	return s.relationship(ctx, caller.UserID, req.GetUserId())
+}
+
+// ListFollowers lists the followers of user_id, most recent first.
+func (s *SocialGraphService) ListFollowers(ctx context.Context, req *socialpb.ListFollowsRequest) (*socialpb.ListFollowsResponse, error) {
+	return s.listFollows(ctx, req, transport.FollowListFollowers, s.followRepo.ListFollowers)
+}
+
+// ListFollowing lists the users user_id follows, most recent first.
+func (s *SocialGraphService) ListFollowing(ctx context.Context, req *socialpb.ListFollowsRequest) (*socialpb.ListFollowsResponse, error) {
+	return s.listFollows(ctx, req, transport.FollowListFollowing, s.followRepo.ListFollowing)
+}
+
+// GetRelationship reports how user_id and other_user_id are connected.
+// Pending follow requests are reported as no connection unless the caller is
+// one of the two users. Returns NotFound unless both accounts exist.
+func (s *SocialGraphService) GetRelationship(ctx context.Context, req *socialpb.GetRelationshipRequest) (*socialpb.Relationship, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+
+	rel, err := s.followRepo.GetRelationship(ctx, req.GetUserId(), req.GetOtherUserId())
+	if err != nil {
+		slog.Error("failed to get relationship", "err", err)
+		return nil, utils.GrpcUserNotFoundError(err)
+	}
+
+	if caller.UserID != rel.UserID && caller.UserID != rel.OtherUserID {
+		rel.Following = withoutRequest(rel.Following)
+		rel.FollowedBy = withoutRequest(rel.FollowedBy)
+	}
+	return s.converter.ToRelationshipResponse(rel), nil
+}
+
+// ListFollowRequests lists pending requests to follow the caller, most recent
+// first.
+func (s *SocialGraphService) ListFollowRequests(
+	ctx context.Context,
+	req *socialpb.ListFollowRequestsRequest,
+) (*socialpb.ListFollowRequestsResponse, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+
+	entries, nextPageToken, err := s.pageFollows(ctx, transport.FollowListRequests, transport.ListFollowsRequest{
+		UserID:    caller.UserID,
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	}, s.followRepo.ListFollowRequests)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ToListFollowRequestsResponse(entries, nextPageToken, caller.UserID), nil
+}
+
+// ApproveFollowRequest makes requester_id a follower of the caller and
+// returns the caller's relationship with the requester. Returns NotFound if
+// there is no pending request from requester_id.
+func (s *SocialGraphService) ApproveFollowRequest(
+	ctx context.Context,
+	req *socialpb.RespondToFollowRequestRequest,
+) (*socialpb.Relationship, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+
+	if err := s.followRepo.ApproveFollowRequest(ctx, caller.UserID, req.GetRequesterId()); err != nil {
+		slog.Error("failed to approve follow request", "requester", req.GetRequesterId(), "err", err)
+		return nil, followRequestError(err)
+	}
+
+	slog.Info("approved follow request", "target", caller.UserID, "requester", req.GetRequesterId())
+	return s.relationship(ctx, caller.UserID, req.GetRequesterId())
+}
+
+// DenyFollowRequest drops the pending request of requester_id and returns the
+// caller's relationship with the requester. Returns NotFound if there is no
+// pending request from requester_id.
+func (s *SocialGraphService) DenyFollowRequest(
+	ctx context.Context,
+	req *socialpb.RespondToFollowRequestRequest,
+) (*socialpb.Relationship, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+
+	if err := s.followRepo.DenyFollowRequest(ctx, caller.UserID, req.GetRequesterId()); err != nil {
+		slog.Error("failed to deny follow request", "requester", req.GetRequesterId(), "err", err)
+		return nil, followRequestError(err)
+	}
+
+	slog.Info("denied follow request", "target", caller.UserID, "requester", req.GetRequesterId())
+	return s.relationship(ctx, caller.UserID, req.GetRequesterId())
+}
+
+// listFollows serves ListFollowers and ListFollowing after checking that the
+// caller may see the lists of the requested user.
+func (s *SocialGraphService) listFollows(
+	ctx context.Context,
+	req *socialpb.ListFollowsRequest,
+	list string,
+	fetch func(context.Context, transport.FollowListQuery) ([]transport.FollowEntry, error),
+) (*socialpb.ListFollowsResponse, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+	input := s.converter.ToListFollowsRequest(req)
+
+	if err := s.checkFollowListAccess(ctx, caller.UserID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	entries, nextPageToken, err := s.pageFollows(ctx, list, input, fetch)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ToListFollowsResponse(entries, nextPageToken, caller.UserID), nil
+}
+
+// checkFollowListAccess returns NotFound for unknown accounts and
+// PermissionDenied if ownerID is a private account that viewerID neither owns
+// nor follows.
+func (s *SocialGraphService) checkFollowListAccess(ctx context.Context, viewerID, ownerID int64) error {
+	owner, err := s.userRepo.FetchUserByID(ctx, transport.FetchUserByIDRequest{UserId: ownerID})
+	if err != nil {
+		slog.Error("failed to fetch follow list owner", "owner", ownerID, "err", err)
+		return utils.GrpcUserNotFoundError(err)
+	}
+	if !owner.Privacy.PrivateAccount || viewerID == ownerID {
+		return nil
+	}
+
+	rel, err := s.followRepo.GetRelationship(ctx, viewerID, ownerID)
+	if err != nil {
+		slog.Error("failed to get relationship", "err", err)
+		return utils.GrpcUserNotFoundError(err)
+	}
+	if rel.Following != transport.FollowStateFollowing {
+		return status.Error(codes.PermissionDenied, errs.ErrPrivateAccount.Error())
+	}
+	return nil
+}
+
+// pageFollows fetches one page of the follow list list of input.UserID and
+// the token for the next page. Page tokens are bound to the list and user
+// they were issued for.
+func (s *SocialGraphService) pageFollows(
+	ctx context.Context,
+	list string,
+	input transport.ListFollowsRequest,
+	fetch func(context.Context, transport.FollowListQuery) ([]transport.FollowEntry, error),
+) ([]transport.FollowEntry, string, error) {
+	pageSize := input.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultSocialPageSize
+	}
+
+	var after *transport.FollowCursor
+	if input.PageToken != "" {
+		var cursor transport.FollowCursor
+		err := decodePageToken(input.PageToken, &cursor)
+		if err != nil || cursor.List != list || cursor.UserID != input.UserID || cursor.ID <= 0 {
+			return nil, "", status.Error(codes.InvalidArgument, errs.ErrInvalidPageToken.Error())
+		}
+		after = &cursor
+	}
+
+	entries, err := fetch(ctx, transport.FollowListQuery{
+		UserID: input.UserID,
+		Limit:  pageSize + 1,
+		After:  after,
+	})
+	if err != nil {
+		slog.Error("failed to list follows", "list", list, "user", input.UserID, "err", err)
+		return nil, "", status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	var nextPageToken string
+	if len(entries) > pageSize {
+		entries = entries[:pageSize]
+		last := entries[len(entries)-1]
+		nextPageToken = encodePageToken(transport.FollowCursor{
+			List:      list,
+			UserID:    input.UserID,
+			CreatedAt: last.CreatedAt,
+			ID:        last.Profile.ID,
+		})
+	}
+	return entries, nextPageToken, nil
+}
+
+// relationship loads and maps the relationship of userID with otherUserID.
+func (s *SocialGraphService) relationship(ctx context.Context, userID, otherUserID int64) (*socialpb.Relationship, error) {
+	rel, err := s.followRepo.GetRelationship(ctx, userID, otherUserID)
+	if err != nil {
+		slog.Error("failed to get relationship", "err", err)
+		return nil, utils.GrpcUserNotFoundError(err)
+	}
+	return s.converter.ToRelationshipResponse(rel), nil
+}
+
+// followRequestError maps follow request repository errors to gRPC errors.
+func followRequestError(err error) error {
+	switch {
+	case errors.Is(err, errs.ErrFollowRequestNotFound):
+		return status.Error(codes.NotFound, errs.ErrFollowRequestNotFound.Error())
+	default:
+		return utils.GrpcUserNotFoundError(err)
+	}
+}
+
+// withoutRequest reports a pending request as no connection.
+func withoutRequest(state transport.FollowState) transport.FollowState {
+	if state == transport.FollowStateRequested {
+		return transport.FollowStateNone
+	}
+	return state
+}
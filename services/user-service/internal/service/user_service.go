@@ -1,20 +1,27 @@
-// Package service implements the business logic for public user profile retrieval.
-// It follows SOLID principles by focusing on a single responsibility (fetching
-// public user data) and relying on abstractions (repositories and mappers)
-// to invert dependencies.
+// Package service implements the business logic for public user profile retrieval,
+// search and profile privacy settings. It follows SOLID principles by focusing on
+// a single responsibility (public user data) and relying on abstractions
+// (repositories and mappers) to invert dependencies.
 package service
 
 import (
 	"context"
 	"log/slog"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	userpb "github.com/mamataliev-dev/social-platform/api/gen/user/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/mapper"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
 )
 
-// UserService handles public, read-only access to user profiles by nickname.
+// UserService handles public access to user profiles by nickname, user search
+// and the caller's profile privacy settings.
 // It depends on the UserRepository and Converter abstractions to keep its
 // business logic decoupled from data storage and transport details.
 type UserService struct {
@@ -35,8 +42,8 @@ func NewUserService(repo model.UserRepository, converter mapper.Converter) *User
 
 // FetchUserProfileByNickname retrieves a public user profile by its unique nickname.
 // It orchestrates data retrieval through the repository and maps the result to a
-// gRPC response. Returns NotFound if the user does not exist or Internal on other
-// failures.
+// gRPC response that honours the owner's privacy settings. Returns NotFound if
+// the user does not exist or Internal on other failures.
 func (s *UserService) FetchUserProfileByNickname(ctx context.Context, req *userpb.FetchUserProfileByNicknameRequest) (*userpb.UserProfile, error) {
 	mUser := s.converter.ToFetchUserByNicknameRequest(req)
 
@@ -47,6 +54,105 @@ func (s *UserService) FetchUserProfileByNickname(ctx context.Context, req *userp
 	}
 
 	slog.Info("fetched user by nickname", "username", user.Username, "nickname", user.Nickname)
-	resp := s.converter.ToFetchUserProfileResponse(user)
+	caller, _ := utils.AuthInfoFromContext(ctx)
+	resp := s.converter.ToPublicUserProfileResponse(user, caller.UserID)
 	return resp, nil
 }
+
+// defaultSearchPageSize is used when SearchUsers is called without a page size.
+const defaultSearchPageSize = 20
+
+// SearchUsers finds users whose nickname or username starts with or resembles
+// the query, case-insensitively and best match first. Deleted and suspended
+// accounts are excluded. Results are paged with an opaque token that is only
+// valid for the query it was issued for; returns InvalidArgument for a
+// malformed or foreign token and Internal on repository failures.
+func (s *UserService) SearchUsers(ctx context.Context, req *userpb.SearchUsersRequest) (*userpb.SearchUsersResponse, error) {
+	input := s.converter.ToSearchUsersRequest(req)
+
+	query := strings.ToLower(strings.TrimSpace(input.Query))
+	pageSize := input.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultSearchPageSize
+	}
+
+	var after *transport.SearchCursor
+	if input.PageToken != "" {
+		cursor, err := decodeSearchCursor(input.PageToken)
+		if err != nil || cursor.Query != query {
+			return nil, status.Error(codes.InvalidArgument, errs.ErrInvalidPageToken.Error())
+		}
+		after = &cursor
+	}
+
+	hits, err := s.repo.SearchUsers(ctx, transport.UserSearchQuery{
+		Query: query,
+		Limit: pageSize + 1,
+		After: after,
+	})
+	if err != nil {
+		slog.Error("failed to search users", "err", err)
+		return nil, status.Error(codes.Internal, errs.ErrInternal.Error())
+	}
+
+	var nextPageToken string
+	if len(hits) > pageSize {
+		hits = hits[:pageSize]
+		last := hits[len(hits)-1]
+		nextPageToken = encodePageToken(transport.SearchCursor{
+			Query: query,
+			Score: last.Score,
+			ID:    last.Profile.ID,
+		})
+	}
+
+	caller, _ := utils.AuthInfoFromContext(ctx)
+	return s.converter.ToSearchUsersResponse(hits, nextPageToken, caller.UserID), nil
+}
+
+// GetPrivacySettings returns the caller's profile privacy settings. Returns
+// Unauthenticated without a caller, NotFound if the account no longer exists
+// or Internal on other failures.
+func (s *UserService) GetPrivacySettings(ctx context.Context, _ *userpb.GetPrivacySettingsRequest) (*userpb.PrivacySettings, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+
+	settings, err := s.repo.FetchPrivacySettings(ctx, caller.UserID)
+	if err != nil {
+		slog.Error("failed to fetch privacy settings", "err", err)
+		return nil, utils.GrpcUserNotFoundError(err)
+	}
+	return s.converter.ToPrivacySettingsResponse(settings), nil
+}
+
+// UpdatePrivacySettings changes the caller's profile privacy settings; fields
+// left unspecified keep their current value. Returns the resulting settings,
+// Unauthenticated without a caller, NotFound if the account no longer exists
+// or Internal on other failures.
+func (s *UserService) UpdatePrivacySettings(ctx context.Context, req *userpb.UpdatePrivacySettingsRequest) (*userpb.PrivacySettings, error) {
+	caller, ok := utils.AuthInfoFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errs.ErrMissingAuthToken.Error())
+	}
+	input := s.converter.ToUpdatePrivacySettingsRequest(req)
+
+	settings, err := s.repo.UpdatePrivacySettings(ctx, caller.UserID, input)
+	if err != nil {
+		slog.Error("failed to update privacy settings", "err", err)
+		return nil, utils.GrpcUserNotFoundError(err)
+	}
+
+	slog.Info("updated privacy settings", "user_id", caller.UserID)
+	return s.converter.ToPrivacySettingsResponse(settings), nil
+}
+
+// decodeSearchCursor parses a page token issued by SearchUsers.
+func decodeSearchCursor(token string) (transport.SearchCursor, error) {
+	var cursor transport.SearchCursor
+	if err := decodePageToken(token, &cursor); err != nil || cursor.ID <= 0 {
+		return cursor, errs.ErrInvalidPageToken
+	}
+	return cursor, nil
+}
@@ -0,0 +1,143 @@
+// Package mapper_test verifies that the Mapper honours profile privacy
+// settings when building public responses.
+package mapper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	userpb "github.com/mamataliev-dev/social-platform/api/gen/user/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/mapper"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/testdata"
+)
+
+// strangerID is a viewer other than the profile owner.
+const strangerID = 99
+
+// profileWithPrivacy returns the sample profile with a last login and the
+// given privacy settings.
+func profileWithPrivacy(settings transport.PrivacySettings) transport.UserProfileResponse {
+	u := testdata.UserProfileResponse()
+	u.LastLogin = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	u.Privacy = settings
+	return u
+}
+
+// allHidden hides every field the settings cover.
+var allHidden = transport.PrivacySettings{
+	Email:     transport.VisibilityOnlyMe,
+	LastLogin: transport.VisibilityOnlyMe,
+	Bio:       transport.VisibilityOnlyMe,
+}
+
+// TestToPublicUserProfileResponse_HidesPrivateFields ensures that fields set to
+// only_me are left empty for other users.
+func TestToPublicUserProfileResponse_HidesPrivateFields(t *testing.T) {
+	// Scenario: Another user views a profile that hides everything.
+	u := profileWithPrivacy(allHidden)
+
+	resp := mapper.NewMapper().ToPublicUserProfileResponse(u, strangerID)
+
+	assert.Empty(t, resp.GetEmail())
+	assert.Empty(t, resp.GetBio())
+	assert.Nil(t, resp.GetLastLogin())
+	assert.Equal(t, u.Nickname, resp.GetNickname())
+	assert.Equal(t, u.AvatarURL, resp.GetAvatarUrl())
+}
+
+// TestToPublicUserProfileResponse_ShowsPublicFields ensures that fields set to
+// everyone are shown to other users.
+func TestToPublicUserProfileResponse_ShowsPublicFields(t *testing.T) {
+	// Scenario: Another user views a profile that shows everything.
+	u := profileWithPrivacy(transport.PrivacySettings{
+		Email:     transport.VisibilityEveryone,
+		LastLogin: transport.VisibilityEveryone,
+		Bio:       transport.VisibilityEveryone,
+	})
+
+	resp := mapper.NewMapper().ToPublicUserProfileResponse(u, strangerID)
+
+	assert.Equal(t, u.Email, resp.GetEmail())
+	assert.Equal(t, u.Bio, resp.GetBio())
+	assert.Equal(t, u.LastLogin, resp.GetLastLogin().AsTime())
+}
+
+// TestToPublicUserProfileResponse_OwnerSeesEverything ensures that privacy
+// settings do not apply to the owner.
+func TestToPublicUserProfileResponse_OwnerSeesEverything(t *testing.T) {
+	// Scenario: The owner views their own profile that hides everything.
+	u := profileWithPrivacy(allHidden)
+
+	resp := mapper.NewMapper().ToPublicUserProfileResponse(u, u.ID)
+
+	assert.Equal(t, u.Email, resp.GetEmail())
+	assert.Equal(t, u.Bio, resp.GetBio())
+	assert.NotNil(t, resp.GetLastLogin())
+}
+
+// TestToPublicUserProfileResponse_UnknownSettingsHide ensures that a field
+// without a known visibility is treated as private.
+func TestToPublicUserProfileResponse_UnknownSettingsHide(t *testing.T) {
+	// Scenario: The profile carries no privacy settings.
+	u := profileWithPrivacy(transport.PrivacySettings{})
+
+	resp := mapper.NewMapper().ToPublicUserProfileResponse(u, strangerID)
+
+	assert.Empty(t, resp.GetEmail())
+	assert.Empty(t, resp.GetBio())
+	assert.Nil(t, resp.GetLastLogin())
+}
+
+// TestToSearchUsersResponse_HonoursPrivacy ensures that search results are
+// built as public profiles.
+func TestToSearchUsersResponse_HonoursPrivacy(t *testing.T) {
+	// Scenario: Another user's search matches a profile that hides its email.
+	hits := []transport.UserSearchHit{{Profile: profileWithPrivacy(allHidden), Score: 2}}
+
+	resp := mapper.NewMapper().ToSearchUsersResponse(hits, "next", strangerID)
+
+	require.Len(t, resp.GetUsers(), 1)
+	assert.Empty(t, resp.GetUsers()[0].GetEmail())
+	assert.Equal(t, "next", resp.GetNextPageToken())
+}
+
+// TestToFetchUserProfileResponse_IgnoresPrivacy ensures that the mapping used
+// for trusted internal callers returns every field.
+func TestToFetchUserProfileResponse_IgnoresPrivacy(t *testing.T) {
+	// Scenario: An internal caller fetches a profile that hides everything.
+	u := profileWithPrivacy(allHidden)
+
+	resp := mapper.NewMapper().ToFetchUserProfileResponse(u)
+
+	assert.Equal(t, u.Email, resp.GetEmail())
+	assert.Equal(t, u.Bio, resp.GetBio())
+	assert.NotNil(t, resp.GetLastLogin())
+}
+
+// TestPrivacySettingsConversion ensures that visibility values map between
+// gRPC and storage, with unspecified update fields left empty.
+func TestPrivacySettingsConversion(t *testing.T) {
+	m := mapper.NewMapper()
+
+	input := m.ToUpdatePrivacySettingsRequest(&userpb.UpdatePrivacySettingsRequest{
+		Email: userpb.ProfileFieldVisibility_PROFILE_FIELD_VISIBILITY_EVERYONE,
+		Bio:   userpb.ProfileFieldVisibility_PROFILE_FIELD_VISIBILITY_ONLY_ME,
+	})
+	assert.Equal(t, transport.UpdatePrivacySettingsRequest{
+		Email: transport.VisibilityEveryone,
+		Bio:   transport.VisibilityOnlyMe,
+	}, input)
+
+	resp := m.ToPrivacySettingsResponse(transport.PrivacySettings{
+		Email:     transport.VisibilityOnlyMe,
+		LastLogin: transport.VisibilityEveryone,
+		Bio:       transport.VisibilityOnlyMe,
+	})
+	assert.Equal(t, userpb.ProfileFieldVisibility_PROFILE_FIELD_VISIBILITY_ONLY_ME, resp.GetEmail())
+	assert.Equal(t, userpb.ProfileFieldVisibility_PROFILE_FIELD_VISIBILITY_EVERYONE, resp.GetLastLogin())
+	assert.Equal(t, userpb.ProfileFieldVisibility_PROFILE_FIELD_VISIBILITY_ONLY_ME, resp.GetBio())
+}
@@ -0,0 +1,82 @@
+// Package middleware_test verifies the behavior of the ClientInfoInterceptor.
+package middleware_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/middleware"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
+)
+
+// resolveClientInfo runs the interceptor for a request from peerIP carrying
+// x-forwarded-for and returns the client info seen by the handler.
+func resolveClientInfo(t *testing.T, trusted []string, peerIP, forwardedFor string) domain.ClientInfo {
+	t.Helper()
+
+	proxies, err := utils.ParseTrustedProxies(trusted)
+	require.NoError(t, err)
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP(peerIP), Port: 41000},
+	})
+	if forwardedFor != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", forwardedFor))
+	}
+
+	var info domain.ClientInfo
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		info = utils.ClientInfoFromContext(ctx)
+		return nil, nil
+	}
+
+	_, err = middleware.ClientInfoInterceptor(proxies)(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	return info
+}
+
+// TestClientInfoInterceptor_ResolvesIP ensures that x-forwarded-for is only
+// believed for hops appended by trusted proxies.
+func TestClientInfoInterceptor_ResolvesIP(t *testing.T) {
+	trusted := []string{"127.0.0.1", "10.0.0.0/8"}
+
+	tests := []struct {
+		name         string
+		peerIP       string
+		forwardedFor string
+		wantIP       string
+	}{
+		{"direct client without header", "198.51.100.4", "", "198.51.100.4"},
+		{"direct client spoofing header", "198.51.100.4", "203.0.113.9", "198.51.100.4"},
+		{"gateway without header", "127.0.0.1", "", "127.0.0.1"},
+		{"gateway appends client", "127.0.0.1", "198.51.100.4", "198.51.100.4"},
+		{"client prepends spoofed hop", "127.0.0.1", "203.0.113.9, 198.51.100.4", "198.51.100.4"},
+		{"chain of trusted proxies", "127.0.0.1", "203.0.113.9, 198.51.100.4, 10.0.0.7", "198.51.100.4"},
+		{"malformed hop", "127.0.0.1", "not-an-ip, 10.0.0.7", "10.0.0.7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := resolveClientInfo(t, trusted, tt.peerIP, tt.forwardedFor)
+			assert.Equal(t, tt.wantIP, info.IPAddress)
+		})
+	}
+}
+
+// TestParseTrustedProxies_Invalid ensures that malformed proxy entries are
+// reported instead of silently trusting nothing.
+func TestParseTrustedProxies_Invalid(t *testing.T) {
+	_, err := utils.ParseTrustedProxies([]string{"10.0.0.0/33"})
+	assert.Error(t, err)
+
+	_, err = utils.ParseTrustedProxies([]string{"gateway"})
+	assert.Error(t, err)
+}
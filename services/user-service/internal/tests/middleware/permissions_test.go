@@ -0,0 +1,82 @@
+package middleware_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	socialpb "github.com/mamataliev-dev/social-platform/api/gen/social/v1"
+	userpb "github.com/mamataliev-dev/social-platform/api/gen/user/v1"
+	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/security"
+)
+
+// callWithRoles invokes the auth interceptor for method with a token granting
+// roles and returns the resulting status code.
+func callWithRoles(t *testing.T, method string, roles ...string) codes.Code {
+	t.Helper()
+	keys := newTestKeySet(t)
+	pair, err := security.NewJWTGenerator(keys, time.Hour, testIssuer, testAudience).
+		CreateTokenPair(domain.CreateTokenPairInput{UserID: 123, SessionID: "session-1", Roles: roles})
+	require.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+pair.AccessToken))
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	_, err = newInterceptor(keys)(ctx, nil, info, handler)
+	return status.Code(err)
+}
+
+// TestUnaryAuthInterceptor_RolePermissions ensures that admin methods are
+// restricted to the roles listed in the permission map.
+func TestUnaryAuthInterceptor_RolePermissions(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		roles  []string
+		want   codes.Code
+	}{
+		{name: "user calls own method", method: "/user.auth.v1.AuthService/ListSessions", want: codes.OK},
+		{name: "user lists users", method: "/user.auth.v1.AdminService/ListUsers", want: codes.PermissionDenied},
+		{name: "moderator lists users", method: "/user.auth.v1.AdminService/ListUsers", roles: []string{auth.RoleModerator}, want: codes.OK},
+		{name: "moderator suspends", method: "/user.auth.v1.AdminService/SuspendUser", roles: []string{auth.RoleModerator}, want: codes.OK},
+		{name: "moderator forces logout", method: "/user.auth.v1.AdminService/ForceLogout", roles: []string{auth.RoleModerator}, want: codes.PermissionDenied},
+		{name: "admin forces logout", method: "/user.auth.v1.AdminService/ForceLogout", roles: []string{auth.RoleAdmin}, want: codes.OK},
+		{name: "unknown method", method: "/user.auth.v1.AdminService/DropDatabase", roles: []string{auth.RoleAdmin}, want: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, callWithRoles(t, tt.method, tt.roles...))
+		})
+	}
+}
+
+// TestUnaryAuthInterceptor_EveryMethodHasPolicy ensures that every RPC of the
+// public gRPC server is public or listed in the permission map, so that new
+// RPCs cannot ship while silently denied to everyone.
+func TestUnaryAuthInterceptor_EveryMethodHasPolicy(t *testing.T) {
+	for _, desc := range []grpc.ServiceDesc{
+		userauthpb.AuthService_ServiceDesc,
+		userauthpb.AdminService_ServiceDesc,
+		userpb.UserService_ServiceDesc,
+		socialpb.SocialGraphService_ServiceDesc,
+	} {
+		for _, m := range desc.Methods {
+			method := "/" + desc.ServiceName + "/" + m.MethodName
+			assert.Equal(t, codes.OK, callWithRoles(t, method, auth.RoleAdmin, auth.RoleModerator), method)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// Package middleware_test verifies the behavior of the ServiceTokenInterceptor.
+package middleware_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/middleware"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
+)
+
+const (
+	listUserEventsMethod = "/user.v1.InternalUserService/ListUserEvents"
+	testServiceSecret    = "0123456789abcdef0123456789abcdef"
+)
+
+// callWithServiceToken runs the interceptor with the given token in the
+// incoming metadata, or none if token is empty.
+func callWithServiceToken(verifier *auth.ServiceTokenVerifier, token string) error {
+	ctx := context.Background()
+	if token != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(auth.ServiceTokenHeader, token))
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	_, err := middleware.ServiceTokenInterceptor(verifier)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: listUserEventsMethod}, handler)
+	return err
+}
+
+// signServiceToken signs a token from chat-service for audience.
+func signServiceToken(t *testing.T, secret, audience string) string {
+	t.Helper()
+	signer, err := auth.NewServiceTokenSigner(secret, "chat-service")
+	require.NoError(t, err)
+	token, err := signer.Sign(audience)
+	require.NoError(t, err)
+	return token
+}
+
+// TestServiceTokenInterceptor verifies that every internal call requires a
+// token signed with the shared secret and addressed to user-service.
+func TestServiceTokenInterceptor(t *testing.T) {
+	verifier, err := auth.NewServiceTokenVerifier(testServiceSecret, "user-service", time.Second)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		verifier *auth.ServiceTokenVerifier
+		token    string
+		wantCode codes.Code
+	}{
+		{"valid token", verifier, signServiceToken(t, testServiceSecret, "user-service"), codes.OK},
+		{"wrong audience", verifier, signServiceToken(t, testServiceSecret, "chat-service"), codes.Unauthenticated},
+		{"wrong secret", verifier, signServiceToken(t, "fedcba9876543210fedcba9876543210", "user-service"), codes.Unauthenticated},
+		{"static token", verifier, testServiceSecret, codes.Unauthenticated},
+		{"missing token", verifier, "", codes.Unauthenticated},
+		{"no secret configured", nil, signServiceToken(t, testServiceSecret, "user-service"), codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := callWithServiceToken(tt.verifier, tt.token)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+// TestServiceTokenInterceptor_AttachesCallerService ensures that the service
+// that signed the token is available to the handler.
+func TestServiceTokenInterceptor_AttachesCallerService(t *testing.T) {
+	verifier, err := auth.NewServiceTokenVerifier(testServiceSecret, "user-service", time.Second)
+	require.NoError(t, err)
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(auth.ServiceTokenHeader, signServiceToken(t, testServiceSecret, "user-service")))
+
+	var caller string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		caller, _ = utils.CallerServiceFromContext(ctx)
+		return "ok", nil
+	}
+
+	_, err = middleware.ServiceTokenInterceptor(verifier)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: listUserEventsMethod}, handler)
+
+	require.NoError(t, err)
+	assert.Equal(t, "chat-service", caller)
+}
@@ -3,41 +3,74 @@ package middleware_test
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
 	"testing"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/middleware"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/security"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
 )
 
-const testSecret = "test-secret"
+const (
+	testKeyID    = "test-key"
+	testIssuer   = "user-service"
+	testAudience = "social-platform"
+)
 
-// generateJWT creates a signed JWT token for testing purposes.
-func generateJWT(secret string) (string, error) {
-	claims := jwt.MapClaims{
-		"sub": "123",
-		"exp": time.Now().Add(time.Hour * 1).Unix(),
-	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(secret))
+// newTestKeySet returns a key set with a single freshly generated Ed25519 key.
+func newTestKeySet(t *testing.T) *security.KeySet {
+	t.Helper()
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	keys, err := security.NewKeySet(testKeyID, security.SigningKey{
+		ID:      testKeyID,
+		Method:  jwt.SigningMethodEdDSA,
+		Private: priv,
+	})
+	require.NoError(t, err)
+	return keys
+}
+
+// newInterceptor builds the interceptor with a verifier trusting keys.
+func newInterceptor(keys *security.KeySet) grpc.UnaryServerInterceptor {
+	return middleware.UnaryAuthInterceptor(auth.NewVerifier(keys.Keyfunc, auth.Config{
+		Issuer:   testIssuer,
+		Audience: testAudience,
+	}))
+}
+
+// generateJWT issues an access token for user 123 the way AuthService does.
+func generateJWT(t *testing.T, keys *security.KeySet, issuer string) string {
+	t.Helper()
+	pair, err := security.NewJWTGenerator(keys, time.Hour, issuer, testAudience).
+		CreateTokenPair(domain.CreateTokenPairInput{UserID: 123, SessionID: "session-1"})
+	require.NoError(t, err)
+	return pair.AccessToken
 }
 
 // TestUnaryAuthInterceptor_PublicMethodSkipsAuth ensures that public methods
 // (like Login and Register) bypass the authentication check.
 func TestUnaryAuthInterceptor_PublicMethodSkipsAuth(t *testing.T) {
 	// Scenario: A public method is called without a token.
-	info := &grpc.UnaryServerInfo{FullMethod: "/user_auth.AuthService/Login"}
+	info := &grpc.UnaryServerInfo{FullMethod: "/user.auth.v1.AuthService/Login"}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return "ok", nil
 	}
 
-	_, err := middleware.UnaryAuthInterceptor(context.Background(), nil, info, handler)
+	_, err := newInterceptor(newTestKeySet(t))(context.Background(), nil, info, handler)
 	assert.NoError(t, err)
 }
 
@@ -45,12 +78,12 @@ func TestUnaryAuthInterceptor_PublicMethodSkipsAuth(t *testing.T) {
 // method without any metadata fails with an Unauthenticated error.
 func TestUnaryAuthInterceptor_MissingMetadata(t *testing.T) {
 	// Scenario: A protected method is called without any metadata.
-	info := &grpc.UnaryServerInfo{FullMethod: "/user.UserService/GetUser"}
+	info := &grpc.UnaryServerInfo{FullMethod: "/user.v1.UserService/FetchUserProfileByNickname"}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return "should not be called", nil
 	}
 
-	_, err := middleware.UnaryAuthInterceptor(context.Background(), nil, info, handler)
+	_, err := newInterceptor(newTestKeySet(t))(context.Background(), nil, info, handler)
 	st, _ := status.FromError(err)
 	assert.Equal(t, codes.Unauthenticated, st.Code())
 }
@@ -61,12 +94,12 @@ func TestUnaryAuthInterceptor_InvalidToken(t *testing.T) {
 	// Scenario: A protected method is called with an invalid token.
 	md := metadata.Pairs("authorization", "Bearer invalid-token")
 	ctx := metadata.NewIncomingContext(context.Background(), md)
-	info := &grpc.UnaryServerInfo{FullMethod: "/user.UserService/GetUser"}
+	info := &grpc.UnaryServerInfo{FullMethod: "/user.v1.UserService/FetchUserProfileByNickname"}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return "should not be called", nil
 	}
 
-	_, err := middleware.UnaryAuthInterceptor(ctx, nil, info, handler)
+	_, err := newInterceptor(newTestKeySet(t))(ctx, nil, info, handler)
 	st, _ := status.FromError(err)
 	assert.Equal(t, codes.Unauthenticated, st.Code())
 }
@@ -75,14 +108,75 @@ func TestUnaryAuthInterceptor_InvalidToken(t *testing.T) {
 // token successfully passes through the interceptor.
 func TestUnaryAuthInterceptor_ValidToken(t *testing.T) {
 	// Scenario: A protected method is called with a valid token.
-	token, _ := generateJWT(testSecret)
-	md := metadata.Pairs("authorization", "Bearer "+token)
+	keys := newTestKeySet(t)
+	md := metadata.Pairs("authorization", "Bearer "+generateJWT(t, keys, testIssuer))
 	ctx := metadata.NewIncomingContext(context.Background(), md)
-	info := &grpc.UnaryServerInfo{FullMethod: "/user.UserService/GetUser"}
+	info := &grpc.UnaryServerInfo{FullMethod: "/user.v1.UserService/FetchUserProfileByNickname"}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		caller, ok := utils.AuthInfoFromContext(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, int64(123), caller.UserID)
+		assert.Equal(t, "session-1", caller.SessionID)
 		return "ok", nil
 	}
 
-	_, err := middleware.UnaryAuthInterceptor(ctx, nil, info, handler)
+	_, err := newInterceptor(keys)(ctx, nil, info, handler)
 	assert.NoError(t, err)
 }
+
+// TestUnaryAuthInterceptor_WrongIssuer ensures that a correctly signed token
+// from an unexpected issuer is rejected.
+func TestUnaryAuthInterceptor_WrongIssuer(t *testing.T) {
+	// Scenario: The token's "iss" does not match the configured issuer.
+	keys := newTestKeySet(t)
+	md := metadata.Pairs("authorization", "Bearer "+generateJWT(t, keys, "someone-else"))
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	info := &grpc.UnaryServerInfo{FullMethod: "/user.v1.UserService/FetchUserProfileByNickname"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "should not be called", nil
+	}
+
+	_, err := newInterceptor(keys)(ctx, nil, info, handler)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+}
+
+// TestUnaryAuthInterceptor_UnknownKeyID ensures that a token signed by a key
+// outside the key set is rejected.
+func TestUnaryAuthInterceptor_UnknownKeyID(t *testing.T) {
+	// Scenario: The token was signed by a different key set.
+	md := metadata.Pairs("authorization", "Bearer "+generateJWT(t, newTestKeySet(t), testIssuer))
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	info := &grpc.UnaryServerInfo{FullMethod: "/user.v1.UserService/FetchUserProfileByNickname"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "should not be called", nil
+	}
+
+	_, err := newInterceptor(newTestKeySet(t))(ctx, nil, info, handler)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+}
+
+// TestUnaryAuthInterceptor_RejectsHMAC ensures that symmetric tokens are
+// rejected, so a public key can never be used as an HMAC secret.
+func TestUnaryAuthInterceptor_RejectsHMAC(t *testing.T) {
+	// Scenario: A protected method is called with an HS256 token.
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub": "123",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	token.Header["kid"] = testKeyID
+	signed, err := token.SignedString([]byte("test-secret"))
+	require.NoError(t, err)
+
+	md := metadata.Pairs("authorization", "Bearer "+signed)
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	info := &grpc.UnaryServerInfo{FullMethod: "/user.v1.UserService/FetchUserProfileByNickname"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "should not be called", nil
+	}
+
+	_, err = newInterceptor(newTestKeySet(t))(ctx, nil, info, handler)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+}
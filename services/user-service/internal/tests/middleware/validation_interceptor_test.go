@@ -10,7 +10,6 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
-	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/middleware"
 )
 
@@ -57,7 +56,7 @@ func TestValidationInterceptor_InvalidRequest(t *testing.T) {
 // not implement the validator interface is handled correctly.
 func TestValidationInterceptor_NoValidator(t *testing.T) {
 	// Scenario: A request that does not have a Validate() method is processed normally.
-	req := &userauthpb.LoginRequest{} // This request does not have a Validate method.
+	req := &struct{ Name string }{} // This request does not have a Validate method.
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return "ok", nil
 	}
@@ -0,0 +1,184 @@
+// Package mocks provides mock implementations of repository and service interfaces
+// for unit testing the user-service.
+package mocks
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/config"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/oidc"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/security"
+)
+
+const (
+	fakeOIDCKeyID        = "fake-oidc-key"
+	fakeOIDCClientID     = "social-platform"
+	fakeOIDCClientSecret = "client-secret"
+	fakeOIDCRedirectURL  = "https://app.example.com/oauth/callback"
+)
+
+// fakeAuthorization is an authorization code issued by FakeOIDCProvider.
+type fakeAuthorization struct {
+	identity      oidc.Identity
+	nonce         string
+	codeChallenge string
+	redirectURI   string
+}
+
+// FakeOIDCProvider is an in-process OpenID Connect provider serving discovery,
+// JWKS and token endpoints. It checks client credentials, redirect URI and the
+// PKCE verifier exactly like a real provider and issues RS256 ID tokens.
+type FakeOIDCProvider struct {
+	Server *httptest.Server
+	keys   *security.KeySet
+
+	mu    sync.Mutex
+	codes map[string]fakeAuthorization
+}
+
+// NewFakeOIDCProvider starts a fake provider that is shut down with the test.
+func NewFakeOIDCProvider(t *testing.T) *FakeOIDCProvider {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate provider key: %v", err)
+	}
+	keys, err := security.NewKeySet(fakeOIDCKeyID, security.SigningKey{
+		ID:      fakeOIDCKeyID,
+		Method:  jwt.SigningMethodRS256,
+		Private: key,
+	})
+	if err != nil {
+		t.Fatalf("build provider key set: %v", err)
+	}
+
+	p := &FakeOIDCProvider{keys: keys, codes: make(map[string]fakeAuthorization)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", p.handleDiscovery)
+	mux.Handle("/jwks", security.JWKSHandler(keys))
+	mux.HandleFunc("/token", p.handleToken)
+	p.Server = httptest.NewServer(mux)
+	t.Cleanup(p.Server.Close)
+
+	return p
+}
+
+// Config returns provider settings for a client registered with the fake.
+func (p *FakeOIDCProvider) Config(name string) config.OIDCProvider {
+	return config.OIDCProvider{
+		Name:         name,
+		Issuer:       p.Server.URL,
+		ClientID:     fakeOIDCClientID,
+		ClientSecret: fakeOIDCClientSecret,
+		RedirectURL:  fakeOIDCRedirectURL,
+	}
+}
+
+// Authorize simulates the user approving the request behind authURL as
+// identity and returns the authorization code the provider would redirect with.
+func (p *FakeOIDCProvider) Authorize(t *testing.T, authURL string, identity oidc.Identity) string {
+	t.Helper()
+
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatalf("parse authorization URL: %v", err)
+	}
+	q := u.Query()
+	if q.Get("client_id") != fakeOIDCClientID || q.Get("response_type") != "code" || q.Get("code_challenge_method") != "S256" {
+		t.Fatalf("unexpected authorization request: %s", authURL)
+	}
+
+	code, err := security.GenerateOpaqueToken()
+	if err != nil {
+		t.Fatalf("generate authorization code: %v", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.codes[code] = fakeAuthorization{
+		identity:      identity,
+		nonce:         q.Get("nonce"),
+		codeChallenge: q.Get("code_challenge"),
+		redirectURI:   q.Get("redirect_uri"),
+	}
+	return code
+}
+
+func (p *FakeOIDCProvider) handleDiscovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{
+		"issuer":                 p.Server.URL,
+		"authorization_endpoint": p.Server.URL + "/authorize",
+		"token_endpoint":         p.Server.URL + "/token",
+		"jwks_uri":               p.Server.URL + "/jwks",
+	})
+}
+
+func (p *FakeOIDCProvider) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+	if r.PostForm.Get("client_id") != fakeOIDCClientID || r.PostForm.Get("client_secret") != fakeOIDCClientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	p.mu.Lock()
+	auth, ok := p.codes[r.PostForm.Get("code")]
+	delete(p.codes, r.PostForm.Get("code"))
+	p.mu.Unlock()
+
+	if !ok ||
+		r.PostForm.Get("grant_type") != "authorization_code" ||
+		r.PostForm.Get("redirect_uri") != auth.redirectURI ||
+		oidc.CodeChallengeS256(r.PostForm.Get("code_verifier")) != auth.codeChallenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":                p.Server.URL,
+		"aud":                fakeOIDCClientID,
+		"sub":                auth.identity.Subject,
+		"iat":                now.Unix(),
+		"exp":                now.Add(5 * time.Minute).Unix(),
+		"nonce":              auth.nonce,
+		"email":              auth.identity.Email,
+		"email_verified":     auth.identity.EmailVerified,
+		"name":               auth.identity.Name,
+		"preferred_username": auth.identity.PreferredUsername,
+		"picture":            auth.identity.Picture,
+	})
+	token.Header["kid"] = fakeOIDCKeyID
+
+	idToken, err := token.SignedString(p.keys.SigningKey().Private)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{
+		"access_token": "provider-access-token",
+		"token_type":   "Bearer",
+		"id_token":     idToken,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
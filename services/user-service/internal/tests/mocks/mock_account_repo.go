@@ -0,0 +1,57 @@
+// Package mocks provides mock implementations of repository and service interfaces
+// for unit testing the user-service.
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+)
+
+// AccountRepoMock is a mock implementation of the AccountRepository interface.
+// It allows tests to simulate deleting and purging accounts without a real
+// database connection.
+type AccountRepoMock struct {
+	mock.Mock
+}
+
+// SoftDeleteUser simulates deactivating an account and recording its deletion event.
+func (m *AccountRepoMock) SoftDeleteUser(ctx context.Context, userID int64) (time.Time, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+// PurgeDeletedUsers simulates removing accounts deleted before cutoff.
+func (m *AccountRepoMock) PurgeDeletedUsers(ctx context.Context, cutoff time.Time) (int64, error) {
+	args := m.Called(ctx, cutoff)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// UserEventRepoMock is a mock implementation of the UserEventRepository
+// interface. It allows tests to simulate reading and pruning the account
+// event log.
+type UserEventRepoMock struct {
+	mock.Mock
+}
+
+// ListUserEvents simulates reading events after a cursor.
+func (m *UserEventRepoMock) ListUserEvents(ctx context.Context, afterID int64, limit int) ([]model.UserEvent, error) {
+	args := m.Called(ctx, afterID, limit)
+	events, _ := args.Get(0).([]model.UserEvent)
+	return events, args.Error(1)
+}
+
+// SaveUserEventCursor simulates recording a consumer's position.
+func (m *UserEventRepoMock) SaveUserEventCursor(ctx context.Context, consumer string, afterID int64) error {
+	args := m.Called(ctx, consumer, afterID)
+	return args.Error(0)
+}
+
+// PruneUserEvents simulates removing events that occurred before cutoff.
+func (m *UserEventRepoMock) PruneUserEvents(ctx context.Context, cutoff time.Time) (int64, error) {
+	args := m.Called(ctx, cutoff)
+	return args.Get(0).(int64), args.Error(1)
+}
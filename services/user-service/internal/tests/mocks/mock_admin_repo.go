@@ -0,0 +1,42 @@
+// Package mocks provides mock implementations of repository and service interfaces
+// for unit testing the user-service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+)
+
+// AdminRepoMock is a mock implementation of the AdminRepository interface. It
+// allows tests to simulate listing and suspending accounts without a real
+// database connection.
+type AdminRepoMock struct {
+	mock.Mock
+}
+
+// ListUsers simulates reading a page of accounts after a cursor.
+func (m *AdminRepoMock) ListUsers(ctx context.Context, afterID int64, limit int) ([]model.AdminUser, error) {
+	args := m.Called(ctx, afterID, limit)
+	return args.Get(0).([]model.AdminUser), args.Error(1)
+}
+
+// GetUser simulates loading a single account.
+func (m *AdminRepoMock) GetUser(ctx context.Context, userID int64) (model.AdminUser, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(model.AdminUser), args.Error(1)
+}
+
+// SuspendUser simulates suspending an account and revoking its sessions.
+func (m *AdminRepoMock) SuspendUser(ctx context.Context, userID int64, reason string) (model.AdminUser, error) {
+	args := m.Called(ctx, userID, reason)
+	return args.Get(0).(model.AdminUser), args.Error(1)
+}
+
+// UnsuspendUser simulates lifting a suspension.
+func (m *AdminRepoMock) UnsuspendUser(ctx context.Context, userID int64) (model.AdminUser, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(model.AdminUser), args.Error(1)
+}
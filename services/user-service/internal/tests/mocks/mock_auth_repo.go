@@ -37,3 +37,22 @@ func (m *AuthRepoMock) FetchUserByEmail(
 	args := m.Called(ctx, email)
 	return args.Get(0).(model.User), args.Error(1)
 }
+
+// FetchUserCredentials simulates retrieving a user's credentials by ID.
+func (m *AuthRepoMock) FetchUserCredentials(
+	ctx context.Context,
+	userID int64,
+) (model.User, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(model.User), args.Error(1)
+}
+
+// UpdatePasswordHash simulates replacing a user's stored password hash.
+func (m *AuthRepoMock) UpdatePasswordHash(
+	ctx context.Context,
+	userID int64,
+	oldHash, newHash string,
+) error {
+	args := m.Called(ctx, userID, oldHash, newHash)
+	return args.Error(0)
+}
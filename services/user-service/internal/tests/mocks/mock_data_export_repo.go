@@ -0,0 +1,74 @@
+// Package mocks provides mock implementations of repository and service interfaces
+// for unit testing the user-service.
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+)
+
+// DataExportRepoMock is a mock implementation of the DataExportRepository
+// interface. It allows tests to simulate queuing, building and downloading
+// data exports without a real database connection.
+type DataExportRepoMock struct {
+	mock.Mock
+}
+
+// CreateDataExport simulates queuing an export.
+func (m *DataExportRepoMock) CreateDataExport(ctx context.Context, userID int64) (model.DataExport, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(model.DataExport), args.Error(1)
+}
+
+// GetDataExport simulates looking up one of a user's exports.
+func (m *DataExportRepoMock) GetDataExport(ctx context.Context, id string, userID int64) (model.DataExport, error) {
+	args := m.Called(ctx, id, userID)
+	return args.Get(0).(model.DataExport), args.Error(1)
+}
+
+// ClaimDataExport simulates claiming the next export to build.
+func (m *DataExportRepoMock) ClaimDataExport(ctx context.Context, staleBefore time.Time) (model.DataExport, error) {
+	args := m.Called(ctx, staleBefore)
+	return args.Get(0).(model.DataExport), args.Error(1)
+}
+
+// CompleteDataExport simulates storing a finished archive.
+func (m *DataExportRepoMock) CompleteDataExport(ctx context.Context, id string, archive []byte, expiresAt time.Time) error {
+	args := m.Called(ctx, id, archive, expiresAt)
+	return args.Error(0)
+}
+
+// FailDataExport simulates marking an export failed.
+func (m *DataExportRepoMock) FailDataExport(ctx context.Context, id string, expiresAt time.Time) error {
+	args := m.Called(ctx, id, expiresAt)
+	return args.Error(0)
+}
+
+// FetchDataExportArchive simulates reading a ready archive.
+func (m *DataExportRepoMock) FetchDataExportArchive(ctx context.Context, id string, userID int64) ([]byte, error) {
+	args := m.Called(ctx, id, userID)
+	archive, _ := args.Get(0).([]byte)
+	return archive, args.Error(1)
+}
+
+// PruneDataExports simulates deleting expired exports.
+func (m *DataExportRepoMock) PruneDataExports(ctx context.Context, cutoff time.Time) (int64, error) {
+	args := m.Called(ctx, cutoff)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// ChatDataSourceMock is a mock implementation of the ChatDataSource interface.
+// It allows tests to simulate chat-service returning a user's chat data.
+type ChatDataSourceMock struct {
+	mock.Mock
+}
+
+// ExportUserChatData simulates fetching a user's rooms and messages.
+func (m *ChatDataSourceMock) ExportUserChatData(ctx context.Context, userID int64) (model.ChatData, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(model.ChatData), args.Error(1)
+}
@@ -0,0 +1,83 @@
+// Package mocks provides mock implementations of repository and service interfaces
+// for unit testing the user-service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+)
+
+// FollowRepoMock is a mock implementation of the FollowRepository interface.
+// It allows tests to simulate the follow graph without a real database
+// connection.
+type FollowRepoMock struct {
+	mock.Mock
+}
+
+// Follow simulates following a user or filing a follow request.
+func (m *FollowRepoMock) Follow(ctx context.Context, followerID, followeeID int64) error {
+	args := m.Called(ctx, followerID, followeeID)
+	return args.Error(0)
+}
+
+// Unfollow simulates removing a follow or a pending follow request.
+func (m *FollowRepoMock) Unfollow(ctx context.Context, followerID, followeeID int64) error {
+	args := m.Called(ctx, followerID, followeeID)
+	return args.Error(0)
+}
+
+// GetRelationship simulates reading how two users are connected.
+func (m *FollowRepoMock) GetRelationship(ctx context.Context, userID, otherUserID int64) (transport.Relationship, error) {
+	args := m.Called(ctx, userID, otherUserID)
+	return args.Get(0).(transport.Relationship), args.Error(1)
+}
+
+// ListFollowers simulates listing one page of followers.
+func (m *FollowRepoMock) ListFollowers(ctx context.Context, query transport.FollowListQuery) ([]transport.FollowEntry, error) {
+	args := m.Called(ctx, query)
+	entries, _ := args.Get(0).([]transport.FollowEntry)
+	return entries, args.Error(1)
+}
+
+// ListFollowing simulates listing one page of followed users.
+func (m *FollowRepoMock) ListFollowing(ctx context.Context, query transport.FollowListQuery) ([]transport.FollowEntry, error) {
+	args := m.Called(ctx, query)
+	entries, _ := args.Get(0).([]transport.FollowEntry)
+	return entries, args.Error(1)
+}
+
+// ListFollowerIDs simulates listing one page of follower IDs.
+func (m *FollowRepoMock) ListFollowerIDs(ctx context.Context, userID, afterID int64, limit int) ([]int64, error) {
+	args := m.Called(ctx, userID, afterID, limit)
+	ids, _ := args.Get(0).([]int64)
+	return ids, args.Error(1)
+}
+
+// ListFollowingIDs simulates listing one page of followed user IDs.
+func (m *FollowRepoMock) ListFollowingIDs(ctx context.Context, userID, afterID int64, limit int) ([]int64, error) {
+	args := m.Called(ctx, userID, afterID, limit)
+	ids, _ := args.Get(0).([]int64)
+	return ids, args.Error(1)
+}
+
+// ListFollowRequests simulates listing one page of pending follow requests.
+func (m *FollowRepoMock) ListFollowRequests(ctx context.Context, query transport.FollowListQuery) ([]transport.FollowEntry, error) {
+	args := m.Called(ctx, query)
+	entries, _ := args.Get(0).([]transport.FollowEntry)
+	return entries, args.Error(1)
+}
+
+// ApproveFollowRequest simulates accepting a pending follow request.
+func (m *FollowRepoMock) ApproveFollowRequest(ctx context.Context, targetID, requesterID int64) error {
+	args := m.Called(ctx, targetID, requesterID)
+	return args.Error(0)
+}
+
+// DenyFollowRequest simulates rejecting a pending follow request.
+func (m *FollowRepoMock) DenyFollowRequest(ctx context.Context, targetID, requesterID int64) error {
+	args := m.Called(ctx, targetID, requesterID)
+	return args.Error(0)
+}
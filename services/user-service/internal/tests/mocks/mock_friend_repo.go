@@ -0,0 +1,69 @@
+// Package mocks provides mock implementations of repository and service interfaces
+// for unit testing the user-service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+)
+
+// FriendRepoMock is a mock implementation of the FriendRepository interface.
+// It allows tests to simulate friendships without a real database connection.
+type FriendRepoMock struct {
+	mock.Mock
+}
+
+// SendFriendRequest simulates sending or accepting a friend request.
+func (m *FriendRepoMock) SendFriendRequest(ctx context.Context, requesterID, addresseeID int64) error {
+	args := m.Called(ctx, requesterID, addresseeID)
+	return args.Error(0)
+}
+
+// RespondToFriendRequest simulates accepting or declining a friend request.
+func (m *FriendRepoMock) RespondToFriendRequest(ctx context.Context, addresseeID, requesterID int64, accept bool) error {
+	args := m.Called(ctx, addresseeID, requesterID, accept)
+	return args.Error(0)
+}
+
+// RemoveFriend simulates ending a friendship or withdrawing a request.
+func (m *FriendRepoMock) RemoveFriend(ctx context.Context, userID, friendID int64) error {
+	args := m.Called(ctx, userID, friendID)
+	return args.Error(0)
+}
+
+// GetFriendship simulates reading how two users are connected as friends.
+func (m *FriendRepoMock) GetFriendship(ctx context.Context, userID, otherUserID int64) (transport.Friendship, error) {
+	args := m.Called(ctx, userID, otherUserID)
+	return args.Get(0).(transport.Friendship), args.Error(1)
+}
+
+// ListFriends simulates listing one page of friends.
+func (m *FriendRepoMock) ListFriends(ctx context.Context, query transport.FriendListQuery) ([]transport.FriendEntry, error) {
+	args := m.Called(ctx, query)
+	entries, _ := args.Get(0).([]transport.FriendEntry)
+	return entries, args.Error(1)
+}
+
+// ListMutualFriends simulates listing one page of mutual friends.
+func (m *FriendRepoMock) ListMutualFriends(ctx context.Context, query transport.FriendListQuery) ([]transport.FriendEntry, error) {
+	args := m.Called(ctx, query)
+	entries, _ := args.Get(0).([]transport.FriendEntry)
+	return entries, args.Error(1)
+}
+
+// ListFriendRequests simulates listing one page of pending friend requests.
+func (m *FriendRepoMock) ListFriendRequests(ctx context.Context, query transport.FriendListQuery) ([]transport.FriendEntry, error) {
+	args := m.Called(ctx, query)
+	entries, _ := args.Get(0).([]transport.FriendEntry)
+	return entries, args.Error(1)
+}
+
+// ListFriendSuggestions simulates ranking friends of friends.
+func (m *FriendRepoMock) ListFriendSuggestions(ctx context.Context, userID int64, limit int) ([]transport.FriendSuggestion, error) {
+	args := m.Called(ctx, userID, limit)
+	suggestions, _ := args.Get(0).([]transport.FriendSuggestion)
+	return suggestions, args.Error(1)
+}
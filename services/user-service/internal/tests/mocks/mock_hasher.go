@@ -26,3 +26,9 @@ func (m *MockHasher) VerifyPassword(hash, password string) error {
 	args := m.Called(hash, password)
 	return args.Error(0)
 }
+
+// NeedsRehash simulates checking whether a stored hash is outdated.
+func (m *MockHasher) NeedsRehash(hash string) bool {
+	args := m.Called(hash)
+	return args.Bool(0)
+}
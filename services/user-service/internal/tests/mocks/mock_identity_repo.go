@@ -0,0 +1,49 @@
+// Package mocks provides mock implementations of repository and service interfaces
+// for unit testing the user-service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+)
+
+// IdentityRepoMock is a mock implementation of the IdentityRepository
+// interface. It allows tests to simulate pending OAuth logins and linked
+// external identities without a real database connection.
+type IdentityRepoMock struct {
+	mock.Mock
+}
+
+// SaveOAuthState simulates storing a pending social login.
+func (m *IdentityRepoMock) SaveOAuthState(ctx context.Context, input domain.SaveOAuthStateInput) error {
+	args := m.Called(ctx, input)
+	return args.Error(0)
+}
+
+// ConsumeOAuthState simulates redeeming a pending social login.
+func (m *IdentityRepoMock) ConsumeOAuthState(ctx context.Context, stateHash string) (model.OAuthState, error) {
+	args := m.Called(ctx, stateHash)
+	return args.Get(0).(model.OAuthState), args.Error(1)
+}
+
+// FindUserByIdentity simulates looking up the user linked to an external identity.
+func (m *IdentityRepoMock) FindUserByIdentity(ctx context.Context, provider, subject string) (model.User, error) {
+	args := m.Called(ctx, provider, subject)
+	return args.Get(0).(model.User), args.Error(1)
+}
+
+// LinkIdentity simulates linking an external identity to an existing user.
+func (m *IdentityRepoMock) LinkIdentity(ctx context.Context, identity model.ExternalIdentity) error {
+	args := m.Called(ctx, identity)
+	return args.Error(0)
+}
+
+// CreateUserWithIdentity simulates creating a passwordless user with a linked identity.
+func (m *IdentityRepoMock) CreateUserWithIdentity(ctx context.Context, user model.User, identity model.ExternalIdentity) (model.User, error) {
+	args := m.Called(ctx, user, identity)
+	return args.Get(0).(model.User), args.Error(1)
+}
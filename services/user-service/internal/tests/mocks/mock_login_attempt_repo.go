@@ -0,0 +1,43 @@
+// Package mocks provides mock implementations of repository and service interfaces
+// for unit testing the user-service.
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+)
+
+// LoginAttemptRepoMock is a mock implementation of the LoginAttemptRepository
+// interface. It allows tests to simulate failed login counters and lockouts
+// without a real database connection.
+type LoginAttemptRepoMock struct {
+	mock.Mock
+}
+
+// LoginLockedUntil simulates looking up the active lockout for keys.
+func (m *LoginAttemptRepoMock) LoginLockedUntil(ctx context.Context, keys []model.LoginAttemptKey) (time.Time, error) {
+	args := m.Called(ctx, keys)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+// RecordLoginFailure simulates counting a failed login.
+func (m *LoginAttemptRepoMock) RecordLoginFailure(ctx context.Context, key model.LoginAttemptKey, window time.Duration) (int, error) {
+	args := m.Called(ctx, key, window)
+	return args.Int(0), args.Error(1)
+}
+
+// LockLogin simulates locking a key.
+func (m *LoginAttemptRepoMock) LockLogin(ctx context.Context, key model.LoginAttemptKey, until time.Time) error {
+	args := m.Called(ctx, key, until)
+	return args.Error(0)
+}
+
+// ResetLoginFailures simulates clearing a key's failures.
+func (m *LoginAttemptRepoMock) ResetLoginFailures(ctx context.Context, key model.LoginAttemptKey) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
@@ -0,0 +1,23 @@
+// Package mocks provides mock implementations of repository and service interfaces
+// for unit testing the user-service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/mailer"
+)
+
+// MockMailer is a mock implementation of the Mailer interface.
+// It lets tests assert on outgoing messages without an SMTP server.
+type MockMailer struct {
+	mock.Mock
+}
+
+// Send simulates delivering an email message.
+func (m *MockMailer) Send(ctx context.Context, msg mailer.Message) error {
+	args := m.Called(ctx, msg)
+	return args.Error(0)
+}
@@ -5,6 +5,7 @@ package mocks
 import (
 	"github.com/stretchr/testify/mock"
 
+	socialpb "github.com/mamataliev-dev/social-platform/api/gen/social/v1"
 	userpb "github.com/mamataliev-dev/social-platform/api/gen/user/v1"
 	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
@@ -54,6 +55,40 @@ func (m *MockMapper) ToFetchUserProfileResponse(u transport.UserProfileResponse)
 	return args.Get(0).(*userpb.UserProfile)
 }
 
+// ToSearchUsersRequest simulates mapping a gRPC request to a transport DTO.
+func (m *MockMapper) ToSearchUsersRequest(req *userpb.SearchUsersRequest) transport.SearchUsersRequest {
+	args := m.Called(req)
+	return args.Get(0).(transport.SearchUsersRequest)
+}
+
+// ToSearchUsersResponse simulates mapping search hits to a gRPC response.
+func (m *MockMapper) ToSearchUsersResponse(
+	hits []transport.UserSearchHit,
+	nextPageToken string,
+	viewerID int64,
+) *userpb.SearchUsersResponse {
+	args := m.Called(hits, nextPageToken, viewerID)
+	return args.Get(0).(*userpb.SearchUsersResponse)
+}
+
+// ToPublicUserProfileResponse simulates mapping a profile as seen by viewerID.
+func (m *MockMapper) ToPublicUserProfileResponse(u transport.UserProfileResponse, viewerID int64) *userpb.UserProfile {
+	args := m.Called(u, viewerID)
+	return args.Get(0).(*userpb.UserProfile)
+}
+
+// ToUpdatePrivacySettingsRequest simulates mapping a gRPC request to a transport DTO.
+func (m *MockMapper) ToUpdatePrivacySettingsRequest(req *userpb.UpdatePrivacySettingsRequest) transport.UpdatePrivacySettingsRequest {
+	args := m.Called(req)
+	return args.Get(0).(transport.UpdatePrivacySettingsRequest)
+}
+
+// ToPrivacySettingsResponse simulates mapping privacy settings to a gRPC response.
+func (m *MockMapper) ToPrivacySettingsResponse(settings transport.PrivacySettings) *userpb.PrivacySettings {
+	args := m.Called(settings)
+	return args.Get(0).(*userpb.PrivacySettings)
+}
+
 // ToGetRefreshTokenRequest simulates mapping a gRPC request to a transport DTO.
 func (m *MockMapper) ToGetRefreshTokenRequest(req *userauthpb.RefreshTokenPayload) transport.RefreshTokenRequest {
 	args := m.Called(req)
@@ -71,3 +106,259 @@ func (m *MockMapper) ToRefreshTokenRequest(req *userauthpb.RefreshTokenPayload)
 	args := m.Called(req)
 	return args.Get(0).(transport.RefreshTokenRequest)
 }
+
+// ToVerifyEmailRequest simulates mapping a gRPC request to a transport DTO.
+func (m *MockMapper) ToVerifyEmailRequest(req *userauthpb.VerifyEmailRequest) transport.VerifyEmailRequest {
+	args := m.Called(req)
+	return args.Get(0).(transport.VerifyEmailRequest)
+}
+
+// ToVerifyEmailResponse simulates mapping a transport DTO to a gRPC response.
+func (m *MockMapper) ToVerifyEmailResponse(resp transport.VerifyEmailResponse) *userauthpb.VerifyEmailResponse {
+	args := m.Called(resp)
+	return args.Get(0).(*userauthpb.VerifyEmailResponse)
+}
+
+// ToResendVerificationEmailRequest simulates mapping a gRPC request to a transport DTO.
+func (m *MockMapper) ToResendVerificationEmailRequest(req *userauthpb.ResendVerificationEmailRequest) transport.ResendVerificationEmailRequest {
+	args := m.Called(req)
+	return args.Get(0).(transport.ResendVerificationEmailRequest)
+}
+
+// ToResendVerificationEmailResponse simulates mapping a transport DTO to a gRPC response.
+func (m *MockMapper) ToResendVerificationEmailResponse(resp transport.ResendVerificationEmailResponse) *userauthpb.ResendVerificationEmailResponse {
+	args := m.Called(resp)
+	return args.Get(0).(*userauthpb.ResendVerificationEmailResponse)
+}
+
+// ToListSessionsResponse simulates mapping domain sessions to a gRPC response.
+func (m *MockMapper) ToListSessionsResponse(sessions []model.Session, currentSessionID string) *userauthpb.ListSessionsResponse {
+	args := m.Called(sessions, currentSessionID)
+	return args.Get(0).(*userauthpb.ListSessionsResponse)
+}
+
+// ToRevokeSessionResponse simulates mapping a transport DTO to a gRPC response.
+func (m *MockMapper) ToRevokeSessionResponse(resp transport.RevokeSessionResponse) *userauthpb.RevokeSessionResponse {
+	args := m.Called(resp)
+	return args.Get(0).(*userauthpb.RevokeSessionResponse)
+}
+
+// ToRevokeAllOtherSessionsResponse simulates mapping a transport DTO to a gRPC response.
+func (m *MockMapper) ToRevokeAllOtherSessionsResponse(resp transport.RevokeAllOtherSessionsResponse) *userauthpb.RevokeAllOtherSessionsResponse {
+	args := m.Called(resp)
+	return args.Get(0).(*userauthpb.RevokeAllOtherSessionsResponse)
+}
+
+// ToMFAChallengeResponse simulates mapping an MFA challenge token to a gRPC response.
+func (m *MockMapper) ToMFAChallengeResponse(mfaToken string) *userauthpb.AuthTokenResponse {
+	args := m.Called(mfaToken)
+	return args.Get(0).(*userauthpb.AuthTokenResponse)
+}
+
+// ToVerifyMFARequest simulates mapping a gRPC request to a transport DTO.
+func (m *MockMapper) ToVerifyMFARequest(req *userauthpb.VerifyMFARequest) transport.VerifyMFARequest {
+	args := m.Called(req)
+	return args.Get(0).(transport.VerifyMFARequest)
+}
+
+// ToEnrollTOTPResponse simulates mapping a transport DTO to a gRPC response.
+func (m *MockMapper) ToEnrollTOTPResponse(resp transport.EnrollTOTPResponse) *userauthpb.EnrollTOTPResponse {
+	args := m.Called(resp)
+	return args.Get(0).(*userauthpb.EnrollTOTPResponse)
+}
+
+// ToConfirmTOTPResponse simulates mapping a transport DTO to a gRPC response.
+func (m *MockMapper) ToConfirmTOTPResponse(resp transport.ConfirmTOTPResponse) *userauthpb.ConfirmTOTPResponse {
+	args := m.Called(resp)
+	return args.Get(0).(*userauthpb.ConfirmTOTPResponse)
+}
+
+// ToDisableTOTPResponse simulates mapping a transport DTO to a gRPC response.
+func (m *MockMapper) ToDisableTOTPResponse(resp transport.DisableTOTPResponse) *userauthpb.DisableTOTPResponse {
+	args := m.Called(resp)
+	return args.Get(0).(*userauthpb.DisableTOTPResponse)
+}
+
+// ToBeginOAuthLoginResponse simulates mapping a transport DTO to a gRPC response.
+func (m *MockMapper) ToBeginOAuthLoginResponse(resp transport.BeginOAuthLoginResponse) *userauthpb.BeginOAuthLoginResponse {
+	args := m.Called(resp)
+	return args.Get(0).(*userauthpb.BeginOAuthLoginResponse)
+}
+
+// ToCompleteOAuthLoginRequest simulates mapping a gRPC request to a transport DTO.
+func (m *MockMapper) ToCompleteOAuthLoginRequest(req *userauthpb.CompleteOAuthLoginRequest) transport.CompleteOAuthLoginRequest {
+	args := m.Called(req)
+	return args.Get(0).(transport.CompleteOAuthLoginRequest)
+}
+
+// ToChangePasswordRequest simulates mapping a gRPC request to a transport DTO.
+func (m *MockMapper) ToChangePasswordRequest(req *userauthpb.ChangePasswordRequest) transport.ChangePasswordRequest {
+	args := m.Called(req)
+	return args.Get(0).(transport.ChangePasswordRequest)
+}
+
+// ToChangePasswordResponse simulates mapping a transport DTO to a gRPC response.
+func (m *MockMapper) ToChangePasswordResponse(resp transport.ChangePasswordResponse) *userauthpb.ChangePasswordResponse {
+	args := m.Called(resp)
+	return args.Get(0).(*userauthpb.ChangePasswordResponse)
+}
+
+// ToRequestPasswordResetRequest simulates mapping a gRPC request to a transport DTO.
+func (m *MockMapper) ToRequestPasswordResetRequest(req *userauthpb.RequestPasswordResetRequest) transport.RequestPasswordResetRequest {
+	args := m.Called(req)
+	return args.Get(0).(transport.RequestPasswordResetRequest)
+}
+
+// ToRequestPasswordResetResponse simulates mapping a transport DTO to a gRPC response.
+func (m *MockMapper) ToRequestPasswordResetResponse(resp transport.RequestPasswordResetResponse) *userauthpb.RequestPasswordResetResponse {
+	args := m.Called(resp)
+	return args.Get(0).(*userauthpb.RequestPasswordResetResponse)
+}
+
+// ToResetPasswordRequest simulates mapping a gRPC request to a transport DTO.
+func (m *MockMapper) ToResetPasswordRequest(req *userauthpb.ResetPasswordRequest) transport.ResetPasswordRequest {
+	args := m.Called(req)
+	return args.Get(0).(transport.ResetPasswordRequest)
+}
+
+// ToResetPasswordResponse simulates mapping a transport DTO to a gRPC response.
+func (m *MockMapper) ToResetPasswordResponse(resp transport.ResetPasswordResponse) *userauthpb.ResetPasswordResponse {
+	args := m.Called(resp)
+	return args.Get(0).(*userauthpb.ResetPasswordResponse)
+}
+
+// ToDeleteMyAccountRequest simulates mapping a gRPC request to a transport DTO.
+func (m *MockMapper) ToDeleteMyAccountRequest(req *userauthpb.DeleteMyAccountRequest) transport.DeleteMyAccountRequest {
+	args := m.Called(req)
+	return args.Get(0).(transport.DeleteMyAccountRequest)
+}
+
+// ToDeleteMyAccountResponse simulates mapping a transport DTO to a gRPC response.
+func (m *MockMapper) ToDeleteMyAccountResponse(resp transport.DeleteMyAccountResponse) *userauthpb.DeleteMyAccountResponse {
+	args := m.Called(resp)
+	return args.Get(0).(*userauthpb.DeleteMyAccountResponse)
+}
+
+// ToBatchFetchUserProfilesResponse simulates mapping a batch lookup result to a gRPC response.
+func (m *MockMapper) ToBatchFetchUserProfilesResponse(
+	users []transport.UserProfileResponse,
+	missingUserIDs []int64,
+) *userpb.BatchFetchUserProfilesResponse {
+	args := m.Called(users, missingUserIDs)
+	return args.Get(0).(*userpb.BatchFetchUserProfilesResponse)
+}
+
+// ToListUserEventsResponse simulates mapping domain events to a gRPC response.
+func (m *MockMapper) ToListUserEventsResponse(events []model.UserEvent) *userpb.ListUserEventsResponse {
+	args := m.Called(events)
+	return args.Get(0).(*userpb.ListUserEventsResponse)
+}
+
+// ToGetDataExportRequest simulates mapping a gRPC request to a transport DTO.
+func (m *MockMapper) ToGetDataExportRequest(req *userauthpb.GetDataExportRequest) transport.GetDataExportRequest {
+	args := m.Called(req)
+	return args.Get(0).(transport.GetDataExportRequest)
+}
+
+// ToDataExportJobResponse simulates mapping a transport DTO to a gRPC response.
+func (m *MockMapper) ToDataExportJobResponse(job transport.DataExportJob) *userauthpb.DataExportJob {
+	args := m.Called(job)
+	return args.Get(0).(*userauthpb.DataExportJob)
+}
+
+// ToAdminUserResponse simulates mapping a domain AdminUser to a gRPC message.
+func (m *MockMapper) ToAdminUserResponse(u model.AdminUser) *userauthpb.AdminUser {
+	args := m.Called(u)
+	return args.Get(0).(*userauthpb.AdminUser)
+}
+
+// ToListUsersResponse simulates mapping domain AdminUsers to a gRPC response.
+func (m *MockMapper) ToListUsersResponse(users []model.AdminUser) *userauthpb.ListUsersResponse {
+	args := m.Called(users)
+	return args.Get(0).(*userauthpb.ListUsersResponse)
+}
+
+// ToForceLogoutResponse simulates mapping a revoked session count to a gRPC response.
+func (m *MockMapper) ToForceLogoutResponse(revokedSessions int64) *userauthpb.ForceLogoutResponse {
+	args := m.Called(revokedSessions)
+	return args.Get(0).(*userauthpb.ForceLogoutResponse)
+}
+
+// ToListFollowsRequest simulates mapping a gRPC request to a transport DTO.
+func (m *MockMapper) ToListFollowsRequest(req *socialpb.ListFollowsRequest) transport.ListFollowsRequest {
+	args := m.Called(req)
+	return args.Get(0).(transport.ListFollowsRequest)
+}
+
+// ToRelationshipResponse simulates mapping a relationship to a gRPC response.
+func (m *MockMapper) ToRelationshipResponse(rel transport.Relationship) *socialpb.Relationship {
+	args := m.Called(rel)
+	return args.Get(0).(*socialpb.Relationship)
+}
+
+// ToListFollowsResponse simulates mapping a page of follows to a gRPC response.
+func (m *MockMapper) ToListFollowsResponse(
+	entries []transport.FollowEntry,
+	nextPageToken string,
+	viewerID int64,
+) *socialpb.ListFollowsResponse {
+	args := m.Called(entries, nextPageToken, viewerID)
+	return args.Get(0).(*socialpb.ListFollowsResponse)
+}
+
+// ToListFollowRequestsResponse simulates mapping a page of follow requests to a gRPC response.
+func (m *MockMapper) ToListFollowRequestsResponse(
+	entries []transport.FollowEntry,
+	nextPageToken string,
+	viewerID int64,
+) *socialpb.ListFollowRequestsResponse {
+	args := m.Called(entries, nextPageToken, viewerID)
+	return args.Get(0).(*socialpb.ListFollowRequestsResponse)
+}
+
+// ToListFriendsRequest simulates mapping a gRPC request to a transport DTO.
+func (m *MockMapper) ToListFriendsRequest(req *socialpb.ListFriendsRequest) transport.ListFriendsRequest {
+	args := m.Called(req)
+	return args.Get(0).(transport.ListFriendsRequest)
+}
+
+// ToListMutualFriendsRequest simulates mapping a gRPC request to a transport DTO.
+func (m *MockMapper) ToListMutualFriendsRequest(req *socialpb.ListMutualFriendsRequest) transport.ListFriendsRequest {
+	args := m.Called(req)
+	return args.Get(0).(transport.ListFriendsRequest)
+}
+
+// ToFriendshipResponse simulates mapping a friendship to a gRPC response.
+func (m *MockMapper) ToFriendshipResponse(f transport.Friendship) *socialpb.Friendship {
+	args := m.Called(f)
+	return args.Get(0).(*socialpb.Friendship)
+}
+
+// ToListFriendsResponse simulates mapping a page of friends to a gRPC response.
+func (m *MockMapper) ToListFriendsResponse(
+	entries []transport.FriendEntry,
+	nextPageToken string,
+	viewerID int64,
+) *socialpb.ListFriendsResponse {
+	args := m.Called(entries, nextPageToken, viewerID)
+	return args.Get(0).(*socialpb.ListFriendsResponse)
+}
+
+// ToListFriendRequestsResponse simulates mapping a page of friend requests to a gRPC response.
+func (m *MockMapper) ToListFriendRequestsResponse(
+	entries []transport.FriendEntry,
+	nextPageToken string,
+	viewerID int64,
+) *socialpb.ListFriendRequestsResponse {
+	args := m.Called(entries, nextPageToken, viewerID)
+	return args.Get(0).(*socialpb.ListFriendRequestsResponse)
+}
+
+// ToListFriendSuggestionsResponse simulates mapping friend suggestions to a gRPC response.
+func (m *MockMapper) ToListFriendSuggestionsResponse(
+	suggestions []transport.FriendSuggestion,
+	viewerID int64,
+) *socialpb.ListFriendSuggestionsResponse {
+	args := m.Called(suggestions, viewerID)
+	return args.Get(0).(*socialpb.ListFriendSuggestionsResponse)
+}
@@ -0,0 +1,77 @@
+// Package mocks provides mock implementations of repository and service interfaces
+// for unit testing the user-service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+)
+
+// MFARepoMock is a mock implementation of the MFARepository interface. It
+// allows tests to simulate TOTP enrollment, recovery codes and MFA login
+// challenges without a real database connection.
+type MFARepoMock struct {
+	mock.Mock
+}
+
+// SaveTOTPSecret simulates storing a pending TOTP secret.
+func (m *MFARepoMock) SaveTOTPSecret(ctx context.Context, userID int64, secret string) error {
+	args := m.Called(ctx, userID, secret)
+	return args.Error(0)
+}
+
+// GetTOTP simulates loading a user's TOTP enrollment.
+func (m *MFARepoMock) GetTOTP(ctx context.Context, userID int64) (model.TOTPEnrollment, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(model.TOTPEnrollment), args.Error(1)
+}
+
+// EnableTOTP simulates confirming an enrollment and storing recovery code digests.
+func (m *MFARepoMock) EnableTOTP(ctx context.Context, userID int64, step int64, codeHashes []string) error {
+	args := m.Called(ctx, userID, step, codeHashes)
+	return args.Error(0)
+}
+
+// MarkTOTPStepUsed simulates recording a used TOTP time step.
+func (m *MFARepoMock) MarkTOTPStepUsed(ctx context.Context, userID int64, step int64) error {
+	args := m.Called(ctx, userID, step)
+	return args.Error(0)
+}
+
+// DeleteTOTP simulates disabling two-factor authentication.
+func (m *MFARepoMock) DeleteTOTP(ctx context.Context, userID int64) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// ConsumeRecoveryCode simulates redeeming a recovery code digest.
+func (m *MFARepoMock) ConsumeRecoveryCode(ctx context.Context, userID int64, codeHash string) error {
+	args := m.Called(ctx, userID, codeHash)
+	return args.Error(0)
+}
+
+// SaveMFAChallenge simulates persisting an MFA challenge digest.
+func (m *MFARepoMock) SaveMFAChallenge(ctx context.Context, in domain.SaveMFAChallengeInput) error {
+	args := m.Called(ctx, in)
+	return args.Error(0)
+}
+
+// ClaimMFAChallengeAttempt simulates counting an attempt against a challenge.
+func (m *MFARepoMock) ClaimMFAChallengeAttempt(
+	ctx context.Context,
+	tokenHash string,
+	maxAttempts int,
+) (model.MFAChallenge, error) {
+	args := m.Called(ctx, tokenHash, maxAttempts)
+	return args.Get(0).(model.MFAChallenge), args.Error(1)
+}
+
+// DeleteMFAChallenge simulates redeeming an MFA challenge.
+func (m *MFARepoMock) DeleteMFAChallenge(ctx context.Context, tokenHash string) error {
+	args := m.Called(ctx, tokenHash)
+	return args.Error(0)
+}
@@ -0,0 +1,37 @@
+// Package mocks provides mock implementations of repository and service interfaces
+// for unit testing the user-service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+)
+
+// PasswordResetRepoMock is a mock implementation of the PasswordResetRepository
+// interface. It allows tests to simulate issuing and redeeming password reset
+// tokens without a real database connection.
+type PasswordResetRepoMock struct {
+	mock.Mock
+}
+
+// SavePasswordResetToken simulates persisting a reset token digest.
+func (m *PasswordResetRepoMock) SavePasswordResetToken(ctx context.Context, in domain.SavePasswordResetTokenInput) error {
+	args := m.Called(ctx, in)
+	return args.Error(0)
+}
+
+// GetPasswordResetToken simulates looking up the owner of a reset token digest.
+func (m *PasswordResetRepoMock) GetPasswordResetToken(ctx context.Context, tokenHash string) (int64, error) {
+	args := m.Called(ctx, tokenHash)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// ConsumePasswordResetToken simulates redeeming a reset token digest.
+// It can be configured to return the owning user ID or an error.
+func (m *PasswordResetRepoMock) ConsumePasswordResetToken(ctx context.Context, tokenHash, passwordHash string) (int64, error) {
+	args := m.Called(ctx, tokenHash, passwordHash)
+	return args.Get(0).(int64), args.Error(1)
+}
@@ -8,7 +8,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
-	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
 )
 
 // TokenRepoMock is a mock implementation of the TokenRepository interface.
@@ -25,16 +25,47 @@ func (m *TokenRepoMock) SaveRefreshToken(ctx context.Context, in domain.SaveRefr
 	return args.Error(0)
 }
 
-// GetRefreshToken simulates retrieving a refresh token by its value.
-// It can be configured to return a user ID string or an error.
-func (m *TokenRepoMock) GetRefreshToken(ctx context.Context, in transport.RefreshTokenRequest) (string, error) {
-	args := m.Called(ctx, in)
-	return args.String(0), args.Error(1)
+// GetRefreshToken simulates retrieving a refresh token by its digest.
+// It can be configured to return the owning Session or an error.
+func (m *TokenRepoMock) GetRefreshToken(ctx context.Context, tokenHash string) (model.Session, error) {
+	args := m.Called(ctx, tokenHash)
+	return args.Get(0).(model.Session), args.Error(1)
+}
+
+// RotateRefreshToken simulates retiring a refresh token during rotation.
+// Tests can configure this to return ErrRefreshTokenReused or a storage error.
+func (m *TokenRepoMock) RotateRefreshToken(ctx context.Context, tokenHash string) error {
+	args := m.Called(ctx, tokenHash)
+	return args.Error(0)
 }
 
-// DeleteRefreshToken simulates deleting a refresh token.
+// DeleteRefreshToken simulates revoking the session of a refresh token.
 // Tests can configure this to return an error to test failure scenarios.
-func (m *TokenRepoMock) DeleteRefreshToken(ctx context.Context, in transport.RefreshTokenRequest) error {
-	args := m.Called(ctx, in)
+func (m *TokenRepoMock) DeleteRefreshToken(ctx context.Context, tokenHash string) error {
+	args := m.Called(ctx, tokenHash)
+	return args.Error(0)
+}
+
+// ListSessions simulates listing a user's active sessions.
+func (m *TokenRepoMock) ListSessions(ctx context.Context, userID int64) ([]model.Session, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]model.Session), args.Error(1)
+}
+
+// DeleteSession simulates revoking a single session.
+func (m *TokenRepoMock) DeleteSession(ctx context.Context, userID int64, sessionID string) error {
+	args := m.Called(ctx, userID, sessionID)
 	return args.Error(0)
 }
+
+// DeleteOtherSessions simulates revoking every session except the current one.
+func (m *TokenRepoMock) DeleteOtherSessions(ctx context.Context, userID int64, keepSessionID string) (int64, error) {
+	args := m.Called(ctx, userID, keepSessionID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// DeleteAllSessions simulates revoking every session of a user.
+func (m *TokenRepoMock) DeleteAllSessions(ctx context.Context, userID int64) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
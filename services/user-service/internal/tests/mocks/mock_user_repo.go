@@ -36,3 +36,43 @@ func (m *UserRepoMock) FetchUserByID(
 	args := m.Called(ctx, in)
 	return args.Get(0).(transport.UserProfileResponse), args.Error(1)
 }
+
+// SearchUsers simulates searching user profiles by nickname and username.
+// It can be configured to return a page of hits or an error.
+func (m *UserRepoMock) SearchUsers(
+	ctx context.Context,
+	input transport.UserSearchQuery,
+) ([]transport.UserSearchHit, error) {
+	args := m.Called(ctx, input)
+	hits, _ := args.Get(0).([]transport.UserSearchHit)
+	return hits, args.Error(1)
+}
+
+// FetchUsersByIDs simulates retrieving several user profiles by ID.
+// It can be configured to return the found profiles or an error.
+func (m *UserRepoMock) FetchUsersByIDs(
+	ctx context.Context,
+	userIDs []int64,
+) ([]transport.UserProfileResponse, error) {
+	args := m.Called(ctx, userIDs)
+	users, _ := args.Get(0).([]transport.UserProfileResponse)
+	return users, args.Error(1)
+}
+
+// FetchPrivacySettings simulates reading a user's profile privacy settings.
+// It can be configured to return the settings or an error.
+func (m *UserRepoMock) FetchPrivacySettings(ctx context.Context, userID int64) (transport.PrivacySettings, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(transport.PrivacySettings), args.Error(1)
+}
+
+// UpdatePrivacySettings simulates changing a user's profile privacy settings.
+// It can be configured to return the resulting settings or an error.
+func (m *UserRepoMock) UpdatePrivacySettings(
+	ctx context.Context,
+	userID int64,
+	input transport.UpdatePrivacySettingsRequest,
+) (transport.PrivacySettings, error) {
+	args := m.Called(ctx, userID, input)
+	return args.Get(0).(transport.PrivacySettings), args.Error(1)
+}
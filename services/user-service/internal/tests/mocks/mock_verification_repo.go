@@ -0,0 +1,31 @@
+// Package mocks provides mock implementations of repository and service interfaces
+// for unit testing the user-service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+)
+
+// VerificationRepoMock is a mock implementation of the VerificationRepository
+// interface. It allows tests to simulate issuing and redeeming email
+// verification tokens without a real database connection.
+type VerificationRepoMock struct {
+	mock.Mock
+}
+
+// SaveVerificationToken simulates persisting a verification token digest.
+func (m *VerificationRepoMock) SaveVerificationToken(ctx context.Context, in domain.SaveVerificationTokenInput) error {
+	args := m.Called(ctx, in)
+	return args.Error(0)
+}
+
+// ConsumeVerificationToken simulates redeeming a token digest.
+// It can be configured to return the owning user ID or an error.
+func (m *VerificationRepoMock) ConsumeVerificationToken(ctx context.Context, tokenHash string) (int64, error) {
+	args := m.Called(ctx, tokenHash)
+	return args.Get(0).(int64), args.Error(1)
+}
@@ -0,0 +1,142 @@
+// Package security_test verifies loading of the JWT key set, token signing with
+// a "kid" header, and JWKS publishing.
+package security_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/security"
+)
+
+// writeKeys writes one RSA key ("rsa-1") and one Ed25519 key ("ed-1") to dir.
+func writeKeys(t *testing.T, dir string) {
+	t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rsaPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "rsa-1.pem"), rsaPEM, 0o600))
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(edKey)
+	require.NoError(t, err)
+	edPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ed-1.pem"), edPEM, 0o600))
+}
+
+// TestLoadKeySet_LoadsAllKeys ensures that every PEM file becomes a key and
+// the configured key is used for signing.
+func TestLoadKeySet_LoadsAllKeys(t *testing.T) {
+	// Scenario: The keys directory holds an old RSA key and a new Ed25519 key.
+	dir := t.TempDir()
+	writeKeys(t, dir)
+
+	keys, err := security.LoadKeySet(dir, "ed-1")
+	require.NoError(t, err)
+
+	assert.Equal(t, "ed-1", keys.SigningKey().ID)
+	assert.Equal(t, jwt.SigningMethodEdDSA, keys.SigningKey().Method)
+	require.Len(t, keys.Keys(), 2)
+	assert.Equal(t, jwt.SigningMethodRS256, keys.Keys()[1].Method)
+}
+
+// TestLoadKeySet_UnknownSigningKey ensures that a signing key ID without a
+// matching file is a configuration error.
+func TestLoadKeySet_UnknownSigningKey(t *testing.T) {
+	// Scenario: signing_key_id points at a key that does not exist.
+	dir := t.TempDir()
+	writeKeys(t, dir)
+
+	_, err := security.LoadKeySet(dir, "missing")
+	assert.ErrorIs(t, err, errs.ErrUnknownSigningKey)
+}
+
+// TestLoadKeySet_InvalidKeyFile ensures that a malformed PEM file is rejected.
+func TestLoadKeySet_InvalidKeyFile(t *testing.T) {
+	// Scenario: The keys directory contains a file that is not a private key.
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bad.pem"), []byte("not a key"), 0o600))
+
+	_, err := security.LoadKeySet(dir, "bad")
+	assert.ErrorIs(t, err, errs.ErrInvalidSigningKey)
+}
+
+// TestJWTGenerator_SignsWithKeyID ensures that access tokens carry the signing
+// key's "kid" and verify against any key set sharing that key.
+func TestJWTGenerator_SignsWithKeyID(t *testing.T) {
+	// Scenario: A token is issued and later verified after the signing key rotated.
+	dir := t.TempDir()
+	writeKeys(t, dir)
+
+	oldKeys, err := security.LoadKeySet(dir, "rsa-1")
+	require.NoError(t, err)
+	pair, err := security.NewJWTGenerator(oldKeys, time.Minute, "user-service", "social-platform").
+		CreateTokenPair(domain.CreateTokenPairInput{UserID: 7})
+	require.NoError(t, err)
+
+	rotatedKeys, err := security.LoadKeySet(dir, "ed-1")
+	require.NoError(t, err)
+	token, err := jwt.Parse(pair.AccessToken, rotatedKeys.Keyfunc)
+	require.NoError(t, err)
+
+	assert.True(t, token.Valid)
+	assert.Equal(t, "rsa-1", token.Header["kid"])
+	assert.Equal(t, "RS256", token.Header["alg"])
+
+	claims, err := auth.NewVerifier(rotatedKeys.Keyfunc, auth.Config{Issuer: "user-service", Audience: "social-platform"}).
+		Verify(pair.AccessToken)
+	require.NoError(t, err)
+	userID, err := claims.UserID()
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), userID)
+}
+
+// TestJWKSHandler_PublishesPublicKeys ensures that the JWKS endpoint exposes
+// every key's public half and nothing else.
+func TestJWKSHandler_PublishesPublicKeys(t *testing.T) {
+	// Scenario: A verifier fetches /.well-known/jwks.json.
+	dir := t.TempDir()
+	writeKeys(t, dir)
+	keys, err := security.LoadKeySet(dir, "ed-1")
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	security.JWKSHandler(keys)(rec, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var raw map[string][]map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &raw))
+	require.Len(t, raw["keys"], 2)
+
+	ed, rsaJWK := raw["keys"][0], raw["keys"][1]
+	assert.Equal(t, "ed-1", ed["kid"])
+	assert.Equal(t, "OKP", ed["kty"])
+	assert.Equal(t, "Ed25519", ed["crv"])
+	assert.Equal(t, "EdDSA", ed["alg"])
+	assert.Equal(t, "rsa-1", rsaJWK["kid"])
+	assert.Equal(t, "RSA", rsaJWK["kty"])
+	assert.Equal(t, "AQAB", rsaJWK["e"])
+	for _, k := range raw["keys"] {
+		assert.NotContains(t, k, "d", "private key material must never be published")
+	}
+}
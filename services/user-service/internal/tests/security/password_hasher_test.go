@@ -0,0 +1,115 @@
+// Package security_test verifies Argon2id password hashing, verification of
+// legacy bcrypt hashes and detection of outdated hashes.
+package security_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/config"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/security"
+)
+
+// testArgon2id returns cheap Argon2id parameters that keep tests fast.
+func testArgon2id() config.Argon2id {
+	return config.Argon2id{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+}
+
+// TestArgon2idHasher_RoundTrip ensures that hashes are in PHC format, salted
+// and verify only the original password.
+func TestArgon2idHasher_RoundTrip(t *testing.T) {
+	hasher := security.Argon2idHasher{Params: testArgon2id()}
+
+	hash, err := hasher.HashPassword("correct horse")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$"), hash)
+
+	again, err := hasher.HashPassword("correct horse")
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, again)
+
+	assert.NoError(t, hasher.VerifyPassword(hash, "correct horse"))
+	assert.ErrorIs(t, hasher.VerifyPassword(hash, "wrong horse"), errs.ErrInvalidPassword)
+	assert.ErrorIs(t, hasher.VerifyPassword("$argon2id$v=19$m=1024$broken", "correct horse"), errs.ErrInvalidPassword)
+	assert.False(t, hasher.NeedsRehash(hash))
+}
+
+// TestArgon2idHasher_NeedsRehashOnParamChange ensures that hashes created with
+// other parameters are flagged for re-hashing but still verify.
+func TestArgon2idHasher_NeedsRehashOnParamChange(t *testing.T) {
+	old := security.Argon2idHasher{Params: testArgon2id()}
+	hash, err := old.HashPassword("correct horse")
+	require.NoError(t, err)
+
+	stronger := testArgon2id()
+	stronger.Memory = 2048
+	current := security.Argon2idHasher{Params: stronger}
+
+	assert.True(t, current.NeedsRehash(hash))
+	assert.NoError(t, current.VerifyPassword(hash, "correct horse"))
+}
+
+// TestNewHasher_MigratesBcrypt ensures that the default hasher creates
+// Argon2id hashes, still verifies bcrypt hashes and flags them for re-hashing.
+func TestNewHasher_MigratesBcrypt(t *testing.T) {
+	hasher, err := security.NewHasher(config.PasswordHashing{Argon2id: testArgon2id()})
+	require.NoError(t, err)
+
+	legacy, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	assert.NoError(t, hasher.VerifyPassword(string(legacy), "correct horse"))
+	assert.ErrorIs(t, hasher.VerifyPassword(string(legacy), "wrong horse"), errs.ErrInvalidPassword)
+	assert.True(t, hasher.NeedsRehash(string(legacy)))
+
+	hash, err := hasher.HashPassword("correct horse")
+	require.NoError(t, err)
+	assert.Equal(t, security.AlgorithmArgon2id, security.HashAlgorithm(hash))
+	assert.False(t, hasher.NeedsRehash(hash))
+}
+
+// TestNewHasher_Bcrypt ensures that bcrypt can still be selected and that the
+// configured cost is enforced.
+func TestNewHasher_Bcrypt(t *testing.T) {
+	hasher, err := security.NewHasher(config.PasswordHashing{Algorithm: "bcrypt", BcryptCost: bcrypt.MinCost + 1})
+	require.NoError(t, err)
+
+	hash, err := hasher.HashPassword("correct horse")
+	require.NoError(t, err)
+	assert.Equal(t, security.AlgorithmBcrypt, security.HashAlgorithm(hash))
+	assert.False(t, hasher.NeedsRehash(hash))
+
+	weaker, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	require.NoError(t, err)
+	assert.True(t, hasher.NeedsRehash(string(weaker)))
+}
+
+// TestNewHasher_RejectsBadConfig ensures that unknown algorithms and weak or
+// missing Argon2id parameters fail at startup.
+func TestNewHasher_RejectsBadConfig(t *testing.T) {
+	_, err := security.NewHasher(config.PasswordHashing{Algorithm: "md5"})
+	assert.ErrorIs(t, err, errs.ErrUnknownHashAlgorithm)
+
+	_, err = security.NewHasher(config.PasswordHashing{Algorithm: "argon2id"})
+	assert.ErrorIs(t, err, errs.ErrInvalidHashParams)
+
+	short := testArgon2id()
+	short.SaltLength = 4
+	_, err = security.NewHasher(config.PasswordHashing{Argon2id: short})
+	assert.ErrorIs(t, err, errs.ErrInvalidHashParams)
+}
+
+// TestVerifyPassword_UnknownFormat ensures that empty or unrecognized hashes,
+// such as those of passwordless accounts, never verify.
+func TestVerifyPassword_UnknownFormat(t *testing.T) {
+	hasher, err := security.NewHasher(config.PasswordHashing{Argon2id: testArgon2id()})
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, hasher.VerifyPassword("", ""), errs.ErrInvalidPassword)
+	assert.ErrorIs(t, hasher.VerifyPassword("plaintext", "plaintext"), errs.ErrInvalidPassword)
+}
@@ -0,0 +1,132 @@
+// Package security_test verifies the password policy rules and the offline
+// breached password list.
+package security_test
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/config"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/security"
+)
+
+// sha1Hex returns the uppercase hex SHA-1 digest used by breached password lists.
+func sha1Hex(password string) string {
+	sum := sha1.Sum([]byte(password))
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+// writeBreachedList writes lines to a temporary breached password list file.
+func writeBreachedList(t *testing.T, lines ...string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o600))
+	return path
+}
+
+// TestPasswordPolicy_Rules ensures that length, character class and
+// identifier rules are enforced with a descriptive ErrWeakPassword.
+func TestPasswordPolicy_Rules(t *testing.T) {
+	policy, err := security.NewPasswordPolicy(config.PasswordPolicy{
+		MinLength:     10,
+		MaxLength:     20,
+		RequireUpper:  true,
+		RequireLower:  true,
+		RequireDigit:  true,
+		RequireSymbol: true,
+	})
+	require.NoError(t, err)
+
+	cases := []struct {
+		name     string
+		password string
+		detail   string
+	}{
+		{"too short", "Ab1!", "at least 10 characters"},
+		{"too long", "Abcdefgh1!Abcdefgh1!x", "at most 20 characters"},
+		{"no uppercase", "abcdefgh1!", "uppercase letter"},
+		{"no lowercase", "ABCDEFGH1!", "lowercase letter"},
+		{"no digit", "Abcdefghi!", "digit"},
+		{"no symbol", "Abcdefghi1", "symbol"},
+		{"contains email local part", "Xx-Alice.99", "email or nickname"},
+		{"contains nickname", "7!Wonderland", "email or nickname"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := policy.Validate(tc.password, "alice@example.com", "wonderland")
+			assert.ErrorIs(t, err, errs.ErrWeakPassword)
+			assert.Contains(t, err.Error(), tc.detail)
+		})
+	}
+
+	assert.NoError(t, policy.Validate("Tr0ub4dor&3x", "alice@example.com", "wonderland"))
+}
+
+// TestPasswordPolicy_CountsCharacters ensures that lengths are measured in
+// characters rather than bytes.
+func TestPasswordPolicy_CountsCharacters(t *testing.T) {
+	policy, err := security.NewPasswordPolicy(config.PasswordPolicy{MinLength: 10})
+	require.NoError(t, err)
+
+	assert.NoError(t, policy.Validate("пароль-паро", "a@example.com", "ab"))
+	assert.ErrorIs(t, policy.Validate("пароль", "a@example.com", "ab"), errs.ErrWeakPassword)
+}
+
+// TestPasswordPolicy_BreachedList ensures that passwords found in the local
+// breached list are rejected, whatever the case of the stored digest.
+func TestPasswordPolicy_BreachedList(t *testing.T) {
+	path := writeBreachedList(t,
+		"# Pwned Passwords sample",
+		sha1Hex("correct horse battery")+":3861493",
+		strings.ToLower(sha1Hex("letmein-please")),
+		"",
+	)
+
+	policy, err := security.NewPasswordPolicy(config.PasswordPolicy{MinLength: 10, BreachedPasswordsFile: path})
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, policy.Validate("correct horse battery", "a@example.com", "ab"), errs.ErrBreachedPassword)
+	assert.ErrorIs(t, policy.Validate("letmein-please", "a@example.com", "ab"), errs.ErrBreachedPassword)
+	assert.NoError(t, policy.Validate("correct horse stapler", "a@example.com", "ab"))
+}
+
+// TestPasswordPolicy_BreachedRangeFiles ensures that a directory of
+// k-anonymity range files, named by hash prefix, is loaded as one list.
+func TestPasswordPolicy_BreachedRangeFiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, password := range []string{"correct horse battery", "letmein-please"} {
+		hash := sha1Hex(password)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, hash[:5]+".txt"), []byte(hash[5:]+":42\n"), 0o600))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a range file"), 0o600))
+
+	list, err := security.LoadBreachedPasswords(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 2, list.Len())
+
+	policy, err := security.NewPasswordPolicy(config.PasswordPolicy{BreachedPasswordsFile: dir})
+	require.NoError(t, err)
+	assert.ErrorIs(t, policy.Validate("letmein-please", "a@example.com", "ab"), errs.ErrBreachedPassword)
+	assert.NoError(t, policy.Validate("correct horse stapler", "a@example.com", "ab"))
+}
+
+// TestNewPasswordPolicy_InvalidList ensures that a malformed or missing
+// breached list fails policy construction.
+func TestNewPasswordPolicy_InvalidList(t *testing.T) {
+	path := writeBreachedList(t, sha1Hex("fine"), "not-a-hash:12", sha1Hex("also fine")+"00")
+
+	_, err := security.NewPasswordPolicy(config.PasswordPolicy{BreachedPasswordsFile: path})
+	assert.ErrorIs(t, err, errs.ErrInvalidBreachedList)
+	assert.Contains(t, err.Error(), "line 2")
+
+	_, err = security.NewPasswordPolicy(config.PasswordPolicy{BreachedPasswordsFile: filepath.Join(t.TempDir(), "missing.txt")})
+	assert.Error(t, err)
+}
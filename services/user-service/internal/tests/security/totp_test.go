@@ -0,0 +1,118 @@
+// Package security_test verifies TOTP code generation against the RFC 6238
+// reference values, recovery code handling, and secret encryption.
+package security_test
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/security"
+)
+
+// rfcSecret is the RFC 6238 SHA-1 test secret "12345678901234567890".
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+// TestTOTPCode_RFC6238Vectors checks generated codes against the RFC 6238
+// appendix B values, truncated to six digits.
+func TestTOTPCode_RFC6238Vectors(t *testing.T) {
+	vectors := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+	for unix, want := range vectors {
+		code, err := security.TOTPCode(rfcSecret, security.TOTPStep(time.Unix(unix, 0)))
+		require.NoError(t, err)
+		assert.Equal(t, want, code, "T=%d", unix)
+	}
+}
+
+// TestValidateTOTP_Skew ensures that codes from adjacent steps are accepted to
+// tolerate clock drift, while older ones are rejected.
+func TestValidateTOTP_Skew(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	step := security.TOTPStep(now)
+
+	previous, err := security.TOTPCode(rfcSecret, step-1)
+	require.NoError(t, err)
+	matched, ok := security.ValidateTOTP(rfcSecret, previous, now)
+	assert.True(t, ok)
+	assert.Equal(t, step-1, matched)
+
+	stale, err := security.TOTPCode(rfcSecret, step-2)
+	require.NoError(t, err)
+	_, ok = security.ValidateTOTP(rfcSecret, stale, now)
+	assert.False(t, ok)
+
+	_, ok = security.ValidateTOTP(rfcSecret, "12345", now)
+	assert.False(t, ok)
+}
+
+// TestGenerateTOTPSecret_RoundTrips ensures that generated secrets are usable.
+func TestGenerateTOTPSecret_RoundTrips(t *testing.T) {
+	secret, err := security.GenerateTOTPSecret()
+	require.NoError(t, err)
+	assert.Len(t, secret, 32)
+
+	now := time.Now()
+	code, err := security.TOTPCode(secret, security.TOTPStep(now))
+	require.NoError(t, err)
+	_, ok := security.ValidateTOTP(secret, code, now)
+	assert.True(t, ok)
+}
+
+// TestGenerateRecoveryCodes ensures that recovery codes are unique and that
+// normalisation ignores case and separators.
+func TestGenerateRecoveryCodes(t *testing.T) {
+	codes, err := security.GenerateRecoveryCodes(10)
+	require.NoError(t, err)
+	require.Len(t, codes, 10)
+
+	seen := map[string]bool{}
+	for _, code := range codes {
+		assert.Regexp(t, `^[a-z2-7]{8}-[a-z2-7]{8}$`, code)
+		assert.False(t, seen[code])
+		seen[code] = true
+	}
+	assert.Equal(t, "abcdefghijklmnop", security.NormalizeRecoveryCode("ABCD EFGH-ijkl-mnop"))
+}
+
+// TestSecretBox_SealOpen ensures that sealed secrets decrypt only with the
+// same key and that tampering is detected.
+func TestSecretBox_SealOpen(t *testing.T) {
+	box, err := security.NewSecretBox(randomKey(t))
+	require.NoError(t, err)
+
+	sealed, err := box.Seal(rfcSecret)
+	require.NoError(t, err)
+	assert.NotContains(t, sealed, rfcSecret)
+
+	opened, err := box.Open(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, rfcSecret, opened)
+
+	otherBox, err := security.NewSecretBox(randomKey(t))
+	require.NoError(t, err)
+	_, err = otherBox.Open(sealed)
+	assert.ErrorIs(t, err, errs.ErrDecryptionFailed)
+
+	_, err = security.NewSecretBox(base64.StdEncoding.EncodeToString([]byte("too-short")))
+	assert.ErrorIs(t, err, errs.ErrInvalidEncryptionKey)
+}
+
+func randomKey(t *testing.T) string {
+	t.Helper()
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(key)
+}
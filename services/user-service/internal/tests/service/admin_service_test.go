@@ -0,0 +1,204 @@
+// Package service_test verifies the behavior of AdminService: listing,
+// suspending and signing out accounts.
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/service"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/mocks"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/testdata"
+)
+
+// sampleAdminUser returns an active account other than the caller's.
+func sampleAdminUser() model.AdminUser {
+	return model.AdminUser{
+		ID:        42,
+		Nickname:  "spammer",
+		Email:     "spammer@example.com",
+		CreatedAt: time.Now().Add(-24 * time.Hour),
+	}
+}
+
+// TestListUsers_Success ensures that a page of accounts is returned through
+// the mapper.
+func TestListUsers_Success(t *testing.T) {
+	// Scenario: A moderator lists the first page of accounts.
+	adminRepo := new(mocks.AdminRepoMock)
+	tokenRepo := new(mocks.TokenRepoMock)
+	mapper := new(mocks.MockMapper)
+	svc := service.NewAdminService(adminRepo, tokenRepo, mapper)
+
+	users := []model.AdminUser{sampleAdminUser()}
+	expected := &userauthpb.ListUsersResponse{Users: []*userauthpb.AdminUser{{UserId: 42}}}
+
+	adminRepo.On("ListUsers", mock.Anything, int64(10), 20).Return(users, nil)
+	mapper.On("ToListUsersResponse", users).Return(expected)
+
+	resp, err := svc.ListUsers(callerContext(), &userauthpb.ListUsersRequest{AfterId: 10, Limit: 20})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, resp)
+}
+
+// TestSuspendUser_Success ensures that the account is suspended with the
+// given reason.
+func TestSuspendUser_Success(t *testing.T) {
+	// Scenario: A moderator suspends a regular account.
+	adminRepo := new(mocks.AdminRepoMock)
+	tokenRepo := new(mocks.TokenRepoMock)
+	mapper := new(mocks.MockMapper)
+	svc := service.NewAdminService(adminRepo, tokenRepo, mapper)
+
+	target := sampleAdminUser()
+	suspended := target
+	suspended.SuspendedAt = time.Now()
+	suspended.SuspendedReason = "spam"
+	expected := &userauthpb.AdminUser{UserId: target.ID, SuspendedReason: "spam"}
+
+	adminRepo.On("GetUser", mock.Anything, target.ID).Return(target, nil)
+	adminRepo.On("SuspendUser", mock.Anything, target.ID, "spam").Return(suspended, nil)
+	mapper.On("ToAdminUserResponse", suspended).Return(expected)
+
+	resp, err := svc.SuspendUser(callerContext(), &userauthpb.SuspendUserRequest{UserId: target.ID, Reason: "spam"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, resp)
+}
+
+// TestSuspendUser_Self ensures that moderators cannot suspend themselves.
+func TestSuspendUser_Self(t *testing.T) {
+	// Scenario: The caller targets their own account.
+	adminRepo := new(mocks.AdminRepoMock)
+	tokenRepo := new(mocks.TokenRepoMock)
+	mapper := new(mocks.MockMapper)
+	svc := service.NewAdminService(adminRepo, tokenRepo, mapper)
+
+	caller := testdata.SampleSession().UserID
+	_, err := svc.SuspendUser(callerContext(), &userauthpb.SuspendUserRequest{UserId: caller, Reason: "oops"})
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	adminRepo.AssertNotCalled(t, "SuspendUser", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestSuspendUser_Admin ensures that administrators cannot be suspended.
+func TestSuspendUser_Admin(t *testing.T) {
+	// Scenario: A moderator targets an administrator.
+	adminRepo := new(mocks.AdminRepoMock)
+	tokenRepo := new(mocks.TokenRepoMock)
+	mapper := new(mocks.MockMapper)
+	svc := service.NewAdminService(adminRepo, tokenRepo, mapper)
+
+	target := sampleAdminUser()
+	target.Roles = []string{"admin"}
+	adminRepo.On("GetUser", mock.Anything, target.ID).Return(target, nil)
+
+	_, err := svc.SuspendUser(callerContext(), &userauthpb.SuspendUserRequest{UserId: target.ID, Reason: "spam"})
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	assert.Equal(t, errs.ErrCannotModerateUser.Error(), st.Message())
+	adminRepo.AssertNotCalled(t, "SuspendUser", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestSuspendUser_NotFound ensures that unknown accounts yield NotFound.
+func TestSuspendUser_NotFound(t *testing.T) {
+	// Scenario: The target account does not exist.
+	adminRepo := new(mocks.AdminRepoMock)
+	tokenRepo := new(mocks.TokenRepoMock)
+	mapper := new(mocks.MockMapper)
+	svc := service.NewAdminService(adminRepo, tokenRepo, mapper)
+
+	adminRepo.On("GetUser", mock.Anything, int64(42)).Return(model.AdminUser{}, errs.ErrUserNotFound)
+
+	_, err := svc.SuspendUser(callerContext(), &userauthpb.SuspendUserRequest{UserId: 42, Reason: "spam"})
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
+// TestSuspendUser_Unauthenticated ensures that a request without caller
+// identity is rejected.
+func TestSuspendUser_Unauthenticated(t *testing.T) {
+	// Scenario: The auth interceptor did not attach a caller.
+	adminRepo := new(mocks.AdminRepoMock)
+	tokenRepo := new(mocks.TokenRepoMock)
+	mapper := new(mocks.MockMapper)
+	svc := service.NewAdminService(adminRepo, tokenRepo, mapper)
+
+	_, err := svc.SuspendUser(context.Background(), &userauthpb.SuspendUserRequest{UserId: 42, Reason: "spam"})
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+}
+
+// TestUnsuspendUser_Success ensures that a suspension is lifted.
+func TestUnsuspendUser_Success(t *testing.T) {
+	// Scenario: A moderator reinstates a suspended account.
+	adminRepo := new(mocks.AdminRepoMock)
+	tokenRepo := new(mocks.TokenRepoMock)
+	mapper := new(mocks.MockMapper)
+	svc := service.NewAdminService(adminRepo, tokenRepo, mapper)
+
+	user := sampleAdminUser()
+	expected := &userauthpb.AdminUser{UserId: user.ID}
+
+	adminRepo.On("UnsuspendUser", mock.Anything, user.ID).Return(user, nil)
+	mapper.On("ToAdminUserResponse", user).Return(expected)
+
+	resp, err := svc.UnsuspendUser(callerContext(), &userauthpb.UnsuspendUserRequest{UserId: user.ID})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, resp)
+}
+
+// TestForceLogout_Success ensures that every session of the account is
+// revoked and the count is reported.
+func TestForceLogout_Success(t *testing.T) {
+	// Scenario: An administrator signs an account out of three devices.
+	adminRepo := new(mocks.AdminRepoMock)
+	tokenRepo := new(mocks.TokenRepoMock)
+	mapper := new(mocks.MockMapper)
+	svc := service.NewAdminService(adminRepo, tokenRepo, mapper)
+
+	user := sampleAdminUser()
+	expected := &userauthpb.ForceLogoutResponse{RevokedSessions: 3}
+
+	adminRepo.On("GetUser", mock.Anything, user.ID).Return(user, nil)
+	tokenRepo.On("DeleteAllSessions", mock.Anything, user.ID).Return(int64(3), nil)
+	mapper.On("ToForceLogoutResponse", int64(3)).Return(expected)
+
+	resp, err := svc.ForceLogout(callerContext(), &userauthpb.ForceLogoutRequest{UserId: user.ID})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, resp)
+}
+
+// TestForceLogout_DBError ensures that a storage failure yields Internal.
+func TestForceLogout_DBError(t *testing.T) {
+	// Scenario: Revoking the sessions fails.
+	adminRepo := new(mocks.AdminRepoMock)
+	tokenRepo := new(mocks.TokenRepoMock)
+	mapper := new(mocks.MockMapper)
+	svc := service.NewAdminService(adminRepo, tokenRepo, mapper)
+
+	user := sampleAdminUser()
+	adminRepo.On("GetUser", mock.Anything, user.ID).Return(user, nil)
+	tokenRepo.On("DeleteAllSessions", mock.Anything, user.ID).Return(int64(0), errs.ErrDBFailure)
+
+	_, err := svc.ForceLogout(callerContext(), &userauthpb.ForceLogoutRequest{UserId: user.ID})
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Internal, st.Code())
+}
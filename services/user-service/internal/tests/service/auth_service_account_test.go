@@ -0,0 +1,210 @@
+// Package service_test verifies account deletion: AuthService.DeleteMyAccount,
+// the user event feed served by InternalUserService and the AccountPurger.
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	userpb "github.com/mamataliev-dev/social-platform/api/gen/user/v1"
+	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/service"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/mocks"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/testdata"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
+)
+
+// TestDeleteMyAccount_Success ensures that a confirmed deletion soft-deletes the
+// account and reports when it will be purged.
+func TestDeleteMyAccount_Success(t *testing.T) {
+	// Scenario: The caller confirms their password and deletes the account.
+	svc, m := newAuthService(t)
+
+	req := &userauthpb.DeleteMyAccountRequest{Password: "secret"}
+	user := testdata.SampleUserModel()
+	deletedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	purgeAfter := deletedAt.Add(testdata.AccountDeletionConfig().GracePeriod)
+
+	m.mapper.On("ToDeleteMyAccountRequest", req).Return(transport.DeleteMyAccountRequest{Password: "secret"})
+	m.authRepo.On("FetchUserCredentials", mock.Anything, user.ID).Return(user, nil)
+	m.hasher.On("VerifyPassword", user.PasswordHash, "secret").Return(nil)
+	m.accountRepo.On("SoftDeleteUser", mock.Anything, user.ID).Return(deletedAt, nil)
+	m.mapper.On("ToDeleteMyAccountResponse", mock.MatchedBy(func(r transport.DeleteMyAccountResponse) bool {
+		return r.PurgeAfter.Equal(purgeAfter)
+	})).Return(&userauthpb.DeleteMyAccountResponse{Message: "deleted", PurgeAfter: timestamppb.New(purgeAfter)})
+
+	resp, err := svc.DeleteMyAccount(callerContext(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, purgeAfter, resp.PurgeAfter.AsTime())
+	m.accountRepo.AssertExpectations(t)
+	m.mapper.AssertExpectations(t)
+}
+
+// TestDeleteMyAccount_WrongPassword ensures that a wrong password yields
+// Unauthenticated and the account is kept.
+func TestDeleteMyAccount_WrongPassword(t *testing.T) {
+	// Scenario: The caller mistypes the password.
+	svc, m := newAuthService(t)
+
+	req := &userauthpb.DeleteMyAccountRequest{Password: "wrong"}
+	user := testdata.SampleUserModel()
+
+	m.mapper.On("ToDeleteMyAccountRequest", req).Return(transport.DeleteMyAccountRequest{Password: "wrong"})
+	m.authRepo.On("FetchUserCredentials", mock.Anything, user.ID).Return(user, nil)
+	m.hasher.On("VerifyPassword", user.PasswordHash, "wrong").Return(errs.ErrInvalidPassword)
+
+	resp, err := svc.DeleteMyAccount(callerContext(), req)
+
+	assert.Nil(t, resp)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+	assert.Equal(t, errs.ErrInvalidPassword.Error(), st.Message())
+	m.accountRepo.AssertNotCalled(t, "SoftDeleteUser", mock.Anything, mock.Anything)
+}
+
+// TestDeleteMyAccount_PasswordlessAccount ensures that an account created
+// through social login, which has no password, can delete itself after a
+// recent sign-in but not from an old session.
+func TestDeleteMyAccount_PasswordlessAccount(t *testing.T) {
+	tests := []struct {
+		name     string
+		signedIn time.Duration
+		wantCode codes.Code
+	}{
+		{"recent sign-in", 2 * time.Minute, codes.OK},
+		{"old session", 48 * time.Hour, codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Scenario: An OAuth-only user deletes their account.
+			svc, m := newAuthService(t)
+
+			req := &userauthpb.DeleteMyAccountRequest{}
+			user := testdata.SampleUserModel()
+			user.PasswordHash = ""
+			session := testdata.SampleSession()
+			session.CreatedAt = time.Now().Add(-tt.signedIn)
+
+			m.mapper.On("ToDeleteMyAccountRequest", req).Return(transport.DeleteMyAccountRequest{})
+			m.authRepo.On("FetchUserCredentials", mock.Anything, user.ID).Return(user, nil)
+			m.tokenRepo.On("ListSessions", mock.Anything, user.ID).Return([]model.Session{session}, nil)
+			m.accountRepo.On("SoftDeleteUser", mock.Anything, user.ID).Return(time.Now(), nil).Maybe()
+			m.mapper.On("ToDeleteMyAccountResponse", mock.Anything).Return(&userauthpb.DeleteMyAccountResponse{}).Maybe()
+
+			_, err := svc.DeleteMyAccount(callerContext(), req)
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			m.hasher.AssertNotCalled(t, "VerifyPassword", mock.Anything, mock.Anything)
+			if tt.wantCode == codes.OK {
+				m.accountRepo.AssertCalled(t, "SoftDeleteUser", mock.Anything, user.ID)
+			} else {
+				assert.Equal(t, errs.ErrRecentLoginRequired.Error(), status.Convert(err).Message())
+				m.accountRepo.AssertNotCalled(t, "SoftDeleteUser", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+// TestDeleteMyAccount_Unauthenticated ensures that a call without caller
+// identity is rejected before anything is looked up.
+func TestDeleteMyAccount_Unauthenticated(t *testing.T) {
+	// Scenario: The request reaches the service without an authenticated caller.
+	svc, m := newAuthService(t)
+
+	resp, err := svc.DeleteMyAccount(context.Background(), &userauthpb.DeleteMyAccountRequest{Password: "secret"})
+
+	assert.Nil(t, resp)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+	m.authRepo.AssertNotCalled(t, "FetchUserCredentials", mock.Anything, mock.Anything)
+}
+
+// TestListUserEvents_Success ensures that events after the cursor are returned
+// through the mapper.
+func TestListUserEvents_Success(t *testing.T) {
+	// Scenario: A consumer polls for events after the last one it handled.
+	userRepo := new(mocks.UserRepoMock)
+	eventRepo := new(mocks.UserEventRepoMock)
+	mapper := new(mocks.MockMapper)
+	svc := service.NewInternalUserService(userRepo, eventRepo, new(mocks.FollowRepoMock), new(mocks.FriendRepoMock), mapper)
+
+	events := []model.UserEvent{{ID: 8, Type: model.UserEventDeleted, UserID: 42, OccurredAt: time.Now()}}
+	want := &userpb.ListUserEventsResponse{Events: []*userpb.UserEvent{{Id: 8, Type: model.UserEventDeleted, UserId: 42}}}
+
+	eventRepo.On("ListUserEvents", mock.Anything, int64(7), 100).Return(events, nil)
+	mapper.On("ToListUserEventsResponse", events).Return(want)
+
+	resp, err := svc.ListUserEvents(context.Background(), &userpb.ListUserEventsRequest{AfterId: 7, Limit: 100})
+
+	assert.NoError(t, err)
+	assert.Equal(t, want, resp)
+}
+
+// TestListUserEvents_SavesConsumerCursor ensures that the cursor a service
+// polls with is recorded, so events it has not handled are not pruned.
+func TestListUserEvents_SavesConsumerCursor(t *testing.T) {
+	// Scenario: post-service polls after handling event 7.
+	eventRepo := new(mocks.UserEventRepoMock)
+	mapper := new(mocks.MockMapper)
+	svc := service.NewInternalUserService(new(mocks.UserRepoMock), eventRepo, new(mocks.FollowRepoMock), new(mocks.FriendRepoMock), mapper)
+
+	eventRepo.On("SaveUserEventCursor", mock.Anything, "post-service", int64(7)).Return(nil)
+	eventRepo.On("ListUserEvents", mock.Anything, int64(7), 100).Return([]model.UserEvent(nil), nil)
+	mapper.On("ToListUserEventsResponse", []model.UserEvent(nil)).Return(&userpb.ListUserEventsResponse{})
+
+	ctx := utils.ContextWithCallerService(context.Background(), "post-service")
+	_, err := svc.ListUserEvents(ctx, &userpb.ListUserEventsRequest{AfterId: 7, Limit: 100})
+
+	assert.NoError(t, err)
+	eventRepo.AssertExpectations(t)
+}
+
+// TestListUserEvents_InternalError ensures that a repository failure yields
+// an Internal error.
+func TestListUserEvents_InternalError(t *testing.T) {
+	// Scenario: The event log cannot be read.
+	userRepo := new(mocks.UserRepoMock)
+	eventRepo := new(mocks.UserEventRepoMock)
+	mapper := new(mocks.MockMapper)
+	svc := service.NewInternalUserService(userRepo, eventRepo, new(mocks.FollowRepoMock), new(mocks.FriendRepoMock), mapper)
+
+	eventRepo.On("ListUserEvents", mock.Anything, int64(0), 10).Return(nil, errs.ErrDBFailure)
+
+	resp, err := svc.ListUserEvents(context.Background(), &userpb.ListUserEventsRequest{Limit: 10})
+
+	assert.Nil(t, resp)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Internal, st.Code())
+}
+
+// TestAccountPurger_PurgeOnce ensures that accounts past the grace period and
+// events past the retention window are removed, and that one failure does not
+// stop the other cleanup.
+func TestAccountPurger_PurgeOnce(t *testing.T) {
+	// Scenario: The purge runs while the event log is unavailable.
+	accountRepo := new(mocks.AccountRepoMock)
+	eventRepo := new(mocks.UserEventRepoMock)
+	cfg := testdata.AccountDeletionConfig()
+	purger := service.NewAccountPurger(accountRepo, eventRepo, cfg)
+	now := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	accountRepo.On("PurgeDeletedUsers", mock.Anything, now.Add(-cfg.GracePeriod)).Return(int64(3), nil)
+	eventRepo.On("PruneUserEvents", mock.Anything, now.Add(-cfg.EventRetention)).Return(int64(0), errs.ErrDBFailure)
+
+	purger.PurgeOnce(context.Background(), now)
+
+	accountRepo.AssertExpectations(t)
+	eventRepo.AssertExpectations(t)
+}
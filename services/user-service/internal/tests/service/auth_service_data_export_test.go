@@ -0,0 +1,256 @@
+// Package service_test verifies data exports: queuing and polling through
+// AuthService, building archives with DataExporter and downloading them.
+package service_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
+	"github.com/mamataliev-dev/social-platform/pkg/auth"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/security"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/service"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/mocks"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/testdata"
+)
+
+const exportJobID = "8d7e2a4c-3b1f-4c55-9f0e-2a6b8c1d4e7f"
+
+// TestExportMyData_Queued ensures that an export is queued for the caller and
+// reported without a download URL.
+func TestExportMyData_Queued(t *testing.T) {
+	// Scenario: The caller asks for a copy of their data.
+	svc, m := newAuthService(t)
+
+	user := testdata.SampleUserModel()
+	export := model.DataExport{ID: exportJobID, UserID: user.ID, Status: model.DataExportPending, CreatedAt: time.Now()}
+
+	m.exportRepo.On("CreateDataExport", mock.Anything, user.ID).Return(export, nil)
+	m.mapper.On("ToDataExportJobResponse", mock.MatchedBy(func(job transport.DataExportJob) bool {
+		return job.JobID == exportJobID && job.Status == model.DataExportPending && job.DownloadURL == ""
+	})).Return(&userauthpb.DataExportJob{JobId: exportJobID, Status: model.DataExportPending})
+
+	resp, err := svc.ExportMyData(callerContext(), &userauthpb.ExportMyDataRequest{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, exportJobID, resp.JobId)
+	m.exportRepo.AssertExpectations(t)
+	m.mapper.AssertExpectations(t)
+}
+
+// TestGetDataExport_Ready ensures that a finished export links to its download.
+func TestGetDataExport_Ready(t *testing.T) {
+	// Scenario: The caller polls an export that has been built.
+	svc, m := newAuthService(t)
+
+	req := &userauthpb.GetDataExportRequest{JobId: exportJobID}
+	user := testdata.SampleUserModel()
+	export := model.DataExport{ID: exportJobID, UserID: user.ID, Status: model.DataExportReady}
+	wantURL := "/v1/auth/account/exports/" + exportJobID + "/download"
+
+	m.mapper.On("ToGetDataExportRequest", req).Return(transport.GetDataExportRequest{JobID: exportJobID})
+	m.exportRepo.On("GetDataExport", mock.Anything, exportJobID, user.ID).Return(export, nil)
+	m.mapper.On("ToDataExportJobResponse", mock.MatchedBy(func(job transport.DataExportJob) bool {
+		return job.DownloadURL == wantURL
+	})).Return(&userauthpb.DataExportJob{JobId: exportJobID, DownloadUrl: wantURL})
+
+	resp, err := svc.GetDataExport(callerContext(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, wantURL, resp.DownloadUrl)
+}
+
+// TestGetDataExport_NotFound ensures that unknown or foreign exports yield NotFound.
+func TestGetDataExport_NotFound(t *testing.T) {
+	// Scenario: The caller polls an export that is not theirs.
+	svc, m := newAuthService(t)
+
+	req := &userauthpb.GetDataExportRequest{JobId: exportJobID}
+
+	m.mapper.On("ToGetDataExportRequest", req).Return(transport.GetDataExportRequest{JobID: exportJobID})
+	m.exportRepo.On("GetDataExport", mock.Anything, exportJobID, int64(1)).Return(model.DataExport{}, errs.ErrExportNotFound)
+
+	resp, err := svc.GetDataExport(callerContext(), req)
+
+	assert.Nil(t, resp)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
+// TestDataExporter_BuildsArchive ensures that a claimed export is built into a
+// zip of JSON files holding the profile, sessions and chat data, without the
+// password hash.
+func TestDataExporter_BuildsArchive(t *testing.T) {
+	// Scenario: The worker picks up a queued export.
+	exportRepo := new(mocks.DataExportRepoMock)
+	authRepo := new(mocks.AuthRepoMock)
+	tokenRepo := new(mocks.TokenRepoMock)
+	chat := new(mocks.ChatDataSourceMock)
+	cfg := testdata.DataExportConfig()
+	exporter := service.NewDataExporter(exportRepo, authRepo, tokenRepo, chat, cfg)
+	now := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	user := testdata.SampleUserModel()
+	session := testdata.SampleSession()
+	chatData := model.ChatData{
+		Rooms:    []model.ChatRoom{{ID: "room-1", InitiatorID: user.ID, ParticipantID: 2}},
+		Messages: []model.ChatMessage{{ID: "msg-1", RoomID: "room-1", Content: "hello"}},
+	}
+
+	var archive []byte
+	exportRepo.On("ClaimDataExport", mock.Anything, now.Add(-cfg.StaleAfter)).
+		Return(model.DataExport{ID: exportJobID, UserID: user.ID, Status: model.DataExportProcessing}, nil)
+	authRepo.On("FetchUserCredentials", mock.Anything, user.ID).Return(user, nil)
+	tokenRepo.On("ListSessions", mock.Anything, user.ID).Return([]model.Session{session}, nil)
+	chat.On("ExportUserChatData", mock.Anything, user.ID).Return(chatData, nil)
+	exportRepo.On("CompleteDataExport", mock.Anything, exportJobID, mock.Anything, now.Add(cfg.DownloadTTL)).
+		Run(func(args mock.Arguments) { archive = args.Get(2).([]byte) }).
+		Return(nil)
+
+	processed, err := exporter.ProcessOnce(context.Background(), now)
+
+	require.NoError(t, err)
+	assert.True(t, processed)
+
+	files := readZip(t, archive)
+	assert.ElementsMatch(t, []string{"profile.json", "sessions.json", "chat/rooms.json", "chat/messages.json"}, fileNames(files))
+	assert.NotContains(t, string(files["profile.json"]), user.PasswordHash)
+
+	var profile transport.ExportProfile
+	require.NoError(t, json.Unmarshal(files["profile.json"], &profile))
+	assert.Equal(t, user.Email, profile.Email)
+
+	var messages []transport.ExportChatMessage
+	require.NoError(t, json.Unmarshal(files["chat/messages.json"], &messages))
+	assert.Equal(t, "hello", messages[0].Content)
+}
+
+// TestDataExporter_ChatUnavailable ensures that an export is marked failed
+// when chat-service cannot be reached.
+func TestDataExporter_ChatUnavailable(t *testing.T) {
+	// Scenario: chat-service is down while the export is built.
+	exportRepo := new(mocks.DataExportRepoMock)
+	authRepo := new(mocks.AuthRepoMock)
+	tokenRepo := new(mocks.TokenRepoMock)
+	chat := new(mocks.ChatDataSourceMock)
+	cfg := testdata.DataExportConfig()
+	exporter := service.NewDataExporter(exportRepo, authRepo, tokenRepo, chat, cfg)
+	now := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	user := testdata.SampleUserModel()
+
+	exportRepo.On("ClaimDataExport", mock.Anything, mock.Anything).
+		Return(model.DataExport{ID: exportJobID, UserID: user.ID}, nil)
+	authRepo.On("FetchUserCredentials", mock.Anything, user.ID).Return(user, nil)
+	tokenRepo.On("ListSessions", mock.Anything, user.ID).Return([]model.Session{}, nil)
+	chat.On("ExportUserChatData", mock.Anything, user.ID).Return(model.ChatData{}, status.Error(codes.Unavailable, "down"))
+	exportRepo.On("FailDataExport", mock.Anything, exportJobID, now.Add(cfg.DownloadTTL)).Return(nil)
+
+	processed, err := exporter.ProcessOnce(context.Background(), now)
+
+	assert.NoError(t, err)
+	assert.True(t, processed)
+	exportRepo.AssertNotCalled(t, "CompleteDataExport", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestDataExportDownload ensures that only the owner of a ready export can
+// download it, and that malformed job IDs never reach the database.
+func TestDataExportDownload(t *testing.T) {
+	keys, verifier := exportTestKeys(t)
+	exportRepo := new(mocks.DataExportRepoMock)
+	exportRepo.On("FetchDataExportArchive", mock.Anything, exportJobID, int64(1)).Return([]byte("zip-bytes"), nil)
+	exportRepo.On("FetchDataExportArchive", mock.Anything, exportJobID, int64(2)).Return(nil, errs.ErrExportNotFound)
+	handler := service.DataExportDownloadHandler(exportRepo, verifier, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	tests := []struct {
+		name     string
+		jobID    string
+		userID   int64
+		withAuth bool
+		wantCode int
+	}{
+		{"owner", exportJobID, 1, true, http.StatusOK},
+		{"other user", exportJobID, 2, true, http.StatusNotFound},
+		{"no token", exportJobID, 1, false, http.StatusUnauthorized},
+		{"malformed job ID", "abc", 1, true, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v1/auth/account/exports/"+tt.jobID+"/download", nil)
+			if tt.withAuth {
+				pair, err := security.NewJWTGenerator(keys, time.Hour, "user-service", "social-platform").
+					CreateTokenPair(domain.CreateTokenPairInput{UserID: tt.userID, SessionID: "session-1"})
+				require.NoError(t, err)
+				req.Header.Set("Authorization", "Bearer "+pair.AccessToken)
+			}
+			rec := httptest.NewRecorder()
+
+			handler(rec, req, map[string]string{"job_id": tt.jobID})
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			if tt.wantCode == http.StatusOK {
+				assert.Equal(t, "application/zip", rec.Header().Get("Content-Type"))
+				assert.Equal(t, "zip-bytes", rec.Body.String())
+			}
+		})
+	}
+	exportRepo.AssertNotCalled(t, "FetchDataExportArchive", mock.Anything, "abc", mock.Anything)
+}
+
+// exportTestKeys returns a fresh signing key set and a verifier trusting it.
+func exportTestKeys(t *testing.T) (*security.KeySet, *auth.Verifier) {
+	t.Helper()
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	keys, err := security.NewKeySet("test-key", security.SigningKey{ID: "test-key", Method: jwt.SigningMethodEdDSA, Private: priv})
+	require.NoError(t, err)
+	return keys, auth.NewVerifier(keys.Keyfunc, auth.Config{Issuer: "user-service", Audience: "social-platform"})
+}
+
+// readZip returns the contents of every file in a zip archive by name.
+func readZip(t *testing.T, archive []byte) map[string][]byte {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	require.NoError(t, err)
+
+	files := make(map[string][]byte, len(zr.File))
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		files[f.Name] = data
+	}
+	return files
+}
+
+// fileNames returns the names of the files read by readZip.
+func fileNames(m map[string][]byte) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
@@ -0,0 +1,83 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/service"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/mocks"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/testdata"
+)
+
+// authMocks holds the mocked dependencies of an AuthService built by
+// newAuthService.
+type authMocks struct {
+	authRepo     *mocks.AuthRepoMock
+	userRepo     *mocks.UserRepoMock
+	tokenRepo    *mocks.TokenRepoMock
+	verifyRepo   *mocks.VerificationRepoMock
+	mfaRepo      *mocks.MFARepoMock
+	identityRepo *mocks.IdentityRepoMock
+	attemptRepo  *mocks.LoginAttemptRepoMock
+	resetRepo    *mocks.PasswordResetRepoMock
+	accountRepo  *mocks.AccountRepoMock
+	exportRepo   *mocks.DataExportRepoMock
+	jwtMock      *mocks.JWTGeneratorMock
+	hasher       *mocks.MockHasher
+	mapper       *mocks.MockMapper
+	mailer       *mocks.MockMailer
+}
+
+// newAuthService returns an AuthService wired to fresh mocks and the testdata
+// configuration. overrides may replace any dependency before the service is
+// built.
+func newAuthService(t *testing.T, overrides ...func(*service.AuthDeps)) (*service.AuthService, *authMocks) {
+	t.Helper()
+
+	m := &authMocks{
+		authRepo:     new(mocks.AuthRepoMock),
+		userRepo:     new(mocks.UserRepoMock),
+		tokenRepo:    new(mocks.TokenRepoMock),
+		verifyRepo:   new(mocks.VerificationRepoMock),
+		mfaRepo:      new(mocks.MFARepoMock),
+		identityRepo: new(mocks.IdentityRepoMock),
+		attemptRepo:  new(mocks.LoginAttemptRepoMock),
+		resetRepo:    new(mocks.PasswordResetRepoMock),
+		accountRepo:  new(mocks.AccountRepoMock),
+		exportRepo:   new(mocks.DataExportRepoMock),
+		jwtMock:      new(mocks.JWTGeneratorMock),
+		hasher:       new(mocks.MockHasher),
+		mapper:       new(mocks.MockMapper),
+		mailer:       new(mocks.MockMailer),
+	}
+	deps := service.AuthDeps{
+		AuthRepo:     m.authRepo,
+		UserRepo:     m.userRepo,
+		TokenRepo:    m.tokenRepo,
+		VerifyRepo:   m.verifyRepo,
+		MFARepo:      m.mfaRepo,
+		IdentityRepo: m.identityRepo,
+		AttemptRepo:  m.attemptRepo,
+		ResetRepo:    m.resetRepo,
+		AccountRepo:  m.accountRepo,
+		ExportRepo:   m.exportRepo,
+		JWTGen:       m.jwtMock,
+		Hasher:       m.hasher,
+		Policy:       testdata.PasswordPolicy(),
+		Converter:    m.mapper,
+		Mailer:       m.mailer,
+		OAuth:        testdata.OAuthProviders(),
+		Config: service.AuthConfig{
+			EmailVerification: testdata.EmailVerificationConfig(),
+			MFA:               testdata.MFAConfig(),
+			OAuth:             testdata.OAuthConfig(),
+			LoginThrottle:     testdata.LoginThrottleConfig(),
+			PasswordReset:     testdata.PasswordResetConfig(),
+			AccountDeletion:   testdata.AccountDeletionConfig(),
+			DataExport:        testdata.DataExportConfig(),
+		},
+	}
+	for _, override := range overrides {
+		override(&deps)
+	}
+	return service.NewAuthService(deps), m
+}
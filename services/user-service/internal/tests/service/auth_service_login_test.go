@@ -17,8 +17,7 @@ import (
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
-	"github.com/mamataliev-dev/social-platform/services/user-service/internal/service"
-	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/mocks"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/security"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/testdata"
 )
 
@@ -34,48 +33,58 @@ func validLoginRequest() *userauthpb.LoginRequest {
 // an AuthTokenResponse containing the expected access and refresh tokens.
 func TestLogin_Success(t *testing.T) {
 	// Scenario: Successful login with valid credentials.
-	authRepo := new(mocks.AuthRepoMock)
-	userRepo := new(mocks.UserRepoMock)
-	tokenRepo := new(mocks.TokenRepoMock)
-	jwtMock := new(mocks.JWTGeneratorMock)
-	hasher := new(mocks.MockHasher)
-	mapper := new(mocks.MockMapper)
-	svc := service.NewAuthService(authRepo, userRepo, tokenRepo, jwtMock, hasher, mapper)
+	svc, m := newAuthService(t)
 
 	req := validLoginRequest()
 	user := testdata.SampleUserModel()
 
-	mapper.
+	m.mapper.
 		On("ToLoginRequest", req).
 		Return(transport.LoginRequest{Email: req.GetEmail(), Password: req.GetPassword()})
 
+	m.attemptRepo.
+		On("LoginLockedUntil", mock.Anything, mock.Anything).
+		Return(time.Time{}, nil)
+
 	tokenResp := testdata.ValidTokenPair()
 	expectedRT := tokenResp.RefreshToken
 	expectedExpiry := time.Now().Add(7 * 24 * time.Hour)
 
-	authRepo.
+	m.authRepo.
 		On("FetchUserByEmail", mock.Anything, req.Email).
 		Return(user, nil)
 
-	hasher.
+	m.hasher.
 		On("VerifyPassword", user.PasswordHash, req.Password).
 		Return(nil)
 
-	jwtMock.
+	m.hasher.
+		On("NeedsRehash", user.PasswordHash).
+		Return(false)
+
+	m.attemptRepo.
+		On("ResetLoginFailures", mock.Anything, mock.Anything).
+		Return(nil)
+
+	m.mfaRepo.
+		On("GetTOTP", mock.Anything, user.ID).
+		Return(model.TOTPEnrollment{}, errs.ErrTOTPNotEnrolled)
+
+	m.jwtMock.
 		On("CreateTokenPair", mock.MatchedBy(func(input domain.CreateTokenPairInput) bool {
 			return input.UserID == user.ID && input.Nickname == user.Nickname
 		})).
 		Return(tokenResp, nil)
 
-	tokenRepo.
+	m.tokenRepo.
 		On("SaveRefreshToken", mock.Anything, mock.MatchedBy(func(input domain.SaveRefreshTokenInput) bool {
 			return input.UserID == user.ID &&
-				input.Token == expectedRT &&
+				input.TokenHash == security.HashToken(expectedRT) &&
 				input.ExpiresAt.Sub(expectedExpiry) < 2*time.Second
 		})).
 		Return(nil)
 
-	mapper.
+	m.mapper.
 		On("ToAuthTokenResponse", tokenResp).
 		Return(&userauthpb.AuthTokenResponse{
 			AccessToken:  tokenResp.AccessToken,
@@ -92,21 +101,19 @@ func TestLogin_Success(t *testing.T) {
 // FetchUserByEmail is translated into an Internal gRPC error.
 func TestLogin_InternalDBError(t *testing.T) {
 	// Scenario: Login fails due to an internal database error.
-	authRepo := new(mocks.AuthRepoMock)
-	userRepo := new(mocks.UserRepoMock)
-	tokenRepo := new(mocks.TokenRepoMock)
-	jwtMock := new(mocks.JWTGeneratorMock)
-	hasher := new(mocks.MockHasher)
-	mapper := new(mocks.MockMapper)
-	svc := service.NewAuthService(authRepo, userRepo, tokenRepo, jwtMock, hasher, mapper)
+	svc, m := newAuthService(t)
 
 	req := validLoginRequest()
 
-	mapper.
+	m.mapper.
 		On("ToLoginRequest", req).
 		Return(transport.LoginRequest{Email: req.GetEmail(), Password: req.GetPassword()})
 
-	authRepo.
+	m.attemptRepo.
+		On("LoginLockedUntil", mock.Anything, mock.Anything).
+		Return(time.Time{}, nil)
+
+	m.authRepo.
 		On("FetchUserByEmail", mock.Anything, req.Email).
 		Return(model.User{}, errs.ErrDBFailure)
 
@@ -120,21 +127,19 @@ func TestLogin_InternalDBError(t *testing.T) {
 // FetchUserByEmail is also translated into an Internal gRPC error.
 func TestLogin_InternalError(t *testing.T) {
 	// Scenario: Login fails due to an unexpected internal error.
-	authRepo := new(mocks.AuthRepoMock)
-	userRepo := new(mocks.UserRepoMock)
-	tokenRepo := new(mocks.TokenRepoMock)
-	jwtMock := new(mocks.JWTGeneratorMock)
-	hasher := new(mocks.MockHasher)
-	mapper := new(mocks.MockMapper)
-	svc := service.NewAuthService(authRepo, userRepo, tokenRepo, jwtMock, hasher, mapper)
+	svc, m := newAuthService(t)
 
 	req := validLoginRequest()
 
-	mapper.
+	m.mapper.
 		On("ToLoginRequest", req).
 		Return(transport.LoginRequest{Email: req.GetEmail(), Password: req.GetPassword()})
 
-	authRepo.
+	m.attemptRepo.
+		On("LoginLockedUntil", mock.Anything, mock.Anything).
+		Return(time.Time{}, nil)
+
+	m.authRepo.
 		On("FetchUserByEmail", mock.Anything, req.Email).
 		Return(model.User{}, errs.ErrInternal)
 
@@ -144,95 +149,119 @@ func TestLogin_InternalError(t *testing.T) {
 	assert.Equal(t, errs.ErrInternal.Error(), st.Message())
 }
 
-// TestLogin_UserNotFound ensures that a missing user leads to a NotFound
-// gRPC error.
+// TestLogin_UserNotFound ensures that a missing user leads to the same
+// Unauthenticated error as a wrong password, after a dummy password check.
 func TestLogin_UserNotFound(t *testing.T) {
 	// Scenario: Login fails because the user with the given email is not found.
-	authRepo := new(mocks.AuthRepoMock)
-	userRepo := new(mocks.UserRepoMock)
-	tokenRepo := new(mocks.TokenRepoMock)
-	jwtMock := new(mocks.JWTGeneratorMock)
-	hasher := new(mocks.MockHasher)
-	mapper := new(mocks.MockMapper)
-	svc := service.NewAuthService(authRepo, userRepo, tokenRepo, jwtMock, hasher, mapper)
+	svc, m := newAuthService(t)
 
 	req := validLoginRequest()
 
-	mapper.
+	m.mapper.
 		On("ToLoginRequest", req).
 		Return(transport.LoginRequest{Email: req.GetEmail(), Password: req.GetPassword()})
 
-	authRepo.
+	m.attemptRepo.
+		On("LoginLockedUntil", mock.Anything, mock.Anything).
+		Return(time.Time{}, nil)
+
+	m.authRepo.
 		On("FetchUserByEmail", mock.Anything, req.Email).
 		Return(model.User{}, errs.ErrUserNotFound)
 
+	m.hasher.
+		On("HashPassword", mock.Anything).
+		Return("dummy-hash", nil)
+
+	m.hasher.
+		On("VerifyPassword", "dummy-hash", req.Password).
+		Return(errs.ErrInvalidPassword)
+
+	m.attemptRepo.
+		On("RecordLoginFailure", mock.Anything, mock.Anything, testdata.LoginThrottleConfig().FailureWindow).
+		Return(1, nil)
+
 	_, err := svc.Login(context.Background(), req)
 	st, _ := status.FromError(err)
-	assert.Equal(t, codes.NotFound, st.Code())
-	assert.Equal(t, errs.ErrUserNotFound.Error(), st.Message())
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+	assert.Equal(t, errs.ErrInvalidCredentials.Error(), st.Message())
+	m.hasher.AssertExpectations(t)
 }
 
 // TestLogin_InvalidPassword ensures that an incorrect password leads to
 // an Unauthenticated gRPC error.
 func TestLogin_InvalidPassword(t *testing.T) {
 	// Scenario: Login fails because the provided password is incorrect.
-	authRepo := new(mocks.AuthRepoMock)
-	userRepo := new(mocks.UserRepoMock)
-	tokenRepo := new(mocks.TokenRepoMock)
-	jwtMock := new(mocks.JWTGeneratorMock)
-	hasher := new(mocks.MockHasher)
-	mapper := new(mocks.MockMapper)
-	svc := service.NewAuthService(authRepo, userRepo, tokenRepo, jwtMock, hasher, mapper)
+	svc, m := newAuthService(t)
 
 	req := validLoginRequest()
 	user := testdata.SampleUserModel()
 
-	mapper.
+	m.mapper.
 		On("ToLoginRequest", req).
 		Return(transport.LoginRequest{Email: req.GetEmail(), Password: req.GetPassword()})
 
-	authRepo.
+	m.attemptRepo.
+		On("LoginLockedUntil", mock.Anything, mock.Anything).
+		Return(time.Time{}, nil)
+
+	m.authRepo.
 		On("FetchUserByEmail", mock.Anything, req.Email).
 		Return(user, nil)
 
-	hasher.
+	m.hasher.
 		On("VerifyPassword", user.PasswordHash, req.Password).
 		Return(errs.ErrInvalidPassword)
 
+	m.attemptRepo.
+		On("RecordLoginFailure", mock.Anything, mock.Anything, testdata.LoginThrottleConfig().FailureWindow).
+		Return(1, nil)
+
 	_, err := svc.Login(context.Background(), req)
 	st, _ := status.FromError(err)
 	assert.Equal(t, codes.Unauthenticated, st.Code())
-	assert.Equal(t, errs.ErrInvalidPassword.Error(), st.Message())
+	assert.Equal(t, errs.ErrInvalidCredentials.Error(), st.Message())
+	m.attemptRepo.AssertNotCalled(t, "LockLogin", mock.Anything, mock.Anything, mock.Anything)
 }
 
 // TestLogin_JWTGenerationFail ensures that a failure in token generation
 // results in an Internal gRPC error.
 func TestLogin_JWTGenerationFail(t *testing.T) {
 	// Scenario: Login fails because JWT creation errors out.
-	authRepo := new(mocks.AuthRepoMock)
-	userRepo := new(mocks.UserRepoMock)
-	tokenRepo := new(mocks.TokenRepoMock)
-	jwtMock := new(mocks.JWTGeneratorMock)
-	hasher := new(mocks.MockHasher)
-	mapper := new(mocks.MockMapper)
-	svc := service.NewAuthService(authRepo, userRepo, tokenRepo, jwtMock, hasher, mapper)
+	svc, m := newAuthService(t)
 
 	req := validLoginRequest()
 	user := testdata.SampleUserModel()
 
-	mapper.
+	m.mapper.
 		On("ToLoginRequest", req).
 		Return(transport.LoginRequest{Email: req.GetEmail(), Password: req.GetPassword()})
 
-	authRepo.
+	m.attemptRepo.
+		On("LoginLockedUntil", mock.Anything, mock.Anything).
+		Return(time.Time{}, nil)
+
+	m.authRepo.
 		On("FetchUserByEmail", mock.Anything, req.Email).
 		Return(user, nil)
 
-	hasher.
+	m.hasher.
 		On("VerifyPassword", user.PasswordHash, req.Password).
 		Return(nil)
 
-	jwtMock.
+	m.hasher.
+		On("NeedsRehash", user.PasswordHash).
+		Return(false)
+
+	m.attemptRepo.
+		On("ResetLoginFailures", mock.Anything, mock.Anything).
+		Return(nil)
+
+	m.mfaRepo.
+		On("GetTOTP", mock.Anything, user.ID).
+		Return(model.TOTPEnrollment{}, errs.ErrTOTPNotEnrolled)
+
+	m.jwtMock.
 		On("CreateTokenPair", mock.MatchedBy(func(input domain.CreateTokenPairInput) bool {
 			return input.UserID == user.ID && input.Nickname == user.Nickname
 		})).
@@ -243,3 +272,118 @@ func TestLogin_JWTGenerationFail(t *testing.T) {
 	assert.Equal(t, codes.Internal, st.Code())
 	assert.Equal(t, errs.ErrInternal.Error(), st.Message())
 }
+
+// TestLogin_RehashesOutdatedHash ensures that a successful login replaces a
+// hash created with an outdated algorithm or parameters.
+func TestLogin_RehashesOutdatedHash(t *testing.T) {
+	// Scenario: A user whose password is still stored as bcrypt signs in.
+	svc, m := newAuthService(t)
+
+	req := validLoginRequest()
+	user := testdata.SampleUserModel()
+	pair := testdata.ValidTokenPair()
+
+	m.mapper.On("ToLoginRequest", req).Return(transport.LoginRequest{Email: req.Email, Password: req.Password})
+	m.attemptRepo.On("LoginLockedUntil", mock.Anything, mock.Anything).Return(time.Time{}, nil)
+	m.authRepo.On("FetchUserByEmail", mock.Anything, req.Email).Return(user, nil)
+	m.hasher.On("VerifyPassword", user.PasswordHash, req.Password).Return(nil)
+	m.hasher.On("NeedsRehash", user.PasswordHash).Return(true)
+	m.hasher.On("HashPassword", req.Password).Return("$argon2id$new-hash", nil)
+	m.authRepo.On("UpdatePasswordHash", mock.Anything, user.ID, user.PasswordHash, "$argon2id$new-hash").Return(nil)
+	m.attemptRepo.On("ResetLoginFailures", mock.Anything, mock.Anything).Return(nil)
+	m.mfaRepo.On("GetTOTP", mock.Anything, user.ID).Return(model.TOTPEnrollment{}, errs.ErrTOTPNotEnrolled)
+	m.jwtMock.On("CreateTokenPair", mock.Anything).Return(pair, nil)
+	m.tokenRepo.On("SaveRefreshToken", mock.Anything, mock.Anything).Return(nil)
+	m.mapper.On("ToAuthTokenResponse", pair).Return(&userauthpb.AuthTokenResponse{})
+
+	_, err := svc.Login(context.Background(), req)
+
+	assert.NoError(t, err)
+	m.authRepo.AssertExpectations(t)
+}
+
+// TestLogin_RehashFailureDoesNotBlockLogin ensures that failing to store the
+// upgraded hash does not fail an otherwise valid login.
+func TestLogin_RehashFailureDoesNotBlockLogin(t *testing.T) {
+	// Scenario: The database rejects the re-hashed password.
+	svc, m := newAuthService(t)
+
+	req := validLoginRequest()
+	user := testdata.SampleUserModel()
+	pair := testdata.ValidTokenPair()
+
+	m.mapper.On("ToLoginRequest", req).Return(transport.LoginRequest{Email: req.Email, Password: req.Password})
+	m.attemptRepo.On("LoginLockedUntil", mock.Anything, mock.Anything).Return(time.Time{}, nil)
+	m.authRepo.On("FetchUserByEmail", mock.Anything, req.Email).Return(user, nil)
+	m.hasher.On("VerifyPassword", user.PasswordHash, req.Password).Return(nil)
+	m.hasher.On("NeedsRehash", user.PasswordHash).Return(true)
+	m.hasher.On("HashPassword", req.Password).Return("$argon2id$new-hash", nil)
+	m.authRepo.On("UpdatePasswordHash", mock.Anything, user.ID, user.PasswordHash, mock.Anything).Return(errs.ErrDBFailure)
+	m.attemptRepo.On("ResetLoginFailures", mock.Anything, mock.Anything).Return(nil)
+	m.mfaRepo.On("GetTOTP", mock.Anything, user.ID).Return(model.TOTPEnrollment{}, errs.ErrTOTPNotEnrolled)
+	m.jwtMock.On("CreateTokenPair", mock.Anything).Return(pair, nil)
+	m.tokenRepo.On("SaveRefreshToken", mock.Anything, mock.Anything).Return(nil)
+	m.mapper.On("ToAuthTokenResponse", pair).Return(&userauthpb.AuthTokenResponse{AccessToken: pair.AccessToken})
+
+	resp, err := svc.Login(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, pair.AccessToken, resp.AccessToken)
+}
+
+// TestLogin_EmbedsRoles ensures that the user's elevated roles are passed to
+// the token generator so that they end up in the access token.
+func TestLogin_EmbedsRoles(t *testing.T) {
+	// Scenario: A moderator signs in.
+	svc, m := newAuthService(t)
+
+	req := validLoginRequest()
+	user := testdata.SampleUserModel()
+	user.Roles = []string{"moderator"}
+	pair := testdata.ValidTokenPair()
+
+	m.mapper.On("ToLoginRequest", req).Return(transport.LoginRequest{Email: req.Email, Password: req.Password})
+	m.attemptRepo.On("LoginLockedUntil", mock.Anything, mock.Anything).Return(time.Time{}, nil)
+	m.authRepo.On("FetchUserByEmail", mock.Anything, req.Email).Return(user, nil)
+	m.hasher.On("VerifyPassword", user.PasswordHash, req.Password).Return(nil)
+	m.hasher.On("NeedsRehash", user.PasswordHash).Return(false)
+	m.attemptRepo.On("ResetLoginFailures", mock.Anything, mock.Anything).Return(nil)
+	m.mfaRepo.On("GetTOTP", mock.Anything, user.ID).Return(model.TOTPEnrollment{}, errs.ErrTOTPNotEnrolled)
+	m.jwtMock.On("CreateTokenPair", mock.MatchedBy(func(input domain.CreateTokenPairInput) bool {
+		return assert.ObjectsAreEqual([]string{"moderator"}, input.Roles)
+	})).Return(pair, nil)
+	m.tokenRepo.On("SaveRefreshToken", mock.Anything, mock.Anything).Return(nil)
+	m.mapper.On("ToAuthTokenResponse", pair).Return(&userauthpb.AuthTokenResponse{AccessToken: pair.AccessToken})
+
+	_, err := svc.Login(context.Background(), req)
+
+	assert.NoError(t, err)
+	m.jwtMock.AssertExpectations(t)
+}
+
+// TestLogin_SuspendedAccount ensures that a suspended account is refused with
+// PermissionDenied after the password check, without opening a session.
+func TestLogin_SuspendedAccount(t *testing.T) {
+	// Scenario: The credentials are correct but a moderator suspended the account.
+	svc, m := newAuthService(t)
+
+	req := validLoginRequest()
+	user := testdata.SampleUserModel()
+	user.SuspendedAt = time.Now().Add(-time.Hour)
+
+	m.mapper.On("ToLoginRequest", req).Return(transport.LoginRequest{Email: req.Email, Password: req.Password})
+	m.attemptRepo.On("LoginLockedUntil", mock.Anything, mock.Anything).Return(time.Time{}, nil)
+	m.authRepo.On("FetchUserByEmail", mock.Anything, req.Email).Return(user, nil)
+	m.hasher.On("VerifyPassword", user.PasswordHash, req.Password).Return(nil)
+	m.hasher.On("NeedsRehash", user.PasswordHash).Return(false)
+	m.attemptRepo.On("ResetLoginFailures", mock.Anything, mock.Anything).Return(nil)
+
+	resp, err := svc.Login(context.Background(), req)
+
+	assert.Nil(t, resp)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+	assert.Equal(t, errs.ErrAccountSuspended.Error(), st.Message())
+	m.jwtMock.AssertNotCalled(t, "CreateTokenPair", mock.Anything)
+	m.tokenRepo.AssertNotCalled(t, "SaveRefreshToken", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,145 @@
+// Package service_test verifies AuthService’s login brute-force protection:
+// lockouts, exponential backoff and per-IP tracking.
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/security"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/testdata"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
+)
+
+// accountKey returns the login attempt key for the email of validLoginRequest.
+func accountKey() model.LoginAttemptKey {
+	return model.LoginAttemptKey{Scope: model.LoginScopeAccount, KeyHash: security.HashToken(validLoginRequest().Email)}
+}
+
+// TestLogin_LockedAccountRejected ensures that a locked account or IP is
+// rejected before the password is checked.
+func TestLogin_LockedAccountRejected(t *testing.T) {
+	// Scenario: An attacker keeps guessing after the account was locked.
+	svc, m := newAuthService(t)
+
+	req := validLoginRequest()
+
+	m.mapper.On("ToLoginRequest", req).Return(transport.LoginRequest{Email: req.Email, Password: req.Password})
+	m.attemptRepo.On("LoginLockedUntil", mock.Anything, []model.LoginAttemptKey{accountKey()}).
+		Return(time.Now().Add(time.Minute), nil)
+
+	_, err := svc.Login(context.Background(), req)
+
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, err.Error(), errs.ErrLoginLocked.Error())
+	m.authRepo.AssertNotCalled(t, "FetchUserByEmail", mock.Anything, mock.Anything)
+	m.hasher.AssertNotCalled(t, "VerifyPassword", mock.Anything, mock.Anything)
+}
+
+// TestLogin_LocksAccountWithBackoff ensures that reaching the failure
+// threshold locks the account, doubling the lockout with each later failure.
+func TestLogin_LocksAccountWithBackoff(t *testing.T) {
+	cfg := testdata.LoginThrottleConfig()
+	cases := []struct {
+		name     string
+		failures int
+		lockout  time.Duration
+	}{
+		{name: "threshold reached", failures: cfg.MaxAccountFailures, lockout: cfg.BaseLockout},
+		{name: "two past threshold", failures: cfg.MaxAccountFailures + 2, lockout: 4 * cfg.BaseLockout},
+		{name: "capped", failures: cfg.MaxAccountFailures + 20, lockout: cfg.MaxLockout},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Scenario: A wrong password is entered after earlier failures.
+			svc, m := newAuthService(t)
+
+			req := validLoginRequest()
+			user := testdata.SampleUserModel()
+
+			var lockedUntil time.Time
+			m.mapper.On("ToLoginRequest", req).Return(transport.LoginRequest{Email: req.Email, Password: req.Password})
+			m.attemptRepo.On("LoginLockedUntil", mock.Anything, mock.Anything).Return(time.Time{}, nil)
+			m.authRepo.On("FetchUserByEmail", mock.Anything, req.Email).Return(user, nil)
+			m.hasher.On("VerifyPassword", user.PasswordHash, req.Password).Return(errs.ErrInvalidPassword)
+			m.attemptRepo.On("RecordLoginFailure", mock.Anything, accountKey(), cfg.FailureWindow).Return(tc.failures, nil)
+			m.attemptRepo.On("LockLogin", mock.Anything, accountKey(), mock.Anything).
+				Run(func(args mock.Arguments) { lockedUntil = args.Get(2).(time.Time) }).
+				Return(nil)
+
+			_, err := svc.Login(context.Background(), req)
+
+			assert.Equal(t, codes.Unauthenticated, status.Code(err))
+			assert.WithinDuration(t, time.Now().Add(tc.lockout), lockedUntil, 5*time.Second)
+		})
+	}
+}
+
+// TestLogin_TracksClientIP ensures that failures are also counted against the
+// client IP resolved by the client info interceptor, with its own higher
+// threshold.
+func TestLogin_TracksClientIP(t *testing.T) {
+	// Scenario: One client sprays passwords across many accounts.
+	cfg := testdata.LoginThrottleConfig()
+	svc, m := newAuthService(t)
+
+	req := validLoginRequest()
+	ipKey := model.LoginAttemptKey{Scope: model.LoginScopeIP, KeyHash: security.HashToken("198.51.100.4")}
+	ctx := utils.ContextWithClientInfo(context.Background(), domain.ClientInfo{IPAddress: "198.51.100.4"})
+
+	m.mapper.On("ToLoginRequest", req).Return(transport.LoginRequest{Email: req.Email, Password: req.Password})
+	m.attemptRepo.On("LoginLockedUntil", mock.Anything, []model.LoginAttemptKey{accountKey(), ipKey}).Return(time.Time{}, nil)
+	m.authRepo.On("FetchUserByEmail", mock.Anything, req.Email).Return(model.User{}, errs.ErrUserNotFound)
+	m.hasher.On("HashPassword", mock.Anything).Return("dummy-hash", nil)
+	m.hasher.On("VerifyPassword", "dummy-hash", req.Password).Return(errs.ErrInvalidPassword)
+	m.attemptRepo.On("RecordLoginFailure", mock.Anything, accountKey(), cfg.FailureWindow).Return(1, nil)
+	m.attemptRepo.On("RecordLoginFailure", mock.Anything, ipKey, cfg.FailureWindow).Return(cfg.MaxIPFailures, nil)
+	m.attemptRepo.On("LockLogin", mock.Anything, ipKey, mock.Anything).Return(nil)
+
+	_, err := svc.Login(ctx, req)
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Equal(t, errs.ErrInvalidCredentials.Error(), status.Convert(err).Message())
+	m.attemptRepo.AssertExpectations(t)
+	m.attemptRepo.AssertNotCalled(t, "LockLogin", mock.Anything, accountKey(), mock.Anything)
+}
+
+// TestLogin_SuccessResetsAccountFailures ensures that a successful login
+// clears the account's failure counter but not the client IP's.
+func TestLogin_SuccessResetsAccountFailures(t *testing.T) {
+	// Scenario: The owner signs in after mistyping their password.
+	svc, m := newAuthService(t)
+
+	req := validLoginRequest()
+	user := testdata.SampleUserModel()
+	user.Email = req.Email
+	ctx := utils.ContextWithClientInfo(context.Background(), domain.ClientInfo{IPAddress: "198.51.100.4"})
+
+	m.mapper.On("ToLoginRequest", req).Return(transport.LoginRequest{Email: req.Email, Password: req.Password})
+	m.attemptRepo.On("LoginLockedUntil", mock.Anything, mock.Anything).Return(time.Time{}, nil)
+	m.authRepo.On("FetchUserByEmail", mock.Anything, req.Email).Return(user, nil)
+	m.hasher.On("VerifyPassword", user.PasswordHash, req.Password).Return(nil)
+	m.hasher.On("NeedsRehash", user.PasswordHash).Return(false)
+	m.attemptRepo.On("ResetLoginFailures", mock.Anything, accountKey()).Return(nil)
+	m.mfaRepo.On("GetTOTP", mock.Anything, user.ID).Return(model.TOTPEnrollment{}, errs.ErrTOTPNotEnrolled)
+	m.jwtMock.On("CreateTokenPair", mock.Anything).Return(testdata.ValidTokenPair(), nil)
+	m.tokenRepo.On("SaveRefreshToken", mock.Anything, mock.Anything).Return(nil)
+	m.mapper.On("ToAuthTokenResponse", mock.Anything).Return(&userauthpb.AuthTokenResponse{})
+
+	_, err := svc.Login(ctx, req)
+
+	assert.NoError(t, err)
+	m.attemptRepo.AssertNumberOfCalls(t, "ResetLoginFailures", 1)
+}
@@ -13,8 +13,6 @@ import (
 	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
-	"github.com/mamataliev-dev/social-platform/services/user-service/internal/service"
-	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/mocks"
 )
 
 // validLogoutRequest returns a RefreshTokenPayload with a sample token.
@@ -28,21 +26,15 @@ func validLogoutRequest() *userauthpb.RefreshTokenPayload {
 // token and returns a success message.
 func TestLogout_Success(t *testing.T) {
 	// Scenario: A user successfully logs out, and the token is revoked.
-	authRepo := new(mocks.AuthRepoMock)
-	userRepo := new(mocks.UserRepoMock)
-	tokenRepo := new(mocks.TokenRepoMock)
-	jwtMock := new(mocks.JWTGeneratorMock)
-	hasher := new(mocks.MockHasher)
-	mapper := new(mocks.MockMapper)
-	svc := service.NewAuthService(authRepo, userRepo, tokenRepo, jwtMock, hasher, mapper)
+	svc, m := newAuthService(t)
 
 	req := validLogoutRequest()
 
-	mapper.On("ToGetRefreshTokenRequest", req).Return(transport.RefreshTokenRequest{
+	m.mapper.On("ToGetRefreshTokenRequest", req).Return(transport.RefreshTokenRequest{
 		RefreshToken: req.GetRefreshToken(),
 	})
-	tokenRepo.On("DeleteRefreshToken", mock.Anything, mock.Anything).Return(nil)
-	mapper.On("ToLogoutResponse", mock.Anything).Return(&userauthpb.LogoutResponse{
+	m.tokenRepo.On("DeleteRefreshToken", mock.Anything, mock.Anything).Return(nil)
+	m.mapper.On("ToLogoutResponse", mock.Anything).Return(&userauthpb.LogoutResponse{
 		Message: "Logout successful",
 	})
 
@@ -55,20 +47,14 @@ func TestLogout_Success(t *testing.T) {
 // token results in a NotFound gRPC error.
 func TestLogout_TokenNotFound(t *testing.T) {
 	// Scenario: A user attempts to log out with a token that is not in the repository.
-	authRepo := new(mocks.AuthRepoMock)
-	userRepo := new(mocks.UserRepoMock)
-	tokenRepo := new(mocks.TokenRepoMock)
-	jwtMock := new(mocks.JWTGeneratorMock)
-	hasher := new(mocks.MockHasher)
-	mapper := new(mocks.MockMapper)
-	svc := service.NewAuthService(authRepo, userRepo, tokenRepo, jwtMock, hasher, mapper)
+	svc, m := newAuthService(t)
 
 	req := validLogoutRequest()
 
-	mapper.On("ToGetRefreshTokenRequest", req).Return(transport.RefreshTokenRequest{
+	m.mapper.On("ToGetRefreshTokenRequest", req).Return(transport.RefreshTokenRequest{
 		RefreshToken: req.GetRefreshToken(),
 	})
-	tokenRepo.On("DeleteRefreshToken", mock.Anything, mock.Anything).Return(errs.ErrTokenNotFound)
+	m.tokenRepo.On("DeleteRefreshToken", mock.Anything, mock.Anything).Return(errs.ErrTokenNotFound)
 
 	_, err := svc.Logout(context.Background(), req)
 	st, _ := status.FromError(err)
@@ -80,20 +66,14 @@ func TestLogout_TokenNotFound(t *testing.T) {
 // deletion results in an Internal gRPC error.
 func TestLogout_InternalError(t *testing.T) {
 	// Scenario: An unexpected internal error occurs during token revocation.
-	authRepo := new(mocks.AuthRepoMock)
-	userRepo := new(mocks.UserRepoMock)
-	tokenRepo := new(mocks.TokenRepoMock)
-	jwtMock := new(mocks.JWTGeneratorMock)
-	hasher := new(mocks.MockHasher)
-	mapper := new(mocks.MockMapper)
-	svc := service.NewAuthService(authRepo, userRepo, tokenRepo, jwtMock, hasher, mapper)
+	svc, m := newAuthService(t)
 
 	req := validLogoutRequest()
 
-	mapper.On("ToGetRefreshTokenRequest", req).Return(transport.RefreshTokenRequest{
+	m.mapper.On("ToGetRefreshTokenRequest", req).Return(transport.RefreshTokenRequest{
 		RefreshToken: req.GetRefreshToken(),
 	})
-	tokenRepo.On("DeleteRefreshToken", mock.Anything, mock.Anything).Return(errs.ErrInternal)
+	m.tokenRepo.On("DeleteRefreshToken", mock.Anything, mock.Anything).Return(errs.ErrInternal)
 
 	_, err := svc.Logout(context.Background(), req)
 	st, _ := status.FromError(err)
@@ -0,0 +1,438 @@
+// Package service_test verifies the behavior of AuthService’s TOTP two-factor
+// authentication: enrollment, confirmation, deactivation and MFA login.
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/security"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/testdata"
+)
+
+// testTOTPSecret is the RFC 6238 reference secret ("12345678901234567890").
+const testTOTPSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+// currentTOTPCode returns the code an authenticator would show right now.
+func currentTOTPCode(t *testing.T) string {
+	t.Helper()
+	code, err := security.TOTPCode(testTOTPSecret, security.TOTPStep(time.Now()))
+	require.NoError(t, err)
+	return code
+}
+
+// confirmedEnrollment returns an enabled TOTP enrollment for the sample user.
+func confirmedEnrollment() model.TOTPEnrollment {
+	return model.TOTPEnrollment{UserID: 1, Secret: testTOTPSecret, Confirmed: true}
+}
+
+// TestLogin_MFAEnabledReturnsChallenge ensures that a correct password for an
+// account with TOTP enabled yields an MFA challenge instead of tokens.
+func TestLogin_MFAEnabledReturnsChallenge(t *testing.T) {
+	// Scenario: A user with two-factor authentication signs in.
+	svc, m := newAuthService(t)
+
+	req := validLoginRequest()
+	user := testdata.SampleUserModel()
+
+	var saved domain.SaveMFAChallengeInput
+	var issued string
+	m.mapper.On("ToLoginRequest", req).Return(transport.LoginRequest{Email: req.Email, Password: req.Password})
+	m.attemptRepo.On("LoginLockedUntil", mock.Anything, mock.Anything).Return(time.Time{}, nil)
+	m.attemptRepo.On("ResetLoginFailures", mock.Anything, mock.Anything).Return(nil)
+	m.authRepo.On("FetchUserByEmail", mock.Anything, req.Email).Return(user, nil)
+	m.hasher.On("VerifyPassword", user.PasswordHash, req.Password).Return(nil)
+	m.hasher.On("NeedsRehash", user.PasswordHash).Return(false)
+	m.mfaRepo.On("GetTOTP", mock.Anything, user.ID).Return(confirmedEnrollment(), nil)
+	m.mfaRepo.On("SaveMFAChallenge", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { saved = args.Get(1).(domain.SaveMFAChallengeInput) }).
+		Return(nil)
+	m.mapper.On("ToMFAChallengeResponse", mock.Anything).
+		Run(func(args mock.Arguments) { issued = args.String(0) }).
+		Return(&userauthpb.AuthTokenResponse{MfaRequired: true})
+
+	resp, err := svc.Login(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.True(t, resp.MfaRequired)
+	assert.Empty(t, resp.AccessToken)
+	assert.Equal(t, user.ID, saved.UserID)
+	assert.Equal(t, security.HashToken(issued), saved.TokenHash)
+	assert.WithinDuration(t, time.Now().Add(5*time.Minute), saved.ExpiresAt, 2*time.Second)
+	m.jwtMock.AssertNotCalled(t, "CreateTokenPair", mock.Anything)
+	m.tokenRepo.AssertNotCalled(t, "SaveRefreshToken", mock.Anything, mock.Anything)
+}
+
+// TestLogin_PendingEnrollmentIssuesTokens ensures that an unconfirmed TOTP
+// enrollment does not yet require a second factor.
+func TestLogin_PendingEnrollmentIssuesTokens(t *testing.T) {
+	// Scenario: The user started TOTP enrollment but never confirmed it.
+	svc, m := newAuthService(t)
+
+	req := validLoginRequest()
+	user := testdata.SampleUserModel()
+	pair := testdata.ValidTokenPair()
+	pending := confirmedEnrollment()
+	pending.Confirmed = false
+
+	m.mapper.On("ToLoginRequest", req).Return(transport.LoginRequest{Email: req.Email, Password: req.Password})
+	m.attemptRepo.On("LoginLockedUntil", mock.Anything, mock.Anything).Return(time.Time{}, nil)
+	m.attemptRepo.On("ResetLoginFailures", mock.Anything, mock.Anything).Return(nil)
+	m.authRepo.On("FetchUserByEmail", mock.Anything, req.Email).Return(user, nil)
+	m.hasher.On("VerifyPassword", user.PasswordHash, req.Password).Return(nil)
+	m.hasher.On("NeedsRehash", user.PasswordHash).Return(false)
+	m.mfaRepo.On("GetTOTP", mock.Anything, user.ID).Return(pending, nil)
+	m.jwtMock.On("CreateTokenPair", mock.Anything).Return(pair, nil)
+	m.tokenRepo.On("SaveRefreshToken", mock.Anything, mock.Anything).Return(nil)
+	m.mapper.On("ToAuthTokenResponse", pair).Return(&userauthpb.AuthTokenResponse{AccessToken: pair.AccessToken})
+
+	resp, err := svc.Login(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.Equal(t, pair.AccessToken, resp.AccessToken)
+}
+
+// TestVerifyMFA_WithTOTPCode ensures that a valid challenge and TOTP code
+// complete the login with a new session.
+func TestVerifyMFA_WithTOTPCode(t *testing.T) {
+	// Scenario: The user enters the code from their authenticator app.
+	svc, m := newAuthService(t)
+
+	req := &userauthpb.VerifyMFARequest{MfaToken: "mfa-challenge-token", Code: currentTOTPCode(t)}
+	challengeHash := security.HashToken(req.MfaToken)
+	profile := testdata.UserProfileResponse()
+	pair := testdata.ValidTokenPair()
+
+	m.mapper.On("ToVerifyMFARequest", req).Return(transport.VerifyMFARequest{MFAToken: req.MfaToken, Code: req.Code})
+	m.mfaRepo.On("ClaimMFAChallengeAttempt", mock.Anything, challengeHash, testdata.MFAConfig().MaxChallengeAttempts).
+		Return(model.MFAChallenge{UserID: 1, Attempts: 1}, nil)
+	m.attemptRepo.On("LoginLockedUntil", mock.Anything, mock.Anything).Return(time.Time{}, nil)
+	m.attemptRepo.On("ResetLoginFailures", mock.Anything, mock.Anything).Return(nil)
+	m.mfaRepo.On("GetTOTP", mock.Anything, int64(1)).Return(confirmedEnrollment(), nil)
+	m.mfaRepo.On("MarkTOTPStepUsed", mock.Anything, int64(1), mock.AnythingOfType("int64")).Return(nil)
+	m.mfaRepo.On("DeleteMFAChallenge", mock.Anything, challengeHash).Return(nil)
+	m.userRepo.On("FetchUserByID", mock.Anything, transport.FetchUserByIDRequest{UserId: 1}).Return(profile, nil)
+	m.jwtMock.On("CreateTokenPair", mock.MatchedBy(func(input domain.CreateTokenPairInput) bool {
+		return input.UserID == profile.ID && input.SessionID != ""
+	})).Return(pair, nil)
+	m.tokenRepo.On("SaveRefreshToken", mock.Anything, mock.MatchedBy(func(input domain.SaveRefreshTokenInput) bool {
+		return input.TokenHash == security.HashToken(pair.RefreshToken)
+	})).Return(nil)
+	m.mapper.On("ToAuthTokenResponse", pair).Return(&userauthpb.AuthTokenResponse{AccessToken: pair.AccessToken})
+
+	resp, err := svc.VerifyMFA(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.Equal(t, pair.AccessToken, resp.AccessToken)
+	m.mfaRepo.AssertNotCalled(t, "ConsumeRecoveryCode", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestVerifyMFA_WithRecoveryCode ensures that a recovery code is accepted in
+// place of a TOTP code, regardless of case and dashes.
+func TestVerifyMFA_WithRecoveryCode(t *testing.T) {
+	// Scenario: The user lost their phone and uses a recovery code.
+	svc, m := newAuthService(t)
+
+	req := &userauthpb.VerifyMFARequest{MfaToken: "mfa-challenge-token", Code: "ABCDEFGH-IJKLMNOP"}
+	challengeHash := security.HashToken(req.MfaToken)
+	pair := testdata.ValidTokenPair()
+
+	m.mapper.On("ToVerifyMFARequest", req).Return(transport.VerifyMFARequest{MFAToken: req.MfaToken, Code: req.Code})
+	m.mfaRepo.On("ClaimMFAChallengeAttempt", mock.Anything, challengeHash, testdata.MFAConfig().MaxChallengeAttempts).
+		Return(model.MFAChallenge{UserID: 1, Attempts: 1}, nil)
+	m.attemptRepo.On("LoginLockedUntil", mock.Anything, mock.Anything).Return(time.Time{}, nil)
+	m.attemptRepo.On("ResetLoginFailures", mock.Anything, mock.Anything).Return(nil)
+	m.mfaRepo.On("GetTOTP", mock.Anything, int64(1)).Return(confirmedEnrollment(), nil)
+	m.mfaRepo.On("ConsumeRecoveryCode", mock.Anything, int64(1), security.HashToken("abcdefghijklmnop")).Return(nil)
+	m.mfaRepo.On("DeleteMFAChallenge", mock.Anything, challengeHash).Return(nil)
+	m.userRepo.On("FetchUserByID", mock.Anything, transport.FetchUserByIDRequest{UserId: 1}).Return(testdata.UserProfileResponse(), nil)
+	m.jwtMock.On("CreateTokenPair", mock.Anything).Return(pair, nil)
+	m.tokenRepo.On("SaveRefreshToken", mock.Anything, mock.Anything).Return(nil)
+	m.mapper.On("ToAuthTokenResponse", pair).Return(&userauthpb.AuthTokenResponse{AccessToken: pair.AccessToken})
+
+	_, err := svc.VerifyMFA(context.Background(), req)
+
+	require.NoError(t, err)
+	m.mfaRepo.AssertExpectations(t)
+}
+
+// TestVerifyMFA_WrongCode ensures that a wrong code is counted as a failed
+// login of the account and rejected without issuing tokens.
+func TestVerifyMFA_WrongCode(t *testing.T) {
+	// Scenario: The user mistypes their TOTP code.
+	svc, m := newAuthService(t)
+
+	req := &userauthpb.VerifyMFARequest{MfaToken: "mfa-challenge-token", Code: "000000"}
+	challengeHash := security.HashToken(req.MfaToken)
+
+	m.mapper.On("ToVerifyMFARequest", req).Return(transport.VerifyMFARequest{MFAToken: req.MfaToken, Code: req.Code})
+	m.mfaRepo.On("ClaimMFAChallengeAttempt", mock.Anything, challengeHash, testdata.MFAConfig().MaxChallengeAttempts).
+		Return(model.MFAChallenge{UserID: 1, Attempts: 1}, nil)
+	m.userRepo.On("FetchUserByID", mock.Anything, transport.FetchUserByIDRequest{UserId: 1}).Return(testdata.UserProfileResponse(), nil)
+	m.attemptRepo.On("LoginLockedUntil", mock.Anything, mock.Anything).Return(time.Time{}, nil)
+	m.attemptRepo.On("RecordLoginFailure", mock.Anything, mock.Anything, mock.Anything).Return(1, nil)
+	m.mfaRepo.On("GetTOTP", mock.Anything, int64(1)).Return(confirmedEnrollment(), nil)
+	m.mfaRepo.On("ConsumeRecoveryCode", mock.Anything, int64(1), mock.Anything).Return(errs.ErrInvalidMFACode)
+
+	_, err := svc.VerifyMFA(context.Background(), req)
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+	assert.Equal(t, errs.ErrInvalidMFACode.Error(), st.Message())
+	m.mfaRepo.AssertExpectations(t)
+	m.attemptRepo.AssertCalled(t, "RecordLoginFailure", mock.Anything, model.LoginAttemptKey{
+		Scope:   model.LoginScopeAccount,
+		KeyHash: security.HashToken(testdata.UserProfileResponse().Email),
+	}, testdata.LoginThrottleConfig().FailureWindow)
+	m.mfaRepo.AssertNotCalled(t, "DeleteMFAChallenge", mock.Anything, mock.Anything)
+	m.jwtMock.AssertNotCalled(t, "CreateTokenPair", mock.Anything)
+}
+
+// TestVerifyMFA_ReplayedTOTPCode ensures that a TOTP code cannot be used twice.
+func TestVerifyMFA_ReplayedTOTPCode(t *testing.T) {
+	// Scenario: An attacker replays a code the user already entered.
+	svc, m := newAuthService(t)
+
+	req := &userauthpb.VerifyMFARequest{MfaToken: "mfa-challenge-token", Code: currentTOTPCode(t)}
+	challengeHash := security.HashToken(req.MfaToken)
+
+	m.mapper.On("ToVerifyMFARequest", req).Return(transport.VerifyMFARequest{MFAToken: req.MfaToken, Code: req.Code})
+	m.mfaRepo.On("ClaimMFAChallengeAttempt", mock.Anything, challengeHash, testdata.MFAConfig().MaxChallengeAttempts).
+		Return(model.MFAChallenge{UserID: 1, Attempts: 1}, nil)
+	m.userRepo.On("FetchUserByID", mock.Anything, transport.FetchUserByIDRequest{UserId: 1}).Return(testdata.UserProfileResponse(), nil)
+	m.attemptRepo.On("LoginLockedUntil", mock.Anything, mock.Anything).Return(time.Time{}, nil)
+	m.attemptRepo.On("RecordLoginFailure", mock.Anything, mock.Anything, mock.Anything).Return(1, nil)
+	m.mfaRepo.On("GetTOTP", mock.Anything, int64(1)).Return(confirmedEnrollment(), nil)
+	m.mfaRepo.On("MarkTOTPStepUsed", mock.Anything, int64(1), mock.AnythingOfType("int64")).Return(errs.ErrInvalidMFACode)
+
+	_, err := svc.VerifyMFA(context.Background(), req)
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+	m.jwtMock.AssertNotCalled(t, "CreateTokenPair", mock.Anything)
+}
+
+// TestVerifyMFA_InvalidChallenge ensures that an unknown or expired challenge
+// token is rejected before any code is checked.
+func TestVerifyMFA_InvalidChallenge(t *testing.T) {
+	// Scenario: The challenge expired before the user entered their code.
+	svc, m := newAuthService(t)
+
+	req := &userauthpb.VerifyMFARequest{MfaToken: "expired-token", Code: "123456"}
+
+	m.mapper.On("ToVerifyMFARequest", req).Return(transport.VerifyMFARequest{MFAToken: req.MfaToken, Code: req.Code})
+	m.mfaRepo.On("ClaimMFAChallengeAttempt", mock.Anything, security.HashToken(req.MfaToken), mock.Anything).
+		Return(model.MFAChallenge{}, errs.ErrInvalidMFAChallenge)
+
+	_, err := svc.VerifyMFA(context.Background(), req)
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+	assert.Equal(t, errs.ErrInvalidMFAChallenge.Error(), st.Message())
+	m.mfaRepo.AssertNotCalled(t, "GetTOTP", mock.Anything, mock.Anything)
+}
+
+// TestEnrollTOTP_Success ensures that enrollment stores a pending secret and
+// returns it with an otpauth URI labelled with the user's email.
+func TestEnrollTOTP_Success(t *testing.T) {
+	// Scenario: A signed-in user starts setting up an authenticator app.
+	svc, m := newAuthService(t)
+
+	profile := testdata.UserProfileResponse()
+	var stored string
+	var mapped transport.EnrollTOTPResponse
+
+	m.userRepo.On("FetchUserByID", mock.Anything, transport.FetchUserByIDRequest{UserId: 1}).Return(profile, nil)
+	m.mfaRepo.On("SaveTOTPSecret", mock.Anything, int64(1), mock.Anything).
+		Run(func(args mock.Arguments) { stored = args.String(2) }).
+		Return(nil)
+	m.mapper.On("ToEnrollTOTPResponse", mock.Anything).
+		Run(func(args mock.Arguments) { mapped = args.Get(0).(transport.EnrollTOTPResponse) }).
+		Return(&userauthpb.EnrollTOTPResponse{})
+
+	_, err := svc.EnrollTOTP(callerContext(), &userauthpb.EnrollTOTPRequest{})
+
+	require.NoError(t, err)
+	assert.Len(t, stored, 32)
+	assert.Equal(t, stored, mapped.Secret)
+	assert.True(t, strings.HasPrefix(mapped.OTPAuthURI, "otpauth://totp/Social%20Platform:test@example.com?"))
+	assert.Contains(t, mapped.OTPAuthURI, "secret="+stored)
+}
+
+// TestEnrollTOTP_AlreadyEnabled ensures that enrolling again once TOTP is
+// enabled is refused, so the active secret cannot be silently replaced.
+func TestEnrollTOTP_AlreadyEnabled(t *testing.T) {
+	// Scenario: A user with TOTP enabled calls EnrollTOTP again.
+	svc, m := newAuthService(t)
+
+	m.userRepo.On("FetchUserByID", mock.Anything, mock.Anything).Return(testdata.UserProfileResponse(), nil)
+	m.mfaRepo.On("SaveTOTPSecret", mock.Anything, int64(1), mock.Anything).Return(errs.ErrTOTPAlreadyEnabled)
+
+	_, err := svc.EnrollTOTP(callerContext(), &userauthpb.EnrollTOTPRequest{})
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	assert.Equal(t, errs.ErrTOTPAlreadyEnabled.Error(), st.Message())
+}
+
+// TestConfirmTOTP_Success ensures that a valid code enables TOTP and that the
+// returned recovery codes are stored only as digests.
+func TestConfirmTOTP_Success(t *testing.T) {
+	// Scenario: The user enters the first code shown by their new authenticator.
+	svc, m := newAuthService(t)
+
+	pending := confirmedEnrollment()
+	pending.Confirmed = false
+	var storedHashes []string
+	var mapped transport.ConfirmTOTPResponse
+
+	m.mfaRepo.On("GetTOTP", mock.Anything, int64(1)).Return(pending, nil)
+	m.mfaRepo.On("EnableTOTP", mock.Anything, int64(1), security.TOTPStep(time.Now()), mock.Anything).
+		Run(func(args mock.Arguments) { storedHashes = args.Get(3).([]string) }).
+		Return(nil)
+	m.mapper.On("ToConfirmTOTPResponse", mock.Anything).
+		Run(func(args mock.Arguments) { mapped = args.Get(0).(transport.ConfirmTOTPResponse) }).
+		Return(&userauthpb.ConfirmTOTPResponse{})
+
+	_, err := svc.ConfirmTOTP(callerContext(), &userauthpb.ConfirmTOTPRequest{Code: currentTOTPCode(t)})
+
+	require.NoError(t, err)
+	require.Len(t, mapped.RecoveryCodes, testdata.MFAConfig().RecoveryCodes)
+	require.Len(t, storedHashes, len(mapped.RecoveryCodes))
+	for i, code := range mapped.RecoveryCodes {
+		assert.Equal(t, security.HashToken(security.NormalizeRecoveryCode(code)), storedHashes[i])
+		assert.NotContains(t, storedHashes, code)
+	}
+}
+
+// TestConfirmTOTP_WrongCode ensures that TOTP stays disabled when the code
+// does not match the pending secret.
+func TestConfirmTOTP_WrongCode(t *testing.T) {
+	// Scenario: The user typed a code from a different account.
+	svc, m := newAuthService(t)
+
+	pending := confirmedEnrollment()
+	pending.Confirmed = false
+	m.mfaRepo.On("GetTOTP", mock.Anything, int64(1)).Return(pending, nil)
+
+	_, err := svc.ConfirmTOTP(callerContext(), &userauthpb.ConfirmTOTPRequest{Code: "000000"})
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, errs.ErrInvalidMFACode.Error(), st.Message())
+	m.mfaRepo.AssertNotCalled(t, "EnableTOTP", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestConfirmTOTP_NotEnrolled ensures that confirming without a pending
+// enrollment is a failed precondition.
+func TestConfirmTOTP_NotEnrolled(t *testing.T) {
+	// Scenario: ConfirmTOTP is called before EnrollTOTP.
+	svc, m := newAuthService(t)
+
+	m.mfaRepo.On("GetTOTP", mock.Anything, int64(1)).Return(model.TOTPEnrollment{}, errs.ErrTOTPNotEnrolled)
+
+	_, err := svc.ConfirmTOTP(callerContext(), &userauthpb.ConfirmTOTPRequest{Code: "123456"})
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	assert.Equal(t, errs.ErrTOTPNotEnrolled.Error(), st.Message())
+}
+
+// TestDisableTOTP_Success ensures that a valid code turns off TOTP.
+func TestDisableTOTP_Success(t *testing.T) {
+	// Scenario: The user disables two-factor authentication.
+	svc, m := newAuthService(t)
+
+	expected := &userauthpb.DisableTOTPResponse{Message: "Two-factor authentication disabled"}
+	m.userRepo.On("FetchUserByID", mock.Anything, transport.FetchUserByIDRequest{UserId: 1}).Return(testdata.UserProfileResponse(), nil)
+	m.attemptRepo.On("LoginLockedUntil", mock.Anything, mock.Anything).Return(time.Time{}, nil)
+	m.mfaRepo.On("GetTOTP", mock.Anything, int64(1)).Return(confirmedEnrollment(), nil)
+	m.mfaRepo.On("MarkTOTPStepUsed", mock.Anything, int64(1), mock.AnythingOfType("int64")).Return(nil)
+	m.mfaRepo.On("DeleteTOTP", mock.Anything, int64(1)).Return(nil)
+	m.mapper.On("ToDisableTOTPResponse", transport.DisableTOTPResponse{Message: expected.Message}).Return(expected)
+
+	resp, err := svc.DisableTOTP(callerContext(), &userauthpb.DisableTOTPRequest{Code: currentTOTPCode(t)})
+
+	require.NoError(t, err)
+	assert.Equal(t, expected, resp)
+}
+
+// TestDisableTOTP_WrongCode ensures that TOTP cannot be disabled with a bad
+// code and that the guess counts as a failed login of the account.
+func TestDisableTOTP_WrongCode(t *testing.T) {
+	// Scenario: Someone with a stolen access token tries to turn off 2FA.
+	svc, m := newAuthService(t)
+
+	m.userRepo.On("FetchUserByID", mock.Anything, transport.FetchUserByIDRequest{UserId: 1}).Return(testdata.UserProfileResponse(), nil)
+	m.attemptRepo.On("LoginLockedUntil", mock.Anything, mock.Anything).Return(time.Time{}, nil)
+	m.attemptRepo.On("RecordLoginFailure", mock.Anything, mock.Anything, mock.Anything).Return(1, nil)
+	m.mfaRepo.On("GetTOTP", mock.Anything, int64(1)).Return(confirmedEnrollment(), nil)
+	m.mfaRepo.On("ConsumeRecoveryCode", mock.Anything, int64(1), mock.Anything).Return(errs.ErrInvalidMFACode)
+
+	_, err := svc.DisableTOTP(callerContext(), &userauthpb.DisableTOTPRequest{Code: "not-a-code"})
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	m.mfaRepo.AssertNotCalled(t, "DeleteTOTP", mock.Anything, mock.Anything)
+	m.attemptRepo.AssertNumberOfCalls(t, "RecordLoginFailure", 1)
+}
+
+// TestVerifyMFA_LockedAccount ensures that codes are not checked while the
+// account is locked, however many challenges the caller obtains.
+func TestVerifyMFA_LockedAccount(t *testing.T) {
+	// Scenario: An attacker who knows the password keeps requesting challenges
+	// to guess TOTP codes.
+	svc, m := newAuthService(t)
+
+	req := &userauthpb.VerifyMFARequest{MfaToken: "mfa-challenge-token", Code: "123456"}
+
+	m.mapper.On("ToVerifyMFARequest", req).Return(transport.VerifyMFARequest{MFAToken: req.MfaToken, Code: req.Code})
+	m.mfaRepo.On("ClaimMFAChallengeAttempt", mock.Anything, security.HashToken(req.MfaToken), mock.Anything).
+		Return(model.MFAChallenge{UserID: 1, Attempts: 1}, nil)
+	m.userRepo.On("FetchUserByID", mock.Anything, transport.FetchUserByIDRequest{UserId: 1}).Return(testdata.UserProfileResponse(), nil)
+	m.attemptRepo.On("LoginLockedUntil", mock.Anything, mock.Anything).Return(time.Now().Add(time.Minute), nil)
+
+	_, err := svc.VerifyMFA(context.Background(), req)
+
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	m.mfaRepo.AssertNotCalled(t, "GetTOTP", mock.Anything, mock.Anything)
+	m.mfaRepo.AssertNotCalled(t, "ConsumeRecoveryCode", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestLogin_MFAChallengeKeepsAccountFailures ensures that a correct password
+// alone does not forgive failed second-factor attempts.
+func TestLogin_MFAChallengeKeepsAccountFailures(t *testing.T) {
+	// Scenario: An attacker who knows the password signs in again to get a
+	// fresh challenge after guessing wrong codes.
+	svc, m := newAuthService(t)
+
+	req := validLoginRequest()
+	user := testdata.SampleUserModel()
+
+	m.mapper.On("ToLoginRequest", req).Return(transport.LoginRequest{Email: req.Email, Password: req.Password})
+	m.attemptRepo.On("LoginLockedUntil", mock.Anything, mock.Anything).Return(time.Time{}, nil)
+	m.authRepo.On("FetchUserByEmail", mock.Anything, req.Email).Return(user, nil)
+	m.hasher.On("VerifyPassword", user.PasswordHash, req.Password).Return(nil)
+	m.hasher.On("NeedsRehash", user.PasswordHash).Return(false)
+	m.mfaRepo.On("GetTOTP", mock.Anything, user.ID).Return(confirmedEnrollment(), nil)
+	m.mfaRepo.On("SaveMFAChallenge", mock.Anything, mock.Anything).Return(nil)
+	m.mapper.On("ToMFAChallengeResponse", mock.Anything).Return(&userauthpb.AuthTokenResponse{MfaRequired: true})
+
+	_, err := svc.Login(context.Background(), req)
+
+	require.NoError(t, err)
+	m.attemptRepo.AssertNotCalled(t, "ResetLoginFailures", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,393 @@
+// Package service_test verifies the behavior of AuthService’s OpenID Connect
+// social login against an in-process fake provider.
+package service_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/config"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/oidc"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/security"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/service"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/mocks"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/testdata"
+)
+
+const testOAuthProvider = "fake"
+
+// oauthFixture bundles an AuthService wired to a fake OpenID Connect provider
+// with the mocks the social login flow touches.
+type oauthFixture struct {
+	svc          *service.AuthService
+	provider     *mocks.FakeOIDCProvider
+	authRepo     *mocks.AuthRepoMock
+	tokenRepo    *mocks.TokenRepoMock
+	mfaRepo      *mocks.MFARepoMock
+	identityRepo *mocks.IdentityRepoMock
+	attemptRepo  *mocks.LoginAttemptRepoMock
+	jwtMock      *mocks.JWTGeneratorMock
+	mapper       *mocks.MockMapper
+}
+
+func newOAuthFixture(t *testing.T) *oauthFixture {
+	t.Helper()
+
+	provider := mocks.NewFakeOIDCProvider(t)
+	registry := oidc.NewRegistry([]config.OIDCProvider{provider.Config(testOAuthProvider)}, http.DefaultClient)
+	svc, m := newAuthService(t, func(deps *service.AuthDeps) { deps.OAuth = registry })
+
+	return &oauthFixture{
+		svc:          svc,
+		provider:     provider,
+		authRepo:     m.authRepo,
+		tokenRepo:    m.tokenRepo,
+		mfaRepo:      m.mfaRepo,
+		identityRepo: m.identityRepo,
+		attemptRepo:  m.attemptRepo,
+		jwtMock:      m.jwtMock,
+		mapper:       m.mapper,
+	}
+}
+
+// begin starts a login and returns the authorization URL, the raw state and
+// the pending login the service stored.
+func (f *oauthFixture) begin(t *testing.T) (string, string, domain.SaveOAuthStateInput) {
+	t.Helper()
+
+	var saved domain.SaveOAuthStateInput
+	var resp transport.BeginOAuthLoginResponse
+	f.identityRepo.On("SaveOAuthState", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { saved = args.Get(1).(domain.SaveOAuthStateInput) }).
+		Return(nil).Once()
+	f.mapper.On("ToBeginOAuthLoginResponse", mock.Anything).
+		Run(func(args mock.Arguments) { resp = args.Get(0).(transport.BeginOAuthLoginResponse) }).
+		Return(&userauthpb.BeginOAuthLoginResponse{}).Once()
+
+	_, err := f.svc.BeginOAuthLogin(context.Background(), &userauthpb.BeginOAuthLoginRequest{Provider: testOAuthProvider})
+	require.NoError(t, err)
+	return resp.AuthorizationURL, resp.State, saved
+}
+
+// complete finishes the login started by begin for identity, redeeming the
+// stored pending login.
+func (f *oauthFixture) complete(t *testing.T, identity oidc.Identity) (*userauthpb.AuthTokenResponse, error) {
+	t.Helper()
+
+	authURL, state, saved := f.begin(t)
+	code := f.provider.Authorize(t, authURL, identity)
+	return f.redeem(state, code, model.OAuthState{
+		Provider:     saved.Provider,
+		CodeVerifier: saved.CodeVerifier,
+		Nonce:        saved.Nonce,
+	})
+}
+
+// redeem calls CompleteOAuthLogin with the repository returning pending for state.
+func (f *oauthFixture) redeem(state, code string, pending model.OAuthState) (*userauthpb.AuthTokenResponse, error) {
+	req := &userauthpb.CompleteOAuthLoginRequest{Provider: testOAuthProvider, State: state, Code: code}
+	f.mapper.On("ToCompleteOAuthLoginRequest", req).
+		Return(transport.CompleteOAuthLoginRequest{Provider: req.Provider, State: req.State, Code: req.Code})
+	f.identityRepo.On("ConsumeOAuthState", mock.Anything, security.HashToken(state)).Return(pending, nil).Once()
+
+	return f.svc.CompleteOAuthLogin(context.Background(), req)
+}
+
+// expectSession sets up a successful session start for user.
+func (f *oauthFixture) expectSession(user model.User) {
+	pair := testdata.ValidTokenPair()
+	f.mfaRepo.On("GetTOTP", mock.Anything, user.ID).Return(model.TOTPEnrollment{}, errs.ErrTOTPNotEnrolled)
+	f.attemptRepo.On("ResetLoginFailures", mock.Anything, mock.Anything).Return(nil)
+	f.jwtMock.On("CreateTokenPair", mock.MatchedBy(func(input domain.CreateTokenPairInput) bool {
+		return input.UserID == user.ID && input.Nickname == user.Nickname
+	})).Return(pair, nil)
+	f.tokenRepo.On("SaveRefreshToken", mock.Anything, mock.Anything).Return(nil)
+	f.mapper.On("ToAuthTokenResponse", pair).Return(&userauthpb.AuthTokenResponse{AccessToken: pair.AccessToken})
+}
+
+// googleIdentity returns a verified identity for the sample user's email.
+func googleIdentity() oidc.Identity {
+	return oidc.Identity{
+		Subject:           "provider-subject-42",
+		Email:             testdata.SampleUserModel().Email,
+		EmailVerified:     true,
+		Name:              "Jane Doe",
+		PreferredUsername: "Jane.Doe",
+		Picture:           "https://cdn.example.com/jane.png",
+	}
+}
+
+// verifiedUser returns the sample user with a confirmed email.
+func verifiedUser() model.User {
+	user := testdata.SampleUserModel()
+	user.EmailVerifiedAt = time.Now().Add(-time.Hour)
+	return user
+}
+
+// TestBeginOAuthLogin_BuildsPKCERequest ensures that the authorization URL
+// carries the stored state, nonce and S256 challenge of the stored verifier.
+func TestBeginOAuthLogin_BuildsPKCERequest(t *testing.T) {
+	// Scenario: A client starts a social login.
+	f := newOAuthFixture(t)
+
+	authURL, state, saved := f.begin(t)
+
+	u, err := url.Parse(authURL)
+	require.NoError(t, err)
+	q := u.Query()
+	assert.True(t, strings.HasPrefix(authURL, f.provider.Server.URL+"/authorize?"))
+	assert.Equal(t, state, q.Get("state"))
+	assert.Equal(t, saved.Nonce, q.Get("nonce"))
+	assert.Equal(t, oidc.CodeChallengeS256(saved.CodeVerifier), q.Get("code_challenge"))
+	assert.Equal(t, "S256", q.Get("code_challenge_method"))
+	assert.Equal(t, "openid email profile", q.Get("scope"))
+	assert.Equal(t, security.HashToken(state), saved.StateHash)
+	assert.Equal(t, testOAuthProvider, saved.Provider)
+	assert.WithinDuration(t, time.Now().Add(testdata.OAuthConfig().StateTTL), saved.ExpiresAt, 5*time.Second)
+	assert.NotContains(t, authURL, saved.CodeVerifier)
+}
+
+// TestBeginOAuthLogin_UnknownProvider ensures that unconfigured providers are
+// rejected before any state is stored.
+func TestBeginOAuthLogin_UnknownProvider(t *testing.T) {
+	// Scenario: A client asks for a provider that is not configured.
+	f := newOAuthFixture(t)
+
+	_, err := f.svc.BeginOAuthLogin(context.Background(), &userauthpb.BeginOAuthLoginRequest{Provider: "myspace"})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	f.identityRepo.AssertNotCalled(t, "SaveOAuthState", mock.Anything, mock.Anything)
+}
+
+// TestCompleteOAuthLogin_ExistingIdentity ensures that a linked identity signs
+// straight into its account.
+func TestCompleteOAuthLogin_ExistingIdentity(t *testing.T) {
+	// Scenario: A returning user signs in with the provider.
+	f := newOAuthFixture(t)
+	identity := googleIdentity()
+	user := verifiedUser()
+
+	f.identityRepo.On("FindUserByIdentity", mock.Anything, testOAuthProvider, identity.Subject).Return(user, nil)
+	f.expectSession(user)
+
+	resp, err := f.complete(t, identity)
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.AccessToken)
+	f.authRepo.AssertNotCalled(t, "FetchUserByEmail", mock.Anything, mock.Anything)
+	f.identityRepo.AssertNotCalled(t, "CreateUserWithIdentity", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestCompleteOAuthLogin_LinksVerifiedAccount ensures that a new identity is
+// linked to the local account with the same verified email.
+func TestCompleteOAuthLogin_LinksVerifiedAccount(t *testing.T) {
+	// Scenario: A user who registered with a password signs in with the provider.
+	f := newOAuthFixture(t)
+	identity := googleIdentity()
+	user := verifiedUser()
+
+	f.identityRepo.On("FindUserByIdentity", mock.Anything, testOAuthProvider, identity.Subject).Return(model.User{}, errs.ErrIdentityNotFound)
+	f.authRepo.On("FetchUserByEmail", mock.Anything, identity.Email).Return(user, nil)
+	f.identityRepo.On("LinkIdentity", mock.Anything, model.ExternalIdentity{
+		Provider: testOAuthProvider,
+		Subject:  identity.Subject,
+		UserID:   user.ID,
+		Email:    identity.Email,
+	}).Return(nil)
+	f.expectSession(user)
+
+	_, err := f.complete(t, identity)
+
+	require.NoError(t, err)
+	f.identityRepo.AssertExpectations(t)
+}
+
+// TestCompleteOAuthLogin_RejectsUnverifiedProviderEmail ensures that an email
+// the provider has not verified is never used to link or create accounts.
+func TestCompleteOAuthLogin_RejectsUnverifiedProviderEmail(t *testing.T) {
+	// Scenario: Someone signs in with a provider account whose email is unverified.
+	f := newOAuthFixture(t)
+	identity := googleIdentity()
+	identity.EmailVerified = false
+
+	f.identityRepo.On("FindUserByIdentity", mock.Anything, testOAuthProvider, identity.Subject).Return(model.User{}, errs.ErrIdentityNotFound)
+
+	_, err := f.complete(t, identity)
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	f.authRepo.AssertNotCalled(t, "FetchUserByEmail", mock.Anything, mock.Anything)
+	f.identityRepo.AssertNotCalled(t, "LinkIdentity", mock.Anything, mock.Anything)
+}
+
+// TestCompleteOAuthLogin_RejectsUnverifiedLocalAccount ensures that an
+// identity is not linked to an account whose email was never confirmed.
+func TestCompleteOAuthLogin_RejectsUnverifiedLocalAccount(t *testing.T) {
+	// Scenario: The matching local account never verified its email.
+	f := newOAuthFixture(t)
+	identity := googleIdentity()
+
+	f.identityRepo.On("FindUserByIdentity", mock.Anything, testOAuthProvider, identity.Subject).Return(model.User{}, errs.ErrIdentityNotFound)
+	f.authRepo.On("FetchUserByEmail", mock.Anything, identity.Email).Return(testdata.SampleUserModel(), nil)
+
+	_, err := f.complete(t, identity)
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), errs.ErrAccountEmailNotVerified.Error())
+	f.identityRepo.AssertNotCalled(t, "LinkIdentity", mock.Anything, mock.Anything)
+}
+
+// TestCompleteOAuthLogin_CreatesUserWithUniqueNickname ensures that a new
+// account is created from the identity and that a taken nickname is retried
+// with a suffix.
+func TestCompleteOAuthLogin_CreatesUserWithUniqueNickname(t *testing.T) {
+	// Scenario: A first-time user signs in and their preferred nickname is taken.
+	f := newOAuthFixture(t)
+	identity := googleIdentity()
+	identity.Email = "jane@example.com"
+
+	var nicknames []string
+	created := model.User{ID: 7, Email: identity.Email, EmailVerifiedAt: time.Now()}
+
+	f.identityRepo.On("FindUserByIdentity", mock.Anything, testOAuthProvider, identity.Subject).Return(model.User{}, errs.ErrIdentityNotFound)
+	f.authRepo.On("FetchUserByEmail", mock.Anything, identity.Email).Return(model.User{}, errs.ErrUserNotFound)
+	f.identityRepo.On("CreateUserWithIdentity", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			user := args.Get(1).(model.User)
+			link := args.Get(2).(model.ExternalIdentity)
+			nicknames = append(nicknames, user.Nickname)
+			assert.Equal(t, identity.Name, user.Username)
+			assert.Equal(t, identity.Picture, user.AvatarURL)
+			assert.True(t, user.IsEmailVerified())
+			assert.Equal(t, identity.Subject, link.Subject)
+		}).
+		Return(model.User{}, errs.ErrNicknameTaken).Once()
+	f.identityRepo.On("CreateUserWithIdentity", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			user := args.Get(1).(model.User)
+			nicknames = append(nicknames, user.Nickname)
+			created.Nickname = user.Nickname
+		}).
+		Return(created, nil).Once()
+	f.mfaRepo.On("GetTOTP", mock.Anything, created.ID).Return(model.TOTPEnrollment{}, errs.ErrTOTPNotEnrolled)
+	f.attemptRepo.On("ResetLoginFailures", mock.Anything, mock.Anything).Return(nil)
+	f.jwtMock.On("CreateTokenPair", mock.Anything).Return(testdata.ValidTokenPair(), nil)
+	f.tokenRepo.On("SaveRefreshToken", mock.Anything, mock.Anything).Return(nil)
+	f.mapper.On("ToAuthTokenResponse", mock.Anything).Return(&userauthpb.AuthTokenResponse{})
+
+	_, err := f.complete(t, identity)
+
+	require.NoError(t, err)
+	require.Len(t, nicknames, 2)
+	assert.Equal(t, "jane_doe", nicknames[0])
+	assert.Regexp(t, `^jane_doe_\d{4}$`, nicknames[1])
+}
+
+// TestCompleteOAuthLogin_MFAEnabledReturnsChallenge ensures that social login
+// does not bypass two-factor authentication.
+func TestCompleteOAuthLogin_MFAEnabledReturnsChallenge(t *testing.T) {
+	// Scenario: A user with TOTP enabled signs in with the provider.
+	f := newOAuthFixture(t)
+	identity := googleIdentity()
+	user := verifiedUser()
+
+	f.identityRepo.On("FindUserByIdentity", mock.Anything, testOAuthProvider, identity.Subject).Return(user, nil)
+	f.mfaRepo.On("GetTOTP", mock.Anything, user.ID).Return(confirmedEnrollment(), nil)
+	f.attemptRepo.On("LoginLockedUntil", mock.Anything, mock.Anything).Return(time.Time{}, nil)
+	f.mfaRepo.On("SaveMFAChallenge", mock.Anything, mock.Anything).Return(nil)
+	f.mapper.On("ToMFAChallengeResponse", mock.Anything).Return(&userauthpb.AuthTokenResponse{MfaRequired: true})
+
+	resp, err := f.complete(t, identity)
+
+	require.NoError(t, err)
+	assert.True(t, resp.MfaRequired)
+	f.jwtMock.AssertNotCalled(t, "CreateTokenPair", mock.Anything)
+}
+
+// TestCompleteOAuthLogin_LockedAccountGetsNoChallenge ensures that no MFA
+// challenge is issued while the account is locked by failed code guesses.
+func TestCompleteOAuthLogin_LockedAccountGetsNoChallenge(t *testing.T) {
+	// Scenario: Someone in control of the provider account tries to get fresh
+	// challenges to keep guessing TOTP codes.
+	f := newOAuthFixture(t)
+	identity := googleIdentity()
+	user := verifiedUser()
+
+	f.identityRepo.On("FindUserByIdentity", mock.Anything, testOAuthProvider, identity.Subject).Return(user, nil)
+	f.mfaRepo.On("GetTOTP", mock.Anything, user.ID).Return(confirmedEnrollment(), nil)
+	f.attemptRepo.On("LoginLockedUntil", mock.Anything, mock.Anything).Return(time.Now().Add(time.Minute), nil)
+
+	_, err := f.complete(t, identity)
+
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	f.mfaRepo.AssertNotCalled(t, "SaveMFAChallenge", mock.Anything, mock.Anything)
+}
+
+// TestCompleteOAuthLogin_InvalidState ensures that unknown, expired or reused
+// states are rejected before the code is redeemed.
+func TestCompleteOAuthLogin_InvalidState(t *testing.T) {
+	// Scenario: A client replays a state that was already consumed.
+	f := newOAuthFixture(t)
+
+	req := &userauthpb.CompleteOAuthLoginRequest{Provider: testOAuthProvider, State: "replayed-state", Code: "code"}
+	f.mapper.On("ToCompleteOAuthLoginRequest", req).
+		Return(transport.CompleteOAuthLoginRequest{Provider: req.Provider, State: req.State, Code: req.Code})
+	f.identityRepo.On("ConsumeOAuthState", mock.Anything, security.HashToken(req.State)).Return(model.OAuthState{}, errs.ErrInvalidOAuthState)
+
+	_, err := f.svc.CompleteOAuthLogin(context.Background(), req)
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	f.identityRepo.AssertNotCalled(t, "FindUserByIdentity", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestCompleteOAuthLogin_RejectsWrongVerifier ensures that a code cannot be
+// redeemed without the PKCE verifier bound to it.
+func TestCompleteOAuthLogin_RejectsWrongVerifier(t *testing.T) {
+	// Scenario: An attacker injects a code intercepted from another login.
+	f := newOAuthFixture(t)
+
+	authURL, state, saved := f.begin(t)
+	code := f.provider.Authorize(t, authURL, googleIdentity())
+
+	_, err := f.redeem(state, code, model.OAuthState{
+		Provider:     saved.Provider,
+		CodeVerifier: "attacker-verifier-attacker-verifier-000000",
+		Nonce:        saved.Nonce,
+	})
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	f.identityRepo.AssertNotCalled(t, "FindUserByIdentity", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestCompleteOAuthLogin_RejectsWrongNonce ensures that an ID token minted for
+// another login is rejected.
+func TestCompleteOAuthLogin_RejectsWrongNonce(t *testing.T) {
+	// Scenario: The ID token's nonce does not match the stored login.
+	f := newOAuthFixture(t)
+
+	authURL, state, saved := f.begin(t)
+	code := f.provider.Authorize(t, authURL, googleIdentity())
+
+	_, err := f.redeem(state, code, model.OAuthState{
+		Provider:     saved.Provider,
+		CodeVerifier: saved.CodeVerifier,
+		Nonce:        "some-other-nonce",
+	})
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Contains(t, err.Error(), errs.ErrInvalidIDToken.Error())
+}
@@ -0,0 +1,312 @@
+// Package service_test verifies the behavior of AuthService’s password
+// management: changing a password, requesting a reset link and redeeming it,
+// with the password policy applied to every new password.
+package service_test
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	mailpkg "github.com/mamataliev-dev/social-platform/services/user-service/internal/mailer"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/security"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/mocks"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/testdata"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
+)
+
+const (
+	currentPassword = "old-password-1"
+	newPassword     = "n3w-Passphrase!"
+	resetToken      = "reset-token-0123456789abcdef0123456789"
+)
+
+// TestChangePassword_Success ensures that the new password is stored and every
+// session except the caller's is revoked.
+func TestChangePassword_Success(t *testing.T) {
+	// Scenario: The caller confirms the current password and picks a strong one.
+	svc, m := newAuthService(t)
+
+	req := &userauthpb.ChangePasswordRequest{CurrentPassword: currentPassword, NewPassword: newPassword}
+	user := testdata.SampleUserModel()
+	session := testdata.SampleSession()
+
+	m.mapper.On("ToChangePasswordRequest", req).Return(transport.ChangePasswordRequest{CurrentPassword: currentPassword, NewPassword: newPassword})
+	m.authRepo.On("FetchUserCredentials", mock.Anything, user.ID).Return(user, nil)
+	m.hasher.On("VerifyPassword", user.PasswordHash, currentPassword).Return(nil)
+	m.hasher.On("HashPassword", newPassword).Return("new-hash", nil)
+	m.authRepo.On("UpdatePasswordHash", mock.Anything, user.ID, user.PasswordHash, "new-hash").Return(nil)
+	m.tokenRepo.On("DeleteOtherSessions", mock.Anything, user.ID, session.ID).Return(int64(2), nil)
+	m.mapper.On("ToChangePasswordResponse", mock.Anything).Return(&userauthpb.ChangePasswordResponse{Message: "changed"})
+
+	resp, err := svc.ChangePassword(callerContext(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "changed", resp.Message)
+	m.authRepo.AssertExpectations(t)
+	m.tokenRepo.AssertExpectations(t)
+}
+
+// TestChangePassword_PasswordlessAccount ensures that an account created
+// through social login can set its first password after a recent sign-in.
+func TestChangePassword_PasswordlessAccount(t *testing.T) {
+	// Scenario: An OAuth-only user adds a password to their account.
+	svc, m := newAuthService(t)
+
+	req := &userauthpb.ChangePasswordRequest{NewPassword: newPassword}
+	user := testdata.SampleUserModel()
+	user.PasswordHash = ""
+	session := testdata.SampleSession()
+	session.CreatedAt = time.Now().Add(-time.Minute)
+
+	m.mapper.On("ToChangePasswordRequest", req).Return(transport.ChangePasswordRequest{NewPassword: newPassword})
+	m.authRepo.On("FetchUserCredentials", mock.Anything, user.ID).Return(user, nil)
+	m.tokenRepo.On("ListSessions", mock.Anything, user.ID).Return([]model.Session{session}, nil)
+	m.hasher.On("HashPassword", newPassword).Return("new-hash", nil)
+	m.authRepo.On("UpdatePasswordHash", mock.Anything, user.ID, "", "new-hash").Return(nil)
+	m.tokenRepo.On("DeleteOtherSessions", mock.Anything, user.ID, session.ID).Return(int64(0), nil)
+	m.mapper.On("ToChangePasswordResponse", mock.Anything).Return(&userauthpb.ChangePasswordResponse{Message: "changed"})
+
+	_, err := svc.ChangePassword(callerContext(), req)
+
+	assert.NoError(t, err)
+	m.authRepo.AssertExpectations(t)
+	m.hasher.AssertNotCalled(t, "VerifyPassword", mock.Anything, mock.Anything)
+}
+
+// TestChangePassword_WrongCurrentPassword ensures that a wrong current password
+// yields Unauthenticated and nothing is changed.
+func TestChangePassword_WrongCurrentPassword(t *testing.T) {
+	// Scenario: The caller mistypes the current password.
+	svc, m := newAuthService(t)
+
+	req := &userauthpb.ChangePasswordRequest{CurrentPassword: "wrong", NewPassword: newPassword}
+	user := testdata.SampleUserModel()
+
+	m.mapper.On("ToChangePasswordRequest", req).Return(transport.ChangePasswordRequest{CurrentPassword: "wrong", NewPassword: newPassword})
+	m.authRepo.On("FetchUserCredentials", mock.Anything, user.ID).Return(user, nil)
+	m.hasher.On("VerifyPassword", user.PasswordHash, "wrong").Return(errs.ErrInvalidPassword)
+
+	_, err := svc.ChangePassword(callerContext(), req)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+	assert.Equal(t, errs.ErrInvalidPassword.Error(), st.Message())
+	m.authRepo.AssertNotCalled(t, "UpdatePasswordHash", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestChangePassword_PolicyViolation ensures that a new password violating the
+// policy is rejected with InvalidArgument and never hashed.
+func TestChangePassword_PolicyViolation(t *testing.T) {
+	cases := []struct {
+		name     string
+		password string
+	}{
+		{"too short", "short-1"},
+		{"contains nickname", "my-Tester-pass"},
+		{"contains email", "TEST@example!!"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Scenario: The caller picks a weak new password.
+			svc, m := newAuthService(t)
+
+			req := &userauthpb.ChangePasswordRequest{CurrentPassword: currentPassword, NewPassword: tc.password}
+			user := testdata.SampleUserModel()
+
+			m.mapper.On("ToChangePasswordRequest", req).Return(transport.ChangePasswordRequest{CurrentPassword: currentPassword, NewPassword: tc.password})
+			m.authRepo.On("FetchUserCredentials", mock.Anything, user.ID).Return(user, nil)
+			m.hasher.On("VerifyPassword", user.PasswordHash, currentPassword).Return(nil)
+
+			_, err := svc.ChangePassword(callerContext(), req)
+			st, _ := status.FromError(err)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+			assert.True(t, strings.HasPrefix(st.Message(), errs.ErrWeakPassword.Error()), st.Message())
+			m.hasher.AssertNotCalled(t, "HashPassword", mock.Anything)
+		})
+	}
+}
+
+// TestChangePassword_WithoutSessionRevokesAll ensures that a caller without a
+// session ID has all sessions revoked, since none can be kept.
+func TestChangePassword_WithoutSessionRevokesAll(t *testing.T) {
+	// Scenario: The access token carries no session claim.
+	svc, m := newAuthService(t)
+
+	req := &userauthpb.ChangePasswordRequest{CurrentPassword: currentPassword, NewPassword: newPassword}
+	user := testdata.SampleUserModel()
+	ctx := utils.ContextWithAuthInfo(context.Background(), utils.AuthInfo{UserID: user.ID})
+
+	m.mapper.On("ToChangePasswordRequest", req).Return(transport.ChangePasswordRequest{CurrentPassword: currentPassword, NewPassword: newPassword})
+	m.authRepo.On("FetchUserCredentials", mock.Anything, user.ID).Return(user, nil)
+	m.hasher.On("VerifyPassword", user.PasswordHash, currentPassword).Return(nil)
+	m.hasher.On("HashPassword", newPassword).Return("new-hash", nil)
+	m.authRepo.On("UpdatePasswordHash", mock.Anything, user.ID, user.PasswordHash, "new-hash").Return(nil)
+	m.tokenRepo.On("DeleteAllSessions", mock.Anything, user.ID).Return(int64(3), nil)
+	m.mapper.On("ToChangePasswordResponse", mock.Anything).Return(&userauthpb.ChangePasswordResponse{Message: "changed"})
+
+	_, err := svc.ChangePassword(ctx, req)
+
+	assert.NoError(t, err)
+	m.tokenRepo.AssertExpectations(t)
+	m.tokenRepo.AssertNotCalled(t, "DeleteOtherSessions", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestRequestPasswordReset_Success ensures that a reset link carrying a token
+// whose digest was stored is mailed to the account's address.
+func TestRequestPasswordReset_Success(t *testing.T) {
+	// Scenario: No reset was requested recently.
+	svc, m := newAuthService(t)
+
+	user := testdata.SampleUserModel()
+	req := &userauthpb.RequestPasswordResetRequest{Email: user.Email}
+
+	var saved domain.SavePasswordResetTokenInput
+	var sent mailpkg.Message
+	m.mapper.On("ToRequestPasswordResetRequest", req).Return(transport.RequestPasswordResetRequest{Email: user.Email})
+	m.authRepo.On("FetchUserByEmail", mock.Anything, user.Email).Return(user, nil)
+	m.resetRepo.On("SavePasswordResetToken", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { saved = args.Get(1).(domain.SavePasswordResetTokenInput) }).
+		Return(nil)
+	m.mailer.On("Send", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { sent = args.Get(1).(mailpkg.Message) }).
+		Return(nil)
+	m.mapper.On("ToRequestPasswordResetResponse", mock.Anything).Return(&userauthpb.RequestPasswordResetResponse{Message: "sent"})
+
+	resp, err := svc.RequestPasswordReset(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "sent", resp.Message)
+	assert.Equal(t, user.ID, saved.UserID)
+	assert.Equal(t, time.Minute, saved.Cooldown)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), saved.ExpiresAt, time.Minute)
+	assert.Equal(t, user.Email, sent.To)
+
+	_, token, found := strings.Cut(sent.Body, "https://app.test/reset-password?token=")
+	assert.True(t, found, sent.Body)
+	token, _, _ = strings.Cut(token, "\n")
+	token, err = url.QueryUnescape(token)
+	assert.NoError(t, err)
+	assert.Equal(t, security.HashToken(token), saved.TokenHash)
+}
+
+// TestRequestPasswordReset_NoEnumeration ensures that unknown addresses and
+// requests within the cooldown get the success response without an email.
+func TestRequestPasswordReset_NoEnumeration(t *testing.T) {
+	cases := []struct {
+		name  string
+		setup func(authRepo *mocks.AuthRepoMock, resetRepo *mocks.PasswordResetRepoMock, user model.User)
+	}{
+		{
+			name: "unknown email",
+			setup: func(authRepo *mocks.AuthRepoMock, _ *mocks.PasswordResetRepoMock, user model.User) {
+				authRepo.On("FetchUserByEmail", mock.Anything, user.Email).Return(model.User{}, errs.ErrUserNotFound)
+			},
+		},
+		{
+			name: "within cooldown",
+			setup: func(authRepo *mocks.AuthRepoMock, resetRepo *mocks.PasswordResetRepoMock, user model.User) {
+				authRepo.On("FetchUserByEmail", mock.Anything, user.Email).Return(user, nil)
+				resetRepo.On("SavePasswordResetToken", mock.Anything, mock.Anything).Return(errs.ErrPasswordResetThrottled)
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc, m := newAuthService(t)
+
+			user := testdata.SampleUserModel()
+			req := &userauthpb.RequestPasswordResetRequest{Email: user.Email}
+
+			m.mapper.On("ToRequestPasswordResetRequest", req).Return(transport.RequestPasswordResetRequest{Email: user.Email})
+			tc.setup(m.authRepo, m.resetRepo, user)
+			m.mapper.On("ToRequestPasswordResetResponse", mock.Anything).Return(&userauthpb.RequestPasswordResetResponse{Message: "sent"})
+
+			resp, err := svc.RequestPasswordReset(context.Background(), req)
+
+			assert.NoError(t, err)
+			assert.Equal(t, "sent", resp.Message)
+			m.mailer.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// TestResetPassword_Success ensures that a valid token sets the new password
+// and clears the account's login lockout.
+func TestResetPassword_Success(t *testing.T) {
+	// Scenario: The user opens the emailed link and picks a strong password.
+	svc, m := newAuthService(t)
+
+	req := &userauthpb.ResetPasswordRequest{Token: resetToken, NewPassword: newPassword}
+	user := testdata.SampleUserModel()
+	tokenHash := security.HashToken(resetToken)
+
+	m.mapper.On("ToResetPasswordRequest", req).Return(transport.ResetPasswordRequest{Token: resetToken, NewPassword: newPassword})
+	m.resetRepo.On("GetPasswordResetToken", mock.Anything, tokenHash).Return(user.ID, nil)
+	m.authRepo.On("FetchUserCredentials", mock.Anything, user.ID).Return(user, nil)
+	m.hasher.On("HashPassword", newPassword).Return("new-hash", nil)
+	m.resetRepo.On("ConsumePasswordResetToken", mock.Anything, tokenHash, "new-hash").Return(user.ID, nil)
+	m.attemptRepo.On("ResetLoginFailures", mock.Anything, model.LoginAttemptKey{
+		Scope:   model.LoginScopeAccount,
+		KeyHash: security.HashToken(user.Email),
+	}).Return(nil)
+	m.mapper.On("ToResetPasswordResponse", mock.Anything).Return(&userauthpb.ResetPasswordResponse{Message: "reset"})
+
+	resp, err := svc.ResetPassword(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "reset", resp.Message)
+	m.resetRepo.AssertExpectations(t)
+	m.attemptRepo.AssertExpectations(t)
+}
+
+// TestResetPassword_InvalidToken ensures that unknown or expired tokens yield
+// InvalidArgument.
+func TestResetPassword_InvalidToken(t *testing.T) {
+	// Scenario: The link has expired.
+	svc, m := newAuthService(t)
+
+	req := &userauthpb.ResetPasswordRequest{Token: resetToken, NewPassword: newPassword}
+
+	m.mapper.On("ToResetPasswordRequest", req).Return(transport.ResetPasswordRequest{Token: resetToken, NewPassword: newPassword})
+	m.resetRepo.On("GetPasswordResetToken", mock.Anything, security.HashToken(resetToken)).Return(int64(0), errs.ErrInvalidResetToken)
+
+	_, err := svc.ResetPassword(context.Background(), req)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, errs.ErrInvalidResetToken.Error(), st.Message())
+	m.hasher.AssertNotCalled(t, "HashPassword", mock.Anything)
+}
+
+// TestResetPassword_PolicyViolation ensures that the token is not consumed when
+// the new password violates the policy, so the user can try again.
+func TestResetPassword_PolicyViolation(t *testing.T) {
+	// Scenario: The new password is too short.
+	svc, m := newAuthService(t)
+
+	req := &userauthpb.ResetPasswordRequest{Token: resetToken, NewPassword: "short"}
+	user := testdata.SampleUserModel()
+
+	m.mapper.On("ToResetPasswordRequest", req).Return(transport.ResetPasswordRequest{Token: resetToken, NewPassword: "short"})
+	m.resetRepo.On("GetPasswordResetToken", mock.Anything, security.HashToken(resetToken)).Return(user.ID, nil)
+	m.authRepo.On("FetchUserCredentials", mock.Anything, user.ID).Return(user, nil)
+
+	_, err := svc.ResetPassword(context.Background(), req)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Contains(t, st.Message(), "at least 10 characters")
+	m.resetRepo.AssertNotCalled(t, "ConsumePasswordResetToken", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,94 @@
+// Package service_test verifies that AuthService only hands refresh token
+// digests to the token repository, never the raw token values.
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/security"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/testdata"
+)
+
+// TestLogin_StoresRefreshTokenDigest ensures that the refresh token issued on
+// login is persisted as its SHA-256 digest.
+func TestLogin_StoresRefreshTokenDigest(t *testing.T) {
+	// Scenario: A successful login saves the new refresh token.
+	svc, m := newAuthService(t)
+
+	req := validLoginRequest()
+	user := testdata.SampleUserModel()
+	pair := testdata.ValidTokenPair()
+
+	var saved domain.SaveRefreshTokenInput
+	m.mapper.On("ToLoginRequest", req).Return(transport.LoginRequest{Email: req.Email, Password: req.Password})
+	m.attemptRepo.On("LoginLockedUntil", mock.Anything, mock.Anything).Return(time.Time{}, nil)
+	m.attemptRepo.On("ResetLoginFailures", mock.Anything, mock.Anything).Return(nil)
+	m.authRepo.On("FetchUserByEmail", mock.Anything, req.Email).Return(user, nil)
+	m.hasher.On("VerifyPassword", user.PasswordHash, req.Password).Return(nil)
+	m.hasher.On("NeedsRehash", user.PasswordHash).Return(false)
+	m.mfaRepo.On("GetTOTP", mock.Anything, user.ID).Return(model.TOTPEnrollment{}, errs.ErrTOTPNotEnrolled)
+	m.jwtMock.On("CreateTokenPair", mock.Anything).Return(pair, nil)
+	m.tokenRepo.On("SaveRefreshToken", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { saved = args.Get(1).(domain.SaveRefreshTokenInput) }).
+		Return(nil)
+	m.mapper.On("ToAuthTokenResponse", pair).Return(&userauthpb.AuthTokenResponse{RefreshToken: pair.RefreshToken})
+
+	resp, err := svc.Login(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, pair.RefreshToken, resp.RefreshToken)
+	assert.Equal(t, security.HashToken(pair.RefreshToken), saved.TokenHash)
+	assert.NotContains(t, saved.TokenHash, pair.RefreshToken)
+}
+
+// TestRefreshToken_UsesDigests ensures that lookup, rotation and persistence
+// during a refresh all operate on digests of the raw tokens.
+func TestRefreshToken_UsesDigests(t *testing.T) {
+	// Scenario: A client exchanges a refresh token for a new pair.
+	svc, m := newAuthService(t)
+
+	req := validRefreshTokenRequest()
+	oldDigest := security.HashToken(req.GetRefreshToken())
+	newPair := testdata.ValidTokenPair()
+
+	m.mapper.On("ToRefreshTokenRequest", req).Return(transport.RefreshTokenRequest{RefreshToken: req.GetRefreshToken()})
+	m.tokenRepo.On("GetRefreshToken", mock.Anything, oldDigest).Return(testdata.SampleSession(), nil)
+	m.userRepo.On("FetchUserByID", mock.Anything, mock.Anything).Return(testdata.UserProfileResponse(), nil)
+	m.tokenRepo.On("RotateRefreshToken", mock.Anything, oldDigest).Return(nil)
+	m.jwtMock.On("CreateTokenPair", mock.Anything).Return(newPair, nil)
+	m.tokenRepo.On("SaveRefreshToken", mock.Anything, mock.MatchedBy(func(input domain.SaveRefreshTokenInput) bool {
+		return input.TokenHash == security.HashToken(newPair.RefreshToken)
+	})).Return(nil)
+	m.mapper.On("ToAuthTokenResponse", newPair).Return(&userauthpb.AuthTokenResponse{})
+
+	_, err := svc.RefreshToken(context.Background(), req)
+	assert.NoError(t, err)
+	m.tokenRepo.AssertExpectations(t)
+	m.tokenRepo.AssertNotCalled(t, "GetRefreshToken", mock.Anything, req.GetRefreshToken())
+}
+
+// TestLogout_UsesDigest ensures that logout revokes the session by the token's
+// digest rather than its raw value.
+func TestLogout_UsesDigest(t *testing.T) {
+	// Scenario: A user logs out with their refresh token.
+	svc, m := newAuthService(t)
+
+	req := validLogoutRequest()
+
+	m.mapper.On("ToGetRefreshTokenRequest", req).Return(transport.RefreshTokenRequest{RefreshToken: req.GetRefreshToken()})
+	m.tokenRepo.On("DeleteRefreshToken", mock.Anything, security.HashToken(req.GetRefreshToken())).Return(nil)
+	m.mapper.On("ToLogoutResponse", mock.Anything).Return(&userauthpb.LogoutResponse{})
+
+	_, err := svc.Logout(context.Background(), req)
+	assert.NoError(t, err)
+	m.tokenRepo.AssertExpectations(t)
+}
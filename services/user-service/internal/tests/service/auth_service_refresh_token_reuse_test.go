@@ -0,0 +1,79 @@
+// Package service_test verifies AuthService's refresh token reuse detection.
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/testdata"
+)
+
+// TestRefreshToken_ReusedTokenRevokesFamily ensures that presenting an
+// already-rotated refresh token revokes its whole session and fails with
+// Unauthenticated, without issuing new tokens.
+func TestRefreshToken_ReusedTokenRevokesFamily(t *testing.T) {
+	// Scenario: A rotated-out refresh token is replayed.
+	svc, m := newAuthService(t)
+
+	req := validRefreshTokenRequest()
+	session := testdata.SampleSession()
+
+	m.mapper.On("ToRefreshTokenRequest", req).Return(transport.RefreshTokenRequest{})
+	m.tokenRepo.On("GetRefreshToken", mock.Anything, mock.Anything).Return(session, errs.ErrRefreshTokenReused)
+	m.tokenRepo.On("DeleteSession", mock.Anything, session.UserID, session.ID).Return(nil)
+
+	_, err := svc.RefreshToken(context.Background(), req)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+	assert.Equal(t, errs.ErrRefreshTokenReused.Error(), st.Message())
+	m.tokenRepo.AssertExpectations(t)
+	m.userRepo.AssertNotCalled(t, "FetchUserByID", mock.Anything, mock.Anything)
+	m.jwtMock.AssertNotCalled(t, "CreateTokenPair", mock.Anything)
+}
+
+// TestRefreshToken_ConcurrentRotationRevokesFamily ensures that losing the
+// rotation race to another request is treated as reuse.
+func TestRefreshToken_ConcurrentRotationRevokesFamily(t *testing.T) {
+	// Scenario: The token is rotated by someone else between lookup and rotation.
+	svc, m := newAuthService(t)
+
+	req := validRefreshTokenRequest()
+	session := testdata.SampleSession()
+
+	m.mapper.On("ToRefreshTokenRequest", req).Return(transport.RefreshTokenRequest{})
+	m.tokenRepo.On("GetRefreshToken", mock.Anything, mock.Anything).Return(session, nil)
+	m.userRepo.On("FetchUserByID", mock.Anything, mock.Anything).Return(testdata.UserProfileResponse(), nil)
+	m.tokenRepo.On("RotateRefreshToken", mock.Anything, mock.Anything).Return(errs.ErrRefreshTokenReused)
+	m.tokenRepo.On("DeleteSession", mock.Anything, session.UserID, session.ID).Return(nil)
+
+	_, err := svc.RefreshToken(context.Background(), req)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+	m.tokenRepo.AssertExpectations(t)
+	m.jwtMock.AssertNotCalled(t, "CreateTokenPair", mock.Anything)
+}
+
+// TestRefreshToken_ReuseRevocationFails ensures that a storage failure while
+// revoking a compromised session surfaces as an Internal gRPC error.
+func TestRefreshToken_ReuseRevocationFails(t *testing.T) {
+	// Scenario: A replayed token is detected but the session cannot be revoked.
+	svc, m := newAuthService(t)
+
+	req := validRefreshTokenRequest()
+	session := testdata.SampleSession()
+
+	m.mapper.On("ToRefreshTokenRequest", req).Return(transport.RefreshTokenRequest{})
+	m.tokenRepo.On("GetRefreshToken", mock.Anything, mock.Anything).Return(session, errs.ErrRefreshTokenReused)
+	m.tokenRepo.On("DeleteSession", mock.Anything, session.UserID, session.ID).Return(errs.ErrDBFailure)
+
+	_, err := svc.RefreshToken(context.Background(), req)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Internal, st.Code())
+}
@@ -11,12 +11,12 @@ import (
 	"google.golang.org/grpc/status"
 
 	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
-	"github.com/mamataliev-dev/social-platform/services/user-service/internal/service"
-	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/mocks"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/testdata"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
 )
 
 // validRefreshTokenRequest returns a RefreshTokenPayload with a sample token.
@@ -30,46 +30,44 @@ func validRefreshTokenRequest() *userauthpb.RefreshTokenPayload {
 // exchanged for a new token pair.
 func TestRefreshToken_Success(t *testing.T) {
 	// Scenario: Successful refresh token rotation.
-	authRepo := new(mocks.AuthRepoMock)
-	userRepo := new(mocks.UserRepoMock)
-	tokenRepo := new(mocks.TokenRepoMock)
-	jwtMock := new(mocks.JWTGeneratorMock)
-	hasher := new(mocks.MockHasher)
-	mapper := new(mocks.MockMapper)
-	svc := service.NewAuthService(authRepo, userRepo, tokenRepo, jwtMock, hasher, mapper)
+	svc, m := newAuthService(t)
 
 	req := validRefreshTokenRequest()
 	user := testdata.UserProfileResponse()
 	newTokenPair := testdata.ValidTokenPair()
-
-	mapper.On("ToRefreshTokenRequest", req).Return(transport.RefreshTokenRequest{})
-	tokenRepo.On("GetRefreshToken", mock.Anything, mock.Anything).Return("1", nil)
-	userRepo.On("FetchUserByID", mock.Anything, mock.Anything).Return(user, nil)
-	tokenRepo.On("DeleteRefreshToken", mock.Anything, mock.Anything).Return(nil)
-	jwtMock.On("CreateTokenPair", mock.Anything).Return(newTokenPair, nil)
-	tokenRepo.On("SaveRefreshToken", mock.Anything, mock.Anything).Return(nil)
-	mapper.On("ToAuthTokenResponse", newTokenPair).Return(&userauthpb.AuthTokenResponse{})
-
-	_, err := svc.RefreshToken(context.Background(), req)
+	session := testdata.SampleSession()
+
+	m.mapper.On("ToRefreshTokenRequest", req).Return(transport.RefreshTokenRequest{})
+	m.tokenRepo.On("GetRefreshToken", mock.Anything, mock.Anything).Return(session, nil)
+	m.userRepo.On("FetchUserByID", mock.Anything, mock.Anything).Return(user, nil)
+	m.tokenRepo.On("RotateRefreshToken", mock.Anything, mock.Anything).Return(nil)
+	m.jwtMock.On("CreateTokenPair", mock.MatchedBy(func(input domain.CreateTokenPairInput) bool {
+		return input.SessionID == session.ID
+	})).Return(newTokenPair, nil)
+	m.tokenRepo.On("SaveRefreshToken", mock.Anything, mock.MatchedBy(func(input domain.SaveRefreshTokenInput) bool {
+		return input.SessionID == session.ID &&
+			input.CreatedAt.Equal(session.CreatedAt) &&
+			input.Client.UserAgent == "mobile-app/2.0" &&
+			input.Client.IPAddress == "198.51.100.4"
+	})).Return(nil)
+	m.mapper.On("ToAuthTokenResponse", newTokenPair).Return(&userauthpb.AuthTokenResponse{})
+
+	client := domain.ClientInfo{UserAgent: "mobile-app/2.0", IPAddress: "198.51.100.4"}
+	_, err := svc.RefreshToken(utils.ContextWithClientInfo(context.Background(), client), req)
 	assert.NoError(t, err)
+	m.tokenRepo.AssertExpectations(t)
 }
 
 // TestRefreshToken_TokenNotFound ensures that a non-existent refresh token
 // results in a NotFound gRPC error.
 func TestRefreshToken_TokenNotFound(t *testing.T) {
 	// Scenario: The provided refresh token is not found in the repository.
-	authRepo := new(mocks.AuthRepoMock)
-	userRepo := new(mocks.UserRepoMock)
-	tokenRepo := new(mocks.TokenRepoMock)
-	jwtMock := new(mocks.JWTGeneratorMock)
-	hasher := new(mocks.MockHasher)
-	mapper := new(mocks.MockMapper)
-	svc := service.NewAuthService(authRepo, userRepo, tokenRepo, jwtMock, hasher, mapper)
+	svc, m := newAuthService(t)
 
 	req := validRefreshTokenRequest()
 
-	mapper.On("ToRefreshTokenRequest", req).Return(transport.RefreshTokenRequest{})
-	tokenRepo.On("GetRefreshToken", mock.Anything, mock.Anything).Return("", errs.ErrTokenNotFound)
+	m.mapper.On("ToRefreshTokenRequest", req).Return(transport.RefreshTokenRequest{})
+	m.tokenRepo.On("GetRefreshToken", mock.Anything, mock.Anything).Return(model.Session{}, errs.ErrTokenNotFound)
 
 	_, err := svc.RefreshToken(context.Background(), req)
 	st, _ := status.FromError(err)
@@ -80,44 +78,32 @@ func TestRefreshToken_TokenNotFound(t *testing.T) {
 // after validating the token results in an Internal gRPC error.
 func TestRefreshToken_FetchUserFails(t *testing.T) {
 	// Scenario: The user associated with the token cannot be fetched.
-	authRepo := new(mocks.AuthRepoMock)
-	userRepo := new(mocks.UserRepoMock)
-	tokenRepo := new(mocks.TokenRepoMock)
-	jwtMock := new(mocks.JWTGeneratorMock)
-	hasher := new(mocks.MockHasher)
-	mapper := new(mocks.MockMapper)
-	svc := service.NewAuthService(authRepo, userRepo, tokenRepo, jwtMock, hasher, mapper)
+	svc, m := newAuthService(t)
 
 	req := validRefreshTokenRequest()
 
-	mapper.On("ToRefreshTokenRequest", req).Return(transport.RefreshTokenRequest{})
-	tokenRepo.On("GetRefreshToken", mock.Anything, mock.Anything).Return("1", nil)
-	userRepo.On("FetchUserByID", mock.Anything, mock.Anything).Return(transport.UserProfileResponse{}, errs.ErrUserNotFound)
+	m.mapper.On("ToRefreshTokenRequest", req).Return(transport.RefreshTokenRequest{})
+	m.tokenRepo.On("GetRefreshToken", mock.Anything, mock.Anything).Return(testdata.SampleSession(), nil)
+	m.userRepo.On("FetchUserByID", mock.Anything, mock.Anything).Return(transport.UserProfileResponse{}, errs.ErrUserNotFound)
 
 	_, err := svc.RefreshToken(context.Background(), req)
 	st, _ := status.FromError(err)
 	assert.Equal(t, codes.Internal, st.Code())
 }
 
-// TestRefreshToken_DeleteFails ensures that a failure to delete the old token
-// results in an Internal gRPC error.
-func TestRefreshToken_DeleteFails(t *testing.T) {
-	// Scenario: The old refresh token cannot be deleted from the repository.
-	authRepo := new(mocks.AuthRepoMock)
-	userRepo := new(mocks.UserRepoMock)
-	tokenRepo := new(mocks.TokenRepoMock)
-	jwtMock := new(mocks.JWTGeneratorMock)
-	hasher := new(mocks.MockHasher)
-	mapper := new(mocks.MockMapper)
-	svc := service.NewAuthService(authRepo, userRepo, tokenRepo, jwtMock, hasher, mapper)
+// TestRefreshToken_RotateFails ensures that a failure to rotate the old token
+// out results in an Internal gRPC error.
+func TestRefreshToken_RotateFails(t *testing.T) {
+	// Scenario: The old refresh token cannot be marked as rotated in the repository.
+	svc, m := newAuthService(t)
 
 	req := validRefreshTokenRequest()
 	user := testdata.UserProfileResponse()
 
-	mapper.On("ToRefreshTokenRequest", req).Return(transport.RefreshTokenRequest{})
-	tokenRepo.On("GetRefreshToken", mock.Anything, mock.Anything).Return("1", nil)
-	userRepo.On("FetchUserByID", mock.Anything, mock.Anything).Return(user, nil)
-	tokenRepo.On("DeleteRefreshToken", mock.Anything, mock.Anything).Return(errs.ErrDBFailure)
+	m.mapper.On("ToRefreshTokenRequest", req).Return(transport.RefreshTokenRequest{})
+	m.tokenRepo.On("GetRefreshToken", mock.Anything, mock.Anything).Return(testdata.SampleSession(), nil)
+	m.userRepo.On("FetchUserByID", mock.Anything, mock.Anything).Return(user, nil)
+	m.tokenRepo.On("RotateRefreshToken", mock.Anything, mock.Anything).Return(errs.ErrDBFailure)
 
 	_, err := svc.RefreshToken(context.Background(), req)
 	st, _ := status.FromError(err)
@@ -128,22 +114,16 @@ func TestRefreshToken_DeleteFails(t *testing.T) {
 // pair results in an Internal gRPC error.
 func TestRefreshToken_JWTGenFails(t *testing.T) {
 	// Scenario: A new token pair cannot be generated after validating the old token.
-	authRepo := new(mocks.AuthRepoMock)
-	userRepo := new(mocks.UserRepoMock)
-	tokenRepo := new(mocks.TokenRepoMock)
-	jwtMock := new(mocks.JWTGeneratorMock)
-	hasher := new(mocks.MockHasher)
-	mapper := new(mocks.MockMapper)
-	svc := service.NewAuthService(authRepo, userRepo, tokenRepo, jwtMock, hasher, mapper)
+	svc, m := newAuthService(t)
 
 	req := validRefreshTokenRequest()
 	user := testdata.UserProfileResponse()
 
-	mapper.On("ToRefreshTokenRequest", req).Return(transport.RefreshTokenRequest{})
-	tokenRepo.On("GetRefreshToken", mock.Anything, mock.Anything).Return("1", nil)
-	userRepo.On("FetchUserByID", mock.Anything, mock.Anything).Return(user, nil)
-	tokenRepo.On("DeleteRefreshToken", mock.Anything, mock.Anything).Return(nil)
-	jwtMock.On("CreateTokenPair", mock.Anything).Return(model.TokenPair{}, errs.ErrTokenSigningFailed)
+	m.mapper.On("ToRefreshTokenRequest", req).Return(transport.RefreshTokenRequest{})
+	m.tokenRepo.On("GetRefreshToken", mock.Anything, mock.Anything).Return(testdata.SampleSession(), nil)
+	m.userRepo.On("FetchUserByID", mock.Anything, mock.Anything).Return(user, nil)
+	m.tokenRepo.On("RotateRefreshToken", mock.Anything, mock.Anything).Return(nil)
+	m.jwtMock.On("CreateTokenPair", mock.Anything).Return(model.TokenPair{}, errs.ErrTokenSigningFailed)
 
 	_, err := svc.RefreshToken(context.Background(), req)
 	st, _ := status.FromError(err)
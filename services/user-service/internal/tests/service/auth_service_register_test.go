@@ -4,6 +4,7 @@ package service_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -12,10 +13,10 @@ import (
 	"google.golang.org/grpc/status"
 
 	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	mailpkg "github.com/mamataliev-dev/social-platform/services/user-service/internal/mailer"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
-	"github.com/mamataliev-dev/social-platform/services/user-service/internal/service"
-	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/mocks"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/testdata"
 )
 
@@ -33,25 +34,25 @@ func validRegisterRequest() *userauthpb.RegisterRequest {
 // generates tokens, and returns them in an AuthTokenResponse.
 func TestRegister_Success(t *testing.T) {
 	// Scenario: Successful user registration with valid data.
-	authRepo := new(mocks.AuthRepoMock)
-	userRepo := new(mocks.UserRepoMock)
-	tokenRepo := new(mocks.TokenRepoMock)
-	jwtMock := new(mocks.JWTGeneratorMock)
-	hasher := new(mocks.MockHasher)
-	mapper := new(mocks.MockMapper)
-	svc := service.NewAuthService(authRepo, userRepo, tokenRepo, jwtMock, hasher, mapper)
+	svc, m := newAuthService(t)
 
 	req := validRegisterRequest()
 	hashedPassword := "hashed-password"
-	createdUser := model.User{ID: 1, Nickname: req.Nickname}
+	createdUser := model.User{ID: 1, Nickname: req.Nickname, Email: req.Email}
 	tokenPair := testdata.ValidTokenPair()
 
-	hasher.On("HashPassword", req.Password).Return(hashedPassword, nil)
-	mapper.On("ToUserModel", req, hashedPassword).Return(model.User{})
-	authRepo.On("CreateUser", mock.Anything, mock.Anything).Return(createdUser, nil)
-	jwtMock.On("CreateTokenPair", mock.Anything).Return(tokenPair, nil)
-	tokenRepo.On("SaveRefreshToken", mock.Anything, mock.Anything).Return(nil)
-	mapper.On("ToAuthTokenResponse", tokenPair).Return(&userauthpb.AuthTokenResponse{
+	m.hasher.On("HashPassword", req.Password).Return(hashedPassword, nil)
+	m.mapper.On("ToUserModel", req, hashedPassword).Return(model.User{})
+	m.authRepo.On("CreateUser", mock.Anything, mock.Anything).Return(createdUser, nil)
+	m.jwtMock.On("CreateTokenPair", mock.Anything).Return(tokenPair, nil)
+	m.tokenRepo.On("SaveRefreshToken", mock.Anything, mock.Anything).Return(nil)
+	m.verifyRepo.On("SaveVerificationToken", mock.Anything, mock.MatchedBy(func(input domain.SaveVerificationTokenInput) bool {
+		return input.UserID == createdUser.ID && len(input.TokenHash) == 64
+	})).Return(nil)
+	m.mailer.On("Send", mock.Anything, mock.MatchedBy(func(msg mailpkg.Message) bool {
+		return msg.To == createdUser.Email && strings.Contains(msg.Body, "https://app.test/verify-email?token=")
+	})).Return(nil)
+	m.mapper.On("ToAuthTokenResponse", tokenPair).Return(&userauthpb.AuthTokenResponse{
 		AccessToken:  tokenPair.AccessToken,
 		RefreshToken: tokenPair.RefreshToken,
 	})
@@ -60,48 +61,87 @@ func TestRegister_Success(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.Equal(t, tokenPair.AccessToken, resp.AccessToken)
-	authRepo.AssertExpectations(t)
+	m.authRepo.AssertExpectations(t)
+	m.verifyRepo.AssertExpectations(t)
+	m.mailer.AssertExpectations(t)
+}
+
+// TestRegister_VerificationEmailFails ensures that a mail delivery failure does
+// not fail registration; the user can request another verification email.
+func TestRegister_VerificationEmailFails(t *testing.T) {
+	// Scenario: Registration succeeds even though the verification email bounces.
+	svc, m := newAuthService(t)
+
+	req := validRegisterRequest()
+	hashedPassword := "hashed-password"
+	createdUser := model.User{ID: 1, Nickname: req.Nickname, Email: req.Email}
+	tokenPair := testdata.ValidTokenPair()
+
+	m.hasher.On("HashPassword", req.Password).Return(hashedPassword, nil)
+	m.mapper.On("ToUserModel", req, hashedPassword).Return(model.User{})
+	m.authRepo.On("CreateUser", mock.Anything, mock.Anything).Return(createdUser, nil)
+	m.jwtMock.On("CreateTokenPair", mock.MatchedBy(func(input domain.CreateTokenPairInput) bool {
+		return input.UserID == createdUser.ID && !input.EmailVerified
+	})).Return(tokenPair, nil)
+	m.tokenRepo.On("SaveRefreshToken", mock.Anything, mock.Anything).Return(nil)
+	m.verifyRepo.On("SaveVerificationToken", mock.Anything, mock.Anything).Return(nil)
+	m.mailer.On("Send", mock.Anything, mock.Anything).Return(errs.ErrMailDeliveryFailed)
+	m.mapper.On("ToAuthTokenResponse", tokenPair).Return(&userauthpb.AuthTokenResponse{
+		AccessToken:  tokenPair.AccessToken,
+		RefreshToken: tokenPair.RefreshToken,
+	})
+
+	resp, err := svc.Register(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, tokenPair.RefreshToken, resp.RefreshToken)
+	m.mailer.AssertExpectations(t)
 }
 
 // TestRegister_HashingFails ensures that a password hashing failure
 // results in an Internal gRPC error.
 func TestRegister_HashingFails(t *testing.T) {
 	// Scenario: Registration fails because password hashing returns an error.
-	authRepo := new(mocks.AuthRepoMock)
-	userRepo := new(mocks.UserRepoMock)
-	tokenRepo := new(mocks.TokenRepoMock)
-	jwtMock := new(mocks.JWTGeneratorMock)
-	hasher := new(mocks.MockHasher)
-	mapper := new(mocks.MockMapper)
-	svc := service.NewAuthService(authRepo, userRepo, tokenRepo, jwtMock, hasher, mapper)
+	svc, m := newAuthService(t)
 
 	req := validRegisterRequest()
 
-	hasher.On("HashPassword", req.Password).Return("", errs.ErrHashingFailed)
+	m.hasher.On("HashPassword", req.Password).Return("", errs.ErrHashingFailed)
 
 	_, err := svc.Register(context.Background(), req)
 	st, _ := status.FromError(err)
 	assert.Equal(t, codes.Internal, st.Code())
 }
 
+// TestRegister_WeakPassword ensures that a password violating the password
+// policy is rejected with InvalidArgument before it is hashed.
+func TestRegister_WeakPassword(t *testing.T) {
+	// Scenario: The password contains the requested nickname.
+	svc, m := newAuthService(t)
+
+	req := validRegisterRequest()
+	req.Password = "Newbie-2024!"
+
+	_, err := svc.Register(context.Background(), req)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Contains(t, st.Message(), errs.ErrWeakPassword.Error())
+	m.hasher.AssertNotCalled(t, "HashPassword", mock.Anything)
+	m.authRepo.AssertNotCalled(t, "CreateUser", mock.Anything, mock.Anything)
+}
+
 // TestRegister_CreateUserFails_EmailTaken ensures that an email taken error
 // results in an AlreadyExists gRPC error.
 func TestRegister_CreateUserFails_EmailTaken(t *testing.T) {
 	// Scenario: Registration fails because the email is already in use.
-	authRepo := new(mocks.AuthRepoMock)
-	userRepo := new(mocks.UserRepoMock)
-	tokenRepo := new(mocks.TokenRepoMock)
-	jwtMock := new(mocks.JWTGeneratorMock)
-	hasher := new(mocks.MockHasher)
-	mapper := new(mocks.MockMapper)
-	svc := service.NewAuthService(authRepo, userRepo, tokenRepo, jwtMock, hasher, mapper)
+	svc, m := newAuthService(t)
 
 	req := validRegisterRequest()
 	hashedPassword := "hashed-password"
 
-	hasher.On("HashPassword", req.Password).Return(hashedPassword, nil)
-	mapper.On("ToUserModel", req, hashedPassword).Return(model.User{})
-	authRepo.On("CreateUser", mock.Anything, mock.Anything).Return(model.User{}, errs.ErrEmailTaken)
+	m.hasher.On("HashPassword", req.Password).Return(hashedPassword, nil)
+	m.mapper.On("ToUserModel", req, hashedPassword).Return(model.User{})
+	m.authRepo.On("CreateUser", mock.Anything, mock.Anything).Return(model.User{}, errs.ErrEmailTaken)
 
 	_, err := svc.Register(context.Background(), req)
 	st, _ := status.FromError(err)
@@ -113,22 +153,16 @@ func TestRegister_CreateUserFails_EmailTaken(t *testing.T) {
 // results in an Internal gRPC error.
 func TestRegister_TokenGenerationFails(t *testing.T) {
 	// Scenario: Registration fails because token generation returns an error.
-	authRepo := new(mocks.AuthRepoMock)
-	userRepo := new(mocks.UserRepoMock)
-	tokenRepo := new(mocks.TokenRepoMock)
-	jwtMock := new(mocks.JWTGeneratorMock)
-	hasher := new(mocks.MockHasher)
-	mapper := new(mocks.MockMapper)
-	svc := service.NewAuthService(authRepo, userRepo, tokenRepo, jwtMock, hasher, mapper)
+	svc, m := newAuthService(t)
 
 	req := validRegisterRequest()
 	hashedPassword := "hashed-password"
 	createdUser := model.User{ID: 1, Nickname: req.Nickname}
 
-	hasher.On("HashPassword", req.Password).Return(hashedPassword, nil)
-	mapper.On("ToUserModel", req, hashedPassword).Return(model.User{})
-	authRepo.On("CreateUser", mock.Anything, mock.Anything).Return(createdUser, nil)
-	jwtMock.On("CreateTokenPair", mock.Anything).Return(model.TokenPair{}, errs.ErrTokenSigningFailed)
+	m.hasher.On("HashPassword", req.Password).Return(hashedPassword, nil)
+	m.mapper.On("ToUserModel", req, hashedPassword).Return(model.User{})
+	m.authRepo.On("CreateUser", mock.Anything, mock.Anything).Return(createdUser, nil)
+	m.jwtMock.On("CreateTokenPair", mock.Anything).Return(model.TokenPair{}, errs.ErrTokenSigningFailed)
 
 	_, err := svc.Register(context.Background(), req)
 	st, _ := status.FromError(err)
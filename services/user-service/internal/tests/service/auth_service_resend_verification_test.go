@@ -0,0 +1,112 @@
+// Package service_test verifies the behavior of AuthService’s verification
+// email resend logic, covering throttling and enumeration resistance.
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/testdata"
+)
+
+// validResendRequest returns a ResendVerificationEmailRequest for the sample user.
+func validResendRequest() *userauthpb.ResendVerificationEmailRequest {
+	return &userauthpb.ResendVerificationEmailRequest{
+		Email: testdata.SampleUserModel().Email,
+	}
+}
+
+// TestResendVerification_Success ensures that an unverified user outside the
+// cooldown window receives a new verification email.
+func TestResendVerification_Success(t *testing.T) {
+	// Scenario: The last token was issued well before the cooldown.
+	svc, m := newAuthService(t)
+
+	req := validResendRequest()
+	user := testdata.SampleUserModel()
+
+	m.mapper.On("ToResendVerificationEmailRequest", req).Return(transport.ResendVerificationEmailRequest{Email: req.Email})
+	m.authRepo.On("FetchUserByEmail", mock.Anything, req.Email).Return(user, nil)
+	m.verifyRepo.On("SaveVerificationToken", mock.Anything, mock.MatchedBy(func(in domain.SaveVerificationTokenInput) bool {
+		return in.UserID == user.ID && in.Cooldown > 0
+	})).Return(nil)
+	m.mailer.On("Send", mock.Anything, mock.Anything).Return(nil)
+	m.mapper.On("ToResendVerificationEmailResponse", mock.Anything).Return(&userauthpb.ResendVerificationEmailResponse{Message: "sent"})
+
+	resp, err := svc.ResendVerificationEmail(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "sent", resp.Message)
+	m.mailer.AssertExpectations(t)
+}
+
+// TestResendVerification_Throttled ensures that a request inside the cooldown
+// window gets the same response as a successful send, so it does not reveal
+// that the account exists, and that no email is sent.
+func TestResendVerification_Throttled(t *testing.T) {
+	// Scenario: A token was issued a few seconds ago.
+	svc, m := newAuthService(t)
+
+	req := validResendRequest()
+	user := testdata.SampleUserModel()
+
+	m.mapper.On("ToResendVerificationEmailRequest", req).Return(transport.ResendVerificationEmailRequest{Email: req.Email})
+	m.authRepo.On("FetchUserByEmail", mock.Anything, req.Email).Return(user, nil)
+	m.verifyRepo.On("SaveVerificationToken", mock.Anything, mock.Anything).Return(errs.ErrVerificationThrottled)
+	m.mapper.On("ToResendVerificationEmailResponse", mock.Anything).Return(&userauthpb.ResendVerificationEmailResponse{Message: "sent"})
+
+	resp, err := svc.ResendVerificationEmail(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "sent", resp.Message)
+	m.mailer.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+}
+
+// TestResendVerification_UnknownEmail ensures that an unregistered address gets
+// the same response as a successful send, preventing account enumeration.
+func TestResendVerification_UnknownEmail(t *testing.T) {
+	// Scenario: No account exists for the address.
+	svc, m := newAuthService(t)
+
+	req := validResendRequest()
+
+	m.mapper.On("ToResendVerificationEmailRequest", req).Return(transport.ResendVerificationEmailRequest{Email: req.Email})
+	m.authRepo.On("FetchUserByEmail", mock.Anything, req.Email).Return(model.User{}, errs.ErrUserNotFound)
+	m.mapper.On("ToResendVerificationEmailResponse", mock.Anything).Return(&userauthpb.ResendVerificationEmailResponse{Message: "sent"})
+
+	resp, err := svc.ResendVerificationEmail(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "sent", resp.Message)
+	m.mailer.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	m.verifyRepo.AssertNotCalled(t, "SaveVerificationToken", mock.Anything, mock.Anything)
+}
+
+// TestResendVerification_AlreadyVerified ensures that verified accounts do not
+// receive further verification emails.
+func TestResendVerification_AlreadyVerified(t *testing.T) {
+	// Scenario: The account's email was confirmed earlier.
+	svc, m := newAuthService(t)
+
+	req := validResendRequest()
+	user := testdata.SampleUserModel()
+	user.EmailVerifiedAt = time.Now().Add(-24 * time.Hour)
+
+	m.mapper.On("ToResendVerificationEmailRequest", req).Return(transport.ResendVerificationEmailRequest{Email: req.Email})
+	m.authRepo.On("FetchUserByEmail", mock.Anything, req.Email).Return(user, nil)
+	m.mapper.On("ToResendVerificationEmailResponse", mock.Anything).Return(&userauthpb.ResendVerificationEmailResponse{Message: "sent"})
+
+	_, err := svc.ResendVerificationEmail(context.Background(), req)
+
+	assert.NoError(t, err)
+	m.mailer.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,131 @@
+// Package service_test verifies the behavior of AuthService’s session
+// management logic: listing and revoking the caller's devices.
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/testdata"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
+)
+
+// callerContext returns a context authenticated as the sample session's owner.
+func callerContext() context.Context {
+	session := testdata.SampleSession()
+	return utils.ContextWithAuthInfo(context.Background(), utils.AuthInfo{
+		UserID:    session.UserID,
+		SessionID: session.ID,
+	})
+}
+
+// TestListSessions_Success ensures that the caller's sessions are listed with
+// the current session identified to the mapper.
+func TestListSessions_Success(t *testing.T) {
+	// Scenario: The caller has two active sessions.
+	svc, m := newAuthService(t)
+
+	current := testdata.SampleSession()
+	other := testdata.SampleSession()
+	other.ID = "0b8f2a4e-1d3c-4f5a-9e7b-6c2d8a1f4e3b"
+	sessions := []model.Session{current, other}
+	expected := &userauthpb.ListSessionsResponse{Sessions: []*userauthpb.Session{
+		{SessionId: current.ID, Current: true},
+		{SessionId: other.ID},
+	}}
+
+	m.tokenRepo.On("ListSessions", mock.Anything, current.UserID).Return(sessions, nil)
+	m.mapper.On("ToListSessionsResponse", sessions, current.ID).Return(expected)
+
+	resp, err := svc.ListSessions(callerContext(), &userauthpb.ListSessionsRequest{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, resp)
+}
+
+// TestListSessions_Unauthenticated ensures that a request without caller
+// identity is rejected.
+func TestListSessions_Unauthenticated(t *testing.T) {
+	// Scenario: The auth interceptor did not attach a caller.
+	svc, _ := newAuthService(t)
+
+	_, err := svc.ListSessions(context.Background(), &userauthpb.ListSessionsRequest{})
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+}
+
+// TestRevokeSession_Success ensures that a session owned by the caller is revoked.
+func TestRevokeSession_Success(t *testing.T) {
+	// Scenario: The caller revokes another of their devices.
+	svc, m := newAuthService(t)
+
+	req := &userauthpb.RevokeSessionRequest{SessionId: "0b8f2a4e-1d3c-4f5a-9e7b-6c2d8a1f4e3b"}
+
+	m.tokenRepo.On("DeleteSession", mock.Anything, int64(1), req.SessionId).Return(nil)
+	m.mapper.On("ToRevokeSessionResponse", transport.RevokeSessionResponse{Message: "Session revoked"}).
+		Return(&userauthpb.RevokeSessionResponse{Message: "Session revoked"})
+
+	resp, err := svc.RevokeSession(callerContext(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Session revoked", resp.Message)
+	m.tokenRepo.AssertExpectations(t)
+}
+
+// TestRevokeSession_NotFound ensures that revoking an unknown or foreign
+// session yields a NotFound gRPC error.
+func TestRevokeSession_NotFound(t *testing.T) {
+	// Scenario: The session ID belongs to a different user.
+	svc, m := newAuthService(t)
+
+	req := &userauthpb.RevokeSessionRequest{SessionId: "0b8f2a4e-1d3c-4f5a-9e7b-6c2d8a1f4e3b"}
+
+	m.tokenRepo.On("DeleteSession", mock.Anything, int64(1), req.SessionId).Return(errs.ErrSessionNotFound)
+
+	_, err := svc.RevokeSession(callerContext(), req)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.Equal(t, errs.ErrSessionNotFound.Error(), st.Message())
+}
+
+// TestRevokeAllOtherSessions_KeepsCurrent ensures that only sessions other than
+// the caller's current one are revoked.
+func TestRevokeAllOtherSessions_KeepsCurrent(t *testing.T) {
+	// Scenario: The caller signs out all other devices.
+	svc, m := newAuthService(t)
+
+	current := testdata.SampleSession()
+
+	m.tokenRepo.On("DeleteOtherSessions", mock.Anything, current.UserID, current.ID).Return(int64(3), nil)
+	m.mapper.On("ToRevokeAllOtherSessionsResponse", transport.RevokeAllOtherSessionsResponse{RevokedCount: 3}).
+		Return(&userauthpb.RevokeAllOtherSessionsResponse{RevokedCount: 3})
+
+	resp, err := svc.RevokeAllOtherSessions(callerContext(), &userauthpb.RevokeAllOtherSessionsRequest{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), resp.RevokedCount)
+	m.tokenRepo.AssertExpectations(t)
+}
+
+// TestRevokeAllOtherSessions_NoSessionClaim ensures that a token without a
+// session ID cannot be used to revoke other sessions.
+func TestRevokeAllOtherSessions_NoSessionClaim(t *testing.T) {
+	// Scenario: A legacy access token lacks the "sid" claim.
+	svc, m := newAuthService(t)
+
+	ctx := utils.ContextWithAuthInfo(context.Background(), utils.AuthInfo{UserID: 1})
+
+	_, err := svc.RevokeAllOtherSessions(ctx, &userauthpb.RevokeAllOtherSessionsRequest{})
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+	m.tokenRepo.AssertNotCalled(t, "DeleteOtherSessions", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,78 @@
+// Package service_test verifies the behavior of AuthService’s email
+// verification logic, covering token redemption outcomes.
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userauthpb "github.com/mamataliev-dev/social-platform/api/gen/user_auth/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/security"
+)
+
+// validVerifyEmailRequest returns a VerifyEmailRequest with a sample token.
+func validVerifyEmailRequest() *userauthpb.VerifyEmailRequest {
+	return &userauthpb.VerifyEmailRequest{
+		Token: "sample-verification-token-0123456789abcdef",
+	}
+}
+
+// TestVerifyEmail_Success ensures that a valid token is redeemed by its digest
+// and a confirmation message is returned.
+func TestVerifyEmail_Success(t *testing.T) {
+	// Scenario: The token exists and has not expired.
+	svc, m := newAuthService(t)
+
+	req := validVerifyEmailRequest()
+
+	m.mapper.On("ToVerifyEmailRequest", req).Return(transport.VerifyEmailRequest{Token: req.Token})
+	m.verifyRepo.On("ConsumeVerificationToken", mock.Anything, security.HashToken(req.Token)).Return(int64(1), nil)
+	m.mapper.On("ToVerifyEmailResponse", mock.Anything).Return(&userauthpb.VerifyEmailResponse{Message: "Email verified successfully"})
+
+	resp, err := svc.VerifyEmail(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Email verified successfully", resp.Message)
+	m.verifyRepo.AssertExpectations(t)
+}
+
+// TestVerifyEmail_InvalidToken ensures that an unknown or expired token yields
+// an InvalidArgument gRPC error.
+func TestVerifyEmail_InvalidToken(t *testing.T) {
+	// Scenario: The token was never issued, already used, or expired.
+	svc, m := newAuthService(t)
+
+	req := validVerifyEmailRequest()
+
+	m.mapper.On("ToVerifyEmailRequest", req).Return(transport.VerifyEmailRequest{Token: req.Token})
+	m.verifyRepo.On("ConsumeVerificationToken", mock.Anything, mock.Anything).Return(int64(0), errs.ErrInvalidVerificationToken)
+
+	_, err := svc.VerifyEmail(context.Background(), req)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, errs.ErrInvalidVerificationToken.Error(), st.Message())
+}
+
+// TestVerifyEmail_DBError ensures that a storage failure yields an Internal
+// gRPC error.
+func TestVerifyEmail_DBError(t *testing.T) {
+	// Scenario: The database fails while redeeming the token.
+	svc, m := newAuthService(t)
+
+	req := validVerifyEmailRequest()
+
+	m.mapper.On("ToVerifyEmailRequest", req).Return(transport.VerifyEmailRequest{Token: req.Token})
+	m.verifyRepo.On("ConsumeVerificationToken", mock.Anything, mock.Anything).Return(int64(0), errs.ErrDBFailure)
+
+	_, err := svc.VerifyEmail(context.Background(), req)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Internal, st.Code())
+	assert.Equal(t, errs.ErrInternal.Error(), st.Message())
+}
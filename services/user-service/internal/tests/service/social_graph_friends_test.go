@@ -0,0 +1,347 @@
+// Package service_test verifies the behavior of SocialGraphService’s logic for
+// friend requests, friend lists and friend suggestions, and the internal
+// friendship check.
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	socialpb "github.com/mamataliev-dev/social-platform/api/gen/social/v1"
+	userpb "github.com/mamataliev-dev/social-platform/api/gen/user/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/service"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/mocks"
+)
+
+func newFriendsService() (*service.SocialGraphService, *mocks.UserRepoMock, *mocks.FriendRepoMock, *mocks.MockMapper) {
+	userRepo := new(mocks.UserRepoMock)
+	friendRepo := new(mocks.FriendRepoMock)
+	mapper := new(mocks.MockMapper)
+	return service.NewSocialGraphService(userRepo, new(mocks.FollowRepoMock), friendRepo, mapper), userRepo, friendRepo, mapper
+}
+
+// friendEntries returns n entries with descending friendship times and
+// ascending IDs.
+func friendEntries(n int) []transport.FriendEntry {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	entries := make([]transport.FriendEntry, n)
+	for i := range entries {
+		entries[i] = transport.FriendEntry{
+			Profile:   profileWithID(int64(i + 10)),
+			CreatedAt: start.Add(-time.Duration(i) * time.Minute),
+		}
+	}
+	return entries
+}
+
+// friendship returns the friendship of callerID with otherID.
+func friendship(otherID int64, state transport.FriendshipState) transport.Friendship {
+	return transport.Friendship{UserID: callerID, OtherUserID: otherID, State: state}
+}
+
+// privateProfile returns a private account with the given ID.
+func privateProfile(id int64) transport.UserProfileResponse {
+	p := profileWithID(id)
+	p.Privacy.PrivateAccount = true
+	return p
+}
+
+// TestSendFriendRequest_Success ensures that the request is stored and the
+// resulting friendship is returned.
+func TestSendFriendRequest_Success(t *testing.T) {
+	// Scenario: The caller asks another user to become friends.
+	svc, _, friendRepo, mapper := newFriendsService()
+
+	f := friendship(2, transport.FriendshipStateRequestSent)
+	expected := &socialpb.Friendship{}
+
+	friendRepo.On("SendFriendRequest", mock.Anything, callerID, int64(2)).Return(nil).Once()
+	friendRepo.On("GetFriendship", mock.Anything, callerID, int64(2)).Return(f, nil)
+	mapper.On("ToFriendshipResponse", f).Return(expected)
+
+	resp, err := svc.SendFriendRequest(callerContext(), &socialpb.SendFriendRequestRequest{UserId: 2})
+
+	require.NoError(t, err)
+	assert.Same(t, expected, resp)
+	friendRepo.AssertExpectations(t)
+}
+
+// TestSendFriendRequest_Self ensures that users cannot befriend themselves.
+func TestSendFriendRequest_Self(t *testing.T) {
+	// Scenario: The caller sends a friend request to their own account.
+	svc, _, friendRepo, _ := newFriendsService()
+
+	_, err := svc.SendFriendRequest(callerContext(), &socialpb.SendFriendRequestRequest{UserId: callerID})
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, errs.ErrCannotFriendSelf.Error(), st.Message())
+	friendRepo.AssertNotCalled(t, "SendFriendRequest", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestSendFriendRequest_NotFound ensures that asking an unknown account
+// results in a NotFound gRPC error.
+func TestSendFriendRequest_NotFound(t *testing.T) {
+	// Scenario: The addressee does not exist.
+	svc, _, friendRepo, _ := newFriendsService()
+
+	friendRepo.On("SendFriendRequest", mock.Anything, callerID, int64(2)).Return(errs.ErrUserNotFound)
+
+	_, err := svc.SendFriendRequest(callerContext(), &socialpb.SendFriendRequestRequest{UserId: 2})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// TestRespondToFriendRequest ensures that the caller's answer is passed on and
+// the resulting friendship is returned.
+func TestRespondToFriendRequest(t *testing.T) {
+	for name, tt := range map[string]struct {
+		accept bool
+		state  transport.FriendshipState
+	}{
+		"accept":  {accept: true, state: transport.FriendshipStateFriends},
+		"decline": {accept: false, state: transport.FriendshipStateNone},
+	} {
+		t.Run(name, func(t *testing.T) {
+			svc, _, friendRepo, mapper := newFriendsService()
+
+			f := friendship(5, tt.state)
+			friendRepo.On("RespondToFriendRequest", mock.Anything, callerID, int64(5), tt.accept).Return(nil).Once()
+			friendRepo.On("GetFriendship", mock.Anything, callerID, int64(5)).Return(f, nil)
+			mapper.On("ToFriendshipResponse", f).Return(&socialpb.Friendship{})
+
+			_, err := svc.RespondToFriendRequest(callerContext(), &socialpb.RespondToFriendRequestRequest{
+				RequesterId: 5,
+				Accept:      tt.accept,
+			})
+
+			require.NoError(t, err)
+			friendRepo.AssertExpectations(t)
+			mapper.AssertExpectations(t)
+		})
+	}
+}
+
+// TestRespondToFriendRequest_NotFound ensures that answering a request that
+// does not exist results in a NotFound gRPC error.
+func TestRespondToFriendRequest_NotFound(t *testing.T) {
+	// Scenario: There is no pending request from the given user.
+	svc, _, friendRepo, _ := newFriendsService()
+
+	friendRepo.On("RespondToFriendRequest", mock.Anything, callerID, int64(5), true).
+		Return(errs.ErrFriendRequestNotFound)
+
+	_, err := svc.RespondToFriendRequest(callerContext(), &socialpb.RespondToFriendRequestRequest{RequesterId: 5, Accept: true})
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.Equal(t, errs.ErrFriendRequestNotFound.Error(), st.Message())
+}
+
+// TestRemoveFriend_Success ensures that the friendship is removed and the
+// resulting friendship is returned.
+func TestRemoveFriend_Success(t *testing.T) {
+	// Scenario: The caller ends a friendship.
+	svc, _, friendRepo, mapper := newFriendsService()
+
+	f := friendship(2, transport.FriendshipStateNone)
+	friendRepo.On("RemoveFriend", mock.Anything, callerID, int64(2)).Return(nil).Once()
+	friendRepo.On("GetFriendship", mock.Anything, callerID, int64(2)).Return(f, nil)
+	mapper.On("ToFriendshipResponse", f).Return(&socialpb.Friendship{})
+
+	_, err := svc.RemoveFriend(callerContext(), &socialpb.RemoveFriendRequest{UserId: 2})
+
+	require.NoError(t, err)
+	friendRepo.AssertExpectations(t)
+}
+
+// TestListFriends_Pages ensures that a page token is issued when there are
+// more friends and that it is only accepted for the same list.
+func TestListFriends_Pages(t *testing.T) {
+	// Scenario: The caller pages through the friends of a public account, then
+	// replays the token against a mutual friends list.
+	svc, userRepo, friendRepo, mapper := newFriendsService()
+
+	req := &socialpb.ListFriendsRequest{UserId: 2, PageSize: 2}
+	entries := friendEntries(3)
+
+	mapper.On("ToListFriendsRequest", req).Return(transport.ListFriendsRequest{UserID: 2, PageSize: 2})
+	userRepo.On("FetchUserByID", mock.Anything, mock.Anything).Return(profileWithID(2), nil)
+	friendRepo.On("ListFriends", mock.Anything, transport.FriendListQuery{UserID: 2, Limit: 3}).Return(entries, nil)
+
+	var token string
+	mapper.On("ToListFriendsResponse", entries[:2], mock.AnythingOfType("string"), callerID).
+		Run(func(args mock.Arguments) { token = args.String(1) }).
+		Return(&socialpb.ListFriendsResponse{})
+
+	_, err := svc.ListFriends(callerContext(), req)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+
+	mutualReq := &socialpb.ListMutualFriendsRequest{UserId: 2, OtherUserId: 3, PageToken: token}
+	mapper.On("ToListMutualFriendsRequest", mutualReq).
+		Return(transport.ListFriendsRequest{UserID: 2, OtherUserID: 3, PageToken: token})
+
+	_, err = svc.ListMutualFriends(callerContext(), mutualReq)
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	friendRepo.AssertNotCalled(t, "ListMutualFriends", mock.Anything, mock.Anything)
+}
+
+// TestListFriends_PrivateAccount ensures that the friends of a private
+// account are only listed to its friends.
+func TestListFriends_PrivateAccount(t *testing.T) {
+	for name, tt := range map[string]struct {
+		state transport.FriendshipState
+		want  codes.Code
+	}{
+		"friend":       {state: transport.FriendshipStateFriends, want: codes.OK},
+		"request sent": {state: transport.FriendshipStateRequestSent, want: codes.PermissionDenied},
+		"stranger":     {state: transport.FriendshipStateNone, want: codes.PermissionDenied},
+	} {
+		t.Run(name, func(t *testing.T) {
+			svc, userRepo, friendRepo, mapper := newFriendsService()
+
+			req := &socialpb.ListFriendsRequest{UserId: 2}
+			mapper.On("ToListFriendsRequest", req).Return(transport.ListFriendsRequest{UserID: 2})
+			userRepo.On("FetchUserByID", mock.Anything, mock.Anything).Return(privateProfile(2), nil)
+			friendRepo.On("GetFriendship", mock.Anything, callerID, int64(2)).Return(friendship(2, tt.state), nil)
+			friendRepo.On("ListFriends", mock.Anything, mock.Anything).Return(nil, nil)
+			mapper.On("ToListFriendsResponse", mock.Anything, "", callerID).Return(&socialpb.ListFriendsResponse{})
+
+			_, err := svc.ListFriends(callerContext(), req)
+
+			assert.Equal(t, tt.want, status.Code(err))
+		})
+	}
+}
+
+// TestListMutualFriends_ChecksBothUsers ensures that mutual friends are only
+// listed if the caller may see both friend lists.
+func TestListMutualFriends_ChecksBothUsers(t *testing.T) {
+	// Scenario: The first user is public, the second private and a stranger.
+	svc, userRepo, friendRepo, mapper := newFriendsService()
+
+	req := &socialpb.ListMutualFriendsRequest{UserId: 2, OtherUserId: 3}
+	mapper.On("ToListMutualFriendsRequest", req).Return(transport.ListFriendsRequest{UserID: 2, OtherUserID: 3})
+	userRepo.On("FetchUserByID", mock.Anything, transport.FetchUserByIDRequest{UserId: 2}).Return(profileWithID(2), nil)
+	userRepo.On("FetchUserByID", mock.Anything, transport.FetchUserByIDRequest{UserId: 3}).Return(privateProfile(3), nil)
+	friendRepo.On("GetFriendship", mock.Anything, callerID, int64(3)).
+		Return(friendship(3, transport.FriendshipStateNone), nil)
+
+	_, err := svc.ListMutualFriends(callerContext(), req)
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	friendRepo.AssertNotCalled(t, "ListMutualFriends", mock.Anything, mock.Anything)
+}
+
+// TestListMutualFriends_Success ensures that mutual friends of two visible
+// users are listed.
+func TestListMutualFriends_Success(t *testing.T) {
+	// Scenario: The caller lists the friends they share with a public account.
+	svc, userRepo, friendRepo, mapper := newFriendsService()
+
+	req := &socialpb.ListMutualFriendsRequest{UserId: callerID, OtherUserId: 3}
+	entries := friendEntries(1)
+
+	mapper.On("ToListMutualFriendsRequest", req).Return(transport.ListFriendsRequest{UserID: callerID, OtherUserID: 3})
+	userRepo.On("FetchUserByID", mock.Anything, mock.Anything).Return(profileWithID(3), nil)
+	friendRepo.On("ListMutualFriends", mock.Anything, transport.FriendListQuery{UserID: callerID, OtherUserID: 3, Limit: 21}).
+		Return(entries, nil)
+	mapper.On("ToListFriendsResponse", entries, "", callerID).Return(&socialpb.ListFriendsResponse{})
+
+	_, err := svc.ListMutualFriends(callerContext(), req)
+
+	require.NoError(t, err)
+	friendRepo.AssertExpectations(t)
+}
+
+// TestListFriendRequests_Success ensures that the caller's incoming requests
+// are listed.
+func TestListFriendRequests_Success(t *testing.T) {
+	// Scenario: The caller reviews pending friend requests.
+	svc, _, friendRepo, mapper := newFriendsService()
+
+	entries := friendEntries(1)
+	friendRepo.On("ListFriendRequests", mock.Anything, transport.FriendListQuery{UserID: callerID, Limit: 21}).Return(entries, nil)
+	mapper.On("ToListFriendRequestsResponse", entries, "", callerID).Return(&socialpb.ListFriendRequestsResponse{})
+
+	_, err := svc.ListFriendRequests(callerContext(), &socialpb.ListFriendRequestsRequest{})
+
+	require.NoError(t, err)
+	friendRepo.AssertExpectations(t)
+}
+
+// TestListFriendSuggestions ensures that suggestions are fetched for the
+// caller with the default or requested size.
+func TestListFriendSuggestions(t *testing.T) {
+	for name, tt := range map[string]struct {
+		pageSize int32
+		limit    int
+	}{
+		"default":   {pageSize: 0, limit: 20},
+		"requested": {pageSize: 5, limit: 5},
+	} {
+		t.Run(name, func(t *testing.T) {
+			svc, _, friendRepo, mapper := newFriendsService()
+
+			suggestions := []transport.FriendSuggestion{{Profile: profileWithID(7), MutualFriendCount: 3}}
+			friendRepo.On("ListFriendSuggestions", mock.Anything, callerID, tt.limit).Return(suggestions, nil)
+			mapper.On("ToListFriendSuggestionsResponse", suggestions, callerID).
+				Return(&socialpb.ListFriendSuggestionsResponse{})
+
+			_, err := svc.ListFriendSuggestions(callerContext(), &socialpb.ListFriendSuggestionsRequest{PageSize: tt.pageSize})
+
+			require.NoError(t, err)
+			friendRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// TestCheckFriendship ensures that internal callers learn whether two users
+// are friends.
+func TestCheckFriendship(t *testing.T) {
+	for name, tt := range map[string]struct {
+		state transport.FriendshipState
+		want  bool
+	}{
+		"friends":      {state: transport.FriendshipStateFriends, want: true},
+		"request sent": {state: transport.FriendshipStateRequestSent, want: false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			friendRepo := new(mocks.FriendRepoMock)
+			svc := service.NewInternalUserService(new(mocks.UserRepoMock), new(mocks.UserEventRepoMock), new(mocks.FollowRepoMock), friendRepo, new(mocks.MockMapper))
+
+			friendRepo.On("GetFriendship", mock.Anything, int64(1), int64(2)).
+				Return(transport.Friendship{UserID: 1, OtherUserID: 2, State: tt.state}, nil)
+
+			resp, err := svc.CheckFriendship(context.Background(), &userpb.CheckFriendshipRequest{UserId: 1, OtherUserId: 2})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, resp.GetFriends())
+		})
+	}
+}
+
+// TestCheckFriendship_NotFound ensures that an unknown user results in a
+// NotFound gRPC error.
+func TestCheckFriendship_NotFound(t *testing.T) {
+	friendRepo := new(mocks.FriendRepoMock)
+	svc := service.NewInternalUserService(new(mocks.UserRepoMock), new(mocks.UserEventRepoMock), new(mocks.FollowRepoMock), friendRepo, new(mocks.MockMapper))
+
+	friendRepo.On("GetFriendship", mock.Anything, int64(1), int64(2)).
+		Return(transport.Friendship{}, errs.ErrUserNotFound)
+
+	_, err := svc.CheckFriendship(context.Background(), &userpb.CheckFriendshipRequest{UserId: 1, OtherUserId: 2})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,430 @@
+// Package service_test verifies the behavior of SocialGraphService’s logic for
+// following users, follow lists and follow requests.
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	socialpb "github.com/mamataliev-dev/social-platform/api/gen/social/v1"
+	userpb "github.com/mamataliev-dev/social-platform/api/gen/user/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/service"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/mocks"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
+)
+
+// callerID is the user of callerContext.
+const callerID int64 = 1
+
+func newSocialGraphService() (*service.SocialGraphService, *mocks.UserRepoMock, *mocks.FollowRepoMock, *mocks.MockMapper) {
+	userRepo := new(mocks.UserRepoMock)
+	followRepo := new(mocks.FollowRepoMock)
+	mapper := new(mocks.MockMapper)
+	return service.NewSocialGraphService(userRepo, followRepo, new(mocks.FriendRepoMock), mapper), userRepo, followRepo, mapper
+}
+
+// followEntries returns n entries with descending follow times and ascending IDs.
+func followEntries(n int) []transport.FollowEntry {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	entries := make([]transport.FollowEntry, n)
+	for i := range entries {
+		entries[i] = transport.FollowEntry{
+			Profile:   profileWithID(int64(i + 10)),
+			CreatedAt: start.Add(-time.Duration(i) * time.Minute),
+		}
+	}
+	return entries
+}
+
+// relationship returns a relationship of callerID with otherID.
+func relationship(otherID int64, following, followedBy transport.FollowState) transport.Relationship {
+	return transport.Relationship{
+		UserID:      callerID,
+		OtherUserID: otherID,
+		Following:   following,
+		FollowedBy:  followedBy,
+	}
+}
+
+// TestFollow_Success ensures that the follow is stored and the resulting
+// relationship is returned.
+func TestFollow_Success(t *testing.T) {
+	// Scenario: The caller follows a public account.
+	svc, _, followRepo, mapper := newSocialGraphService()
+
+	rel := relationship(2, transport.FollowStateFollowing, transport.FollowStateNone)
+	expected := &socialpb.Relationship{}
+
+	followRepo.On("Follow", mock.Anything, callerID, int64(2)).Return(nil).Once()
+	followRepo.On("GetRelationship", mock.Anything, callerID, int64(2)).Return(rel, nil)
+	mapper.On("ToRelationshipResponse", rel).Return(expected)
+
+	resp, err := svc.Follow(callerContext(), &socialpb.FollowRequest{UserId: 2})
+
+	require.NoError(t, err)
+	assert.Same(t, expected, resp)
+	followRepo.AssertExpectations(t)
+}
+
+// TestFollow_Self ensures that users cannot follow themselves.
+func TestFollow_Self(t *testing.T) {
+	// Scenario: The caller tries to follow their own account.
+	svc, _, followRepo, _ := newSocialGraphService()
+
+	_, err := svc.Follow(callerContext(), &socialpb.FollowRequest{UserId: callerID})
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, errs.ErrCannotFollowSelf.Error(), st.Message())
+	followRepo.AssertNotCalled(t, "Follow", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestFollow_NotFound ensures that following an unknown account results in a
+// NotFound gRPC error.
+func TestFollow_NotFound(t *testing.T) {
+	// Scenario: The followed account does not exist.
+	svc, _, followRepo, _ := newSocialGraphService()
+
+	followRepo.On("Follow", mock.Anything, callerID, int64(2)).Return(errs.ErrUserNotFound)
+
+	_, err := svc.Follow(callerContext(), &socialpb.FollowRequest{UserId: 2})
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
+// TestFollow_Unauthenticated ensures that a caller is required.
+func TestFollow_Unauthenticated(t *testing.T) {
+	// Scenario: The request carries no caller.
+	svc, _, _, _ := newSocialGraphService()
+
+	_, err := svc.Follow(context.Background(), &socialpb.FollowRequest{UserId: 2})
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+}
+
+// TestUnfollow_Success ensures that the follow is removed and the resulting
+// relationship is returned.
+func TestUnfollow_Success(t *testing.T) {
+	// Scenario: The caller unfollows a user.
+	svc, _, followRepo, mapper := newSocialGraphService()
+
+	rel := relationship(2, transport.FollowStateNone, transport.FollowStateNone)
+
+	followRepo.On("Unfollow", mock.Anything, callerID, int64(2)).Return(nil).Once()
+	followRepo.On("GetRelationship", mock.Anything, callerID, int64(2)).Return(rel, nil)
+	mapper.On("ToRelationshipResponse", rel).Return(&socialpb.Relationship{})
+
+	_, err := svc.Unfollow(callerContext(), &socialpb.UnfollowRequest{UserId: 2})
+
+	require.NoError(t, err)
+	followRepo.AssertExpectations(t)
+}
+
+// TestGetRelationship_HidesRequestsFromOthers ensures that pending follow
+// requests are only reported to the two users involved.
+func TestGetRelationship_HidesRequestsFromOthers(t *testing.T) {
+	stored := transport.Relationship{
+		UserID:      2,
+		OtherUserID: 3,
+		Following:   transport.FollowStateRequested,
+		FollowedBy:  transport.FollowStateFollowing,
+	}
+
+	for name, tt := range map[string]struct {
+		caller int64
+		want   transport.FollowState
+	}{
+		"participant": {caller: 2, want: transport.FollowStateRequested},
+		"third party": {caller: callerID, want: transport.FollowStateNone},
+	} {
+		t.Run(name, func(t *testing.T) {
+			svc, _, followRepo, mapper := newSocialGraphService()
+			ctx := utils.ContextWithAuthInfo(context.Background(), utils.AuthInfo{UserID: tt.caller})
+
+			want := stored
+			want.Following = tt.want
+
+			followRepo.On("GetRelationship", mock.Anything, int64(2), int64(3)).Return(stored, nil)
+			mapper.On("ToRelationshipResponse", want).Return(&socialpb.Relationship{})
+
+			_, err := svc.GetRelationship(ctx, &socialpb.GetRelationshipRequest{UserId: 2, OtherUserId: 3})
+
+			require.NoError(t, err)
+			mapper.AssertExpectations(t)
+		})
+	}
+}
+
+// listFollowersFirstPage lists the first page of two followers of a public
+// account with three followers and returns the issued page token.
+func listFollowersFirstPage(t *testing.T) string {
+	t.Helper()
+	svc, userRepo, followRepo, mapper := newSocialGraphService()
+
+	req := &socialpb.ListFollowsRequest{UserId: 2, PageSize: 2}
+	entries := followEntries(3)
+
+	mapper.On("ToListFollowsRequest", req).Return(transport.ListFollowsRequest{UserID: 2, PageSize: 2})
+	userRepo.On("FetchUserByID", mock.Anything, transport.FetchUserByIDRequest{UserId: 2}).Return(profileWithID(2), nil)
+	followRepo.On("ListFollowers", mock.Anything, transport.FollowListQuery{UserID: 2, Limit: 3}).Return(entries, nil)
+
+	var token string
+	mapper.On("ToListFollowsResponse", entries[:2], mock.AnythingOfType("string"), callerID).
+		Run(func(args mock.Arguments) { token = args.String(1) }).
+		Return(&socialpb.ListFollowsResponse{})
+
+	_, err := svc.ListFollowers(callerContext(), req)
+	require.NoError(t, err)
+	mapper.AssertExpectations(t)
+	return token
+}
+
+// TestListFollowers_Pages ensures that a page token is issued when there are
+// more followers and continues after the last one.
+func TestListFollowers_Pages(t *testing.T) {
+	// Scenario: The caller pages through the followers of a public account.
+	token := listFollowersFirstPage(t)
+	require.NotEmpty(t, token)
+
+	svc, userRepo, followRepo, mapper := newSocialGraphService()
+	req := &socialpb.ListFollowsRequest{UserId: 2, PageSize: 2, PageToken: token}
+	last := followEntries(3)[2:]
+
+	mapper.On("ToListFollowsRequest", req).Return(transport.ListFollowsRequest{UserID: 2, PageSize: 2, PageToken: token})
+	userRepo.On("FetchUserByID", mock.Anything, mock.Anything).Return(profileWithID(2), nil)
+	followRepo.On("ListFollowers", mock.Anything, transport.FollowListQuery{
+		UserID: 2,
+		Limit:  3,
+		After: &transport.FollowCursor{
+			List:      transport.FollowListFollowers,
+			UserID:    2,
+			CreatedAt: followEntries(2)[1].CreatedAt,
+			ID:        11,
+		},
+	}).Return(last, nil)
+	mapper.On("ToListFollowsResponse", last, "", callerID).Return(&socialpb.ListFollowsResponse{})
+
+	_, err := svc.ListFollowers(callerContext(), req)
+
+	require.NoError(t, err)
+	followRepo.AssertExpectations(t)
+}
+
+// TestListFollowing_RejectsForeignPageToken ensures that a page token of
+// another list is rejected.
+func TestListFollowing_RejectsForeignPageToken(t *testing.T) {
+	// Scenario: A followers page token is replayed against the following list.
+	token := listFollowersFirstPage(t)
+
+	svc, userRepo, followRepo, mapper := newSocialGraphService()
+	req := &socialpb.ListFollowsRequest{UserId: 2, PageToken: token}
+
+	mapper.On("ToListFollowsRequest", req).Return(transport.ListFollowsRequest{UserID: 2, PageToken: token})
+	userRepo.On("FetchUserByID", mock.Anything, mock.Anything).Return(profileWithID(2), nil)
+
+	_, err := svc.ListFollowing(callerContext(), req)
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	followRepo.AssertNotCalled(t, "ListFollowing", mock.Anything, mock.Anything)
+}
+
+// TestListFollowers_PrivateAccount ensures that the followers of a private
+// account are only listed to its followers.
+func TestListFollowers_PrivateAccount(t *testing.T) {
+	for name, tt := range map[string]struct {
+		following transport.FollowState
+		want      codes.Code
+	}{
+		"follower":      {following: transport.FollowStateFollowing, want: codes.OK},
+		"pending":       {following: transport.FollowStateRequested, want: codes.PermissionDenied},
+		"not following": {following: transport.FollowStateNone, want: codes.PermissionDenied},
+	} {
+		t.Run(name, func(t *testing.T) {
+			svc, userRepo, followRepo, mapper := newSocialGraphService()
+
+			req := &socialpb.ListFollowsRequest{UserId: 2}
+			owner := profileWithID(2)
+			owner.Privacy.PrivateAccount = true
+
+			mapper.On("ToListFollowsRequest", req).Return(transport.ListFollowsRequest{UserID: 2})
+			userRepo.On("FetchUserByID", mock.Anything, mock.Anything).Return(owner, nil)
+			followRepo.On("GetRelationship", mock.Anything, callerID, int64(2)).
+				Return(relationship(2, tt.following, transport.FollowStateNone), nil)
+			followRepo.On("ListFollowers", mock.Anything, mock.Anything).Return(nil, nil)
+			mapper.On("ToListFollowsResponse", mock.Anything, "", callerID).Return(&socialpb.ListFollowsResponse{})
+
+			_, err := svc.ListFollowers(callerContext(), req)
+
+			assert.Equal(t, tt.want, status.Code(err))
+		})
+	}
+}
+
+// TestListFollowers_OwnPrivateAccount ensures that owners see their own lists.
+func TestListFollowers_OwnPrivateAccount(t *testing.T) {
+	// Scenario: The owner of a private account lists their followers.
+	svc, userRepo, followRepo, mapper := newSocialGraphService()
+
+	req := &socialpb.ListFollowsRequest{UserId: callerID}
+	owner := profileWithID(callerID)
+	owner.Privacy.PrivateAccount = true
+
+	mapper.On("ToListFollowsRequest", req).Return(transport.ListFollowsRequest{UserID: callerID})
+	userRepo.On("FetchUserByID", mock.Anything, mock.Anything).Return(owner, nil)
+	followRepo.On("ListFollowers", mock.Anything, transport.FollowListQuery{UserID: callerID, Limit: 21}).Return(nil, nil)
+	mapper.On("ToListFollowsResponse", mock.Anything, "", callerID).Return(&socialpb.ListFollowsResponse{})
+
+	_, err := svc.ListFollowers(callerContext(), req)
+
+	require.NoError(t, err)
+	followRepo.AssertNotCalled(t, "GetRelationship", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestListFollowers_NotFound ensures that listing the followers of an unknown
+// account results in a NotFound gRPC error.
+func TestListFollowers_NotFound(t *testing.T) {
+	// Scenario: The account does not exist.
+	svc, userRepo, _, mapper := newSocialGraphService()
+
+	req := &socialpb.ListFollowsRequest{UserId: 2}
+	mapper.On("ToListFollowsRequest", req).Return(transport.ListFollowsRequest{UserID: 2})
+	userRepo.On("FetchUserByID", mock.Anything, mock.Anything).Return(transport.UserProfileResponse{}, errs.ErrUserNotFound)
+
+	_, err := svc.ListFollowers(callerContext(), req)
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// TestListFollowRequests_Success ensures that the caller's incoming requests
+// are listed.
+func TestListFollowRequests_Success(t *testing.T) {
+	// Scenario: The owner of a private account reviews pending requests.
+	svc, _, followRepo, mapper := newSocialGraphService()
+
+	entries := followEntries(1)
+	followRepo.On("ListFollowRequests", mock.Anything, transport.FollowListQuery{UserID: callerID, Limit: 6}).Return(entries, nil)
+	mapper.On("ToListFollowRequestsResponse", entries, "", callerID).Return(&socialpb.ListFollowRequestsResponse{})
+
+	_, err := svc.ListFollowRequests(callerContext(), &socialpb.ListFollowRequestsRequest{PageSize: 5})
+
+	require.NoError(t, err)
+	followRepo.AssertExpectations(t)
+}
+
+// TestApproveFollowRequest_Success ensures that approving makes the requester
+// a follower.
+func TestApproveFollowRequest_Success(t *testing.T) {
+	// Scenario: The caller approves a pending request.
+	svc, _, followRepo, mapper := newSocialGraphService()
+
+	rel := relationship(5, transport.FollowStateNone, transport.FollowStateFollowing)
+
+	followRepo.On("ApproveFollowRequest", mock.Anything, callerID, int64(5)).Return(nil).Once()
+	followRepo.On("GetRelationship", mock.Anything, callerID, int64(5)).Return(rel, nil)
+	mapper.On("ToRelationshipResponse", rel).Return(&socialpb.Relationship{})
+
+	_, err := svc.ApproveFollowRequest(callerContext(), &socialpb.RespondToFollowRequestRequest{RequesterId: 5})
+
+	require.NoError(t, err)
+	followRepo.AssertExpectations(t)
+}
+
+// TestRespondToFollowRequest_NotFound ensures that responding to a request
+// that does not exist results in a NotFound gRPC error.
+func TestRespondToFollowRequest_NotFound(t *testing.T) {
+	// Scenario: There is no pending request from the given user.
+	svc, _, followRepo, _ := newSocialGraphService()
+	req := &socialpb.RespondToFollowRequestRequest{RequesterId: 5}
+
+	followRepo.On("ApproveFollowRequest", mock.Anything, callerID, int64(5)).Return(errs.ErrFollowRequestNotFound)
+	followRepo.On("DenyFollowRequest", mock.Anything, callerID, int64(5)).Return(errs.ErrFollowRequestNotFound)
+
+	_, err := svc.ApproveFollowRequest(callerContext(), req)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.Equal(t, errs.ErrFollowRequestNotFound.Error(), st.Message())
+
+	_, err = svc.DenyFollowRequest(callerContext(), req)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// TestDenyFollowRequest_Success ensures that denying drops the request.
+func TestDenyFollowRequest_Success(t *testing.T) {
+	// Scenario: The caller denies a pending request.
+	svc, _, followRepo, mapper := newSocialGraphService()
+
+	rel := relationship(5, transport.FollowStateNone, transport.FollowStateNone)
+
+	followRepo.On("DenyFollowRequest", mock.Anything, callerID, int64(5)).Return(nil).Once()
+	followRepo.On("GetRelationship", mock.Anything, callerID, int64(5)).Return(rel, nil)
+	mapper.On("ToRelationshipResponse", rel).Return(&socialpb.Relationship{})
+
+	_, err := svc.DenyFollowRequest(callerContext(), &socialpb.RespondToFollowRequestRequest{RequesterId: 5})
+
+	require.NoError(t, err)
+	followRepo.AssertExpectations(t)
+}
+
+// TestCheckFollowing ensures that internal callers learn whether one user
+// follows another, and that a pending request does not count.
+func TestCheckFollowing(t *testing.T) {
+	for name, tt := range map[string]struct {
+		state transport.FollowState
+		want  bool
+	}{
+		"following": {state: transport.FollowStateFollowing, want: true},
+		"requested": {state: transport.FollowStateRequested, want: false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			followRepo := new(mocks.FollowRepoMock)
+			svc := service.NewInternalUserService(new(mocks.UserRepoMock), new(mocks.UserEventRepoMock), followRepo, new(mocks.FriendRepoMock), new(mocks.MockMapper))
+
+			followRepo.On("GetRelationship", mock.Anything, int64(1), int64(2)).
+				Return(transport.Relationship{UserID: 1, OtherUserID: 2, Following: tt.state}, nil)
+
+			resp, err := svc.CheckFollowing(context.Background(), &userpb.CheckFollowingRequest{FollowerId: 1, FolloweeId: 2})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, resp.GetFollowing())
+		})
+	}
+}
+
+// TestListFollowerIDs ensures that follower IDs are paged by the given cursor
+// and limit.
+func TestListFollowerIDs(t *testing.T) {
+	followRepo := new(mocks.FollowRepoMock)
+	svc := service.NewInternalUserService(new(mocks.UserRepoMock), new(mocks.UserEventRepoMock), followRepo, new(mocks.FriendRepoMock), new(mocks.MockMapper))
+
+	followRepo.On("ListFollowerIDs", mock.Anything, int64(1), int64(7), 500).Return([]int64{8, 12}, nil)
+
+	resp, err := svc.ListFollowerIDs(context.Background(), &userpb.ListFollowIDsRequest{UserId: 1, AfterId: 7, Limit: 500})
+
+	require.NoError(t, err)
+	assert.Equal(t, []int64{8, 12}, resp.GetUserIds())
+}
+
+// TestListFollowingIDs_InternalError ensures that a repository failure yields
+// an Internal error.
+func TestListFollowingIDs_InternalError(t *testing.T) {
+	followRepo := new(mocks.FollowRepoMock)
+	svc := service.NewInternalUserService(new(mocks.UserRepoMock), new(mocks.UserEventRepoMock), followRepo, new(mocks.FriendRepoMock), new(mocks.MockMapper))
+
+	followRepo.On("ListFollowingIDs", mock.Anything, int64(1), int64(0), 100).Return(nil, errs.ErrDBFailure)
+
+	_, err := svc.ListFollowingIDs(context.Background(), &userpb.ListFollowIDsRequest{UserId: 1, Limit: 100})
+
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
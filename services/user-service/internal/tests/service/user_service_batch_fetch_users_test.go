@@ -0,0 +1,84 @@
+// Package service_test verifies the behavior of InternalUserService’s logic for
+// fetching several users by ID at once.
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userpb "github.com/mamataliev-dev/social-platform/api/gen/user/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/service"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/mocks"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/testdata"
+)
+
+// profileWithID returns the sample profile with the given user ID.
+func profileWithID(id int64) transport.UserProfileResponse {
+	u := testdata.UserProfileResponse()
+	u.ID = id
+	return u
+}
+
+// TestBatchFetchUserProfiles_Success ensures that duplicates are queried once
+// and that IDs without a profile are reported as missing in request order.
+func TestBatchFetchUserProfiles_Success(t *testing.T) {
+	// Scenario: Two of four distinct requested users exist.
+	userRepo := new(mocks.UserRepoMock)
+	eventRepo := new(mocks.UserEventRepoMock)
+	mapper := new(mocks.MockMapper)
+	svc := service.NewInternalUserService(userRepo, eventRepo, new(mocks.FollowRepoMock), new(mocks.FriendRepoMock), mapper)
+
+	req := &userpb.BatchFetchUserProfilesRequest{UserIds: []int64{9, 3, 9, 7, 5}}
+	found := []transport.UserProfileResponse{profileWithID(3), profileWithID(7)}
+
+	expected := &userpb.BatchFetchUserProfilesResponse{}
+
+	userRepo.On("FetchUsersByIDs", mock.Anything, []int64{9, 3, 7, 5}).Return(found, nil).Once()
+	mapper.On("ToBatchFetchUserProfilesResponse", found, []int64{9, 5}).Return(expected)
+
+	resp, err := svc.BatchFetchUserProfiles(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.Same(t, expected, resp)
+	mapper.AssertExpectations(t)
+	userRepo.AssertExpectations(t)
+}
+
+// TestBatchFetchUserProfiles_InternalError ensures that a repository failure
+// results in an Internal gRPC error.
+func TestBatchFetchUserProfiles_InternalError(t *testing.T) {
+	// Scenario: The database query fails.
+	userRepo := new(mocks.UserRepoMock)
+	eventRepo := new(mocks.UserEventRepoMock)
+	mapper := new(mocks.MockMapper)
+	svc := service.NewInternalUserService(userRepo, eventRepo, new(mocks.FollowRepoMock), new(mocks.FriendRepoMock), mapper)
+
+	req := &userpb.BatchFetchUserProfilesRequest{UserIds: []int64{1, 2}}
+	userRepo.On("FetchUsersByIDs", mock.Anything, []int64{1, 2}).Return(nil, errs.ErrDBFailure)
+
+	_, err := svc.BatchFetchUserProfiles(context.Background(), req)
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Internal, st.Code())
+}
+
+// TestBatchFetchUserProfilesRequest_Validation ensures the request size is bounded.
+func TestBatchFetchUserProfilesRequest_Validation(t *testing.T) {
+	tooMany := make([]int64, 101)
+	for i := range tooMany {
+		tooMany[i] = int64(i + 1)
+	}
+
+	assert.Error(t, (&userpb.BatchFetchUserProfilesRequest{}).Validate())
+	assert.Error(t, (&userpb.BatchFetchUserProfilesRequest{UserIds: tooMany}).Validate())
+	assert.Error(t, (&userpb.BatchFetchUserProfilesRequest{UserIds: []int64{1, 0}}).Validate())
+	assert.NoError(t, (&userpb.BatchFetchUserProfilesRequest{UserIds: tooMany[:100]}).Validate())
+}
@@ -31,7 +31,8 @@ func TestFetchUserByID_Success(t *testing.T) {
 	// Scenario: A user profile is successfully fetched by its ID.
 	userRepo := new(mocks.UserRepoMock)
 	mapper := new(mocks.MockMapper)
-	svc := service.NewInternalUserService(userRepo, mapper)
+	eventRepo := new(mocks.UserEventRepoMock)
+	svc := service.NewInternalUserService(userRepo, eventRepo, new(mocks.FollowRepoMock), new(mocks.FriendRepoMock), mapper)
 
 	req := validFetchUserByIDRequest()
 	user := testdata.UserProfileResponse()
@@ -50,7 +51,8 @@ func TestFetchUserByID_NotFound(t *testing.T) {
 	// Scenario: A user profile cannot be found for the given ID.
 	userRepo := new(mocks.UserRepoMock)
 	mapper := new(mocks.MockMapper)
-	svc := service.NewInternalUserService(userRepo, mapper)
+	eventRepo := new(mocks.UserEventRepoMock)
+	svc := service.NewInternalUserService(userRepo, eventRepo, new(mocks.FollowRepoMock), new(mocks.FriendRepoMock), mapper)
 
 	req := validFetchUserByIDRequest()
 
@@ -68,7 +70,8 @@ func TestFetchUserByID_InternalError(t *testing.T) {
 	// Scenario: An unexpected internal error occurs while fetching a user profile.
 	userRepo := new(mocks.UserRepoMock)
 	mapper := new(mocks.MockMapper)
-	svc := service.NewInternalUserService(userRepo, mapper)
+	eventRepo := new(mocks.UserEventRepoMock)
+	svc := service.NewInternalUserService(userRepo, eventRepo, new(mocks.FollowRepoMock), new(mocks.FriendRepoMock), mapper)
 
 	req := validFetchUserByIDRequest()
 
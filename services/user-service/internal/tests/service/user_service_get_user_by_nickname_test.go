@@ -38,7 +38,7 @@ func TestFetchUserByNickname_Success(t *testing.T) {
 
 	mapper.On("ToFetchUserByNicknameRequest", req).Return(transport.FetchUserByNicknameRequest{})
 	userRepo.On("FetchUserByNickname", mock.Anything, mock.Anything).Return(user, nil)
-	mapper.On("ToFetchUserProfileResponse", user).Return(&userpb.UserProfile{})
+	mapper.On("ToPublicUserProfileResponse", user, int64(0)).Return(&userpb.UserProfile{})
 
 	_, err := svc.FetchUserProfileByNickname(context.Background(), req)
 	assert.NoError(t, err)
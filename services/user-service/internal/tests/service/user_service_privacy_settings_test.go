@@ -0,0 +1,152 @@
+// Package service_test verifies the behavior of UserService’s logic for
+// reading and changing profile privacy settings.
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userpb "github.com/mamataliev-dev/social-platform/api/gen/user/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/service"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/mocks"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/testdata"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/utils"
+)
+
+// defaultPrivacySettings are the settings of a new account.
+func defaultPrivacySettings() transport.PrivacySettings {
+	return transport.PrivacySettings{
+		Email:     transport.VisibilityOnlyMe,
+		LastLogin: transport.VisibilityEveryone,
+		Bio:       transport.VisibilityEveryone,
+	}
+}
+
+// TestGetPrivacySettings_Success ensures that the caller's settings are returned.
+func TestGetPrivacySettings_Success(t *testing.T) {
+	// Scenario: A signed-in user reads their privacy settings.
+	userRepo := new(mocks.UserRepoMock)
+	mapper := new(mocks.MockMapper)
+	svc := service.NewUserService(userRepo, mapper)
+
+	settings := defaultPrivacySettings()
+	expected := &userpb.PrivacySettings{}
+
+	userRepo.On("FetchPrivacySettings", mock.Anything, testdata.SampleSession().UserID).Return(settings, nil)
+	mapper.On("ToPrivacySettingsResponse", settings).Return(expected)
+
+	resp, err := svc.GetPrivacySettings(callerContext(), &userpb.GetPrivacySettingsRequest{})
+
+	require.NoError(t, err)
+	assert.Same(t, expected, resp)
+}
+
+// TestGetPrivacySettings_Unauthenticated ensures that a caller is required.
+func TestGetPrivacySettings_Unauthenticated(t *testing.T) {
+	// Scenario: The request carries no caller.
+	userRepo := new(mocks.UserRepoMock)
+	svc := service.NewUserService(userRepo, new(mocks.MockMapper))
+
+	_, err := svc.GetPrivacySettings(context.Background(), &userpb.GetPrivacySettingsRequest{})
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+	userRepo.AssertNotCalled(t, "FetchPrivacySettings", mock.Anything, mock.Anything)
+}
+
+// TestGetPrivacySettings_NotFound ensures that a deleted account results in a
+// NotFound gRPC error.
+func TestGetPrivacySettings_NotFound(t *testing.T) {
+	// Scenario: The caller's account has been deleted.
+	userRepo := new(mocks.UserRepoMock)
+	svc := service.NewUserService(userRepo, new(mocks.MockMapper))
+
+	userRepo.On("FetchPrivacySettings", mock.Anything, mock.Anything).
+		Return(transport.PrivacySettings{}, errs.ErrUserNotFound)
+
+	_, err := svc.GetPrivacySettings(callerContext(), &userpb.GetPrivacySettingsRequest{})
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
+// TestUpdatePrivacySettings_Success ensures that the mapped changes are applied
+// to the caller's account and the resulting settings are returned.
+func TestUpdatePrivacySettings_Success(t *testing.T) {
+	// Scenario: A signed-in user makes their email public.
+	userRepo := new(mocks.UserRepoMock)
+	mapper := new(mocks.MockMapper)
+	svc := service.NewUserService(userRepo, mapper)
+
+	req := &userpb.UpdatePrivacySettingsRequest{Email: userpb.ProfileFieldVisibility_PROFILE_FIELD_VISIBILITY_EVERYONE}
+	input := transport.UpdatePrivacySettingsRequest{Email: transport.VisibilityEveryone}
+	settings := defaultPrivacySettings()
+	settings.Email = transport.VisibilityEveryone
+	expected := &userpb.PrivacySettings{}
+
+	mapper.On("ToUpdatePrivacySettingsRequest", req).Return(input)
+	userRepo.On("UpdatePrivacySettings", mock.Anything, testdata.SampleSession().UserID, input).Return(settings, nil)
+	mapper.On("ToPrivacySettingsResponse", settings).Return(expected)
+
+	resp, err := svc.UpdatePrivacySettings(callerContext(), req)
+
+	require.NoError(t, err)
+	assert.Same(t, expected, resp)
+	userRepo.AssertExpectations(t)
+}
+
+// TestUpdatePrivacySettings_InternalError ensures that a repository failure
+// results in an Internal gRPC error.
+func TestUpdatePrivacySettings_InternalError(t *testing.T) {
+	// Scenario: The update query fails.
+	userRepo := new(mocks.UserRepoMock)
+	mapper := new(mocks.MockMapper)
+	svc := service.NewUserService(userRepo, mapper)
+
+	req := &userpb.UpdatePrivacySettingsRequest{}
+	mapper.On("ToUpdatePrivacySettingsRequest", req).Return(transport.UpdatePrivacySettingsRequest{})
+	userRepo.On("UpdatePrivacySettings", mock.Anything, mock.Anything, mock.Anything).
+		Return(transport.PrivacySettings{}, errs.ErrDBFailure)
+
+	_, err := svc.UpdatePrivacySettings(callerContext(), req)
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Internal, st.Code())
+}
+
+// TestUpdatePrivacySettingsRequest_Validation ensures that only defined
+// visibility values are accepted.
+func TestUpdatePrivacySettingsRequest_Validation(t *testing.T) {
+	assert.NoError(t, (&userpb.UpdatePrivacySettingsRequest{}).Validate())
+	assert.Error(t, (&userpb.UpdatePrivacySettingsRequest{Bio: userpb.ProfileFieldVisibility(42)}).Validate())
+}
+
+// TestFetchUserByNickname_PassesViewer ensures that the profile is mapped as
+// seen by the caller, so the owner's privacy settings can be applied.
+func TestFetchUserByNickname_PassesViewer(t *testing.T) {
+	// Scenario: A signed-in user looks up a profile.
+	userRepo := new(mocks.UserRepoMock)
+	mapper := new(mocks.MockMapper)
+	svc := service.NewUserService(userRepo, mapper)
+
+	req := validFetchUserByNicknameRequest()
+	user := testdata.UserProfileResponse()
+	ctx := utils.ContextWithAuthInfo(context.Background(), utils.AuthInfo{UserID: 42})
+
+	mapper.On("ToFetchUserByNicknameRequest", req).Return(transport.FetchUserByNicknameRequest{})
+	userRepo.On("FetchUserByNickname", mock.Anything, mock.Anything).Return(user, nil)
+	mapper.On("ToPublicUserProfileResponse", user, int64(42)).Return(&userpb.UserProfile{})
+
+	_, err := svc.FetchUserProfileByNickname(ctx, req)
+
+	require.NoError(t, err)
+	mapper.AssertExpectations(t)
+}
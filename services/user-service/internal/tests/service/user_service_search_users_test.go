@@ -0,0 +1,161 @@
+// Package service_test verifies the behavior of UserService’s logic for
+// searching users.
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userpb "github.com/mamataliev-dev/social-platform/api/gen/user/v1"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/errs"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/service"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/tests/mocks"
+)
+
+// searchHits returns n hits with descending scores and ascending IDs.
+func searchHits(n int) []transport.UserSearchHit {
+	hits := make([]transport.UserSearchHit, n)
+	for i := range hits {
+		hits[i] = transport.UserSearchHit{
+			Profile: profileWithID(int64(i + 1)),
+			Score:   2.5 - float64(i)/10,
+		}
+	}
+	return hits
+}
+
+// searchFirstPage runs a first-page search for "Tes" with page size 2 against
+// three hits and returns the next page token handed to the mapper.
+func searchFirstPage(t *testing.T) string {
+	t.Helper()
+	userRepo := new(mocks.UserRepoMock)
+	mapper := new(mocks.MockMapper)
+	svc := service.NewUserService(userRepo, mapper)
+
+	req := &userpb.SearchUsersRequest{Query: " Tes ", PageSize: 2}
+	hits := searchHits(3)
+
+	mapper.On("ToSearchUsersRequest", req).Return(transport.SearchUsersRequest{Query: " Tes ", PageSize: 2})
+	userRepo.On("SearchUsers", mock.Anything, transport.UserSearchQuery{Query: "tes", Limit: 3}).Return(hits, nil)
+
+	var token string
+	mapper.On("ToSearchUsersResponse", hits[:2], mock.AnythingOfType("string"), int64(0)).
+		Run(func(args mock.Arguments) { token = args.String(1) }).
+		Return(&userpb.SearchUsersResponse{})
+
+	_, err := svc.SearchUsers(context.Background(), req)
+	require.NoError(t, err)
+	userRepo.AssertExpectations(t)
+	mapper.AssertExpectations(t)
+	return token
+}
+
+// TestSearchUsers_FirstPage ensures that one extra hit is requested to detect
+// further pages and that a page token is issued when there are more.
+func TestSearchUsers_FirstPage(t *testing.T) {
+	// Scenario: Three users match and the page holds two.
+	token := searchFirstPage(t)
+	assert.NotEmpty(t, token)
+}
+
+// TestSearchUsers_NextPage ensures that a page token continues after the last
+// hit of the previous page.
+func TestSearchUsers_NextPage(t *testing.T) {
+	// Scenario: The client requests the second page with the issued token.
+	token := searchFirstPage(t)
+
+	userRepo := new(mocks.UserRepoMock)
+	mapper := new(mocks.MockMapper)
+	svc := service.NewUserService(userRepo, mapper)
+
+	req := &userpb.SearchUsersRequest{Query: "tes", PageSize: 2, PageToken: token}
+	last := searchHits(3)[2:]
+
+	mapper.On("ToSearchUsersRequest", req).Return(transport.SearchUsersRequest{Query: "tes", PageSize: 2, PageToken: token})
+	userRepo.On("SearchUsers", mock.Anything, transport.UserSearchQuery{
+		Query: "tes",
+		Limit: 3,
+		After: &transport.SearchCursor{Query: "tes", Score: 2.4, ID: 2},
+	}).Return(last, nil)
+	mapper.On("ToSearchUsersResponse", last, "", int64(0)).Return(&userpb.SearchUsersResponse{})
+
+	_, err := svc.SearchUsers(context.Background(), req)
+
+	assert.NoError(t, err)
+	userRepo.AssertExpectations(t)
+	mapper.AssertExpectations(t)
+}
+
+// TestSearchUsers_DefaultPageSize ensures that a missing page size falls back
+// to the default.
+func TestSearchUsers_DefaultPageSize(t *testing.T) {
+	// Scenario: The client does not set page_size.
+	userRepo := new(mocks.UserRepoMock)
+	mapper := new(mocks.MockMapper)
+	svc := service.NewUserService(userRepo, mapper)
+
+	req := &userpb.SearchUsersRequest{Query: "tes"}
+
+	mapper.On("ToSearchUsersRequest", req).Return(transport.SearchUsersRequest{Query: "tes"})
+	userRepo.On("SearchUsers", mock.Anything, transport.UserSearchQuery{Query: "tes", Limit: 21}).Return(nil, nil)
+	mapper.On("ToSearchUsersResponse", mock.Anything, "", int64(0)).Return(&userpb.SearchUsersResponse{})
+
+	_, err := svc.SearchUsers(context.Background(), req)
+
+	assert.NoError(t, err)
+	userRepo.AssertExpectations(t)
+}
+
+// TestSearchUsers_InvalidPageToken ensures that malformed tokens and tokens
+// issued for another query are rejected.
+func TestSearchUsers_InvalidPageToken(t *testing.T) {
+	// Scenario: The page token is garbage or belongs to a different query.
+	token := searchFirstPage(t)
+
+	for name, in := range map[string]transport.SearchUsersRequest{
+		"malformed":     {Query: "tes", PageToken: "not-a-token"},
+		"another query": {Query: "other", PageToken: token},
+	} {
+		t.Run(name, func(t *testing.T) {
+			userRepo := new(mocks.UserRepoMock)
+			mapper := new(mocks.MockMapper)
+			svc := service.NewUserService(userRepo, mapper)
+
+			req := &userpb.SearchUsersRequest{Query: in.Query, PageToken: in.PageToken}
+			mapper.On("ToSearchUsersRequest", req).Return(in)
+
+			_, err := svc.SearchUsers(context.Background(), req)
+
+			st, _ := status.FromError(err)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+			assert.Equal(t, errs.ErrInvalidPageToken.Error(), st.Message())
+			userRepo.AssertNotCalled(t, "SearchUsers", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// TestSearchUsers_InternalError ensures that a repository failure results in
+// an Internal gRPC error.
+func TestSearchUsers_InternalError(t *testing.T) {
+	// Scenario: The search query fails.
+	userRepo := new(mocks.UserRepoMock)
+	mapper := new(mocks.MockMapper)
+	svc := service.NewUserService(userRepo, mapper)
+
+	req := &userpb.SearchUsersRequest{Query: "tes"}
+
+	mapper.On("ToSearchUsersRequest", req).Return(transport.SearchUsersRequest{Query: "tes"})
+	userRepo.On("SearchUsers", mock.Anything, mock.Anything).Return(nil, errs.ErrDBFailure)
+
+	_, err := svc.SearchUsers(context.Background(), req)
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Internal, st.Code())
+}
@@ -3,10 +3,14 @@
 package testdata
 
 import (
+	"net/http"
 	"time"
 
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/config"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/transport"
 	"github.com/mamataliev-dev/social-platform/services/user-service/internal/model"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/oidc"
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/security"
 )
 
 func ValidTokenPair() model.TokenPair {
@@ -42,3 +46,103 @@ func UserProfileResponse() transport.UserProfileResponse {
 		AvatarURL: "https://test.com",
 	}
 }
+
+// EmailVerificationConfig returns verification settings used across AuthService tests.
+func EmailVerificationConfig() config.EmailVerification {
+	return config.EmailVerification{
+		TokenTTL:       24 * time.Hour,
+		ResendCooldown: time.Minute,
+		LinkBaseURL:    "https://app.test/verify-email",
+	}
+}
+
+// MFAConfig returns two-factor authentication settings used across AuthService tests.
+func MFAConfig() config.MFA {
+	return config.MFA{
+		Issuer:               "Social Platform",
+		ChallengeTTL:         5 * time.Minute,
+		MaxChallengeAttempts: 5,
+		RecoveryCodes:        10,
+	}
+}
+
+// OAuthConfig returns social login settings used across AuthService tests.
+func OAuthConfig() config.OAuth {
+	return config.OAuth{StateTTL: 10 * time.Minute, ReauthWindow: 5 * time.Minute}
+}
+
+// LoginThrottleConfig returns brute-force protection settings used across
+// AuthService tests.
+func LoginThrottleConfig() config.LoginThrottle {
+	return config.LoginThrottle{
+		MaxAccountFailures: 5,
+		MaxIPFailures:      20,
+		FailureWindow:      15 * time.Minute,
+		BaseLockout:        time.Minute,
+		MaxLockout:         time.Hour,
+	}
+}
+
+// PasswordResetConfig returns password reset settings used across AuthService tests.
+func PasswordResetConfig() config.PasswordReset {
+	return config.PasswordReset{
+		TokenTTL:       time.Hour,
+		ResendCooldown: time.Minute,
+		LinkBaseURL:    "https://app.test/reset-password",
+	}
+}
+
+// AccountDeletionConfig returns account deletion settings used across tests.
+func AccountDeletionConfig() config.AccountDeletion {
+	return config.AccountDeletion{
+		GracePeriod:    30 * 24 * time.Hour,
+		PurgeInterval:  time.Hour,
+		EventRetention: 30 * 24 * time.Hour,
+	}
+}
+
+// DataExportConfig returns data export settings used across tests.
+func DataExportConfig() config.DataExport {
+	return config.DataExport{
+		PollInterval: time.Second,
+		StaleAfter:   10 * time.Minute,
+		DownloadTTL:  7 * 24 * time.Hour,
+	}
+}
+
+// PasswordPolicyConfig returns password policy rules used across tests.
+func PasswordPolicyConfig() config.PasswordPolicy {
+	return config.PasswordPolicy{
+		MinLength: 10,
+		MaxLength: 128,
+	}
+}
+
+// PasswordPolicy returns a policy built from PasswordPolicyConfig without a
+// breached password list.
+func PasswordPolicy() *security.PasswordPolicy {
+	policy, err := security.NewPasswordPolicy(PasswordPolicyConfig())
+	if err != nil {
+		panic(err)
+	}
+	return policy
+}
+
+// OAuthProviders returns a registry without providers for tests that do not
+// exercise social login.
+func OAuthProviders() *oidc.Registry {
+	return oidc.NewRegistry(nil, http.DefaultClient)
+}
+
+// SampleSession returns an active session belonging to SampleUserModel.
+func SampleSession() model.Session {
+	return model.Session{
+		ID:         "5f0c6c1e-8f5b-4c39-9a2e-3b7a1d1f2c10",
+		UserID:     1,
+		UserAgent:  "test-agent/1.0",
+		IPAddress:  "203.0.113.7",
+		CreatedAt:  time.Now().Add(-48 * time.Hour),
+		LastUsedAt: time.Now().Add(-time.Hour),
+		ExpiresAt:  time.Now().Add(5 * 24 * time.Hour),
+	}
+}
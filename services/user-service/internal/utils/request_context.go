@@ -0,0 +1,159 @@
+// Package utils provides helpers for reading caller identity, calling service
+// and device metadata from a gRPC request context. It supports Single Responsibility.
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/mamataliev-dev/social-platform/services/user-service/internal/dto/domain"
+)
+
+// AuthInfo identifies the authenticated caller of an RPC.
+type AuthInfo struct {
+	UserID    int64
+	SessionID string
+}
+
+type authInfoKey struct{}
+
+// ContextWithAuthInfo attaches the authenticated caller to ctx.
+func ContextWithAuthInfo(ctx context.Context, info AuthInfo) context.Context {
+	return context.WithValue(ctx, authInfoKey{}, info)
+}
+
+// AuthInfoFromContext returns the caller attached by the auth interceptor.
+func AuthInfoFromContext(ctx context.Context) (AuthInfo, bool) {
+	info, ok := ctx.Value(authInfoKey{}).(AuthInfo)
+	return info, ok
+}
+
+type clientInfoKey struct{}
+
+type callerServiceKey struct{}
+
+// ContextWithCallerService attaches the name of the service that signed the
+// caller's service token to ctx.
+func ContextWithCallerService(ctx context.Context, service string) context.Context {
+	return context.WithValue(ctx, callerServiceKey{}, service)
+}
+
+// CallerServiceFromContext returns the calling service attached by the
+// service token interceptor.
+func CallerServiceFromContext(ctx context.Context) (string, bool) {
+	service, ok := ctx.Value(callerServiceKey{}).(string)
+	return service, ok && service != ""
+}
+
+// TrustedProxies lists the proxies whose x-forwarded-for entries are believed
+// when resolving a caller's IP address.
+type TrustedProxies []netip.Prefix
+
+// ParseTrustedProxies parses proxy addresses given as IPs or CIDR prefixes.
+func ParseTrustedProxies(entries []string) (TrustedProxies, error) {
+	proxies := make(TrustedProxies, 0, len(entries))
+	for _, entry := range entries {
+		if !strings.Contains(entry, "/") {
+			addr, err := netip.ParseAddr(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %w", entry, err)
+			}
+			proxies = append(proxies, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", entry, err)
+		}
+		proxies = append(proxies, prefix.Masked())
+	}
+	return proxies, nil
+}
+
+// Contains reports whether ip belongs to a trusted proxy.
+func (t TrustedProxies) Contains(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range t {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContextWithClientInfo attaches the resolved device metadata of the caller
+// to ctx.
+func ContextWithClientInfo(ctx context.Context, info domain.ClientInfo) context.Context {
+	return context.WithValue(ctx, clientInfoKey{}, info)
+}
+
+// ClientInfoFromContext returns the caller's user agent and IP address
+// attached by the client info interceptor. Without it, the IP address is that
+// of the direct gRPC peer.
+func ClientInfoFromContext(ctx context.Context) domain.ClientInfo {
+	if info, ok := ctx.Value(clientInfoKey{}).(domain.ClientInfo); ok {
+		return info
+	}
+	return ResolveClientInfo(ctx, nil)
+}
+
+// ResolveClientInfo extracts the caller's user agent and IP address. The IP
+// address is that of the direct gRPC peer unless the peer is a trusted proxy,
+// such as the grpc-gateway, in which case x-forwarded-for is walked from the
+// right and the first hop not appended by a trusted proxy is used. Entries
+// further left are supplied by the client and never believed.
+func ResolveClientInfo(ctx context.Context, trusted TrustedProxies) domain.ClientInfo {
+	var info domain.ClientInfo
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	info.UserAgent = firstMetadata(md, "grpcgateway-user-agent", "user-agent")
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			host = p.Addr.String()
+		}
+		info.IPAddress = host
+	}
+
+	if !trusted.Contains(info.IPAddress) {
+		return info
+	}
+
+	var hops []string
+	for _, header := range md.Get("x-forwarded-for") {
+		hops = append(hops, strings.Split(header, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if _, err := netip.ParseAddr(hop); err != nil {
+			break
+		}
+		info.IPAddress = hop
+		if !trusted.Contains(hop) {
+			break
+		}
+	}
+
+	return info
+}
+
+func firstMetadata(md metadata.MD, keys ...string) string {
+	for _, key := range keys {
+		if values := md.Get(key); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,12 @@
+ALTER TABLE users
+    ADD COLUMN email_verified_at TIMESTAMP;
+
+CREATE TABLE email_verification_tokens
+(
+    token_hash CHAR(64) PRIMARY KEY,
+    user_id    BIGINT    NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_email_verification_tokens_user_id ON email_verification_tokens (user_id, created_at);
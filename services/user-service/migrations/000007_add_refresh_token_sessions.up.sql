@@ -0,0 +1,8 @@
+ALTER TABLE refresh_tokens
+    ADD COLUMN session_id   UUID      NOT NULL DEFAULT gen_random_uuid(),
+    ADD COLUMN user_agent   TEXT      NOT NULL DEFAULT '',
+    ADD COLUMN ip_address   TEXT      NOT NULL DEFAULT '',
+    ADD COLUMN created_at   TIMESTAMP NOT NULL DEFAULT NOW(),
+    ADD COLUMN last_used_at TIMESTAMP NOT NULL DEFAULT NOW();
+
+CREATE UNIQUE INDEX idx_refresh_tokens_session_id ON refresh_tokens (session_id);
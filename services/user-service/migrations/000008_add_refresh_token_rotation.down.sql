@@ -0,0 +1,9 @@
+DELETE FROM refresh_tokens WHERE rotated_at IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_refresh_tokens_active_session;
+DROP INDEX IF EXISTS idx_refresh_tokens_session_id;
+
+CREATE UNIQUE INDEX idx_refresh_tokens_session_id ON refresh_tokens (session_id);
+
+ALTER TABLE refresh_tokens
+    DROP COLUMN IF EXISTS rotated_at;
@@ -0,0 +1,11 @@
+ALTER TABLE refresh_tokens
+    ADD COLUMN rotated_at TIMESTAMP;
+
+-- A session is a token family: it keeps its rotated-out tokens for reuse
+-- detection, but only one token per family may be active at a time.
+DROP INDEX IF EXISTS idx_refresh_tokens_session_id;
+
+CREATE INDEX idx_refresh_tokens_session_id ON refresh_tokens (session_id);
+
+CREATE UNIQUE INDEX idx_refresh_tokens_active_session ON refresh_tokens (session_id)
+    WHERE rotated_at IS NULL;
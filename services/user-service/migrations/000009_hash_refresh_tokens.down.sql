@@ -0,0 +1,10 @@
+-- Digests cannot be turned back into tokens, so every session is revoked.
+DELETE FROM refresh_tokens;
+
+ALTER INDEX IF EXISTS idx_refresh_tokens_token_hash RENAME TO idx_refresh_tokens_token;
+
+ALTER TABLE refresh_tokens
+    ALTER COLUMN token_hash TYPE TEXT;
+
+ALTER TABLE refresh_tokens
+    RENAME COLUMN token_hash TO token;
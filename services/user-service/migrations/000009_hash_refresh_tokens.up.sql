@@ -0,0 +1,13 @@
+-- Refresh tokens are stored as hex-encoded SHA-256 digests so that a database
+-- leak cannot be replayed. Existing raw tokens are hashed in place, which keeps
+-- every current session valid.
+ALTER TABLE refresh_tokens
+    RENAME COLUMN token TO token_hash;
+
+UPDATE refresh_tokens
+SET token_hash = encode(sha256(convert_to(token_hash, 'UTF8')), 'hex');
+
+ALTER TABLE refresh_tokens
+    ALTER COLUMN token_hash TYPE CHAR(64);
+
+ALTER INDEX IF EXISTS idx_refresh_tokens_token RENAME TO idx_refresh_tokens_token_hash;
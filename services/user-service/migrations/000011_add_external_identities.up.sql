@@ -0,0 +1,21 @@
+CREATE TABLE external_identities
+(
+    provider   VARCHAR(32)  NOT NULL,
+    subject    VARCHAR(255) NOT NULL,
+    user_id    BIGINT       NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    email      VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP    NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (provider, subject)
+);
+
+CREATE INDEX idx_external_identities_user_id ON external_identities (user_id);
+
+CREATE TABLE oauth_states
+(
+    state_hash    CHAR(64) PRIMARY KEY,
+    provider      VARCHAR(32) NOT NULL,
+    code_verifier TEXT        NOT NULL,
+    nonce         TEXT        NOT NULL,
+    expires_at    TIMESTAMP   NOT NULL,
+    created_at    TIMESTAMP   NOT NULL DEFAULT NOW()
+);
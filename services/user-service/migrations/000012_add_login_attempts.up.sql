@@ -0,0 +1,9 @@
+CREATE TABLE login_attempts
+(
+    scope          VARCHAR(16) NOT NULL,
+    key_hash       CHAR(64)    NOT NULL,
+    failures       INT         NOT NULL DEFAULT 0,
+    last_failed_at TIMESTAMP   NOT NULL DEFAULT NOW(),
+    locked_until   TIMESTAMP,
+    PRIMARY KEY (scope, key_hash)
+);
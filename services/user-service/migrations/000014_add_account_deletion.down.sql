@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS user_events;
+
+DROP INDEX IF EXISTS idx_users_deleted_at;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS deleted_at;
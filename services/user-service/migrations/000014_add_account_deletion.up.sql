@@ -0,0 +1,14 @@
+ALTER TABLE users
+    ADD COLUMN deleted_at TIMESTAMP;
+
+CREATE INDEX idx_users_deleted_at ON users (deleted_at) WHERE deleted_at IS NOT NULL;
+
+CREATE TABLE user_events
+(
+    id          BIGSERIAL PRIMARY KEY,
+    type        VARCHAR(64) NOT NULL,
+    user_id     BIGINT      NOT NULL,
+    occurred_at TIMESTAMP   NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_user_events_occurred_at ON user_events (occurred_at);
@@ -0,0 +1,18 @@
+CREATE TABLE data_exports
+(
+    id           UUID PRIMARY KEY,
+    user_id      BIGINT      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    status       VARCHAR(16) NOT NULL DEFAULT 'pending',
+    archive      BYTEA,
+    created_at   TIMESTAMP   NOT NULL DEFAULT NOW(),
+    started_at   TIMESTAMP,
+    completed_at TIMESTAMP,
+    expires_at   TIMESTAMP
+);
+
+-- At most one unfinished export per user.
+CREATE UNIQUE INDEX uniq_data_exports_active ON data_exports (user_id)
+    WHERE status IN ('pending', 'processing');
+
+CREATE INDEX idx_data_exports_pending ON data_exports (created_at)
+    WHERE status IN ('pending', 'processing');
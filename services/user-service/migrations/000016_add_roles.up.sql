@@ -0,0 +1,22 @@
+CREATE TABLE roles
+(
+    name        VARCHAR(32) PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT ''
+);
+
+INSERT INTO roles (name, description)
+VALUES ('moderator', 'Can list and suspend users'),
+       ('admin', 'Full administrative access');
+
+-- Every account implicitly has the "user" role; only elevated roles are stored.
+CREATE TABLE user_roles
+(
+    user_id    BIGINT      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    role       VARCHAR(32) NOT NULL REFERENCES roles (name) ON DELETE CASCADE,
+    granted_at TIMESTAMP   NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, role)
+);
+
+ALTER TABLE users
+    ADD COLUMN suspended_at     TIMESTAMP,
+    ADD COLUMN suspended_reason TEXT NOT NULL DEFAULT '';
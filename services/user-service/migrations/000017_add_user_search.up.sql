@@ -0,0 +1,6 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Trigram indexes serve both the prefix (LIKE 'q%') and the similarity (%)
+-- conditions of SearchUsers, which compare lower-cased values.
+CREATE INDEX idx_users_nickname_trgm ON users USING gin (lower(nickname) gin_trgm_ops);
+CREATE INDEX idx_users_username_trgm ON users USING gin (lower(username) gin_trgm_ops);
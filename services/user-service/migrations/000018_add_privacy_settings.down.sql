@@ -0,0 +1,4 @@
+ALTER TABLE users
+    DROP COLUMN IF EXISTS bio_visibility,
+    DROP COLUMN IF EXISTS last_login_visibility,
+    DROP COLUMN IF EXISTS email_visibility;
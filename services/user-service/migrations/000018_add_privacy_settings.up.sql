@@ -0,0 +1,9 @@
+-- Who, besides the owner, may see each profile field in public responses:
+-- 'everyone' or 'only_me'. Email is private unless the owner opts in.
+ALTER TABLE users
+    ADD COLUMN email_visibility      VARCHAR(16) NOT NULL DEFAULT 'only_me'
+        CHECK (email_visibility IN ('everyone', 'only_me')),
+    ADD COLUMN last_login_visibility VARCHAR(16) NOT NULL DEFAULT 'everyone'
+        CHECK (last_login_visibility IN ('everyone', 'only_me')),
+    ADD COLUMN bio_visibility        VARCHAR(16) NOT NULL DEFAULT 'everyone'
+        CHECK (bio_visibility IN ('everyone', 'only_me'));
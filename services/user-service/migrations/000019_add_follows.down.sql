@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS user_follow_counts;
+
+DROP TABLE IF EXISTS follow_requests;
+
+DROP TABLE IF EXISTS follows;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS private_account;
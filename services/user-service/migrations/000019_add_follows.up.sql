@@ -0,0 +1,37 @@
+ALTER TABLE users
+    ADD COLUMN private_account BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE follows
+(
+    follower_id BIGINT    NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    followee_id BIGINT    NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at  TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (follower_id, followee_id),
+    CHECK (follower_id <> followee_id)
+);
+
+-- Serve ListFollowers and ListFollowing, newest first.
+CREATE INDEX idx_follows_followee ON follows (followee_id, created_at DESC, follower_id DESC);
+CREATE INDEX idx_follows_follower ON follows (follower_id, created_at DESC, followee_id DESC);
+
+-- Pending requests to follow private accounts.
+CREATE TABLE follow_requests
+(
+    requester_id BIGINT    NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    target_id    BIGINT    NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at   TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (requester_id, target_id),
+    CHECK (requester_id <> target_id)
+);
+
+CREATE INDEX idx_follow_requests_target ON follow_requests (target_id, created_at DESC, requester_id DESC);
+
+-- Denormalized follow counts, kept in step with follows by the repository.
+-- They live outside users so that following someone does not touch the
+-- profile row and its updated_at trigger.
+CREATE TABLE user_follow_counts
+(
+    user_id         BIGINT PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    follower_count  BIGINT NOT NULL DEFAULT 0 CHECK (follower_count >= 0),
+    following_count BIGINT NOT NULL DEFAULT 0 CHECK (following_count >= 0)
+);
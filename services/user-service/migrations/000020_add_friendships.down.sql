@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS friend_requests;
+
+DROP TABLE IF EXISTS friendships;
@@ -0,0 +1,25 @@
+-- Friendships are mutual and stored once per direction, so that listing a
+-- user's friends and joining friends of friends only needs user_id.
+CREATE TABLE friendships
+(
+    user_id    BIGINT    NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    friend_id  BIGINT    NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, friend_id),
+    CHECK (user_id <> friend_id)
+);
+
+-- Serve ListFriends, newest first.
+CREATE INDEX idx_friendships_user ON friendships (user_id, created_at DESC, friend_id DESC);
+
+-- Pending friend requests.
+CREATE TABLE friend_requests
+(
+    requester_id BIGINT    NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    addressee_id BIGINT    NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at   TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (requester_id, addressee_id),
+    CHECK (requester_id <> addressee_id)
+);
+
+CREATE INDEX idx_friend_requests_addressee ON friend_requests (addressee_id, created_at DESC, requester_id DESC);
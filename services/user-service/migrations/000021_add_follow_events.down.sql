@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_follows_followee_follower;
+
+ALTER TABLE user_events
+    DROP COLUMN IF EXISTS subject_user_id;
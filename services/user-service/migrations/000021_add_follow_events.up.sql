@@ -0,0 +1,6 @@
+-- Follow events name the followed user as well as the follower.
+ALTER TABLE user_events
+    ADD COLUMN subject_user_id BIGINT;
+
+-- Serve ListFollowerIDs in ascending follower order.
+CREATE INDEX idx_follows_followee_follower ON follows (followee_id, follower_id);
@@ -0,0 +1,8 @@
+-- Position of each service consuming user_events, so events are only pruned
+-- once every consumer has handled them.
+CREATE TABLE user_event_consumers
+(
+    consumer      VARCHAR(64) PRIMARY KEY,
+    last_event_id BIGINT      NOT NULL,
+    updated_at    TIMESTAMP   NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,3 @@
+ALTER TABLE users ADD CONSTRAINT users_email_key UNIQUE (email);
+
+DROP INDEX IF EXISTS users_email_lower_key;
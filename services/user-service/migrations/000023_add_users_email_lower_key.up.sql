@@ -0,0 +1,6 @@
+-- Emails are matched case-insensitively: providers report them lower-cased
+-- while users may register with any casing. Fails if existing accounts differ
+-- only in the case of their email; merge or rename those first.
+CREATE UNIQUE INDEX users_email_lower_key ON users (lower(email));
+
+ALTER TABLE users DROP CONSTRAINT users_email_key;